@@ -461,3 +461,25 @@ func TestDeleteAll(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, doc)
 }
+
+func TestSchema(t *testing.T) {
+	db, closeFn := testDB(t)
+	defer closeFn()
+	ctx := context.TODO()
+
+	schema, err := db.Schema(ctx)
+	require.NoError(t, err)
+	require.Nil(t, schema)
+
+	err = db.SetSchema(ctx, &Schema{Version: 2, Build: "1.2.3"})
+	require.NoError(t, err)
+
+	schema, err = db.Schema(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Schema{Version: 2, Build: "1.2.3"}, schema)
+
+	err = db.Set(ctx, schemaPath, []byte("invalid"))
+	require.NoError(t, err)
+	_, err = db.Schema(ctx)
+	require.EqualError(t, err, "invalid schema: invalid character 'i' looking for beginning of value")
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keys-pub/keys/ds"
+	"github.com/pkg/errors"
+)
+
+// schemaPath is the document with the Schema.
+var schemaPath = ds.Path("schema", "version")
+
+// Schema describes the format of the data in the db.
+type Schema struct {
+	// Version of the schema, incremented for each migration.
+	Version int `json:"version"`
+	// Build that last wrote the schema, for debugging.
+	Build string `json:"build,omitempty"`
+}
+
+// Schema returns the schema, or nil if not set (a new db, or one written
+// before schema versioning).
+func (d *DB) Schema(ctx context.Context) (*Schema, error) {
+	doc, err := d.Get(ctx, schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, nil
+	}
+	var schema Schema
+	if err := json.Unmarshal(doc.Data, &schema); err != nil {
+		return nil, errors.Wrapf(err, "invalid schema")
+	}
+	return &schema, nil
+}
+
+// SetSchema sets the schema.
+func (d *DB) SetSchema(ctx context.Context, schema *Schema) error {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	return d.Set(ctx, schemaPath, b)
+}
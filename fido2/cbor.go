@@ -0,0 +1,177 @@
+package fido2
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// Minimal CBOR (RFC 7049) decoding, only what we need for authenticator data
+// (COSE keys and extensions). Maps decode to map[interface{}]interface{} with
+// int64 or string keys, integers decode to int64.
+
+const cborMaxDepth = 16
+
+type cborDecoder struct {
+	b     []byte
+	off   int
+	depth int
+}
+
+// cborDecode decodes the first CBOR item in b, returning the number of bytes
+// read.
+func cborDecode(b []byte) (interface{}, int, error) {
+	d := &cborDecoder{b: b}
+	v, err := d.decode()
+	if err != nil {
+		return nil, 0, err
+	}
+	return v, d.off, nil
+}
+
+// cborDecodeBytes decodes b, which must be a single CBOR byte string.
+func cborDecodeBytes(b []byte) ([]byte, error) {
+	v, n, err := cborDecode(b)
+	if err != nil {
+		return nil, err
+	}
+	bs, ok := v.([]byte)
+	if !ok || n != len(b) {
+		return nil, errors.Errorf("cbor: not a byte string")
+	}
+	return bs, nil
+}
+
+func (d *cborDecoder) next(n uint64) ([]byte, error) {
+	if n > uint64(len(d.b)-d.off) {
+		return nil, errors.Errorf("cbor: unexpected end of data")
+	}
+	b := d.b[d.off : d.off+int(n)]
+	d.off += int(n)
+	return b, nil
+}
+
+func (d *cborDecoder) head() (byte, byte, uint64, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	switch {
+	case info < 24:
+		return major, info, uint64(info), nil
+	case info == 24:
+		b, err := d.next(1)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		return major, info, uint64(b[0]), nil
+	case info == 25:
+		b, err := d.next(2)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		return major, info, uint64(binary.BigEndian.Uint16(b)), nil
+	case info == 26:
+		b, err := d.next(4)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		return major, info, uint64(binary.BigEndian.Uint32(b)), nil
+	case info == 27:
+		b, err := d.next(8)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		return major, info, binary.BigEndian.Uint64(b), nil
+	default:
+		return 0, 0, 0, errors.Errorf("cbor: unsupported additional info %d", info)
+	}
+}
+
+func (d *cborDecoder) decode() (interface{}, error) {
+	d.depth++
+	defer func() { d.depth-- }()
+	if d.depth > cborMaxDepth {
+		return nil, errors.Errorf("cbor: max depth exceeded")
+	}
+
+	major, info, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0:
+		if n > 1<<63-1 {
+			return nil, errors.Errorf("cbor: integer overflow")
+		}
+		return int64(n), nil
+	case 1:
+		if n > 1<<63-1 {
+			return nil, errors.Errorf("cbor: integer overflow")
+		}
+		return -1 - int64(n), nil
+	case 2:
+		b, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, b...), nil
+	case 3:
+		b, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case 4:
+		if n > uint64(len(d.b)-d.off) {
+			return nil, errors.Errorf("cbor: unexpected end of data")
+		}
+		arr := make([]interface{}, 0, n)
+		for i := uint64(0); i < n; i++ {
+			v, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case 5:
+		if n > uint64(len(d.b)-d.off) {
+			return nil, errors.Errorf("cbor: unexpected end of data")
+		}
+		m := make(map[interface{}]interface{}, n)
+		for i := uint64(0); i < n; i++ {
+			k, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			switch k.(type) {
+			case int64, string:
+			default:
+				return nil, errors.Errorf("cbor: unsupported map key")
+			}
+			if _, ok := m[k]; ok {
+				return nil, errors.Errorf("cbor: duplicate map key")
+			}
+			v, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	case 7:
+		switch info {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		}
+		return nil, errors.Errorf("cbor: unsupported simple value %d", info)
+	default:
+		return nil, errors.Errorf("cbor: unsupported major type %d", major)
+	}
+}
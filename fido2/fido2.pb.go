@@ -543,6 +543,57 @@ func (m *CredentialsInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CredentialsInfo proto.InternalMessageInfo
 
+// AuthData is parsed authenticator data.
+type AuthData struct {
+	RPIDHash  []byte `protobuf:"bytes,1,opt,name=rpIdHash,proto3" json:"rpIdHash,omitempty"`
+	Flags     uint32 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	UP        bool   `protobuf:"varint,3,opt,name=userPresent,proto3" json:"userPresent,omitempty"`
+	UV        bool   `protobuf:"varint,4,opt,name=userVerified,proto3" json:"userVerified,omitempty"`
+	SignCount uint32 `protobuf:"varint,5,opt,name=signCount,proto3" json:"signCount,omitempty"`
+	// Attested credential data (attestation only)
+	AAGUID               []byte   `protobuf:"bytes,10,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	CredID               []byte   `protobuf:"bytes,11,opt,name=credId,proto3" json:"credId,omitempty"`
+	CredType             string   `protobuf:"bytes,12,opt,name=credType,proto3" json:"credType,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,13,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Extensions           []string `protobuf:"bytes,20,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthData) Reset()         { *m = AuthData{} }
+func (m *AuthData) String() string { return proto.CompactTextString(m) }
+func (*AuthData) ProtoMessage()    {}
+func (*AuthData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05bb79244b7f5be6, []int{10}
+}
+func (m *AuthData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthData.Merge(m, src)
+}
+func (m *AuthData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthData proto.InternalMessageInfo
+
 type DevicesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -553,7 +604,7 @@ func (m *DevicesRequest) Reset()         { *m = DevicesRequest{} }
 func (m *DevicesRequest) String() string { return proto.CompactTextString(m) }
 func (*DevicesRequest) ProtoMessage()    {}
 func (*DevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{10}
+	return fileDescriptor_05bb79244b7f5be6, []int{11}
 }
 func (m *DevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +644,7 @@ func (m *DevicesResponse) Reset()         { *m = DevicesResponse{} }
 func (m *DevicesResponse) String() string { return proto.CompactTextString(m) }
 func (*DevicesResponse) ProtoMessage()    {}
 func (*DevicesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{11}
+	return fileDescriptor_05bb79244b7f5be6, []int{12}
 }
 func (m *DevicesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +684,7 @@ func (m *DeviceInfoRequest) Reset()         { *m = DeviceInfoRequest{} }
 func (m *DeviceInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*DeviceInfoRequest) ProtoMessage()    {}
 func (*DeviceInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{12}
+	return fileDescriptor_05bb79244b7f5be6, []int{13}
 }
 func (m *DeviceInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +724,7 @@ func (m *DeviceInfoResponse) Reset()         { *m = DeviceInfoResponse{} }
 func (m *DeviceInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*DeviceInfoResponse) ProtoMessage()    {}
 func (*DeviceInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{13}
+	return fileDescriptor_05bb79244b7f5be6, []int{14}
 }
 func (m *DeviceInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +772,7 @@ func (m *MakeCredentialRequest) Reset()         { *m = MakeCredentialRequest{} }
 func (m *MakeCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*MakeCredentialRequest) ProtoMessage()    {}
 func (*MakeCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{14}
+	return fileDescriptor_05bb79244b7f5be6, []int{15}
 }
 func (m *MakeCredentialRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +812,7 @@ func (m *MakeCredentialResponse) Reset()         { *m = MakeCredentialResponse{}
 func (m *MakeCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*MakeCredentialResponse) ProtoMessage()    {}
 func (*MakeCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{15}
+	return fileDescriptor_05bb79244b7f5be6, []int{16}
 }
 func (m *MakeCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +854,7 @@ func (m *SetPINRequest) Reset()         { *m = SetPINRequest{} }
 func (m *SetPINRequest) String() string { return proto.CompactTextString(m) }
 func (*SetPINRequest) ProtoMessage()    {}
 func (*SetPINRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{16}
+	return fileDescriptor_05bb79244b7f5be6, []int{17}
 }
 func (m *SetPINRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,7 +893,7 @@ func (m *SetPINResponse) Reset()         { *m = SetPINResponse{} }
 func (m *SetPINResponse) String() string { return proto.CompactTextString(m) }
 func (*SetPINResponse) ProtoMessage()    {}
 func (*SetPINResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{17}
+	return fileDescriptor_05bb79244b7f5be6, []int{18}
 }
 func (m *SetPINResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,7 +933,7 @@ func (m *ResetRequest) Reset()         { *m = ResetRequest{} }
 func (m *ResetRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetRequest) ProtoMessage()    {}
 func (*ResetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{18}
+	return fileDescriptor_05bb79244b7f5be6, []int{19}
 }
 func (m *ResetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,7 +972,7 @@ func (m *ResetResponse) Reset()         { *m = ResetResponse{} }
 func (m *ResetResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetResponse) ProtoMessage()    {}
 func (*ResetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{19}
+	return fileDescriptor_05bb79244b7f5be6, []int{20}
 }
 func (m *ResetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,7 +1012,7 @@ func (m *RetryCountRequest) Reset()         { *m = RetryCountRequest{} }
 func (m *RetryCountRequest) String() string { return proto.CompactTextString(m) }
 func (*RetryCountRequest) ProtoMessage()    {}
 func (*RetryCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{20}
+	return fileDescriptor_05bb79244b7f5be6, []int{21}
 }
 func (m *RetryCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,7 +1052,7 @@ func (m *RetryCountResponse) Reset()         { *m = RetryCountResponse{} }
 func (m *RetryCountResponse) String() string { return proto.CompactTextString(m) }
 func (*RetryCountResponse) ProtoMessage()    {}
 func (*RetryCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{21}
+	return fileDescriptor_05bb79244b7f5be6, []int{22}
 }
 func (m *RetryCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1049,7 +1100,7 @@ func (m *AssertionRequest) Reset()         { *m = AssertionRequest{} }
 func (m *AssertionRequest) String() string { return proto.CompactTextString(m) }
 func (*AssertionRequest) ProtoMessage()    {}
 func (*AssertionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{22}
+	return fileDescriptor_05bb79244b7f5be6, []int{23}
 }
 func (m *AssertionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1140,7 @@ func (m *AssertionResponse) Reset()         { *m = AssertionResponse{} }
 func (m *AssertionResponse) String() string { return proto.CompactTextString(m) }
 func (*AssertionResponse) ProtoMessage()    {}
 func (*AssertionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{23}
+	return fileDescriptor_05bb79244b7f5be6, []int{24}
 }
 func (m *AssertionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,7 +1181,7 @@ func (m *CredentialsInfoRequest) Reset()         { *m = CredentialsInfoRequest{}
 func (m *CredentialsInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*CredentialsInfoRequest) ProtoMessage()    {}
 func (*CredentialsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{24}
+	return fileDescriptor_05bb79244b7f5be6, []int{25}
 }
 func (m *CredentialsInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1221,7 @@ func (m *CredentialsInfoResponse) Reset()         { *m = CredentialsInfoResponse
 func (m *CredentialsInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*CredentialsInfoResponse) ProtoMessage()    {}
 func (*CredentialsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{25}
+	return fileDescriptor_05bb79244b7f5be6, []int{26}
 }
 func (m *CredentialsInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,7 +1263,7 @@ func (m *CredentialsRequest) Reset()         { *m = CredentialsRequest{} }
 func (m *CredentialsRequest) String() string { return proto.CompactTextString(m) }
 func (*CredentialsRequest) ProtoMessage()    {}
 func (*CredentialsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{26}
+	return fileDescriptor_05bb79244b7f5be6, []int{27}
 }
 func (m *CredentialsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1252,7 +1303,7 @@ func (m *CredentialsResponse) Reset()         { *m = CredentialsResponse{} }
 func (m *CredentialsResponse) String() string { return proto.CompactTextString(m) }
 func (*CredentialsResponse) ProtoMessage()    {}
 func (*CredentialsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{27}
+	return fileDescriptor_05bb79244b7f5be6, []int{28}
 }
 func (m *CredentialsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1344,7 @@ func (m *RelyingPartiesRequest) Reset()         { *m = RelyingPartiesRequest{} }
 func (m *RelyingPartiesRequest) String() string { return proto.CompactTextString(m) }
 func (*RelyingPartiesRequest) ProtoMessage()    {}
 func (*RelyingPartiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{28}
+	return fileDescriptor_05bb79244b7f5be6, []int{29}
 }
 func (m *RelyingPartiesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1384,7 @@ func (m *RelyingPartiesResponse) Reset()         { *m = RelyingPartiesResponse{}
 func (m *RelyingPartiesResponse) String() string { return proto.CompactTextString(m) }
 func (*RelyingPartiesResponse) ProtoMessage()    {}
 func (*RelyingPartiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05bb79244b7f5be6, []int{29}
+	return fileDescriptor_05bb79244b7f5be6, []int{30}
 }
 func (m *RelyingPartiesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,6 +1413,186 @@ func (m *RelyingPartiesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RelyingPartiesResponse proto.InternalMessageInfo
 
+type VerifyAttestationRequest struct {
+	Attestation *Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	RPID        string       `protobuf:"bytes,2,opt,name=rpId,proto3" json:"rpId,omitempty"`
+	// Roots (DER) to verify the attestation certificate with. If not
+	// specified, the certificate chain isn't verified (and not trusted).
+	Roots                [][]byte `protobuf:"bytes,100,rep,name=roots,proto3" json:"roots,omitempty"`
+	Intermediates        [][]byte `protobuf:"bytes,101,rep,name=intermediates,proto3" json:"intermediates,omitempty"`
+	RequireUV            bool     `protobuf:"varint,102,opt,name=requireUv,proto3" json:"requireUv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyAttestationRequest) Reset()         { *m = VerifyAttestationRequest{} }
+func (m *VerifyAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyAttestationRequest) ProtoMessage()    {}
+func (*VerifyAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05bb79244b7f5be6, []int{31}
+}
+func (m *VerifyAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAttestationRequest.Merge(m, src)
+}
+func (m *VerifyAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAttestationRequest proto.InternalMessageInfo
+
+type VerifyAttestationResponse struct {
+	AuthData *AuthData `protobuf:"bytes,1,opt,name=authData,proto3" json:"authData,omitempty"`
+	// Type is the attestation type: basic, self or none.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Trusted if the attestation certificate chain was verified with roots.
+	Trusted              bool     `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyAttestationResponse) Reset()         { *m = VerifyAttestationResponse{} }
+func (m *VerifyAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyAttestationResponse) ProtoMessage()    {}
+func (*VerifyAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05bb79244b7f5be6, []int{32}
+}
+func (m *VerifyAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAttestationResponse.Merge(m, src)
+}
+func (m *VerifyAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAttestationResponse proto.InternalMessageInfo
+
+type VerifyAssertionRequest struct {
+	Assertion      *Assertion `protobuf:"bytes,1,opt,name=assertion,proto3" json:"assertion,omitempty"`
+	RPID           string     `protobuf:"bytes,2,opt,name=rpId,proto3" json:"rpId,omitempty"`
+	ClientDataHash []byte     `protobuf:"bytes,3,opt,name=clientDataHash,proto3" json:"clientDataHash,omitempty"`
+	// CredType and PubKey are from the attestation (as stored by the relying
+	// party).
+	CredType string `protobuf:"bytes,4,opt,name=credType,proto3" json:"credType,omitempty"`
+	PubKey   []byte `protobuf:"bytes,5,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	// SignCount is the last (stored) sign count.
+	SignCount            uint32   `protobuf:"varint,100,opt,name=signCount,proto3" json:"signCount,omitempty"`
+	RequireUV            bool     `protobuf:"varint,101,opt,name=requireUv,proto3" json:"requireUv,omitempty"`
+	AllowNoUP            bool     `protobuf:"varint,102,opt,name=allowNoUp,proto3" json:"allowNoUp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyAssertionRequest) Reset()         { *m = VerifyAssertionRequest{} }
+func (m *VerifyAssertionRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyAssertionRequest) ProtoMessage()    {}
+func (*VerifyAssertionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05bb79244b7f5be6, []int{33}
+}
+func (m *VerifyAssertionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyAssertionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyAssertionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyAssertionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAssertionRequest.Merge(m, src)
+}
+func (m *VerifyAssertionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyAssertionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAssertionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAssertionRequest proto.InternalMessageInfo
+
+type VerifyAssertionResponse struct {
+	AuthData             *AuthData `protobuf:"bytes,1,opt,name=authData,proto3" json:"authData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *VerifyAssertionResponse) Reset()         { *m = VerifyAssertionResponse{} }
+func (m *VerifyAssertionResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyAssertionResponse) ProtoMessage()    {}
+func (*VerifyAssertionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05bb79244b7f5be6, []int{34}
+}
+func (m *VerifyAssertionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyAssertionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyAssertionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyAssertionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAssertionResponse.Merge(m, src)
+}
+func (m *VerifyAssertionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyAssertionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAssertionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAssertionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("fido2.DeviceType", DeviceType_name, DeviceType_value)
 	proto.RegisterEnum("fido2.CredentialType", CredentialType_name, CredentialType_value)
@@ -1376,6 +1607,7 @@ func init() {
 	proto.RegisterType((*Credential)(nil), "fido2.Credential")
 	proto.RegisterType((*Assertion)(nil), "fido2.Assertion")
 	proto.RegisterType((*CredentialsInfo)(nil), "fido2.CredentialsInfo")
+	proto.RegisterType((*AuthData)(nil), "fido2.AuthData")
 	proto.RegisterType((*DevicesRequest)(nil), "fido2.DevicesRequest")
 	proto.RegisterType((*DevicesResponse)(nil), "fido2.DevicesResponse")
 	proto.RegisterType((*DeviceInfoRequest)(nil), "fido2.DeviceInfoRequest")
@@ -1396,111 +1628,133 @@ func init() {
 	proto.RegisterType((*CredentialsResponse)(nil), "fido2.CredentialsResponse")
 	proto.RegisterType((*RelyingPartiesRequest)(nil), "fido2.RelyingPartiesRequest")
 	proto.RegisterType((*RelyingPartiesResponse)(nil), "fido2.RelyingPartiesResponse")
+	proto.RegisterType((*VerifyAttestationRequest)(nil), "fido2.VerifyAttestationRequest")
+	proto.RegisterType((*VerifyAttestationResponse)(nil), "fido2.VerifyAttestationResponse")
+	proto.RegisterType((*VerifyAssertionRequest)(nil), "fido2.VerifyAssertionRequest")
+	proto.RegisterType((*VerifyAssertionResponse)(nil), "fido2.VerifyAssertionResponse")
 }
 
 func init() { proto.RegisterFile("fido2.proto", fileDescriptor_05bb79244b7f5be6) }
 
 var fileDescriptor_05bb79244b7f5be6 = []byte{
-	// 1577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xbd, 0x6f, 0x1b, 0x47,
-	0x16, 0xe7, 0x2e, 0x3f, 0x24, 0x3e, 0x52, 0x14, 0x39, 0x96, 0xe8, 0xf5, 0xc2, 0x26, 0x79, 0x0b,
-	0xd8, 0x27, 0x48, 0xb0, 0x0e, 0x47, 0xfb, 0xee, 0x00, 0x5f, 0x73, 0x14, 0x49, 0xdb, 0xb4, 0xce,
-	0x12, 0x31, 0x92, 0x7c, 0xc5, 0x15, 0xc6, 0x9a, 0x1c, 0x51, 0x0b, 0x51, 0xbb, 0xbc, 0xdd, 0x59,
-	0x9d, 0xf5, 0x0f, 0x04, 0x81, 0x9a, 0x54, 0x29, 0x52, 0xa8, 0x8a, 0x9b, 0x94, 0x69, 0xd3, 0x07,
-	0x70, 0x99, 0x32, 0x15, 0x11, 0xb3, 0x49, 0xca, 0xfc, 0x09, 0xc1, 0x7c, 0xec, 0x07, 0x3f, 0x14,
-	0x21, 0x76, 0xb7, 0xef, 0xf7, 0xde, 0xbc, 0xf9, 0xcd, 0x9b, 0x37, 0xbf, 0x99, 0x85, 0xdc, 0xb1,
-	0xd5, 0x77, 0xea, 0xdb, 0x23, 0xd7, 0xa1, 0x0e, 0x4a, 0x73, 0x43, 0x5f, 0x1b, 0x38, 0x03, 0x87,
-	0x23, 0x7f, 0x61, 0x5f, 0xc2, 0x69, 0x60, 0x58, 0xc6, 0xdd, 0x66, 0xdb, 0x75, 0x1d, 0x17, 0x21,
-	0x48, 0xf5, 0x9c, 0x3e, 0xd1, 0x94, 0x9a, 0xb2, 0x91, 0xc6, 0xfc, 0x1b, 0x69, 0xb0, 0x74, 0x46,
-	0x3c, 0xcf, 0x1c, 0x10, 0x4d, 0xad, 0x29, 0x1b, 0x59, 0x1c, 0x98, 0xcc, 0xd3, 0x27, 0xd4, 0xb4,
-	0x86, 0x9e, 0x96, 0x14, 0x1e, 0x69, 0x1a, 0xdf, 0x2a, 0x90, 0x69, 0x91, 0x73, 0xab, 0x47, 0x58,
-	0xca, 0x91, 0x49, 0x4f, 0x78, 0xca, 0x2c, 0xe6, 0xdf, 0x68, 0x0b, 0xb2, 0x23, 0xd7, 0xe9, 0xfb,
-	0x3d, 0xda, 0xe9, 0xf3, 0xa4, 0xe9, 0x9d, 0x95, 0xc9, 0xb8, 0x9a, 0xed, 0x4a, 0xb0, 0x85, 0x23,
-	0x3f, 0xda, 0x80, 0xe5, 0x73, 0x62, 0xf7, 0x1d, 0xb7, 0xd3, 0xe7, 0xd3, 0xa4, 0x77, 0xf2, 0x93,
-	0x71, 0x75, 0xf9, 0x95, 0xc0, 0x5a, 0x38, 0xf4, 0x22, 0x03, 0xf2, 0x67, 0xa6, 0xed, 0x1f, 0x9b,
-	0x3d, 0xea, 0xbb, 0xc4, 0xd5, 0x52, 0x7c, 0xca, 0x29, 0x8c, 0x71, 0x96, 0xa9, 0xb5, 0xb4, 0xe0,
-	0x2c, 0x4d, 0xa3, 0x0e, 0x99, 0xfd, 0x11, 0xb5, 0x1c, 0x9b, 0x51, 0xb6, 0xcd, 0x33, 0x12, 0x50,
-	0x66, 0xdf, 0x68, 0x0d, 0xd2, 0xe7, 0xe6, 0xd0, 0x0f, 0x6a, 0x20, 0x0c, 0xe3, 0x4b, 0x05, 0x40,
-	0xac, 0xb3, 0x63, 0x1f, 0x3b, 0x48, 0x67, 0x54, 0x5d, 0xcf, 0x72, 0x6c, 0x4f, 0x53, 0x6a, 0xc9,
-	0x8d, 0x2c, 0x0e, 0x6d, 0x54, 0x01, 0x20, 0x6f, 0x29, 0xb1, 0x85, 0x57, 0xe5, 0xde, 0x18, 0x82,
-	0x0c, 0xc8, 0x98, 0xe6, 0xc0, 0xb7, 0xc4, 0x22, 0xf3, 0x3b, 0x30, 0x19, 0x57, 0x33, 0x8d, 0xc6,
-	0xb3, 0xa3, 0x4e, 0x0b, 0x4b, 0x0f, 0xfa, 0x33, 0x2c, 0x39, 0x9c, 0xa2, 0xa7, 0xa5, 0x6a, 0xc9,
-	0x8d, 0x5c, 0x7d, 0x65, 0x5b, 0x6c, 0xb3, 0x20, 0x8e, 0x03, 0xaf, 0xf1, 0x04, 0xf2, 0x98, 0x0c,
-	0x2f, 0x2c, 0x7b, 0xd0, 0x35, 0x5d, 0x7a, 0x81, 0xca, 0xa0, 0x5a, 0x7d, 0xb1, 0x9e, 0x9d, 0xcc,
-	0x64, 0x5c, 0x55, 0x3b, 0x2d, 0xac, 0x5a, 0xfd, 0x70, 0xa5, 0x6a, 0xb4, 0x52, 0xe3, 0x04, 0x52,
-	0x47, 0x1e, 0x71, 0x63, 0x63, 0xf2, 0x37, 0x8d, 0x41, 0x35, 0xc8, 0xf5, 0x2d, 0x6f, 0x34, 0x34,
-	0x2f, 0xf6, 0x98, 0x4b, 0x74, 0x43, 0x1c, 0x62, 0xa3, 0xac, 0x9e, 0x63, 0xcb, 0x3d, 0xe1, 0xdf,
-	0xc6, 0xcf, 0x0a, 0xe4, 0x1a, 0x94, 0x12, 0x8f, 0x9a, 0xbc, 0xee, 0x0f, 0xa0, 0xd0, 0x1b, 0x5a,
-	0xc4, 0xa6, 0x2d, 0x93, 0x9a, 0xcf, 0x4d, 0x4f, 0x34, 0x4d, 0x1e, 0xcf, 0xa0, 0xac, 0xcc, 0xa6,
-	0x4f, 0x4f, 0x98, 0xcd, 0x59, 0xe4, 0x71, 0x68, 0xb3, 0x32, 0xf6, 0x5c, 0xd2, 0xef, 0x4c, 0x95,
-	0xb1, 0xc9, 0x90, 0x16, 0x96, 0x1e, 0x36, 0x9e, 0x7d, 0x1d, 0x5e, 0x8c, 0x88, 0xe4, 0x13, 0xda,
-	0xa8, 0x0c, 0x99, 0x91, 0xff, 0x66, 0x97, 0x5c, 0xf0, 0xf6, 0xc8, 0x63, 0x69, 0xf1, 0x93, 0x41,
-	0x5c, 0xaa, 0x65, 0x38, 0xca, 0xbf, 0x51, 0x11, 0x92, 0x9e, 0x35, 0xd0, 0x96, 0x38, 0xc4, 0x3e,
-	0xd9, 0xe8, 0x63, 0xc7, 0x3d, 0x33, 0xa9, 0xb6, 0xcc, 0xf3, 0x4a, 0xcb, 0xf8, 0x4c, 0x01, 0x60,
-	0x24, 0x88, 0x4d, 0x2d, 0x73, 0xf8, 0x7b, 0xa5, 0xa5, 0x8c, 0x94, 0x2c, 0x2d, 0xfb, 0x46, 0x5b,
-	0xa0, 0xba, 0x23, 0x0d, 0x6a, 0xca, 0x46, 0xae, 0x7e, 0x4b, 0x6e, 0x77, 0x7c, 0x6f, 0x45, 0x02,
-	0xdc, 0xc5, 0xaa, 0x3b, 0x42, 0x55, 0x48, 0xf9, 0x1e, 0x71, 0xb5, 0x1c, 0x0f, 0xcf, 0xc9, 0x70,
-	0xb6, 0x9d, 0x98, 0x3b, 0x0c, 0x0b, 0xb2, 0x0d, 0xcf, 0x23, 0x2e, 0xaf, 0x77, 0xbc, 0x8e, 0xca,
-	0x4c, 0x1d, 0xe5, 0xda, 0xd4, 0x68, 0x6d, 0xdb, 0x00, 0x27, 0x67, 0x66, 0xef, 0x80, 0xf4, 0x5c,
-	0x42, 0x65, 0x75, 0x0b, 0x93, 0x71, 0x15, 0x9e, 0xbf, 0x6c, 0x34, 0x05, 0x8a, 0x63, 0x11, 0x06,
-	0x85, 0xd5, 0x68, 0xc9, 0x1e, 0x3f, 0x1f, 0xdb, 0x00, 0xee, 0x69, 0xfb, 0xad, 0xe5, 0x51, 0xcb,
-	0x1e, 0x08, 0x91, 0x11, 0x29, 0xf0, 0x6e, 0x80, 0xe2, 0x58, 0x04, 0xfa, 0x2b, 0xe4, 0xdc, 0x53,
-	0x4c, 0xce, 0x4c, 0xcb, 0x66, 0x03, 0x84, 0x52, 0xac, 0x4e, 0xc6, 0xd5, 0x1c, 0xde, 0x0d, 0x61,
-	0x1c, 0x8f, 0x31, 0x8a, 0x50, 0x10, 0x07, 0xd2, 0xc3, 0xe4, 0x7f, 0x3e, 0xf1, 0xa8, 0xf1, 0x04,
-	0x56, 0x43, 0xc4, 0x1b, 0x39, 0xb6, 0x47, 0xd8, 0x39, 0xea, 0x0b, 0x88, 0x1f, 0xd3, 0xe8, 0x1c,
-	0x89, 0x40, 0x1c, 0x78, 0x8d, 0x2d, 0x28, 0x45, 0xc7, 0x5b, 0x26, 0x64, 0x9b, 0x2c, 0xfc, 0x52,
-	0x20, 0xa4, 0x65, 0xfc, 0x13, 0x50, 0x3c, 0x58, 0xce, 0x75, 0x1f, 0x52, 0x96, 0x7d, 0xec, 0xf0,
-	0xd8, 0x5c, 0xbd, 0x34, 0x35, 0x11, 0x0f, 0xe4, 0x6e, 0xe3, 0x9d, 0x0a, 0xeb, 0x2f, 0xcd, 0x53,
-	0x12, 0x95, 0xec, 0x86, 0xe9, 0x16, 0x9c, 0x16, 0x75, 0xe1, 0x69, 0x11, 0x0d, 0x94, 0xfc, 0x63,
-	0x0d, 0x94, 0xba, 0xa6, 0x81, 0xc2, 0x16, 0x4d, 0xc7, 0x5a, 0xf4, 0x0e, 0x24, 0x47, 0x96, 0xcd,
-	0x8f, 0x46, 0x76, 0x67, 0x69, 0x32, 0xae, 0x26, 0xbb, 0x9d, 0x3d, 0xcc, 0xb0, 0x19, 0xd5, 0xeb,
-	0xcf, 0xa9, 0x5e, 0x19, 0x54, 0xf7, 0x54, 0x23, 0x91, 0x30, 0xe1, 0x5d, 0xac, 0xba, 0xa7, 0x0c,
-	0xf7, 0xcf, 0xb5, 0xe3, 0x08, 0x3f, 0x7a, 0x85, 0x55, 0xff, 0xdc, 0xd8, 0x83, 0xf2, 0x6c, 0x95,
-	0x64, 0x9d, 0x1f, 0x43, 0xce, 0x8c, 0xb4, 0x44, 0x96, 0x1b, 0xc9, 0x05, 0xc4, 0x54, 0x06, 0xc7,
-	0xc3, 0x8c, 0x63, 0x58, 0x39, 0x20, 0x94, 0xd1, 0xbd, 0xa1, 0xda, 0x72, 0x8d, 0xea, 0x82, 0x35,
-	0x1a, 0x90, 0x71, 0x86, 0xfd, 0xae, 0x65, 0x0b, 0xdd, 0x13, 0x92, 0xb3, 0x3f, 0xec, 0xb3, 0x00,
-	0xe9, 0x61, 0x6d, 0x19, 0xcc, 0x23, 0xf8, 0x1a, 0x0f, 0x98, 0x44, 0x7b, 0x84, 0xde, 0xd4, 0x55,
-	0xab, 0xb0, 0x22, 0xe3, 0xe4, 0xc0, 0x2d, 0x28, 0x61, 0x42, 0xdd, 0x8b, 0xa6, 0xe3, 0xdb, 0x37,
-	0x8e, 0xde, 0x04, 0x14, 0x0f, 0x96, 0xb5, 0x5a, 0x83, 0x74, 0x8f, 0x01, 0xf2, 0x9e, 0x17, 0x86,
-	0xf1, 0x8d, 0x0a, 0xc5, 0x50, 0x1c, 0x6e, 0xaa, 0xc7, 0x5d, 0x48, 0xb9, 0x23, 0x79, 0x7b, 0x67,
-	0x77, 0x96, 0x27, 0xe3, 0x6a, 0x0a, 0x77, 0x3b, 0x2d, 0xcc, 0xd1, 0x05, 0xbd, 0x99, 0x5c, 0xd8,
-	0x9b, 0x91, 0x5a, 0xa7, 0xae, 0x55, 0x6b, 0x59, 0xf9, 0xf4, 0xc7, 0x75, 0x97, 0x7f, 0x1e, 0xef,
-	0x2e, 0xd1, 0x45, 0x1c, 0x1f, 0x4d, 0x75, 0x57, 0x17, 0xab, 0xfe, 0x88, 0x3d, 0x35, 0xb8, 0x80,
-	0x99, 0x43, 0xaa, 0x0d, 0x38, 0x21, 0xfe, 0xd4, 0xe0, 0x02, 0x67, 0x0e, 0x29, 0x0e, 0xbd, 0x46,
-	0x13, 0x4a, 0xb1, 0x52, 0xc9, 0xb2, 0x6e, 0x43, 0xd6, 0x0c, 0x40, 0xd9, 0x80, 0xc5, 0xa0, 0x01,
-	0xc3, 0xe0, 0x28, 0xc4, 0xd8, 0x85, 0xf2, 0x8c, 0x42, 0x7e, 0x7c, 0x17, 0x1a, 0x6d, 0xb8, 0x3d,
-	0x97, 0x4c, 0xf2, 0xda, 0x9c, 0x92, 0xa0, 0xb2, 0xa4, 0x34, 0x1b, 0x2d, 0x74, 0x88, 0x00, 0x8a,
-	0x39, 0x3e, 0xad, 0x0b, 0x24, 0xdb, 0xe4, 0x02, 0xb6, 0x2f, 0xe0, 0xd6, 0xd4, 0x34, 0x92, 0xe9,
-	0x23, 0xc8, 0xf5, 0x22, 0x58, 0x8a, 0x73, 0x69, 0x8e, 0x30, 0x8e, 0x47, 0x19, 0x2f, 0x60, 0x3d,
-	0xa6, 0x67, 0x16, 0xf1, 0x3e, 0xa1, 0x8a, 0xcf, 0xa0, 0x3c, 0x9b, 0x4b, 0x52, 0x7b, 0x08, 0x4b,
-	0x23, 0x01, 0x49, 0x5a, 0x8b, 0xb4, 0x14, 0x07, 0x31, 0x9b, 0xff, 0x0d, 0x1e, 0x86, 0xfc, 0x55,
-	0x71, 0x1f, 0x0a, 0x47, 0x7b, 0xbb, 0x7b, 0xfb, 0xff, 0xd9, 0x7b, 0xdd, 0x6a, 0xbf, 0xea, 0x34,
-	0xdb, 0xc5, 0x84, 0x5e, 0xba, 0xbc, 0xaa, 0xad, 0x1c, 0xd9, 0xa7, 0xb6, 0xf3, 0x7f, 0x5b, 0xbe,
-	0x95, 0x97, 0x20, 0x79, 0x54, 0x7f, 0x5a, 0x54, 0x50, 0x16, 0xd2, 0x4f, 0x3b, 0xad, 0xfd, 0x7a,
-	0x51, 0xd5, 0xd1, 0xe7, 0x5f, 0x57, 0x12, 0xdf, 0xbd, 0xab, 0xc4, 0xd2, 0x6d, 0x7e, 0xa5, 0x40,
-	0x21, 0xaa, 0x06, 0x9f, 0xe1, 0x21, 0xa0, 0x60, 0x86, 0x26, 0x6e, 0xb7, 0xda, 0x7b, 0x87, 0x9d,
-	0xc6, 0xbf, 0x8b, 0x09, 0x7d, 0xfd, 0xf2, 0xaa, 0x56, 0x92, 0xb3, 0xc4, 0x5e, 0x20, 0x6b, 0x90,
-	0x6e, 0x1f, 0xd4, 0xff, 0xf6, 0xf7, 0xa2, 0xa2, 0x67, 0x2f, 0xaf, 0x6a, 0xc2, 0xe0, 0x68, 0xab,
-	0x75, 0xd0, 0x28, 0xaa, 0x12, 0x65, 0x06, 0x43, 0x31, 0x8f, 0x4d, 0x0a, 0x94, 0x1b, 0x7a, 0x59,
-	0xf2, 0x9a, 0x21, 0xb2, 0xf9, 0x85, 0x02, 0xd9, 0x76, 0x70, 0x04, 0xd1, 0x16, 0x94, 0x02, 0x5a,
-	0x21, 0x58, 0x4c, 0xe8, 0x6b, 0x97, 0x57, 0xb5, 0xa2, 0x64, 0x15, 0x05, 0x57, 0x21, 0xc7, 0x8e,
-	0xda, 0xeb, 0x83, 0x76, 0x13, 0xb7, 0x0f, 0x8b, 0x8a, 0x5e, 0xb8, 0xbc, 0xaa, 0xc5, 0x9e, 0x17,
-	0xe8, 0x4f, 0x90, 0x67, 0x8b, 0x7b, 0xdd, 0xc5, 0xfb, 0x87, 0xed, 0xe6, 0x61, 0x51, 0xd5, 0x57,
-	0x2f, 0xaf, 0x6a, 0x39, 0xc6, 0xa0, 0xeb, 0x3a, 0x94, 0xf4, 0xa8, 0x5e, 0x92, 0xb4, 0x22, 0x0e,
-	0xf5, 0xef, 0xd3, 0x50, 0x68, 0xf8, 0xf4, 0x84, 0x91, 0xec, 0x99, 0xd4, 0x71, 0x3d, 0xf4, 0x04,
-	0x96, 0xe4, 0x9b, 0x00, 0xad, 0x4f, 0xdd, 0xc8, 0x41, 0xef, 0xe8, 0xe5, 0x59, 0x58, 0xaa, 0x6f,
-	0x02, 0x35, 0xa7, 0x9e, 0xfc, 0xda, 0xfc, 0x85, 0x2e, 0x33, 0xdc, 0x59, 0xe0, 0x09, 0x93, 0xec,
-	0x43, 0x61, 0xfa, 0x1e, 0x43, 0x77, 0x65, 0xf8, 0xc2, 0x47, 0x80, 0x7e, 0xef, 0x1a, 0x6f, 0x98,
-	0xf0, 0x1f, 0x90, 0x11, 0x17, 0x0c, 0x5a, 0x93, 0xa1, 0x53, 0xf7, 0x9a, 0xbe, 0x3e, 0x83, 0x86,
-	0x03, 0x1f, 0x43, 0x9a, 0xdf, 0x2f, 0x28, 0xea, 0xe7, 0xe8, 0x56, 0xd2, 0xd7, 0xa6, 0xc1, 0x78,
-	0x11, 0xa2, 0x7b, 0x25, 0x2c, 0xc2, 0xdc, 0xbd, 0x14, 0x16, 0x61, 0xfe, 0x12, 0x32, 0x12, 0xe8,
-	0x5f, 0xf1, 0xc7, 0xe8, 0xed, 0x39, 0xa5, 0x94, 0x29, 0xb4, 0x79, 0x47, 0x98, 0x01, 0xcf, 0xbf,
-	0x31, 0xef, 0x5d, 0x23, 0x6f, 0x32, 0x5b, 0xe5, 0x3a, 0x77, 0x98, 0xf3, 0x29, 0xe4, 0x62, 0x4e,
-	0x74, 0x67, 0x7e, 0x40, 0x90, 0x4b, 0x5f, 0xe4, 0x8a, 0x6f, 0xf1, 0xb4, 0x94, 0x84, 0x5b, 0xbc,
-	0x50, 0xad, 0xc2, 0x2d, 0x5e, 0xac, 0x3f, 0x46, 0x62, 0xe7, 0xee, 0xfb, 0x0f, 0x95, 0xc4, 0x8f,
-	0x1f, 0x2a, 0xca, 0xaf, 0x1f, 0x2a, 0xca, 0xfb, 0x49, 0x45, 0xf9, 0x61, 0x52, 0x51, 0x7e, 0x9a,
-	0x54, 0x94, 0x5f, 0x26, 0x15, 0xe5, 0x4d, 0x86, 0xff, 0xcd, 0x3f, 0xfa, 0x2d, 0x00, 0x00, 0xff,
-	0xff, 0x96, 0x52, 0xac, 0xbe, 0xf9, 0x0f, 0x00, 0x00,
+	// 1872 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xbd, 0x6f, 0x2b, 0xc7,
+	0x11, 0xd7, 0x1d, 0x3f, 0x44, 0xce, 0x51, 0x12, 0xb9, 0x96, 0xe8, 0xd3, 0xe1, 0x3d, 0x92, 0x39,
+	0xc4, 0x8e, 0x20, 0xc1, 0x0a, 0x42, 0x3b, 0x09, 0xf0, 0xd2, 0x44, 0x22, 0xf9, 0x6c, 0x5a, 0xb1,
+	0x44, 0xac, 0x44, 0x25, 0x40, 0x8a, 0x87, 0x33, 0x6f, 0xc9, 0x77, 0x10, 0x75, 0x47, 0xdf, 0x2d,
+	0x65, 0xeb, 0x1f, 0x08, 0x02, 0x35, 0xa9, 0x52, 0xa4, 0x50, 0x15, 0x37, 0x29, 0xd3, 0xfa, 0x2f,
+	0x70, 0x99, 0x2e, 0x41, 0x0a, 0x21, 0x8f, 0x4d, 0x52, 0x06, 0xc8, 0x3f, 0x10, 0xec, 0xc7, 0x7d,
+	0xf1, 0xc3, 0x8a, 0xe5, 0x74, 0x37, 0xbf, 0x99, 0x9d, 0x9d, 0x99, 0x9d, 0xfd, 0xed, 0x90, 0xa0,
+	0x0d, 0x1d, 0xdb, 0x6b, 0x1e, 0x4e, 0x7c, 0x8f, 0x7a, 0x28, 0xc7, 0x05, 0x63, 0x7b, 0xe4, 0x8d,
+	0x3c, 0x8e, 0xfc, 0x90, 0x7d, 0x09, 0xa5, 0x89, 0xa1, 0x80, 0x7b, 0xad, 0x8e, 0xef, 0x7b, 0x3e,
+	0x42, 0x90, 0x1d, 0x78, 0x36, 0xd1, 0x95, 0x86, 0xb2, 0x97, 0xc3, 0xfc, 0x1b, 0xe9, 0xb0, 0x7e,
+	0x4d, 0x82, 0xc0, 0x1a, 0x11, 0x5d, 0x6d, 0x28, 0x7b, 0x45, 0x1c, 0x8a, 0x4c, 0x63, 0x13, 0x6a,
+	0x39, 0xe3, 0x40, 0xcf, 0x08, 0x8d, 0x14, 0xcd, 0x3f, 0x2b, 0x90, 0x6f, 0x93, 0x1b, 0x67, 0x40,
+	0x98, 0xcb, 0x89, 0x45, 0x5f, 0x73, 0x97, 0x45, 0xcc, 0xbf, 0xd1, 0x01, 0x14, 0x27, 0xbe, 0x67,
+	0x4f, 0x07, 0xb4, 0x6b, 0x73, 0xa7, 0xb9, 0xe3, 0x8d, 0xd9, 0x43, 0xbd, 0xd8, 0x93, 0x60, 0x1b,
+	0xc7, 0x7a, 0xb4, 0x07, 0x85, 0x1b, 0xe2, 0xda, 0x9e, 0xdf, 0xb5, 0xf9, 0x36, 0xb9, 0xe3, 0xd2,
+	0xec, 0xa1, 0x5e, 0xb8, 0x14, 0x58, 0x1b, 0x47, 0x5a, 0x64, 0x42, 0xe9, 0xda, 0x72, 0xa7, 0x43,
+	0x6b, 0x40, 0xa7, 0x3e, 0xf1, 0xf5, 0x2c, 0xdf, 0x32, 0x85, 0xb1, 0x98, 0xa5, 0x6b, 0x3d, 0x27,
+	0x62, 0x96, 0xa2, 0xd9, 0x84, 0xfc, 0xd9, 0x84, 0x3a, 0x9e, 0xcb, 0x42, 0x76, 0xad, 0x6b, 0x12,
+	0x86, 0xcc, 0xbe, 0xd1, 0x36, 0xe4, 0x6e, 0xac, 0xf1, 0x34, 0xac, 0x81, 0x10, 0xcc, 0xdf, 0x2b,
+	0x00, 0x22, 0xcf, 0xae, 0x3b, 0xf4, 0x90, 0xc1, 0x42, 0xf5, 0x03, 0xc7, 0x73, 0x03, 0x5d, 0x69,
+	0x64, 0xf6, 0x8a, 0x38, 0x92, 0x51, 0x0d, 0x80, 0x7c, 0x41, 0x89, 0x2b, 0xb4, 0x2a, 0xd7, 0x26,
+	0x10, 0x64, 0x42, 0xde, 0xb2, 0x46, 0x53, 0x47, 0x24, 0x59, 0x3a, 0x86, 0xd9, 0x43, 0x3d, 0x7f,
+	0x74, 0xf4, 0x61, 0xbf, 0xdb, 0xc6, 0x52, 0x83, 0x7e, 0x00, 0xeb, 0x1e, 0x0f, 0x31, 0xd0, 0xb3,
+	0x8d, 0xcc, 0x9e, 0xd6, 0xdc, 0x38, 0x14, 0xc7, 0x2c, 0x02, 0xc7, 0xa1, 0xd6, 0x7c, 0x01, 0x25,
+	0x4c, 0xc6, 0xb7, 0x8e, 0x3b, 0xea, 0x59, 0x3e, 0xbd, 0x45, 0x55, 0x50, 0x1d, 0x5b, 0xe4, 0x73,
+	0x9c, 0x9f, 0x3d, 0xd4, 0xd5, 0x6e, 0x1b, 0xab, 0x8e, 0x1d, 0x65, 0xaa, 0xc6, 0x99, 0x9a, 0xaf,
+	0x21, 0xdb, 0x0f, 0x88, 0x9f, 0x58, 0x53, 0x7a, 0x6c, 0x0d, 0x6a, 0x80, 0x66, 0x3b, 0xc1, 0x64,
+	0x6c, 0xdd, 0x9e, 0x32, 0x95, 0xe8, 0x86, 0x24, 0xc4, 0x56, 0x39, 0x03, 0xcf, 0x95, 0x67, 0xc2,
+	0xbf, 0xcd, 0x7f, 0x2a, 0xa0, 0x1d, 0x51, 0x4a, 0x02, 0x6a, 0xf1, 0xba, 0xbf, 0x0b, 0x9b, 0x83,
+	0xb1, 0x43, 0x5c, 0xda, 0xb6, 0xa8, 0xf5, 0x91, 0x15, 0x88, 0xa6, 0x29, 0xe1, 0x39, 0x94, 0x95,
+	0xd9, 0x9a, 0xd2, 0xd7, 0x4c, 0xe6, 0x51, 0x94, 0x70, 0x24, 0xb3, 0x32, 0x0e, 0x7c, 0x62, 0x77,
+	0x53, 0x65, 0x6c, 0x31, 0xa4, 0x8d, 0xa5, 0x86, 0xad, 0x67, 0x5f, 0x17, 0xb7, 0x13, 0x22, 0xe3,
+	0x89, 0x64, 0x54, 0x85, 0xfc, 0x64, 0xfa, 0xe9, 0x09, 0xb9, 0xe5, 0xed, 0x51, 0xc2, 0x52, 0xe2,
+	0x37, 0x83, 0xf8, 0x54, 0xcf, 0x73, 0x94, 0x7f, 0xa3, 0x32, 0x64, 0x02, 0x67, 0xa4, 0xaf, 0x73,
+	0x88, 0x7d, 0xb2, 0xd5, 0x43, 0xcf, 0xbf, 0xb6, 0xa8, 0x5e, 0xe0, 0x7e, 0xa5, 0x64, 0xfe, 0x46,
+	0x01, 0x60, 0x41, 0x10, 0x97, 0x3a, 0xd6, 0xf8, 0x9b, 0x4a, 0x4b, 0x59, 0x50, 0xb2, 0xb4, 0xec,
+	0x1b, 0x1d, 0x80, 0xea, 0x4f, 0x74, 0x68, 0x28, 0x7b, 0x5a, 0xf3, 0x2d, 0x79, 0xdc, 0xc9, 0xb3,
+	0x15, 0x0e, 0x70, 0x0f, 0xab, 0xfe, 0x04, 0xd5, 0x21, 0x3b, 0x0d, 0x88, 0xaf, 0x6b, 0xdc, 0x5c,
+	0x93, 0xe6, 0xec, 0x38, 0x31, 0x57, 0x98, 0x0e, 0x14, 0x8f, 0x82, 0x80, 0xf8, 0xbc, 0xde, 0xc9,
+	0x3a, 0x2a, 0x73, 0x75, 0x94, 0xb9, 0xa9, 0x71, 0x6e, 0x87, 0x00, 0xaf, 0xaf, 0xad, 0xc1, 0x39,
+	0x19, 0xf8, 0x84, 0xca, 0xea, 0x6e, 0xce, 0x1e, 0xea, 0xf0, 0xd1, 0x27, 0x47, 0x2d, 0x81, 0xe2,
+	0x84, 0x85, 0x49, 0x61, 0x2b, 0x4e, 0x39, 0xe0, 0xf7, 0xe3, 0x10, 0xc0, 0xbf, 0xea, 0x7c, 0xe1,
+	0x04, 0xd4, 0x71, 0x47, 0x82, 0x64, 0x84, 0x0b, 0x7c, 0x12, 0xa2, 0x38, 0x61, 0x81, 0x7e, 0x04,
+	0x9a, 0x7f, 0x85, 0xc9, 0xb5, 0xe5, 0xb8, 0x8e, 0x2b, 0x82, 0xc9, 0x1d, 0x6f, 0xcd, 0x1e, 0xea,
+	0x1a, 0x3e, 0x89, 0x60, 0x9c, 0xb4, 0x31, 0xff, 0xae, 0x42, 0xe1, 0x28, 0x4c, 0x62, 0x0f, 0x0a,
+	0xfe, 0xa4, 0x6b, 0xc7, 0xad, 0x24, 0xa8, 0x03, 0xf7, 0xba, 0x6d, 0x86, 0xe1, 0x48, 0xcb, 0xae,
+	0xf7, 0x70, 0x6c, 0x8d, 0x02, 0xbe, 0xc7, 0x06, 0x16, 0x02, 0xda, 0x03, 0x8d, 0x55, 0xad, 0xe7,
+	0x93, 0x80, 0xb8, 0x22, 0xe7, 0x82, 0xa8, 0x77, 0xbf, 0x87, 0x93, 0x2a, 0xb4, 0x0f, 0x25, 0x26,
+	0x5e, 0x12, 0xdf, 0x19, 0x3a, 0xc4, 0xd6, 0xb3, 0x09, 0xd3, 0x4b, 0x9c, 0xd2, 0xa1, 0x67, 0x50,
+	0x0c, 0x9c, 0x91, 0xdb, 0xf2, 0xa6, 0xae, 0x20, 0xa1, 0x0d, 0x1c, 0x03, 0x09, 0x1e, 0x80, 0x95,
+	0x3c, 0x10, 0x37, 0xb9, 0xf6, 0x3f, 0x35, 0x79, 0x69, 0x65, 0x93, 0x6f, 0xa4, 0x9a, 0x3c, 0xcd,
+	0x51, 0xdb, 0xf3, 0x1c, 0x65, 0x96, 0x61, 0x53, 0xb0, 0x5d, 0x80, 0xc9, 0x67, 0x53, 0x12, 0x50,
+	0xf3, 0x05, 0x6c, 0x45, 0x48, 0x30, 0xf1, 0xdc, 0x80, 0x30, 0x92, 0xb2, 0x05, 0xa4, 0x2b, 0x29,
+	0x92, 0x12, 0x86, 0x38, 0xd4, 0x9a, 0x07, 0x50, 0x89, 0xb9, 0x53, 0x3a, 0x64, 0xa1, 0x09, 0xbd,
+	0x64, 0x5f, 0x29, 0x99, 0x3f, 0x03, 0x94, 0x34, 0x96, 0x7b, 0xbd, 0x03, 0x59, 0xc7, 0x1d, 0x7a,
+	0xdc, 0x56, 0x6b, 0x56, 0x52, 0x1b, 0x71, 0x43, 0xae, 0x36, 0xbf, 0x54, 0x61, 0xe7, 0x13, 0xeb,
+	0x8a, 0xc4, 0xfd, 0xf8, 0xc8, 0x76, 0x4b, 0xa8, 0x48, 0x5d, 0x4a, 0x45, 0xe2, 0x76, 0x66, 0xbe,
+	0xdd, 0xed, 0xcc, 0xae, 0xb8, 0x9d, 0xd1, 0xfd, 0xcf, 0x25, 0xee, 0xff, 0x2e, 0x64, 0x26, 0x8e,
+	0xcb, 0x79, 0xa7, 0x78, 0xbc, 0x3e, 0x7b, 0xa8, 0x67, 0x7a, 0xdd, 0x53, 0xcc, 0xb0, 0xb9, 0xe3,
+	0xb2, 0x17, 0x9e, 0x94, 0x2a, 0xa8, 0xfe, 0x95, 0x4e, 0x62, 0xd6, 0xc7, 0x27, 0x58, 0xf5, 0xaf,
+	0x18, 0x3e, 0xbd, 0xd1, 0x87, 0x31, 0xde, 0xbf, 0xc4, 0xea, 0xf4, 0xc6, 0x3c, 0x85, 0xea, 0x7c,
+	0x95, 0x64, 0x9d, 0x3f, 0x00, 0xcd, 0x8a, 0x89, 0x5a, 0x96, 0x1b, 0xc9, 0x04, 0x12, 0x14, 0x8e,
+	0x93, 0x66, 0xe6, 0x10, 0x36, 0xce, 0x09, 0x65, 0xe1, 0x3e, 0x52, 0x6d, 0x99, 0xa3, 0xba, 0x24,
+	0x47, 0x13, 0xf2, 0xde, 0xd8, 0xee, 0x39, 0xae, 0x78, 0x54, 0x44, 0xab, 0x9f, 0x8d, 0x6d, 0x66,
+	0x20, 0x35, 0xac, 0x2d, 0xc3, 0x7d, 0x44, 0xbc, 0xe6, 0xbb, 0xec, 0xfd, 0x0b, 0x08, 0x7d, 0xac,
+	0xab, 0xb6, 0x60, 0x43, 0xda, 0xc9, 0x85, 0x07, 0x50, 0xc1, 0x84, 0xfa, 0xb7, 0xfc, 0x2e, 0x3e,
+	0xb6, 0x7a, 0x1f, 0x50, 0xd2, 0x58, 0xd6, 0x6a, 0x1b, 0x72, 0x03, 0x7e, 0xb5, 0xc5, 0x10, 0x25,
+	0x04, 0xf3, 0x4f, 0x2a, 0x94, 0x23, 0xe6, 0x7d, 0xac, 0x1e, 0xcf, 0x20, 0xcb, 0x98, 0x49, 0x16,
+	0xa4, 0x30, 0x7b, 0xa8, 0x67, 0x19, 0x67, 0x61, 0x8e, 0x2e, 0xe9, 0xcd, 0xcc, 0xd2, 0xde, 0x8c,
+	0x59, 0x22, 0xbb, 0x92, 0x25, 0x64, 0xe5, 0x73, 0x4f, 0xeb, 0xae, 0xe9, 0x8d, 0x4e, 0xe6, 0xbb,
+	0x88, 0xe3, 0x93, 0x54, 0x77, 0xf5, 0xb0, 0x3a, 0x9d, 0x30, 0x32, 0xe6, 0xaf, 0x83, 0x35, 0xa6,
+	0xfa, 0x28, 0x26, 0x63, 0xfe, 0x7a, 0x58, 0x63, 0x8a, 0x23, 0xad, 0xd9, 0x82, 0x4a, 0xa2, 0x54,
+	0xb2, 0xac, 0x87, 0x50, 0xb4, 0x42, 0x50, 0x36, 0x60, 0x39, 0x6c, 0xc0, 0xc8, 0x38, 0x36, 0x31,
+	0x4f, 0xa0, 0x3a, 0xf7, 0xfc, 0x3c, 0xbd, 0x0b, 0xcd, 0x0e, 0xbc, 0xbd, 0xe0, 0x4c, 0xc6, 0xb5,
+	0x9f, 0xa2, 0xa0, 0xaa, 0x0c, 0x69, 0xde, 0x5a, 0xf0, 0x10, 0x01, 0x94, 0x50, 0x7c, 0xb7, 0x2e,
+	0x90, 0xd1, 0x66, 0x96, 0x44, 0xfb, 0x31, 0xbc, 0x95, 0xda, 0x46, 0x46, 0xfa, 0x3e, 0x68, 0x83,
+	0x18, 0x96, 0xe4, 0x5c, 0x59, 0x08, 0x18, 0x27, 0xad, 0xcc, 0x8f, 0x61, 0x27, 0xc1, 0x67, 0x0e,
+	0x09, 0xbe, 0x43, 0x15, 0x3f, 0x84, 0xea, 0xbc, 0x2f, 0x19, 0xda, 0x7b, 0xb0, 0x3e, 0x11, 0x90,
+	0x0c, 0x6b, 0x19, 0x97, 0xe2, 0xd0, 0xc6, 0xfc, 0xab, 0x02, 0x3a, 0x7f, 0x4e, 0x6f, 0x93, 0xdc,
+	0x23, 0x03, 0x7b, 0x12, 0x57, 0x3d, 0x52, 0xec, 0x6d, 0xc8, 0xf9, 0x9e, 0x47, 0xc5, 0x35, 0x28,
+	0x61, 0x21, 0xa0, 0xef, 0xc3, 0x86, 0xe3, 0x52, 0xe2, 0x5f, 0x13, 0xdb, 0xb1, 0x28, 0x09, 0x74,
+	0xc2, 0xb5, 0x69, 0x90, 0xfd, 0xd8, 0xf1, 0xc9, 0x67, 0x53, 0xc7, 0x27, 0x7d, 0x41, 0xba, 0x05,
+	0xf1, 0x63, 0x07, 0x4b, 0xf0, 0x12, 0xc7, 0x7a, 0xf3, 0x06, 0x76, 0x97, 0x24, 0x26, 0xab, 0x74,
+	0x30, 0x37, 0xaf, 0x69, 0xcd, 0xad, 0x30, 0x2d, 0x09, 0x27, 0x06, 0xb8, 0x65, 0xb3, 0xa4, 0x0e,
+	0xeb, 0xd4, 0x9f, 0x06, 0x94, 0x88, 0xe9, 0xb8, 0x80, 0x43, 0xd1, 0xfc, 0x4a, 0x85, 0xaa, 0xdc,
+	0x78, 0x9e, 0xa4, 0xbe, 0xe5, 0xc5, 0xfb, 0x3f, 0x91, 0xd7, 0x53, 0x66, 0xf4, 0xd4, 0x60, 0x65,
+	0xcf, 0x0f, 0x56, 0xa9, 0x73, 0x20, 0xdf, 0x7c, 0x0e, 0xcc, 0xd8, 0x1a, 0x8f, 0xbd, 0xcf, 0x4f,
+	0xbd, 0xfe, 0x24, 0x79, 0x68, 0x47, 0x12, 0xec, 0xe1, 0x58, 0x6f, 0xbe, 0x84, 0xb7, 0x17, 0x6a,
+	0xf7, 0x84, 0x23, 0xdb, 0xff, 0x75, 0xf8, 0x63, 0x92, 0x67, 0xf9, 0x0e, 0x6c, 0xf6, 0x4f, 0x4f,
+	0x4e, 0xcf, 0x7e, 0x79, 0xfa, 0xaa, 0xdd, 0xb9, 0xec, 0xb6, 0x3a, 0xe5, 0x35, 0xa3, 0x72, 0x77,
+	0xdf, 0xd8, 0xe8, 0xbb, 0x57, 0xae, 0xf7, 0xb9, 0x2b, 0x4c, 0xd1, 0x3a, 0x64, 0xfa, 0xcd, 0x97,
+	0x65, 0x05, 0x15, 0x21, 0xf7, 0xb2, 0xdb, 0x3e, 0x6b, 0x96, 0x55, 0x03, 0xfd, 0xf6, 0x8f, 0xb5,
+	0xb5, 0xaf, 0xbe, 0xac, 0x25, 0xdc, 0xed, 0xff, 0x41, 0x81, 0xcd, 0xf8, 0x92, 0xf3, 0x1d, 0xde,
+	0x03, 0x14, 0xee, 0xd0, 0xc2, 0x9d, 0x76, 0xe7, 0xf4, 0xa2, 0x7b, 0xf4, 0x8b, 0xf2, 0x9a, 0xb1,
+	0x73, 0x77, 0xdf, 0xa8, 0xc8, 0x5d, 0xe2, 0x25, 0xec, 0x12, 0x74, 0xce, 0x9b, 0x3f, 0xfe, 0x49,
+	0x59, 0x31, 0x8a, 0x77, 0xf7, 0x0d, 0x21, 0x70, 0xb4, 0xdd, 0x3e, 0x3f, 0x2a, 0xab, 0x12, 0x65,
+	0x02, 0x43, 0x31, 0xb7, 0xcd, 0x08, 0x94, 0x0b, 0x46, 0x55, 0xc6, 0x35, 0x17, 0xc8, 0xfe, 0xef,
+	0x14, 0x28, 0x76, 0xc2, 0x97, 0x05, 0x1d, 0x40, 0x25, 0x0c, 0x2b, 0x02, 0xcb, 0x6b, 0xc6, 0xf6,
+	0xdd, 0x7d, 0xa3, 0x2c, 0xa3, 0x8a, 0x8d, 0xeb, 0xa0, 0xb1, 0x17, 0xe4, 0xd5, 0x79, 0xa7, 0x85,
+	0x3b, 0x17, 0x65, 0xc5, 0xd8, 0xbc, 0xbb, 0x6f, 0x24, 0x7e, 0x92, 0xa0, 0xef, 0x41, 0x89, 0x25,
+	0xf7, 0xaa, 0x87, 0xcf, 0x2e, 0x3a, 0xad, 0x8b, 0xb2, 0x6a, 0x6c, 0xdd, 0xdd, 0x37, 0x34, 0x16,
+	0x41, 0xcf, 0xf7, 0x28, 0x19, 0x50, 0xa3, 0x22, 0xc3, 0x8a, 0x63, 0x68, 0xfe, 0x27, 0x0f, 0x9b,
+	0xec, 0x84, 0x58, 0x90, 0x03, 0x8b, 0x7a, 0x7e, 0x80, 0x5e, 0xc0, 0xba, 0x1c, 0x75, 0xd1, 0x4e,
+	0x6a, 0xd0, 0x0c, 0x29, 0xd1, 0xa8, 0xce, 0xc3, 0x72, 0xa8, 0x58, 0x43, 0xad, 0xd4, 0xdf, 0x04,
+	0xfa, 0xe2, 0x9c, 0x2a, 0x3d, 0xec, 0x2e, 0xd1, 0x44, 0x4e, 0xce, 0x60, 0x33, 0x3d, 0x9e, 0xa1,
+	0x67, 0xd2, 0x7c, 0xe9, 0x6c, 0x6b, 0x3c, 0x5f, 0xa1, 0x8d, 0x1c, 0xfe, 0x14, 0xf2, 0x62, 0x6e,
+	0x42, 0xdb, 0xd2, 0x34, 0x35, 0xae, 0x19, 0x3b, 0x73, 0x68, 0xb4, 0xf0, 0x03, 0xc8, 0xf1, 0xb1,
+	0x09, 0xc5, 0x34, 0x1d, 0x0f, 0x5b, 0xc6, 0x76, 0x1a, 0x4c, 0x16, 0x21, 0x1e, 0x97, 0xa2, 0x22,
+	0x2c, 0x8c, 0x5b, 0xc6, 0xee, 0x12, 0x4d, 0xe4, 0xe4, 0xe7, 0xc9, 0x1f, 0xb0, 0x6f, 0x2f, 0xf0,
+	0x90, 0x74, 0xa1, 0x2f, 0x2a, 0x22, 0x0f, 0x78, 0xf1, 0x77, 0xe9, 0xf3, 0x15, 0xaf, 0xb6, 0xf4,
+	0x56, 0x5b, 0xa5, 0x8e, 0x7c, 0xbe, 0x04, 0x2d, 0xa1, 0x44, 0xbb, 0x8b, 0x0b, 0x42, 0x5f, 0xc6,
+	0x32, 0x55, 0xf2, 0x88, 0xd3, 0x2f, 0x64, 0x74, 0xc4, 0x4b, 0x1f, 0x61, 0xe3, 0xf9, 0x0a, 0x6d,
+	0xe4, 0xf0, 0x57, 0x50, 0x59, 0x78, 0x4f, 0x50, 0x5d, 0xae, 0x5a, 0xf5, 0x84, 0x1a, 0x8d, 0xd5,
+	0x06, 0xc9, 0x32, 0xce, 0x91, 0x5e, 0x54, 0xc6, 0xe5, 0x0f, 0x89, 0x51, 0x5b, 0xa5, 0x0e, 0x7d,
+	0x1e, 0x3f, 0xfb, 0xfa, 0x4d, 0x6d, 0xed, 0x6f, 0x6f, 0x6a, 0xca, 0xbf, 0xdf, 0xd4, 0x94, 0xaf,
+	0x67, 0x35, 0xe5, 0x2f, 0xb3, 0x9a, 0xf2, 0x8f, 0x59, 0x4d, 0xf9, 0xd7, 0xac, 0xa6, 0x7c, 0x9a,
+	0xe7, 0xff, 0x57, 0xbe, 0xff, 0xdf, 0x01, 0x00, 0xd9, 0x97, 0x36, 0x93, 0xdb, 0x14, 0x00, 0x00,
 }
 
 func (this *RPCError) GoString() string {
@@ -1666,6 +1920,28 @@ func (this *CredentialsInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *AuthData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&fido2.AuthData{")
+	s = append(s, "RPIDHash: "+fmt.Sprintf("%#v", this.RPIDHash)+",\n")
+	s = append(s, "Flags: "+fmt.Sprintf("%#v", this.Flags)+",\n")
+	s = append(s, "UP: "+fmt.Sprintf("%#v", this.UP)+",\n")
+	s = append(s, "UV: "+fmt.Sprintf("%#v", this.UV)+",\n")
+	s = append(s, "SignCount: "+fmt.Sprintf("%#v", this.SignCount)+",\n")
+	s = append(s, "AAGUID: "+fmt.Sprintf("%#v", this.AAGUID)+",\n")
+	s = append(s, "CredID: "+fmt.Sprintf("%#v", this.CredID)+",\n")
+	s = append(s, "CredType: "+fmt.Sprintf("%#v", this.CredType)+",\n")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "Extensions: "+fmt.Sprintf("%#v", this.Extensions)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *DevicesRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1963,6 +2239,79 @@ func (this *RelyingPartiesResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *VerifyAttestationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&fido2.VerifyAttestationRequest{")
+	if this.Attestation != nil {
+		s = append(s, "Attestation: "+fmt.Sprintf("%#v", this.Attestation)+",\n")
+	}
+	s = append(s, "RPID: "+fmt.Sprintf("%#v", this.RPID)+",\n")
+	s = append(s, "Roots: "+fmt.Sprintf("%#v", this.Roots)+",\n")
+	s = append(s, "Intermediates: "+fmt.Sprintf("%#v", this.Intermediates)+",\n")
+	s = append(s, "RequireUV: "+fmt.Sprintf("%#v", this.RequireUV)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyAttestationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&fido2.VerifyAttestationResponse{")
+	if this.AuthData != nil {
+		s = append(s, "AuthData: "+fmt.Sprintf("%#v", this.AuthData)+",\n")
+	}
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Trusted: "+fmt.Sprintf("%#v", this.Trusted)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyAssertionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&fido2.VerifyAssertionRequest{")
+	if this.Assertion != nil {
+		s = append(s, "Assertion: "+fmt.Sprintf("%#v", this.Assertion)+",\n")
+	}
+	s = append(s, "RPID: "+fmt.Sprintf("%#v", this.RPID)+",\n")
+	s = append(s, "ClientDataHash: "+fmt.Sprintf("%#v", this.ClientDataHash)+",\n")
+	s = append(s, "CredType: "+fmt.Sprintf("%#v", this.CredType)+",\n")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "SignCount: "+fmt.Sprintf("%#v", this.SignCount)+",\n")
+	s = append(s, "RequireUV: "+fmt.Sprintf("%#v", this.RequireUV)+",\n")
+	s = append(s, "AllowNoUP: "+fmt.Sprintf("%#v", this.AllowNoUP)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyAssertionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&fido2.VerifyAssertionResponse{")
+	if this.AuthData != nil {
+		s = append(s, "AuthData: "+fmt.Sprintf("%#v", this.AuthData)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringFido2(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1994,6 +2343,9 @@ type AuthenticatorsClient interface {
 	CredentialsInfo(ctx context.Context, in *CredentialsInfoRequest, opts ...grpc.CallOption) (*CredentialsInfoResponse, error)
 	Credentials(ctx context.Context, in *CredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error)
 	RelyingParties(ctx context.Context, in *RelyingPartiesRequest, opts ...grpc.CallOption) (*RelyingPartiesResponse, error)
+	// Verification (relying party), doesn't use a device.
+	VerifyAttestation(ctx context.Context, in *VerifyAttestationRequest, opts ...grpc.CallOption) (*VerifyAttestationResponse, error)
+	VerifyAssertion(ctx context.Context, in *VerifyAssertionRequest, opts ...grpc.CallOption) (*VerifyAssertionResponse, error)
 }
 
 type authenticatorsClient struct {
@@ -2094,6 +2446,24 @@ func (c *authenticatorsClient) RelyingParties(ctx context.Context, in *RelyingPa
 	return out, nil
 }
 
+func (c *authenticatorsClient) VerifyAttestation(ctx context.Context, in *VerifyAttestationRequest, opts ...grpc.CallOption) (*VerifyAttestationResponse, error) {
+	out := new(VerifyAttestationResponse)
+	err := c.cc.Invoke(ctx, "/fido2.Authenticators/VerifyAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorsClient) VerifyAssertion(ctx context.Context, in *VerifyAssertionRequest, opts ...grpc.CallOption) (*VerifyAssertionResponse, error) {
+	out := new(VerifyAssertionResponse)
+	err := c.cc.Invoke(ctx, "/fido2.Authenticators/VerifyAssertion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticatorsServer is the server API for Authenticators service.
 type AuthenticatorsServer interface {
 	Devices(context.Context, *DevicesRequest) (*DevicesResponse, error)
@@ -2106,6 +2476,9 @@ type AuthenticatorsServer interface {
 	CredentialsInfo(context.Context, *CredentialsInfoRequest) (*CredentialsInfoResponse, error)
 	Credentials(context.Context, *CredentialsRequest) (*CredentialsResponse, error)
 	RelyingParties(context.Context, *RelyingPartiesRequest) (*RelyingPartiesResponse, error)
+	// Verification (relying party), doesn't use a device.
+	VerifyAttestation(context.Context, *VerifyAttestationRequest) (*VerifyAttestationResponse, error)
+	VerifyAssertion(context.Context, *VerifyAssertionRequest) (*VerifyAssertionResponse, error)
 }
 
 // UnimplementedAuthenticatorsServer can be embedded to have forward compatible implementations.
@@ -2142,6 +2515,12 @@ func (*UnimplementedAuthenticatorsServer) Credentials(ctx context.Context, req *
 func (*UnimplementedAuthenticatorsServer) RelyingParties(ctx context.Context, req *RelyingPartiesRequest) (*RelyingPartiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RelyingParties not implemented")
 }
+func (*UnimplementedAuthenticatorsServer) VerifyAttestation(ctx context.Context, req *VerifyAttestationRequest) (*VerifyAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAttestation not implemented")
+}
+func (*UnimplementedAuthenticatorsServer) VerifyAssertion(ctx context.Context, req *VerifyAssertionRequest) (*VerifyAssertionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAssertion not implemented")
+}
 
 func RegisterAuthenticatorsServer(s *grpc.Server, srv AuthenticatorsServer) {
 	s.RegisterService(&_Authenticators_serviceDesc, srv)
@@ -2327,18 +2706,54 @@ func _Authenticators_RelyingParties_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Authenticators_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fido2.Authenticators",
-	HandlerType: (*AuthenticatorsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Devices",
-			Handler:    _Authenticators_Devices_Handler,
-		},
-		{
-			MethodName: "DeviceInfo",
-			Handler:    _Authenticators_DeviceInfo_Handler,
-		},
+func _Authenticators_VerifyAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorsServer).VerifyAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fido2.Authenticators/VerifyAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorsServer).VerifyAttestation(ctx, req.(*VerifyAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticators_VerifyAssertion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAssertionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorsServer).VerifyAssertion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fido2.Authenticators/VerifyAssertion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorsServer).VerifyAssertion(ctx, req.(*VerifyAssertionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Authenticators_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fido2.Authenticators",
+	HandlerType: (*AuthenticatorsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Devices",
+			Handler:    _Authenticators_Devices_Handler,
+		},
+		{
+			MethodName: "DeviceInfo",
+			Handler:    _Authenticators_DeviceInfo_Handler,
+		},
 		{
 			MethodName: "MakeCredential",
 			Handler:    _Authenticators_MakeCredential_Handler,
@@ -2371,6 +2786,14 @@ var _Authenticators_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RelyingParties",
 			Handler:    _Authenticators_RelyingParties_Handler,
 		},
+		{
+			MethodName: "VerifyAttestation",
+			Handler:    _Authenticators_VerifyAttestation_Handler,
+		},
+		{
+			MethodName: "VerifyAssertion",
+			Handler:    _Authenticators_VerifyAssertion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fido2.proto",
@@ -2916,6 +3339,109 @@ func (m *CredentialsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuthData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Extensions[iNdEx])
+			copy(dAtA[i:], m.Extensions[iNdEx])
+			i = encodeVarintFido2(dAtA, i, uint64(len(m.Extensions[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.CredType) > 0 {
+		i -= len(m.CredType)
+		copy(dAtA[i:], m.CredType)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.CredType)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CredID) > 0 {
+		i -= len(m.CredID)
+		copy(dAtA[i:], m.CredID)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.CredID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AAGUID) > 0 {
+		i -= len(m.AAGUID)
+		copy(dAtA[i:], m.AAGUID)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.AAGUID)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SignCount != 0 {
+		i = encodeVarintFido2(dAtA, i, uint64(m.SignCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UV {
+		i--
+		if m.UV {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UP {
+		i--
+		if m.UP {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Flags != 0 {
+		i = encodeVarintFido2(dAtA, i, uint64(m.Flags))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RPIDHash) > 0 {
+		i -= len(m.RPIDHash)
+		copy(dAtA[i:], m.RPIDHash)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.RPIDHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DevicesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3796,101 +4322,374 @@ func (m *RelyingPartiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFido2(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFido2(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VerifyAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RPCError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovFido2(uint64(m.Code))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovFido2(uint64(l))
-	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovFido2(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *VerifyAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Device) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VerifyAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovFido2(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ProductID != 0 {
-		n += 1 + sovFido2(uint64(m.ProductID))
+	if m.RequireUV {
+		i--
+		if m.RequireUV {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb0
 	}
-	if m.VendorID != 0 {
-		n += 1 + sovFido2(uint64(m.VendorID))
+	if len(m.Intermediates) > 0 {
+		for iNdEx := len(m.Intermediates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Intermediates[iNdEx])
+			copy(dAtA[i:], m.Intermediates[iNdEx])
+			i = encodeVarintFido2(dAtA, i, uint64(len(m.Intermediates[iNdEx])))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	l = len(m.Manufacturer)
-	if l > 0 {
-		n += 1 + l + sovFido2(uint64(l))
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roots[iNdEx])
+			copy(dAtA[i:], m.Roots[iNdEx])
+			i = encodeVarintFido2(dAtA, i, uint64(len(m.Roots[iNdEx])))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	l = len(m.Product)
-	if l > 0 {
-		n += 1 + l + sovFido2(uint64(l))
+	if len(m.RPID) > 0 {
+		i -= len(m.RPID)
+		copy(dAtA[i:], m.RPID)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.RPID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFido2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Option) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFido2(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovFido2(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *VerifyAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeviceInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VerifyAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Versions) > 0 {
-		for _, s := range m.Versions {
-			l = len(s)
-			n += 1 + l + sovFido2(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Trusted {
+		i--
+		if m.Trusted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuthData != nil {
+		{
+			size, err := m.AuthData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFido2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyAssertionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyAssertionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyAssertionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AllowNoUP {
+		i--
+		if m.AllowNoUP {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.RequireUV {
+		i--
+		if m.RequireUV {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.SignCount != 0 {
+		i = encodeVarintFido2(dAtA, i, uint64(m.SignCount))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CredType) > 0 {
+		i -= len(m.CredType)
+		copy(dAtA[i:], m.CredType)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.CredType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientDataHash) > 0 {
+		i -= len(m.ClientDataHash)
+		copy(dAtA[i:], m.ClientDataHash)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.ClientDataHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RPID) > 0 {
+		i -= len(m.RPID)
+		copy(dAtA[i:], m.RPID)
+		i = encodeVarintFido2(dAtA, i, uint64(len(m.RPID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Assertion != nil {
+		{
+			size, err := m.Assertion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFido2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyAssertionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyAssertionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyAssertionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AuthData != nil {
+		{
+			size, err := m.AuthData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFido2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFido2(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFido2(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RPCError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovFido2(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if m.ProductID != 0 {
+		n += 1 + sovFido2(uint64(m.ProductID))
+	}
+	if m.VendorID != 0 {
+		n += 1 + sovFido2(uint64(m.VendorID))
+	}
+	l = len(m.Manufacturer)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.Product)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Option) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeviceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovFido2(uint64(l))
+		}
 	}
 	if len(m.Extensions) > 0 {
 		for _, s := range m.Extensions {
@@ -4076,34 +4875,84 @@ func (m *CredentialsInfo) Size() (n int) {
 	return n
 }
 
-func (m *DevicesRequest) Size() (n int) {
+func (m *AuthData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.RPIDHash)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
 	}
-	return n
-}
-
-func (m *DevicesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Flags != 0 {
+		n += 1 + sovFido2(uint64(m.Flags))
 	}
-	var l int
-	_ = l
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
-			l = e.Size()
-			n += 1 + l + sovFido2(uint64(l))
-		}
+	if m.UP {
+		n += 2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UV {
+		n += 2
 	}
-	return n
+	if m.SignCount != 0 {
+		n += 1 + sovFido2(uint64(m.SignCount))
+	}
+	l = len(m.AAGUID)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.CredID)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.CredType)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if len(m.Extensions) > 0 {
+		for _, s := range m.Extensions {
+			l = len(s)
+			n += 2 + l + sovFido2(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DevicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovFido2(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *DeviceInfoRequest) Size() (n int) {
@@ -4481,6 +5330,121 @@ func (m *RelyingPartiesResponse) Size() (n int) {
 	return n
 }
 
+func (m *VerifyAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.RPID)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if len(m.Roots) > 0 {
+		for _, b := range m.Roots {
+			l = len(b)
+			n += 2 + l + sovFido2(uint64(l))
+		}
+	}
+	if len(m.Intermediates) > 0 {
+		for _, b := range m.Intermediates {
+			l = len(b)
+			n += 2 + l + sovFido2(uint64(l))
+		}
+	}
+	if m.RequireUV {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthData != nil {
+		l = m.AuthData.Size()
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if m.Trusted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyAssertionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Assertion != nil {
+		l = m.Assertion.Size()
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.RPID)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.ClientDataHash)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.CredType)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if m.SignCount != 0 {
+		n += 2 + sovFido2(uint64(m.SignCount))
+	}
+	if m.RequireUV {
+		n += 3
+	}
+	if m.AllowNoUP {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyAssertionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthData != nil {
+		l = m.AuthData.Size()
+		n += 1 + l + sovFido2(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovFido2(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6180,61 +7144,7 @@ func (m *CredentialsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DevicesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFido2
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DevicesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFido2(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFido2
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthFido2
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DevicesResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6257,17 +7167,17 @@ func (m *DevicesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DevicesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DevicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RPIDHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFido2
@@ -6277,51 +7187,437 @@ func (m *DevicesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthFido2
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFido2
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Devices = append(m.Devices, &Device{})
-			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RPIDHash = append(m.RPIDHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RPIDHash == nil {
+				m.RPIDHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFido2(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFido2
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthFido2
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UP", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UP = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UV", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UV = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignCount", wireType)
+			}
+			m.SignCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AAGUID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AAGUID = append(m.AAGUID[:0], dAtA[iNdEx:postIndex]...)
+			if m.AAGUID == nil {
+				m.AAGUID = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredID = append(m.CredID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredID == nil {
+				m.CredID = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFido2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevicesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFido2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevicesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, &Device{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeviceInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7144,7 +8440,147 @@ func (m *SetPINResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFido2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFido2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7167,10 +8603,10 @@ func (m *ResetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7230,7 +8666,7 @@ func (m *ResetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetResponse) Unmarshal(dAtA []byte) error {
+func (m *RetryCountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7253,12 +8689,31 @@ func (m *ResetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryCountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFido2(dAtA[iNdEx:])
@@ -7284,7 +8739,7 @@ func (m *ResetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryCountRequest) Unmarshal(dAtA []byte) error {
+func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7307,15 +8762,243 @@ func (m *RetryCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssertionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssertionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RPID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientDataHash = append(m.ClientDataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientDataHash == nil {
+				m.ClientDataHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredID = append(m.CredID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredID == nil {
+				m.CredID = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PIN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UV", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UV = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7343,7 +9026,41 @@ func (m *RetryCountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Device = string(dAtA[iNdEx:postIndex])
+			m.UP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HMACSalt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HMACSalt = append(m.HMACSalt[:0], dAtA[iNdEx:postIndex]...)
+			if m.HMACSalt == nil {
+				m.HMACSalt = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7370,7 +9087,7 @@ func (m *RetryCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryCountResponse) Unmarshal(dAtA []byte) error {
+func (m *AssertionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7393,17 +9110,17 @@ func (m *RetryCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssertionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssertionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assertion", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFido2
@@ -7413,11 +9130,28 @@ func (m *RetryCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Assertion == nil {
+				m.Assertion = &Assertion{}
+			}
+			if err := m.Assertion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFido2(dAtA[iNdEx:])
@@ -7443,7 +9177,7 @@ func (m *RetryCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
+func (m *CredentialsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7466,10 +9200,10 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssertionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CredentialsInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssertionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CredentialsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7506,7 +9240,7 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7534,47 +9268,67 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RPID = string(dAtA[iNdEx:postIndex])
+			m.PIN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientDataHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFido2
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFido2
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthFido2
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientDataHash = append(m.ClientDataHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientDataHash == nil {
-				m.ClientDataHash = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFido2
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFido2
@@ -7584,61 +9338,85 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFido2
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFido2
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredID = append(m.CredID[:0], dAtA[iNdEx:postIndex]...)
-			if m.CredID == nil {
-				m.CredID = []byte{}
+			if m.Info == nil {
+				m.Info = &CredentialsInfo{}
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFido2
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFido2
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthFido2
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PIN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 100:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFido2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7666,11 +9444,11 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extensions = append(m.Extensions, string(dAtA[iNdEx:postIndex]))
+			m.Device = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 101:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UV", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RPID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7698,11 +9476,11 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UV = string(dAtA[iNdEx:postIndex])
+			m.RPID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7730,41 +9508,7 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HMACSalt", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFido2
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFido2
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFido2
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HMACSalt = append(m.HMACSalt[:0], dAtA[iNdEx:postIndex]...)
-			if m.HMACSalt == nil {
-				m.HMACSalt = []byte{}
-			}
+			m.PIN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7791,7 +9535,7 @@ func (m *AssertionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssertionResponse) Unmarshal(dAtA []byte) error {
+func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7814,15 +9558,15 @@ func (m *AssertionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssertionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CredentialsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssertionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assertion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7849,10 +9593,8 @@ func (m *AssertionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Assertion == nil {
-				m.Assertion = &Assertion{}
-			}
-			if err := m.Assertion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Credentials = append(m.Credentials, &Credential{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7881,7 +9623,7 @@ func (m *AssertionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CredentialsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *RelyingPartiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7904,10 +9646,10 @@ func (m *CredentialsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CredentialsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelyingPartiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CredentialsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelyingPartiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7999,7 +9741,7 @@ func (m *CredentialsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CredentialsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *RelyingPartiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8022,15 +9764,103 @@ func (m *CredentialsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CredentialsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelyingPartiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CredentialsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelyingPartiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, &RelyingParty{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFido2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFido2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8047,23 +9877,139 @@ func (m *CredentialsInfoResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RPID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roots = append(m.Roots, make([]byte, postIndex-iNdEx))
+			copy(m.Roots[len(m.Roots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intermediates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthFido2
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFido2
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &CredentialsInfo{}
+			m.Intermediates = append(m.Intermediates, make([]byte, postIndex-iNdEx))
+			copy(m.Intermediates[len(m.Intermediates)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireUV", wireType)
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.RequireUV = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFido2(dAtA[iNdEx:])
@@ -8089,7 +10035,7 @@ func (m *CredentialsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
+func (m *VerifyAttestationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8112,17 +10058,17 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CredentialsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyAttestationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFido2
@@ -8132,27 +10078,31 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFido2
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFido2
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Device = string(dAtA[iNdEx:postIndex])
+			if m.AuthData == nil {
+				m.AuthData = &AuthData{}
+			}
+			if err := m.AuthData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8180,13 +10130,13 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RPID = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trusted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFido2
@@ -8196,24 +10146,12 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFido2
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFido2
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PIN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Trusted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFido2(dAtA[iNdEx:])
@@ -8239,7 +10177,7 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
+func (m *VerifyAssertionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8262,15 +10200,15 @@ func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CredentialsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyAssertionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyAssertionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assertion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8297,68 +10235,82 @@ func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credentials = append(m.Credentials, &Credential{})
-			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Assertion == nil {
+				m.Assertion = &Assertion{}
+			}
+			if err := m.Assertion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFido2(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPID", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFido2
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthFido2
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RelyingPartiesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFido2
+			m.RPID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDataHash", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFido2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFido2
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ClientDataHash = append(m.ClientDataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientDataHash == nil {
+				m.ClientDataHash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RelyingPartiesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelyingPartiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8386,13 +10338,13 @@ func (m *RelyingPartiesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Device = string(dAtA[iNdEx:postIndex])
+			m.CredType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFido2
@@ -8402,24 +10354,85 @@ func (m *RelyingPartiesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthFido2
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFido2
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PIN = string(dAtA[iNdEx:postIndex])
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
 			iNdEx = postIndex
+		case 100:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignCount", wireType)
+			}
+			m.SignCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireUV", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireUV = bool(v != 0)
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNoUP", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFido2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNoUP = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFido2(dAtA[iNdEx:])
@@ -8445,7 +10458,7 @@ func (m *RelyingPartiesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelyingPartiesResponse) Unmarshal(dAtA []byte) error {
+func (m *VerifyAssertionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8468,15 +10481,15 @@ func (m *RelyingPartiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelyingPartiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyAssertionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelyingPartiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyAssertionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8503,8 +10516,10 @@ func (m *RelyingPartiesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parties = append(m.Parties, &RelyingParty{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AuthData == nil {
+				m.AuthData = &AuthData{}
+			}
+			if err := m.AuthData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
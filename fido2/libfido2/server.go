@@ -175,8 +175,11 @@ func (s *Server) Assertion(ctx context.Context, req *fido2.AssertionRequest) (*f
 		return nil, err
 	}
 
-	assertion, err := device.Assertion(req.RPID, req.ClientDataHash, req.CredID, req.PIN, &libfido2.AssertionOpts{Extensions: extensions, UV: uv, UP: up})
+	assertion, err := device.Assertion(req.RPID, req.ClientDataHash, req.CredID, req.PIN, &libfido2.AssertionOpts{Extensions: extensions, UV: uv, UP: up, HMACSalt: req.HMACSalt})
 	if err != nil {
+		if errors.Cause(err) == libfido2.ErrNoCredentials {
+			return nil, status.Error(codes.NotFound, "no credentials")
+		}
 		return nil, err
 	}
 
@@ -273,3 +276,13 @@ func (s *Server) RelyingParties(ctx context.Context, req *fido2.RelyingPartiesRe
 		Parties: relyingPartiesToRPC(rps),
 	}, nil
 }
+
+// VerifyAttestation ...
+func (s *Server) VerifyAttestation(ctx context.Context, req *fido2.VerifyAttestationRequest) (*fido2.VerifyAttestationResponse, error) {
+	return fido2.VerifyAttestation(ctx, req)
+}
+
+// VerifyAssertion ...
+func (s *Server) VerifyAssertion(ctx context.Context, req *fido2.VerifyAssertionRequest) (*fido2.VerifyAssertionResponse, error) {
+	return fido2.VerifyAssertion(ctx, req)
+}
@@ -0,0 +1,472 @@
+package fido2
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Verification of attestations and assertions, as a WebAuthn relying party
+// would. See https://www.w3.org/TR/webauthn/#sctn-registering-a-new-credential
+// and https://www.w3.org/TR/webauthn/#sctn-verifying-assertion.
+//
+// Public keys are in the format libfido2 returns: for es256 the X and Y
+// coordinates (64 bytes), for eddsa the Ed25519 public key (32 bytes) and for
+// rs256 the modulus (256 bytes) and exponent (4 bytes).
+
+// Authenticator data flags.
+const (
+	flagUP = 0x01
+	flagUV = 0x04
+	flagAT = 0x40
+	flagED = 0x80
+)
+
+// COSE algorithms.
+const (
+	coseES256 = -7
+	coseEdDSA = -8
+	coseRS256 = -257
+)
+
+// oidAAGUID is id-fido-gen-ce-aaguid, the attestation certificate extension
+// with the authenticator AAGUID.
+var oidAAGUID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 45724, 1, 1, 4}
+
+// ParseAuthData parses (raw) authenticator data. Attestation and Assertion
+// AuthData is a CBOR byte string (as libfido2 returns), see authDataFromCBOR.
+func ParseAuthData(b []byte) (*AuthData, error) {
+	if len(b) < 37 {
+		return nil, errors.Errorf("invalid auth data length")
+	}
+	flags := b[32]
+	ad := &AuthData{
+		RPIDHash:  b[0:32],
+		Flags:     uint32(flags),
+		UP:        flags&flagUP != 0,
+		UV:        flags&flagUV != 0,
+		SignCount: binary.BigEndian.Uint32(b[33:37]),
+	}
+	rest := b[37:]
+
+	if flags&flagAT != 0 {
+		if len(rest) < 18 {
+			return nil, errors.Errorf("invalid attested credential data")
+		}
+		ad.AAGUID = rest[0:16]
+		credIDLen := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if len(rest) < credIDLen {
+			return nil, errors.Errorf("invalid attested credential data")
+		}
+		ad.CredID = rest[:credIDLen]
+		rest = rest[credIDLen:]
+
+		v, n, err := cborDecode(rest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid credential public key")
+		}
+		typ, pk, err := parseCOSEKey(v)
+		if err != nil {
+			return nil, err
+		}
+		ad.CredType = typ
+		ad.PubKey = pk
+		rest = rest[n:]
+	}
+
+	if flags&flagED != 0 {
+		v, n, err := cborDecode(rest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid extensions")
+		}
+		m, ok := v.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid extensions")
+		}
+		exts := make([]string, 0, len(m))
+		for k := range m {
+			s, ok := k.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid extensions")
+			}
+			exts = append(exts, s)
+		}
+		sort.Strings(exts)
+		ad.Extensions = exts
+		rest = rest[n:]
+	}
+
+	if len(rest) != 0 {
+		return nil, errors.Errorf("invalid auth data, trailing bytes")
+	}
+	return ad, nil
+}
+
+// authDataFromCBOR returns the authenticator data from the CBOR byte string in
+// an Attestation or Assertion.
+func authDataFromCBOR(b []byte) ([]byte, error) {
+	authData, err := cborDecodeBytes(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid auth data")
+	}
+	return authData, nil
+}
+
+// parseCOSEKey returns the credential type and public key from a COSE_Key.
+func parseCOSEKey(v interface{}) (string, []byte, error) {
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return "", nil, errors.Errorf("invalid credential public key")
+	}
+	alg, _ := m[int64(3)].(int64)
+	switch alg {
+	case coseES256:
+		crv, _ := m[int64(-1)].(int64)
+		x, _ := m[int64(-2)].([]byte)
+		y, _ := m[int64(-3)].([]byte)
+		if m[int64(1)] != int64(2) || crv != 1 || len(x) != 32 || len(y) != 32 {
+			return "", nil, errors.Errorf("invalid es256 public key")
+		}
+		pk := append(append([]byte{}, x...), y...)
+		if _, err := parsePublicKey("es256", pk); err != nil {
+			return "", nil, err
+		}
+		return "es256", pk, nil
+	case coseEdDSA:
+		crv, _ := m[int64(-1)].(int64)
+		x, _ := m[int64(-2)].([]byte)
+		if m[int64(1)] != int64(1) || crv != 6 || len(x) != ed25519.PublicKeySize {
+			return "", nil, errors.Errorf("invalid eddsa public key")
+		}
+		return "eddsa", x, nil
+	case coseRS256:
+		n, _ := m[int64(-1)].([]byte)
+		e, _ := m[int64(-2)].([]byte)
+		if m[int64(1)] != int64(3) || len(n) != 256 || len(e) == 0 || len(e) > 4 {
+			return "", nil, errors.Errorf("invalid rs256 public key")
+		}
+		pk := append(append([]byte{}, n...), padBytes(e, 4)...)
+		return "rs256", pk, nil
+	default:
+		return "", nil, errors.Errorf("unsupported credential public key algorithm %d", alg)
+	}
+}
+
+func parsePublicKey(typ string, b []byte) (crypto.PublicKey, error) {
+	switch typ {
+	case "es256":
+		if len(b) == 65 && b[0] == 0x04 {
+			b = b[1:]
+		}
+		if len(b) != 64 {
+			return nil, errors.Errorf("invalid es256 public key")
+		}
+		curve := elliptic.P256()
+		x, y := new(big.Int).SetBytes(b[:32]), new(big.Int).SetBytes(b[32:])
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.Errorf("invalid es256 public key")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "eddsa":
+		if len(b) != ed25519.PublicKeySize {
+			return nil, errors.Errorf("invalid eddsa public key")
+		}
+		return ed25519.PublicKey(b), nil
+	case "rs256":
+		if len(b) != 260 {
+			return nil, errors.Errorf("invalid rs256 public key")
+		}
+		e := binary.BigEndian.Uint32(b[256:])
+		if e < 3 || e > 1<<31-1 {
+			return nil, errors.Errorf("invalid rs256 public key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(b[:256]), E: int(e)}, nil
+	default:
+		return nil, errors.Errorf("unsupported credential type %s", typ)
+	}
+}
+
+// verifySignature verifies sig (ASN.1 DER for ECDSA) of msg.
+func verifySignature(pk crypto.PublicKey, msg []byte, sig []byte) error {
+	switch k := pk.(type) {
+	case *ecdsa.PublicKey:
+		var es struct{ R, S *big.Int }
+		rest, err := asn1.Unmarshal(sig, &es)
+		if err != nil || len(rest) != 0 || es.R == nil || es.S == nil {
+			return errors.Errorf("invalid signature")
+		}
+		h := sha256.Sum256(msg)
+		if !ecdsa.Verify(k, h[:], es.R, es.S) {
+			return errors.Errorf("invalid signature")
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, msg, sig) {
+			return errors.Errorf("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		h := sha256.Sum256(msg)
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, h[:], sig); err != nil {
+			return errors.Errorf("invalid signature")
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported public key")
+	}
+}
+
+func checkAuthData(ad *AuthData, rpID string, requireUP bool, requireUV bool) error {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	if !bytes.Equal(ad.RPIDHash, rpIDHash[:]) {
+		return errors.Errorf("rp id hash mismatch")
+	}
+	if requireUP && !ad.UP {
+		return errors.Errorf("user not present")
+	}
+	if requireUV && !ad.UV {
+		return errors.Errorf("user not verified")
+	}
+	return nil
+}
+
+// VerifyAttestation verifies an attestation (packed, fido-u2f or none format)
+// from MakeCredential.
+func VerifyAttestation(ctx context.Context, req *VerifyAttestationRequest) (*VerifyAttestationResponse, error) {
+	att := req.Attestation
+	if att == nil {
+		return nil, errors.Errorf("no attestation specified")
+	}
+	if req.RPID == "" {
+		return nil, errors.Errorf("no rp id specified")
+	}
+	if len(att.ClientDataHash) != 32 {
+		return nil, errors.Errorf("invalid client data hash")
+	}
+
+	authData, err := authDataFromCBOR(att.AuthData)
+	if err != nil {
+		return nil, err
+	}
+	ad, err := ParseAuthData(authData)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAuthData(ad, req.RPID, true, req.RequireUV); err != nil {
+		return nil, err
+	}
+	if len(ad.CredID) == 0 || len(ad.PubKey) == 0 {
+		return nil, errors.Errorf("no attested credential data")
+	}
+	if len(att.CredID) > 0 && !bytes.Equal(att.CredID, ad.CredID) {
+		return nil, errors.Errorf("credential id mismatch")
+	}
+	if att.CredType != "" && att.CredType != ad.CredType {
+		return nil, errors.Errorf("credential type mismatch")
+	}
+	if len(att.PubKey) > 0 && !bytes.Equal(att.PubKey, ad.PubKey) {
+		return nil, errors.Errorf("credential public key mismatch")
+	}
+
+	var typ string
+	var cert *x509.Certificate
+	switch att.Format {
+	case "none":
+		if len(att.Sig) > 0 || len(att.Cert) > 0 {
+			return nil, errors.Errorf("invalid none attestation")
+		}
+		typ = "none"
+	case "packed":
+		msg := append(append([]byte{}, authData...), att.ClientDataHash...)
+		if len(att.Cert) == 0 {
+			// Self attestation
+			pk, err := parsePublicKey(ad.CredType, ad.PubKey)
+			if err != nil {
+				return nil, err
+			}
+			if err := verifySignature(pk, msg, att.Sig); err != nil {
+				return nil, err
+			}
+			typ = "self"
+			break
+		}
+		cert, err = x509.ParseCertificate(att.Cert)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid attestation certificate")
+		}
+		if err := checkPackedCert(cert, ad.AAGUID); err != nil {
+			return nil, err
+		}
+		if err := verifySignature(cert.PublicKey, msg, att.Sig); err != nil {
+			return nil, err
+		}
+		typ = "basic"
+	case "fido-u2f":
+		if len(att.Cert) == 0 {
+			return nil, errors.Errorf("no attestation certificate")
+		}
+		cert, err = x509.ParseCertificate(att.Cert)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid attestation certificate")
+		}
+		if k, ok := cert.PublicKey.(*ecdsa.PublicKey); !ok || k.Curve != elliptic.P256() {
+			return nil, errors.Errorf("invalid fido-u2f attestation certificate key")
+		}
+		if ad.CredType != "es256" {
+			return nil, errors.Errorf("invalid fido-u2f credential type %s", ad.CredType)
+		}
+		// U2F authenticators don't have an AAGUID, so it must be zero.
+		if !bytes.Equal(ad.AAGUID, make([]byte, 16)) {
+			return nil, errors.Errorf("invalid fido-u2f aaguid")
+		}
+		// 0x00 || rpIdHash || clientDataHash || credId || 0x04 || x || y
+		var msg bytes.Buffer
+		msg.WriteByte(0x00)
+		msg.Write(ad.RPIDHash)
+		msg.Write(att.ClientDataHash)
+		msg.Write(ad.CredID)
+		msg.WriteByte(0x04)
+		msg.Write(ad.PubKey)
+		if err := verifySignature(cert.PublicKey, msg.Bytes(), att.Sig); err != nil {
+			return nil, err
+		}
+		typ = "basic"
+	default:
+		return nil, errors.Errorf("unsupported attestation format %q", att.Format)
+	}
+
+	trusted := false
+	if cert != nil && len(req.Roots) > 0 {
+		if err := verifyCertChain(cert, req.Roots, req.Intermediates); err != nil {
+			return nil, err
+		}
+		trusted = true
+	}
+
+	return &VerifyAttestationResponse{
+		AuthData: ad,
+		Type:     typ,
+		Trusted:  trusted,
+	}, nil
+}
+
+// checkPackedCert checks packed attestation certificate requirements.
+// See https://www.w3.org/TR/webauthn/#sctn-packed-attestation-cert-requirements.
+func checkPackedCert(cert *x509.Certificate, aaguid []byte) error {
+	if cert.Version != 3 {
+		return errors.Errorf("invalid attestation certificate version")
+	}
+	if len(cert.Subject.OrganizationalUnit) != 1 || cert.Subject.OrganizationalUnit[0] != "Authenticator Attestation" {
+		return errors.Errorf("invalid attestation certificate subject")
+	}
+	if cert.IsCA {
+		return errors.Errorf("invalid attestation certificate, is CA")
+	}
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oidAAGUID) {
+			continue
+		}
+		if ext.Critical {
+			return errors.Errorf("invalid attestation certificate aaguid extension")
+		}
+		var b []byte
+		if _, err := asn1.Unmarshal(ext.Value, &b); err != nil {
+			return errors.Errorf("invalid attestation certificate aaguid extension")
+		}
+		if !bytes.Equal(b, aaguid) {
+			return errors.Errorf("attestation certificate aaguid mismatch")
+		}
+	}
+	return nil
+}
+
+func verifyCertChain(cert *x509.Certificate, roots [][]byte, intermediates [][]byte) error {
+	opts := x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, b := range roots {
+		c, err := x509.ParseCertificate(b)
+		if err != nil {
+			return errors.Wrapf(err, "invalid root certificate")
+		}
+		opts.Roots.AddCert(c)
+	}
+	for _, b := range intermediates {
+		c, err := x509.ParseCertificate(b)
+		if err != nil {
+			return errors.Wrapf(err, "invalid intermediate certificate")
+		}
+		opts.Intermediates.AddCert(c)
+	}
+	if _, err := cert.Verify(opts); err != nil {
+		return errors.Wrapf(err, "attestation certificate chain failed to verify")
+	}
+	return nil
+}
+
+// VerifyAssertion verifies an assertion with the credential public key.
+func VerifyAssertion(ctx context.Context, req *VerifyAssertionRequest) (*VerifyAssertionResponse, error) {
+	assertion := req.Assertion
+	if assertion == nil {
+		return nil, errors.Errorf("no assertion specified")
+	}
+	if req.RPID == "" {
+		return nil, errors.Errorf("no rp id specified")
+	}
+	if len(req.ClientDataHash) != 32 {
+		return nil, errors.Errorf("invalid client data hash")
+	}
+	pk, err := parsePublicKey(req.CredType, req.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	authData, err := authDataFromCBOR(assertion.AuthData)
+	if err != nil {
+		return nil, err
+	}
+	ad, err := ParseAuthData(authData)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAuthData(ad, req.RPID, !req.AllowNoUP, req.RequireUV); err != nil {
+		return nil, err
+	}
+
+	msg := append(append([]byte{}, authData...), req.ClientDataHash...)
+	if err := verifySignature(pk, msg, assertion.Sig); err != nil {
+		return nil, err
+	}
+
+	// If the authenticator supports sign counts, it must increase, otherwise
+	// the authenticator may have been cloned.
+	if (ad.SignCount != 0 || req.SignCount != 0) && ad.SignCount <= req.SignCount {
+		return nil, errors.Errorf("sign count %d not greater than %d", ad.SignCount, req.SignCount)
+	}
+
+	return &VerifyAssertionResponse{
+		AuthData: ad,
+	}, nil
+}
+
+func padBytes(b []byte, n int) []byte {
+	if len(b) >= n {
+		return b
+	}
+	return append(make([]byte, n-len(b)), b...)
+}
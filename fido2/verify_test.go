@@ -0,0 +1,425 @@
+package fido2_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keysd/fido2"
+	"github.com/keys-pub/keysd/fido2/virtual"
+	"github.com/stretchr/testify/require"
+)
+
+func randBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestVerifyVirtual(t *testing.T) {
+	ctx := context.TODO()
+	fas, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+
+	for _, typ := range []string{"es256", "eddsa"} {
+		cdh := randBytes(32)
+		credResp, err := fas.MakeCredential(ctx, &fido2.MakeCredentialRequest{
+			Device:         virtual.DevicePath,
+			ClientDataHash: cdh,
+			RP:             &fido2.RelyingParty{ID: "keys.pub"},
+			User:           &fido2.User{ID: randBytes(16), Name: "alice"},
+			Type:           typ,
+			Extensions:     []string{"hmac-secret"},
+		})
+		require.NoError(t, err)
+		att := credResp.Attestation
+
+		attResp, err := fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub"})
+		require.NoError(t, err)
+		require.Equal(t, "self", attResp.Type)
+		require.False(t, attResp.Trusted)
+		require.Equal(t, typ, attResp.AuthData.CredType)
+		require.Equal(t, att.CredID, attResp.AuthData.CredID)
+		require.Equal(t, att.PubKey, attResp.AuthData.PubKey)
+		require.Equal(t, virtual.AAGUID, attResp.AuthData.AAGUID)
+		require.Equal(t, []string{"hmac-secret"}, attResp.AuthData.Extensions)
+		require.True(t, attResp.AuthData.UP)
+		require.False(t, attResp.AuthData.UV)
+
+		_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub", RequireUV: true})
+		require.EqualError(t, err, "user not verified")
+		_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "other.com"})
+		require.EqualError(t, err, "rp id hash mismatch")
+
+		tampered := *att
+		tampered.ClientDataHash = randBytes(32)
+		_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: &tampered, RPID: "keys.pub"})
+		require.EqualError(t, err, "invalid signature")
+
+		// Assertions
+		signCount := uint32(0)
+		for i := 0; i < 2; i++ {
+			cdh := randBytes(32)
+			assertResp, err := fas.Assertion(ctx, &fido2.AssertionRequest{
+				Device:         virtual.DevicePath,
+				RPID:           "keys.pub",
+				ClientDataHash: cdh,
+				CredID:         att.CredID,
+			})
+			require.NoError(t, err)
+			req := &fido2.VerifyAssertionRequest{
+				Assertion:      assertResp.Assertion,
+				RPID:           "keys.pub",
+				ClientDataHash: cdh,
+				CredType:       attResp.AuthData.CredType,
+				PubKey:         attResp.AuthData.PubKey,
+				SignCount:      signCount,
+			}
+			verifyResp, err := fido2.VerifyAssertion(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, signCount+1, verifyResp.AuthData.SignCount)
+
+			// Replay (same sign count)
+			req.SignCount = verifyResp.AuthData.SignCount
+			_, err = fido2.VerifyAssertion(ctx, req)
+			require.Error(t, err)
+			signCount = verifyResp.AuthData.SignCount
+
+			req.SignCount = 0
+			req.ClientDataHash = randBytes(32)
+			_, err = fido2.VerifyAssertion(ctx, req)
+			require.EqualError(t, err, "invalid signature")
+		}
+
+		// No user presence
+		cdh = randBytes(32)
+		assertResp, err := fas.Assertion(ctx, &fido2.AssertionRequest{
+			Device:         virtual.DevicePath,
+			RPID:           "keys.pub",
+			ClientDataHash: cdh,
+			CredID:         att.CredID,
+			UP:             "false",
+		})
+		require.NoError(t, err)
+		req := &fido2.VerifyAssertionRequest{
+			Assertion:      assertResp.Assertion,
+			RPID:           "keys.pub",
+			ClientDataHash: cdh,
+			CredType:       attResp.AuthData.CredType,
+			PubKey:         attResp.AuthData.PubKey,
+		}
+		_, err = fido2.VerifyAssertion(ctx, req)
+		require.EqualError(t, err, "user not present")
+		req.AllowNoUP = true
+		_, err = fido2.VerifyAssertion(ctx, req)
+		require.NoError(t, err)
+	}
+}
+
+// testAuthData returns authenticator data with an es256 credential.
+func testAuthData(rpID string, aaguid []byte, credID []byte, key *ecdsa.PrivateKey) []byte {
+	var b bytes.Buffer
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	b.Write(rpIDHash[:])
+	b.WriteByte(0x01 | 0x40) // UP, AT
+	_ = binary.Write(&b, binary.BigEndian, uint32(0))
+	b.Write(aaguid)
+	_ = binary.Write(&b, binary.BigEndian, uint16(len(credID)))
+	b.Write(credID)
+	// COSE_Key {1: 2, 3: -7, -1: 1, -2: x, -3: y}
+	b.Write([]byte{0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20})
+	b.Write(testPad32(key.X.Bytes()))
+	b.Write([]byte{0x22, 0x58, 0x20})
+	b.Write(testPad32(key.Y.Bytes()))
+	return b.Bytes()
+}
+
+// testCBORBytes returns b as a CBOR byte string (as libfido2 returns auth
+// data).
+func testCBORBytes(b []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte(0x59)
+	_ = binary.Write(&buf, binary.BigEndian, uint16(len(b)))
+	buf.Write(b)
+	return buf.Bytes()
+}
+
+func testPad32(b []byte) []byte {
+	return append(make([]byte, 32-len(b)), b...)
+}
+
+func testPubKey(key *ecdsa.PrivateKey) []byte {
+	return append(testPad32(key.X.Bytes()), testPad32(key.Y.Bytes())...)
+}
+
+func testSign(t *testing.T, key *ecdsa.PrivateKey, msg []byte) []byte {
+	h := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, key, h[:])
+	require.NoError(t, err)
+	sig, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	require.NoError(t, err)
+	return sig
+}
+
+func testCerts(t *testing.T, aaguid []byte) ([]byte, []byte, *ecdsa.PrivateKey) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err = x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	aaguidExt, err := asn1.Marshal(aaguid)
+	require.NoError(t, err)
+	leaf := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject: pkix.Name{
+			Country:            []string{"US"},
+			Organization:       []string{"Test"},
+			OrganizationalUnit: []string{"Authenticator Attestation"},
+			CommonName:         "Test Attestation",
+		},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		ExtraExtensions: []pkix.Extension{
+			{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 45724, 1, 1, 4}, Value: aaguidExt},
+		},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leaf, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	return caDER, leafDER, key
+}
+
+func TestVerifyAttestationPacked(t *testing.T) {
+	ctx := context.TODO()
+	aaguid := randBytes(16)
+	root, cert, attKey := testCerts(t, aaguid)
+	credKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	credID := randBytes(32)
+
+	authData := testAuthData("keys.pub", aaguid, credID, credKey)
+	cdh := randBytes(32)
+	att := &fido2.Attestation{
+		ClientDataHash: cdh,
+		AuthData:       testCBORBytes(authData),
+		CredID:         credID,
+		CredType:       "es256",
+		PubKey:         testPubKey(credKey),
+		Cert:           cert,
+		Sig:            testSign(t, attKey, append(append([]byte{}, authData...), cdh...)),
+		Format:         "packed",
+	}
+
+	resp, err := fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub"})
+	require.NoError(t, err)
+	require.Equal(t, "basic", resp.Type)
+	require.False(t, resp.Trusted)
+
+	resp, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub", Roots: [][]byte{root}})
+	require.NoError(t, err)
+	require.True(t, resp.Trusted)
+
+	otherRoot, _, _ := testCerts(t, aaguid)
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub", Roots: [][]byte{otherRoot}})
+	require.Error(t, err)
+
+	// Signed with the credential key (instead of attestation key)
+	invalid := *att
+	invalid.Sig = testSign(t, credKey, append(append([]byte{}, authData...), cdh...))
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: &invalid, RPID: "keys.pub"})
+	require.EqualError(t, err, "invalid signature")
+
+	// AAGUID mismatch
+	authData2 := testAuthData("keys.pub", randBytes(16), credID, credKey)
+	invalid = *att
+	invalid.AuthData = testCBORBytes(authData2)
+	invalid.Sig = testSign(t, attKey, append(append([]byte{}, authData2...), cdh...))
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: &invalid, RPID: "keys.pub"})
+	require.EqualError(t, err, "attestation certificate aaguid mismatch")
+
+	// Public key mismatch
+	invalid = *att
+	invalid.PubKey = randBytes(64)
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: &invalid, RPID: "keys.pub"})
+	require.EqualError(t, err, "credential public key mismatch")
+}
+
+func TestVerifyAttestationU2F(t *testing.T) {
+	ctx := context.TODO()
+	root, cert, attKey := testCerts(t, make([]byte, 16))
+	credKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	credID := randBytes(64)
+
+	authData := testAuthData("keys.pub", make([]byte, 16), credID, credKey)
+	cdh := randBytes(32)
+	rpIDHash := sha256.Sum256([]byte("keys.pub"))
+	var msg bytes.Buffer
+	msg.WriteByte(0x00)
+	msg.Write(rpIDHash[:])
+	msg.Write(cdh)
+	msg.Write(credID)
+	msg.WriteByte(0x04)
+	msg.Write(testPubKey(credKey))
+
+	att := &fido2.Attestation{
+		ClientDataHash: cdh,
+		AuthData:       testCBORBytes(authData),
+		Cert:           cert,
+		Sig:            testSign(t, attKey, msg.Bytes()),
+		Format:         "fido-u2f",
+	}
+	resp, err := fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub", Roots: [][]byte{root}})
+	require.NoError(t, err)
+	require.Equal(t, "basic", resp.Type)
+	require.True(t, resp.Trusted)
+	require.Equal(t, credID, resp.AuthData.CredID)
+
+	// AAGUID must be zero
+	invalid := *att
+	invalid.AuthData = testCBORBytes(testAuthData("keys.pub", randBytes(16), credID, credKey))
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: &invalid, RPID: "keys.pub"})
+	require.EqualError(t, err, "invalid fido-u2f aaguid")
+
+	att.Cert = nil
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub"})
+	require.EqualError(t, err, "no attestation certificate")
+}
+
+func TestVerifyAttestationNone(t *testing.T) {
+	ctx := context.TODO()
+	credKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	att := &fido2.Attestation{
+		ClientDataHash: randBytes(32),
+		AuthData:       testCBORBytes(testAuthData("keys.pub", make([]byte, 16), randBytes(32), credKey)),
+		Format:         "none",
+	}
+	resp, err := fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub"})
+	require.NoError(t, err)
+	require.Equal(t, "none", resp.Type)
+	require.Equal(t, testPubKey(credKey), resp.AuthData.PubKey)
+
+	att.Format = "tpm"
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub"})
+	require.EqualError(t, err, `unsupported attestation format "tpm"`)
+}
+
+func TestVerifyAttestationAuthDataNotCBOR(t *testing.T) {
+	ctx := context.TODO()
+	credKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	att := &fido2.Attestation{
+		ClientDataHash: randBytes(32),
+		AuthData:       testAuthData("keys.pub", make([]byte, 16), randBytes(32), credKey),
+		Format:         "none",
+	}
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub"})
+	require.Error(t, err)
+
+	authData := testCBORBytes(testAuthData("keys.pub", make([]byte, 16), randBytes(32), credKey))
+	att.AuthData = append(authData, 0x00)
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: att, RPID: "keys.pub"})
+	require.EqualError(t, err, "invalid auth data: cbor: not a byte string")
+}
+
+func testHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Attestations from real authenticators (from duo-labs/webauthn), with
+// ClientDataHash the SHA-256 of the clientDataJSON.
+
+// Self (packed) attestation, es256 (macOS).
+var testPackedAttestation = &fido2.Attestation{
+	ClientDataHash: testHex("9625603dbf3ebec10cc9161af0edb97912da8f1d1533af8ceab4089dda2f9af6"),
+	AuthData:       testCBORBytes(testHex("49960de5880e8c687434170f6476605b8fe4aeb9a28632c7995cf3ba831d9763455c9139ccadce000235bcc60a648b0b25f1f05503003300ec7abc5186213b65c2386a145bc0909981cd2cdfc04d5d05ad5f551fcbb6ae4bdf914944d7609177bce2fdfed131153424a9a5010203262001215820a6f4e622770888a07f52c1542a5009c7f068f9649dbfaabf208db838d6ae0cd52258200d3b5caa474a04b5984ed1d4c8e8b55210ab21e2f560311b01d0b02b5e54ad95")),
+	Sig:            testHex("3045022100981d830e71f09cc4e097d1eb1d5104ef1e087344f1e5bf2f5553574df3a722f902206ff1e533b9add7492ddb3d80bb9bc797cc985c691c9e19eed472ea88ac34af0b"),
+	Format:         "packed",
+}
+
+// Basic (fido-u2f) attestation, from a Yubico U2F security key.
+var testU2FAttestation = &fido2.Attestation{
+	ClientDataHash: testHex("f5d3602b868644253a69c8801a4610adc65ee314bebc3a46d20aa99264820739"),
+	AuthData:       testCBORBytes(testHex("74a6ea9213c99c2f74b22492b320cf40262a94c1a950a0397f29250b60841ef0410000000000000000000000000000000000000000004014ec5c9aca8f2cd087b7220bbdb364aed1cc74a01ea922576190db78577491ce449e6f0a97a6b4269d2cce02e2943c354b80a36617bd676eb5d445066e3c849aa5010203262001215820ff6a9dd0f7353c3ac19ef7aeede3cbf2471aa7f0d7c54e4df4ee60b55ac1f621225820872a02e5b7331854d2e0ed6925fcf85b5fff378d24b7423848d92461ecacbc47")),
+	Cert:           testHex("3082024f30820137a00302010202041236d17f300d06092a864886f70d01010b0500302e312c302a0603550403132359756269636f2055324620526f6f742043412053657269616c203435373230303633313020170d3134303830313030303030305a180f32303530303930343030303030305a3031312f302d06035504030c2659756269636f205532462045452053657269616c2032333932353733343130333234313038373059301306072a8648ce3d020106082a8648ce3d03010703420004d365a91e5e99e0d5b439c0d9afbb87f4058e47dd12b144edb14d2b33f8d35c1513e40d79f0f999abe23671959381c9dc2b07858b82ac63476204ccf734d6ae21a33b3039302206092b0601040182c40a020415312e332e362e312e342e312e34313438322e312e353013060b2b0601040182e51c020101040403020520300d06092a864886f70d01010b05000382010100221b9bb3b27224f13ebea322f0351eaf464966a36f7269857c8e23f9e505b55275dd4e41223e7f2611091469cf929fa5263e6cc77681b2486daaf41fb1cfabe85508f13f6750f6c81b29de601b5e7208bbfa6476e564a91d7d64ab524ad04ebb5ace218b1526f171f87cdef52398e8432c50b9bf1578197ab6ebbe32abd1769338389c24b8c97acee3f1bc616476caf42f1367df2928d02655c63b9d3cd0ab69b6996fe573788b9952f802ab4f941155b109dc1e20ec6d2542175857eeabe19b478a5f2617860d319d3e45a60fc4069835690561dcce6426887506d745979f8067db3148800b683058dedf88f1d5f5ebbcd8d632a46537d8e8a31bd063846b7f"),
+	Sig:            testHex("304402207f2221c198fe7e4115c93d4638af1c8431c94767215c12d2460e9b4c28ce0e6c022071fa19723f050adc47e18783925f6b329541d7b3a7afd058815f4f896d4c23b6"),
+	Format:         "fido-u2f",
+}
+
+func TestVerifyAttestationKnownAnswer(t *testing.T) {
+	ctx := context.TODO()
+
+	resp, err := fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: testPackedAttestation, RPID: "localhost"})
+	require.NoError(t, err)
+	require.Equal(t, "self", resp.Type)
+	require.Equal(t, "es256", resp.AuthData.CredType)
+	require.Equal(t, testHex("adce000235bcc60a648b0b25f1f05503"), resp.AuthData.AAGUID)
+	require.Equal(t, 51, len(resp.AuthData.CredID))
+	require.True(t, resp.AuthData.UP)
+	require.True(t, resp.AuthData.UV)
+	require.Equal(t, uint32(1553021388), resp.AuthData.SignCount)
+
+	resp, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: testU2FAttestation, RPID: "webauthn.io"})
+	require.NoError(t, err)
+	require.Equal(t, "basic", resp.Type)
+	require.False(t, resp.Trusted)
+	require.Equal(t, "es256", resp.AuthData.CredType)
+	require.Equal(t, make([]byte, 16), resp.AuthData.AAGUID)
+	require.Equal(t, 64, len(resp.AuthData.CredID))
+	require.True(t, resp.AuthData.UP)
+	require.False(t, resp.AuthData.UV)
+
+	// Tampered
+	tampered := *testPackedAttestation
+	tampered.ClientDataHash = randBytes(32)
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: &tampered, RPID: "localhost"})
+	require.EqualError(t, err, "invalid signature")
+	tampered = *testU2FAttestation
+	tampered.ClientDataHash = randBytes(32)
+	_, err = fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{Attestation: &tampered, RPID: "webauthn.io"})
+	require.EqualError(t, err, "invalid signature")
+}
+
+func TestParseAuthData(t *testing.T) {
+	_, err := fido2.ParseAuthData([]byte{0x01, 0x02})
+	require.EqualError(t, err, "invalid auth data length")
+
+	credKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	authData := testAuthData("keys.pub", make([]byte, 16), randBytes(32), credKey)
+	_, err = fido2.ParseAuthData(append(authData, 0x00))
+	require.EqualError(t, err, "invalid auth data, trailing bytes")
+	_, err = fido2.ParseAuthData(authData[:len(authData)-1])
+	require.EqualError(t, err, "invalid credential public key: cbor: unexpected end of data")
+}
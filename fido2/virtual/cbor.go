@@ -0,0 +1,108 @@
+package virtual
+
+import (
+	"bytes"
+)
+
+// Minimal CBOR (RFC 7049) encoding, only what we need for authenticator data
+// (COSE keys and extensions), in CTAP2 canonical form.
+
+const (
+	cborUint   = 0
+	cborNegInt = 1
+	cborBytes  = 2
+	cborText   = 3
+	cborMap    = 5
+	cborTrue   = 0xf5
+)
+
+func cborHead(buf *bytes.Buffer, major byte, n uint64) {
+	m := major << 5
+	switch {
+	case n < 24:
+		buf.WriteByte(m | byte(n))
+	case n <= 0xff:
+		buf.WriteByte(m | 24)
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(m | 25)
+		buf.Write([]byte{byte(n >> 8), byte(n)})
+	case n <= 0xffffffff:
+		buf.WriteByte(m | 26)
+		buf.Write([]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)})
+	default:
+		buf.WriteByte(m | 27)
+		for i := 7; i >= 0; i-- {
+			buf.WriteByte(byte(n >> (uint(i) * 8)))
+		}
+	}
+}
+
+func cborInt(buf *bytes.Buffer, n int64) {
+	if n < 0 {
+		cborHead(buf, cborNegInt, uint64(-1-n))
+		return
+	}
+	cborHead(buf, cborUint, uint64(n))
+}
+
+func cborByteString(buf *bytes.Buffer, b []byte) {
+	cborHead(buf, cborBytes, uint64(len(b)))
+	buf.Write(b)
+}
+
+func cborTextString(buf *bytes.Buffer, s string) {
+	cborHead(buf, cborText, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+// cborEncodeBytes returns b as a CBOR byte string. libfido2 returns
+// authenticator data this way, so we do too.
+func cborEncodeBytes(b []byte) []byte {
+	var buf bytes.Buffer
+	cborByteString(&buf, b)
+	return buf.Bytes()
+}
+
+// coseKeyES256 returns the COSE_Key for a P-256 public key.
+func coseKeyES256(x, y []byte) []byte {
+	var buf bytes.Buffer
+	cborHead(&buf, cborMap, 5)
+	cborInt(&buf, 1) // kty
+	cborInt(&buf, 2) // EC2
+	cborInt(&buf, 3) // alg
+	cborInt(&buf, -7)
+	cborInt(&buf, -1) // crv
+	cborInt(&buf, 1)  // P-256
+	cborInt(&buf, -2) // x
+	cborByteString(&buf, x)
+	cborInt(&buf, -3) // y
+	cborByteString(&buf, y)
+	return buf.Bytes()
+}
+
+// coseKeyEdDSA returns the COSE_Key for an Ed25519 public key.
+func coseKeyEdDSA(x []byte) []byte {
+	var buf bytes.Buffer
+	cborHead(&buf, cborMap, 4)
+	cborInt(&buf, 1) // kty
+	cborInt(&buf, 1) // OKP
+	cborInt(&buf, 3) // alg
+	cborInt(&buf, -8)
+	cborInt(&buf, -1) // crv
+	cborInt(&buf, 6)  // Ed25519
+	cborInt(&buf, -2) // x
+	cborByteString(&buf, x)
+	return buf.Bytes()
+}
+
+// cborExtensions returns the extensions map with each extension set to true.
+func cborExtensions(names []string) []byte {
+	var buf bytes.Buffer
+	cborHead(&buf, cborMap, uint64(len(names)))
+	for _, name := range names {
+		cborTextString(&buf, name)
+		buf.WriteByte(cborTrue)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,593 @@
+// Package virtual provides a software (virtual) FIDO2 authenticator,
+// implementing fido2.AuthenticatorsServer without libfido2 or hardware, for
+// testing.
+package virtual
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/keys-pub/keysd/fido2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DevicePath is the (only) device path for the virtual authenticator.
+const DevicePath = "virtual"
+
+// AAGUID for the virtual authenticator.
+var AAGUID = []byte("keys.pub/virtual")
+
+const (
+	maxRetries  = 8
+	maxResident = 25
+	minPINLen   = 4
+)
+
+// Authenticator data flags.
+const (
+	flagUP = 0x01
+	flagUV = 0x04
+	flagAT = 0x40
+	flagED = 0x80
+)
+
+// Server is a virtual authenticator.
+//
+// There is no user presence check (it is always satisfied, unless UP is
+// "false") and user verification is by PIN only. State (PIN, retries and
+// credentials) is saved to a file, if a path is specified.
+type Server struct {
+	mtx   sync.Mutex
+	path  string
+	state *state
+}
+
+var _ fido2.AuthenticatorsServer = &Server{}
+
+// NewAuthenticatorsServer creates a virtual authenticator with state at path.
+// If path is empty, state is only kept in memory.
+func NewAuthenticatorsServer(path string) (*Server, error) {
+	st, err := loadState(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		path:  path,
+		state: st,
+	}, nil
+}
+
+func (s *Server) save() error {
+	return saveState(s.path, s.state)
+}
+
+func checkDevice(device string) error {
+	if device != DevicePath {
+		return status.Errorf(codes.NotFound, "device not found %s", device)
+	}
+	return nil
+}
+
+// Devices ...
+func (s *Server) Devices(ctx context.Context, req *fido2.DevicesRequest) (*fido2.DevicesResponse, error) {
+	return &fido2.DevicesResponse{
+		Devices: []*fido2.Device{
+			&fido2.Device{
+				Path:         DevicePath,
+				Manufacturer: "keys.pub",
+				Product:      "Virtual FIDO2",
+			},
+		},
+	}, nil
+}
+
+// DeviceInfo ...
+func (s *Server) DeviceInfo(ctx context.Context, req *fido2.DeviceInfoRequest) (*fido2.DeviceInfoResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	clientPin := "false"
+	if len(s.state.PINHash) > 0 {
+		clientPin = "true"
+	}
+	return &fido2.DeviceInfoResponse{
+		Info: &fido2.DeviceInfo{
+			Versions:   []string{"FIDO_2_0"},
+			Extensions: []string{"hmac-secret"},
+			AAGUID:     AAGUID,
+			Options: []*fido2.Option{
+				&fido2.Option{Name: "rk", Value: "true"},
+				&fido2.Option{Name: "up", Value: "true"},
+				&fido2.Option{Name: "plat", Value: "false"},
+				&fido2.Option{Name: "clientPin", Value: clientPin},
+			},
+		},
+	}, nil
+}
+
+// checkPIN verifies the PIN, decrementing the retry count if invalid.
+// Returns true if the user is verified (PIN was specified).
+func (s *Server) checkPIN(pin string, required bool) (bool, error) {
+	if pin == "" {
+		if required && len(s.state.PINHash) > 0 {
+			return false, status.Error(codes.InvalidArgument, "pin required")
+		}
+		return false, nil
+	}
+	if len(s.state.PINHash) == 0 {
+		return false, status.Error(codes.FailedPrecondition, "pin not set")
+	}
+	if s.state.Retries <= 0 {
+		return false, status.Error(codes.FailedPrecondition, "pin blocked")
+	}
+	if !hmac.Equal(s.state.PINHash, pinHash(pin)) {
+		s.state.Retries--
+		if err := s.save(); err != nil {
+			return false, err
+		}
+		if s.state.Retries <= 0 {
+			return false, status.Error(codes.FailedPrecondition, "pin blocked")
+		}
+		return false, status.Error(codes.InvalidArgument, "pin invalid")
+	}
+	if s.state.Retries != maxRetries {
+		s.state.Retries = maxRetries
+		if err := s.save(); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// MakeCredential ...
+func (s *Server) MakeCredential(ctx context.Context, req *fido2.MakeCredentialRequest) (*fido2.MakeCredentialResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	if len(req.ClientDataHash) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "invalid client data hash")
+	}
+	if req.RP == nil || req.RP.ID == "" {
+		return nil, status.Error(codes.InvalidArgument, "no rp id specified")
+	}
+	if req.User == nil || len(req.User.ID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no user id specified")
+	}
+	typ, err := credTypeFromRPC(req.Type)
+	if err != nil {
+		return nil, err
+	}
+	rk, err := optionValue(req.RK, false)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := optionValue(req.UV, false); err != nil {
+		return nil, err
+	}
+	if req.UV == "true" && req.PIN == "" {
+		return nil, status.Error(codes.InvalidArgument, "unsupported option uv")
+	}
+	hmacSecret := false
+	for _, ext := range req.Extensions {
+		switch ext {
+		case "hmac-secret":
+			hmacSecret = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported extension %s", ext)
+		}
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	uv, err := s.checkPIN(req.PIN, true)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := generatePrivateKey(typ)
+	if err != nil {
+		return nil, err
+	}
+	cred := &credential{
+		ID:     randBytes(32),
+		Type:   typ,
+		RPID:   req.RP.ID,
+		RPName: req.RP.Name,
+		User: &user{
+			ID:          req.User.ID,
+			Name:        req.User.Name,
+			DisplayName: req.User.DisplayName,
+			Icon:        req.User.Icon,
+		},
+		PrivateKey: privateKey,
+		RK:         rk,
+		HMACSecret: hmacSecret,
+	}
+	if hmacSecret {
+		cred.CredRandom = randBytes(32)
+		cred.CredRandomUV = randBytes(32)
+	}
+
+	pubKey, coseKey, err := cred.publicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Attested credential data
+	var authData bytes.Buffer
+	flags := byte(flagUP | flagAT)
+	if uv {
+		flags |= flagUV
+	}
+	var exts []byte
+	if hmacSecret {
+		flags |= flagED
+		exts = cborExtensions([]string{"hmac-secret"})
+	}
+	rpIDHash := sha256.Sum256([]byte(cred.RPID))
+	authData.Write(rpIDHash[:])
+	authData.WriteByte(flags)
+	_ = binary.Write(&authData, binary.BigEndian, cred.SignCount)
+	authData.Write(AAGUID)
+	_ = binary.Write(&authData, binary.BigEndian, uint16(len(cred.ID)))
+	authData.Write(cred.ID)
+	authData.Write(coseKey)
+	authData.Write(exts)
+
+	// Self attestation (packed), signed with the credential key.
+	sig, err := cred.sign(authData.Bytes(), req.ClientDataHash)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := make([]*credential, 0, len(s.state.Credentials)+1)
+	resident := 0
+	for _, c := range s.state.Credentials {
+		// Resident credentials for the same RP and user are replaced.
+		if rk && c.RK && c.RPID == cred.RPID && bytes.Equal(c.User.ID, cred.User.ID) {
+			continue
+		}
+		if c.RK {
+			resident++
+		}
+		creds = append(creds, c)
+	}
+	if rk && resident >= maxResident {
+		return nil, status.Error(codes.ResourceExhausted, "key store full")
+	}
+	s.state.Credentials = append(creds, cred)
+	if err := s.save(); err != nil {
+		return nil, err
+	}
+
+	return &fido2.MakeCredentialResponse{
+		Attestation: &fido2.Attestation{
+			ClientDataHash: req.ClientDataHash,
+			AuthData:       cborEncodeBytes(authData.Bytes()),
+			CredID:         cred.ID,
+			CredType:       typ,
+			PubKey:         pubKey,
+			Sig:            sig,
+			Format:         "packed",
+		},
+	}, nil
+}
+
+// SetPIN ...
+func (s *Server) SetPIN(ctx context.Context, req *fido2.SetPINRequest) (*fido2.SetPINResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	if len(req.PIN) < minPINLen {
+		return nil, status.Error(codes.InvalidArgument, "pin policy violation")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.state.PINHash) > 0 {
+		if req.OldPIN == "" {
+			return nil, status.Error(codes.InvalidArgument, "pin required")
+		}
+		if _, err := s.checkPIN(req.OldPIN, true); err != nil {
+			return nil, err
+		}
+	} else if req.OldPIN != "" {
+		return nil, status.Error(codes.FailedPrecondition, "pin not set")
+	}
+
+	s.state.PINHash = pinHash(req.PIN)
+	s.state.Retries = maxRetries
+	if err := s.save(); err != nil {
+		return nil, err
+	}
+	return &fido2.SetPINResponse{}, nil
+}
+
+// Reset removes all credentials and the PIN.
+func (s *Server) Reset(ctx context.Context, req *fido2.ResetRequest) (*fido2.ResetResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.state = newState()
+	if err := s.save(); err != nil {
+		return nil, err
+	}
+	return &fido2.ResetResponse{}, nil
+}
+
+// RetryCount ...
+func (s *Server) RetryCount(ctx context.Context, req *fido2.RetryCountRequest) (*fido2.RetryCountResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return &fido2.RetryCountResponse{
+		Count: int32(s.state.Retries),
+	}, nil
+}
+
+// Assertion ...
+func (s *Server) Assertion(ctx context.Context, req *fido2.AssertionRequest) (*fido2.AssertionResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	if req.RPID == "" {
+		return nil, status.Error(codes.InvalidArgument, "no rp id specified")
+	}
+	if len(req.ClientDataHash) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "invalid client data hash")
+	}
+	up, err := optionValue(req.UP, true)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := optionValue(req.UV, false); err != nil {
+		return nil, err
+	}
+	if req.UV == "true" && req.PIN == "" {
+		return nil, status.Error(codes.InvalidArgument, "unsupported option uv")
+	}
+	hmacSecret := false
+	for _, ext := range req.Extensions {
+		switch ext {
+		case "hmac-secret":
+			hmacSecret = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported extension %s", ext)
+		}
+	}
+	if hmacSecret && len(req.HMACSalt) != 32 && len(req.HMACSalt) != 64 {
+		return nil, status.Error(codes.InvalidArgument, "invalid hmac salt")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	uv, err := s.checkPIN(req.PIN, false)
+	if err != nil {
+		return nil, err
+	}
+
+	cred := s.findCredential(req.RPID, req.CredID)
+	if cred == nil {
+		return nil, status.Error(codes.NotFound, "no credentials")
+	}
+
+	var hmacOut []byte
+	if hmacSecret {
+		if !cred.HMACSecret {
+			return nil, status.Error(codes.InvalidArgument, "credential doesn't support hmac-secret")
+		}
+		key := cred.CredRandom
+		if uv {
+			key = cred.CredRandomUV
+		}
+		for i := 0; i < len(req.HMACSalt); i += 32 {
+			h := hmac.New(sha256.New, key)
+			_, _ = h.Write(req.HMACSalt[i : i+32])
+			hmacOut = append(hmacOut, h.Sum(nil)...)
+		}
+	}
+
+	cred.SignCount++
+	if err := s.save(); err != nil {
+		return nil, err
+	}
+
+	var authData bytes.Buffer
+	flags := byte(0)
+	if up {
+		flags |= flagUP
+	}
+	if uv {
+		flags |= flagUV
+	}
+	rpIDHash := sha256.Sum256([]byte(cred.RPID))
+	authData.Write(rpIDHash[:])
+	authData.WriteByte(flags)
+	_ = binary.Write(&authData, binary.BigEndian, cred.SignCount)
+
+	sig, err := cred.sign(authData.Bytes(), req.ClientDataHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fido2.AssertionResponse{
+		Assertion: &fido2.Assertion{
+			AuthData:   cborEncodeBytes(authData.Bytes()),
+			Sig:        sig,
+			HMACSecret: hmacOut,
+		},
+	}, nil
+}
+
+// findCredential returns the credential for the RP. If no credential ID is
+// specified, returns the most recent resident credential for the RP.
+func (s *Server) findCredential(rpID string, credID []byte) *credential {
+	for i := len(s.state.Credentials) - 1; i >= 0; i-- {
+		cred := s.state.Credentials[i]
+		if cred.RPID != rpID {
+			continue
+		}
+		if len(credID) == 0 {
+			if cred.RK {
+				return cred
+			}
+			continue
+		}
+		if bytes.Equal(cred.ID, credID) {
+			return cred
+		}
+	}
+	return nil
+}
+
+// CredentialsInfo ...
+func (s *Server) CredentialsInfo(ctx context.Context, req *fido2.CredentialsInfoRequest) (*fido2.CredentialsInfoResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	if req.PIN == "" {
+		return nil, status.Error(codes.InvalidArgument, "pin required")
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.checkPIN(req.PIN, true); err != nil {
+		return nil, err
+	}
+	existing := len(s.resident(""))
+	return &fido2.CredentialsInfoResponse{
+		Info: &fido2.CredentialsInfo{
+			RKExisting:  int32(existing),
+			RKRemaining: int32(maxResident - existing),
+		},
+	}, nil
+}
+
+// Credentials returns resident credentials.
+func (s *Server) Credentials(ctx context.Context, req *fido2.CredentialsRequest) (*fido2.CredentialsResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	if req.PIN == "" {
+		return nil, status.Error(codes.InvalidArgument, "pin required")
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.checkPIN(req.PIN, true); err != nil {
+		return nil, err
+	}
+	creds := s.resident(req.RPID)
+	out := make([]*fido2.Credential, 0, len(creds))
+	for _, cred := range creds {
+		out = append(out, &fido2.Credential{
+			ID:   cred.ID,
+			Type: cred.Type,
+			RP:   &fido2.RelyingParty{ID: cred.RPID, Name: cred.RPName},
+			User: &fido2.User{
+				ID:          cred.User.ID,
+				Name:        cred.User.Name,
+				DisplayName: cred.User.DisplayName,
+				Icon:        cred.User.Icon,
+			},
+		})
+	}
+	return &fido2.CredentialsResponse{
+		Credentials: out,
+	}, nil
+}
+
+// RelyingParties returns relying parties with resident credentials.
+func (s *Server) RelyingParties(ctx context.Context, req *fido2.RelyingPartiesRequest) (*fido2.RelyingPartiesResponse, error) {
+	if err := checkDevice(req.Device); err != nil {
+		return nil, err
+	}
+	if req.PIN == "" {
+		return nil, status.Error(codes.InvalidArgument, "pin required")
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.checkPIN(req.PIN, true); err != nil {
+		return nil, err
+	}
+	rps := []*fido2.RelyingParty{}
+	seen := map[string]bool{}
+	for _, cred := range s.resident("") {
+		if seen[cred.RPID] {
+			continue
+		}
+		seen[cred.RPID] = true
+		rps = append(rps, &fido2.RelyingParty{ID: cred.RPID, Name: cred.RPName})
+	}
+	sort.Slice(rps, func(i, j int) bool {
+		return rps[i].ID < rps[j].ID
+	})
+	return &fido2.RelyingPartiesResponse{
+		Parties: rps,
+	}, nil
+}
+
+// VerifyAttestation ...
+func (s *Server) VerifyAttestation(ctx context.Context, req *fido2.VerifyAttestationRequest) (*fido2.VerifyAttestationResponse, error) {
+	return fido2.VerifyAttestation(ctx, req)
+}
+
+// VerifyAssertion ...
+func (s *Server) VerifyAssertion(ctx context.Context, req *fido2.VerifyAssertionRequest) (*fido2.VerifyAssertionResponse, error) {
+	return fido2.VerifyAssertion(ctx, req)
+}
+
+// resident returns resident credentials, for the RP if specified.
+func (s *Server) resident(rpID string) []*credential {
+	creds := []*credential{}
+	for _, cred := range s.state.Credentials {
+		if !cred.RK || (rpID != "" && cred.RPID != rpID) {
+			continue
+		}
+		creds = append(creds, cred)
+	}
+	return creds
+}
+
+func credTypeFromRPC(typ string) (string, error) {
+	switch typ {
+	case "es256", "ES256":
+		return "es256", nil
+	case "eddsa", "EDDSA":
+		return "eddsa", nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unsupported credential type %v", typ)
+	}
+}
+
+func optionValue(in string, dflt bool) (bool, error) {
+	switch in {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "":
+		return dflt, nil
+	default:
+		return false, status.Error(codes.InvalidArgument, "invalid option value")
+	}
+}
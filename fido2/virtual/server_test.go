@@ -0,0 +1,332 @@
+package virtual_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/keys-pub/keysd/fido2"
+	"github.com/keys-pub/keysd/fido2/virtual"
+	"github.com/stretchr/testify/require"
+)
+
+// authData returns authenticator data from CBOR byte string.
+func authData(t *testing.T, b []byte) []byte {
+	require.True(t, len(b) > 2)
+	switch b[0] {
+	case 0x58:
+		return b[2:]
+	case 0x59:
+		return b[3:]
+	}
+	t.Fatalf("unexpected cbor header %x", b[0])
+	return nil
+}
+
+func clientDataHash(s string) []byte {
+	h := sha256.Sum256([]byte(s))
+	return h[:]
+}
+
+func verifyES256(t *testing.T, pubKey []byte, authData []byte, cdh []byte, sig []byte) {
+	require.Equal(t, 64, len(pubKey))
+	pk := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(pubKey[:32]),
+		Y:     new(big.Int).SetBytes(pubKey[32:]),
+	}
+	var rs struct{ R, S *big.Int }
+	_, err := asn1.Unmarshal(sig, &rs)
+	require.NoError(t, err)
+	h := sha256.Sum256(append(append([]byte{}, authData...), cdh...))
+	require.True(t, ecdsa.Verify(pk, h[:], rs.R, rs.S))
+}
+
+func TestMakeCredentialAssertion(t *testing.T) {
+	ctx := context.TODO()
+	server, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+
+	devices, err := server.Devices(ctx, &fido2.DevicesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(devices.Devices))
+	device := devices.Devices[0].Path
+
+	info, err := server.DeviceInfo(ctx, &fido2.DeviceInfoRequest{Device: device})
+	require.NoError(t, err)
+	require.Equal(t, []string{"hmac-secret"}, info.Info.Extensions)
+	require.Equal(t, virtual.AAGUID, info.Info.AAGUID)
+
+	cdh := clientDataHash("make credential")
+	mresp, err := server.MakeCredential(ctx, &fido2.MakeCredentialRequest{
+		Device:         device,
+		ClientDataHash: cdh,
+		RP:             &fido2.RelyingParty{ID: "keys.pub", Name: "keys.pub"},
+		User:           &fido2.User{ID: []byte("alice"), Name: "alice"},
+		Type:           "es256",
+		Extensions:     []string{"hmac-secret"},
+	})
+	require.NoError(t, err)
+	att := mresp.Attestation
+	require.Equal(t, "packed", att.Format)
+	require.Equal(t, "es256", att.CredType)
+	ad := authData(t, att.AuthData)
+	rpIDHash := sha256.Sum256([]byte("keys.pub"))
+	require.Equal(t, rpIDHash[:], ad[:32])
+	require.Equal(t, byte(0x01|0x40|0x80), ad[32])
+	require.True(t, bytes.Contains(ad, att.CredID))
+	verifyES256(t, att.PubKey, ad, cdh, att.Sig)
+
+	salt := bytes.Repeat([]byte{0x01}, 32)
+	cdh = clientDataHash("assertion")
+	aresp, err := server.Assertion(ctx, &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           "keys.pub",
+		ClientDataHash: cdh,
+		CredID:         att.CredID,
+		Extensions:     []string{"hmac-secret"},
+		HMACSalt:       salt,
+	})
+	require.NoError(t, err)
+	ad = authData(t, aresp.Assertion.AuthData)
+	require.Equal(t, byte(0x01), ad[32])
+	require.Equal(t, []byte{0, 0, 0, 1}, ad[33:37])
+	verifyES256(t, att.PubKey, ad, cdh, aresp.Assertion.Sig)
+	require.Equal(t, 32, len(aresp.Assertion.HMACSecret))
+	secret := aresp.Assertion.HMACSecret
+
+	// Same salt, same secret
+	aresp, err = server.Assertion(ctx, &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           "keys.pub",
+		ClientDataHash: cdh,
+		CredID:         att.CredID,
+		Extensions:     []string{"hmac-secret"},
+		HMACSalt:       salt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, secret, aresp.Assertion.HMACSecret)
+	require.Equal(t, []byte{0, 0, 0, 2}, authData(t, aresp.Assertion.AuthData)[33:37])
+
+	// Different salt
+	aresp, err = server.Assertion(ctx, &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           "keys.pub",
+		ClientDataHash: cdh,
+		CredID:         att.CredID,
+		Extensions:     []string{"hmac-secret"},
+		HMACSalt:       bytes.Repeat([]byte{0x02}, 32),
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, secret, aresp.Assertion.HMACSecret)
+
+	// Wrong RP
+	_, err = server.Assertion(ctx, &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           "other.com",
+		ClientDataHash: cdh,
+		CredID:         att.CredID,
+	})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = no credentials")
+
+	_, err = server.DeviceInfo(ctx, &fido2.DeviceInfoRequest{Device: "/dev/hidraw9"})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = device not found /dev/hidraw9")
+}
+
+func TestEdDSA(t *testing.T) {
+	ctx := context.TODO()
+	server, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+
+	cdh := clientDataHash("make credential")
+	mresp, err := server.MakeCredential(ctx, &fido2.MakeCredentialRequest{
+		Device:         virtual.DevicePath,
+		ClientDataHash: cdh,
+		RP:             &fido2.RelyingParty{ID: "keys.pub"},
+		User:           &fido2.User{ID: []byte("alice")},
+		Type:           "eddsa",
+	})
+	require.NoError(t, err)
+	att := mresp.Attestation
+	ad := authData(t, att.AuthData)
+	require.True(t, ed25519.Verify(att.PubKey, append(ad, cdh...), att.Sig))
+
+	_, err = server.MakeCredential(ctx, &fido2.MakeCredentialRequest{
+		Device:         virtual.DevicePath,
+		ClientDataHash: cdh,
+		RP:             &fido2.RelyingParty{ID: "keys.pub"},
+		User:           &fido2.User{ID: []byte("alice")},
+		Type:           "rs256",
+	})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = unsupported credential type rs256")
+}
+
+func TestPIN(t *testing.T) {
+	ctx := context.TODO()
+	server, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+	device := virtual.DevicePath
+
+	_, err = server.SetPIN(ctx, &fido2.SetPINRequest{Device: device, PIN: "123"})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = pin policy violation")
+
+	_, err = server.SetPIN(ctx, &fido2.SetPINRequest{Device: device, PIN: "12345"})
+	require.NoError(t, err)
+
+	// PIN required to make credential
+	req := &fido2.MakeCredentialRequest{
+		Device:         device,
+		ClientDataHash: clientDataHash("test"),
+		RP:             &fido2.RelyingParty{ID: "keys.pub"},
+		User:           &fido2.User{ID: []byte("alice")},
+		Type:           "es256",
+		Extensions:     []string{"hmac-secret"},
+	}
+	_, err = server.MakeCredential(ctx, req)
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = pin required")
+
+	req.PIN = "00000"
+	_, err = server.MakeCredential(ctx, req)
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = pin invalid")
+	rresp, err := server.RetryCount(ctx, &fido2.RetryCountRequest{Device: device})
+	require.NoError(t, err)
+	require.Equal(t, int32(7), rresp.Count)
+
+	req.PIN = "12345"
+	mresp, err := server.MakeCredential(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x01|0x04|0x40|0x80), authData(t, mresp.Attestation.AuthData)[32])
+	rresp, err = server.RetryCount(ctx, &fido2.RetryCountRequest{Device: device})
+	require.NoError(t, err)
+	require.Equal(t, int32(8), rresp.Count)
+
+	// hmac-secret is different with and without user verification
+	salt := bytes.Repeat([]byte{0x01}, 64)
+	areq := &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           "keys.pub",
+		ClientDataHash: clientDataHash("test"),
+		CredID:         mresp.Attestation.CredID,
+		Extensions:     []string{"hmac-secret"},
+		HMACSalt:       salt,
+	}
+	aresp, err := server.Assertion(ctx, areq)
+	require.NoError(t, err)
+	require.Equal(t, 64, len(aresp.Assertion.HMACSecret))
+	areq.PIN = "12345"
+	aresp2, err := server.Assertion(ctx, areq)
+	require.NoError(t, err)
+	require.NotEqual(t, aresp.Assertion.HMACSecret, aresp2.Assertion.HMACSecret)
+
+	// Change PIN
+	_, err = server.SetPIN(ctx, &fido2.SetPINRequest{Device: device, PIN: "54321", OldPIN: "11111"})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = pin invalid")
+	_, err = server.SetPIN(ctx, &fido2.SetPINRequest{Device: device, PIN: "54321", OldPIN: "12345"})
+	require.NoError(t, err)
+
+	// Block
+	for i := 0; i < 7; i++ {
+		_, err = server.RelyingParties(ctx, &fido2.RelyingPartiesRequest{Device: device, PIN: "00000"})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = pin invalid")
+	}
+	_, err = server.RelyingParties(ctx, &fido2.RelyingPartiesRequest{Device: device, PIN: "00000"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = pin blocked")
+	_, err = server.RelyingParties(ctx, &fido2.RelyingPartiesRequest{Device: device, PIN: "54321"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = pin blocked")
+
+	// Reset
+	_, err = server.Reset(ctx, &fido2.ResetRequest{Device: device})
+	require.NoError(t, err)
+	rresp, err = server.RetryCount(ctx, &fido2.RetryCountRequest{Device: device})
+	require.NoError(t, err)
+	require.Equal(t, int32(8), rresp.Count)
+	_, err = server.Assertion(ctx, areq)
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = pin not set")
+	areq.PIN = ""
+	_, err = server.Assertion(ctx, areq)
+	require.EqualError(t, err, "rpc error: code = NotFound desc = no credentials")
+}
+
+func TestResidentCredentials(t *testing.T) {
+	ctx := context.TODO()
+	dir, err := ioutil.TempDir("", "fido2-virtual-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "state.json")
+
+	server, err := virtual.NewAuthenticatorsServer(path)
+	require.NoError(t, err)
+	device := virtual.DevicePath
+
+	_, err = server.SetPIN(ctx, &fido2.SetPINRequest{Device: device, PIN: "12345"})
+	require.NoError(t, err)
+
+	makeCredential := func(rp string, userID string, rk string) {
+		_, err := server.MakeCredential(ctx, &fido2.MakeCredentialRequest{
+			Device:         device,
+			ClientDataHash: clientDataHash("test"),
+			RP:             &fido2.RelyingParty{ID: rp, Name: rp},
+			User:           &fido2.User{ID: []byte(userID), Name: userID},
+			Type:           "es256",
+			PIN:            "12345",
+			RK:             rk,
+		})
+		require.NoError(t, err)
+	}
+	makeCredential("keys.pub", "alice", "true")
+	makeCredential("keys.pub", "alice", "true") // Replaces
+	makeCredential("keys.pub", "bob", "true")
+	makeCredential("example.com", "alice", "true")
+	makeCredential("other.com", "alice", "")
+
+	// Resident credential without credential ID
+	_, err = server.Assertion(ctx, &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           "example.com",
+		ClientDataHash: clientDataHash("test"),
+	})
+	require.NoError(t, err)
+	_, err = server.Assertion(ctx, &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           "other.com",
+		ClientDataHash: clientDataHash("test"),
+	})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = no credentials")
+
+	// Load from state
+	server, err = virtual.NewAuthenticatorsServer(path)
+	require.NoError(t, err)
+
+	_, err = server.Credentials(ctx, &fido2.CredentialsRequest{Device: device})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = pin required")
+
+	iresp, err := server.CredentialsInfo(ctx, &fido2.CredentialsInfoRequest{Device: device, PIN: "12345"})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), iresp.Info.RKExisting)
+	require.Equal(t, int32(22), iresp.Info.RKRemaining)
+
+	rpsResp, err := server.RelyingParties(ctx, &fido2.RelyingPartiesRequest{Device: device, PIN: "12345"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rpsResp.Parties))
+	require.Equal(t, "example.com", rpsResp.Parties[0].ID)
+	require.Equal(t, "keys.pub", rpsResp.Parties[1].ID)
+
+	cresp, err := server.Credentials(ctx, &fido2.CredentialsRequest{Device: device, PIN: "12345", RPID: "keys.pub"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cresp.Credentials))
+	require.Equal(t, []byte("alice"), cresp.Credentials[0].User.ID)
+	require.Equal(t, []byte("bob"), cresp.Credentials[1].User.ID)
+
+	cresp, err = server.Credentials(ctx, &fido2.CredentialsRequest{Device: device, PIN: "12345"})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(cresp.Credentials))
+}
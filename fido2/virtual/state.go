@@ -0,0 +1,170 @@
+package virtual
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// state is the (persisted) authenticator state.
+// The state file includes private keys and is not encrypted, the virtual
+// authenticator is for testing only.
+type state struct {
+	// PINHash is LEFT(SHA-256(PIN), 16), or empty if no PIN is set.
+	PINHash     []byte        `json:"pinHash,omitempty"`
+	Retries     int           `json:"retries"`
+	Credentials []*credential `json:"credentials"`
+}
+
+type user struct {
+	ID          []byte `json:"id"`
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+}
+
+type credential struct {
+	ID     []byte `json:"id"`
+	Type   string `json:"type"`
+	RPID   string `json:"rpId"`
+	RPName string `json:"rpName,omitempty"`
+	User   *user  `json:"user"`
+	// PrivateKey is the P-256 scalar (es256) or Ed25519 seed (eddsa).
+	PrivateKey []byte `json:"privateKey"`
+	RK         bool   `json:"rk,omitempty"`
+	HMACSecret bool   `json:"hmacSecret,omitempty"`
+	// CredRandom and CredRandomUV are the hmac-secret keys, without and with
+	// user verification.
+	CredRandom   []byte `json:"credRandom,omitempty"`
+	CredRandomUV []byte `json:"credRandomUV,omitempty"`
+	SignCount    uint32 `json:"signCount"`
+}
+
+func newState() *state {
+	return &state{
+		Retries:     maxRetries,
+		Credentials: []*credential{},
+	}
+}
+
+func loadState(path string) (*state, error) {
+	if path == "" {
+		return newState(), nil
+	}
+	b, err := ioutil.ReadFile(path) // #nosec
+	if err != nil {
+		if os.IsNotExist(err) {
+			return newState(), nil
+		}
+		return nil, err
+	}
+	var st state
+	if err := json.Unmarshal(b, &st); err != nil {
+		return nil, errors.Wrapf(err, "invalid virtual authenticator state")
+	}
+	if st.Credentials == nil {
+		st.Credentials = []*credential{}
+	}
+	return &st, nil
+}
+
+func saveState(path string, st *state) error {
+	if path == "" {
+		return nil
+	}
+	b, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func pinHash(pin string) []byte {
+	h := sha256.Sum256([]byte(pin))
+	return h[:16]
+}
+
+func randBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func generatePrivateKey(typ string) ([]byte, error) {
+	switch typ {
+	case "es256":
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return padBytes(key.D.Bytes(), 32), nil
+	case "eddsa":
+		return randBytes(ed25519.SeedSize), nil
+	default:
+		return nil, errors.Errorf("unsupported credential type %s", typ)
+	}
+}
+
+func (c *credential) ecdsaKey() *ecdsa.PrivateKey {
+	curve := elliptic.P256()
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(c.PrivateKey)}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(c.PrivateKey)
+	return key
+}
+
+// publicKey returns the public key (as libfido2 does, x||y for es256) and the
+// COSE_Key.
+func (c *credential) publicKey() ([]byte, []byte, error) {
+	switch c.Type {
+	case "es256":
+		key := c.ecdsaKey()
+		x, y := padBytes(key.X.Bytes(), 32), padBytes(key.Y.Bytes(), 32)
+		return append(append([]byte{}, x...), y...), coseKeyES256(x, y), nil
+	case "eddsa":
+		pk := ed25519.NewKeyFromSeed(c.PrivateKey).Public().(ed25519.PublicKey)
+		return []byte(pk), coseKeyEdDSA(pk), nil
+	default:
+		return nil, nil, errors.Errorf("unsupported credential type %s", c.Type)
+	}
+}
+
+// sign returns signature (ASN.1 DER for es256) over authData || clientDataHash.
+func (c *credential) sign(authData []byte, clientDataHash []byte) ([]byte, error) {
+	msg := append(append([]byte{}, authData...), clientDataHash...)
+	switch c.Type {
+	case "es256":
+		h := sha256.Sum256(msg)
+		r, s, err := ecdsa.Sign(rand.Reader, c.ecdsaKey(), h[:])
+		if err != nil {
+			return nil, err
+		}
+		return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	case "eddsa":
+		return ed25519.Sign(ed25519.NewKeyFromSeed(c.PrivateKey), msg), nil
+	default:
+		return nil, errors.Errorf("unsupported credential type %s", c.Type)
+	}
+}
+
+func padBytes(b []byte, n int) []byte {
+	if len(b) >= n {
+		return b
+	}
+	return append(make([]byte, n-len(b)), b...)
+}
@@ -11,8 +11,11 @@ type Response struct {
 
 // Error ...
 type Error struct {
+	// Status is the HTTP status code.
+	Status  int    `json:"code,omitempty"`
 	Message string `json:"message,omitempty"`
-	Status  int    `json:"status,omitempty"`
+	// Code is a stable (machine-readable) error code, see ErrorCode.
+	Code ErrorCode `json:"errorCode,omitempty"`
 }
 
 // Metadata ...
@@ -1,9 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -16,29 +20,53 @@ import (
 	"github.com/pkg/errors"
 )
 
-// TODO: Headers are not included in auth.
-
 // Auth describes auth for a HTTP request.
+//
+// The v2 scheme signs the method, URL (with nonce and timestamp), a SHA-256
+// digest of the body and the values of AuthHeaders. The Authorization header
+// is of the form "v2 kid:sig".
+//
+// The (deprecated) v1 scheme signs only the method and URL and the
+// Authorization header is of the form "kid:sig".
 type Auth struct {
 	KID     keys.ID
 	Method  string
 	URL     *url.URL
 	Sig     string
 	Message string
+	// V1 if using the deprecated scheme.
+	V1 bool
 }
 
+// AuthHeaders are the request headers included in v2 auth.
+var AuthHeaders = []string{"Content-Type"}
+
 // Header is header value.
 func (a Auth) Header() string {
-	return a.KID.String() + ":" + a.Sig
+	if a.V1 {
+		return a.KID.String() + ":" + a.Sig
+	}
+	return "v2 " + a.KID.String() + ":" + a.Sig
 }
 
-// NewAuth returns auth for an HTTP request.
+// NewAuth returns auth for an HTTP request with no body.
 // The url shouldn't have ? or &.
 func NewAuth(method string, urs string, tm time.Time, key *keys.EdX25519Key) (*Auth, error) {
-	return newAuth(method, urs, tm, keys.Rand32(), key)
+	return newAuth(method, urs, nil, nil, tm, keys.Rand32(), key)
+}
+
+// NewAuthWithBody returns auth for an HTTP request with a body and headers.
+func NewAuthWithBody(method string, urs string, body []byte, header http.Header, tm time.Time, key *keys.EdX25519Key) (*Auth, error) {
+	return newAuth(method, urs, body, header, tm, keys.Rand32(), key)
 }
 
-func newAuth(method string, urs string, tm time.Time, nonce *[32]byte, key *keys.EdX25519Key) (*Auth, error) {
+// NewAuthV1 returns auth using the deprecated v1 scheme, which doesn't
+// include the body or headers.
+func NewAuthV1(method string, urs string, tm time.Time, key *keys.EdX25519Key) (*Auth, error) {
+	return newAuthV1(method, urs, tm, keys.Rand32(), key)
+}
+
+func authURL(urs string, tm time.Time, nonce *[32]byte) (*url.URL, error) {
 	ur, err := url.Parse(urs)
 	if err != nil {
 		return nil, err
@@ -49,35 +77,97 @@ func newAuth(method string, urs string, tm time.Time, nonce *[32]byte, key *keys
 	ts := util.TimeToMillis(tm)
 	q.Set("ts", fmt.Sprintf("%d", ts))
 	ur.RawQuery = q.Encode()
+	return ur, nil
+}
 
-	msg := method + "," + ur.String()
+func newAuth(method string, urs string, body []byte, header http.Header, tm time.Time, nonce *[32]byte, key *keys.EdX25519Key) (*Auth, error) {
+	ur, err := authURL(urs, tm, nonce)
+	if err != nil {
+		return nil, err
+	}
+	msg := authMessage(method, ur, body, header)
 	logger.Debugf("Signing %s", msg)
 	sb := key.SignDetached([]byte(msg))
 	sig := encoding.MustEncode(sb, encoding.Base62)
 	return &Auth{KID: key.ID(), Method: method, URL: ur, Sig: sig, Message: msg}, nil
 }
 
+func newAuthV1(method string, urs string, tm time.Time, nonce *[32]byte, key *keys.EdX25519Key) (*Auth, error) {
+	ur, err := authURL(urs, tm, nonce)
+	if err != nil {
+		return nil, err
+	}
+	msg := authMessageV1(method, ur)
+	logger.Debugf("Signing (v1) %s", msg)
+	sb := key.SignDetached([]byte(msg))
+	sig := encoding.MustEncode(sb, encoding.Base62)
+	return &Auth{KID: key.ID(), Method: method, URL: ur, Sig: sig, Message: msg, V1: true}, nil
+}
+
+func authMessageV1(method string, ur *url.URL) string {
+	return method + "," + ur.String()
+}
+
+func authMessage(method string, ur *url.URL, body []byte, header http.Header) string {
+	lines := []string{"v2", method, ur.String(), ContentDigest(body)}
+	for _, name := range AuthHeaders {
+		lines = append(lines, strings.ToLower(name)+":"+header.Get(name))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ContentDigest returns the digest of the body included in v2 auth, of the
+// form "sha-256=<base64>".
+func ContentDigest(body []byte) string {
+	h := sha256.Sum256(body)
+	return "sha-256=" + base64.StdEncoding.EncodeToString(h[:])
+}
+
 // NewRequest returns new authorized/signed HTTP request.
 func NewRequest(method string, urs string, body io.Reader, tm time.Time, key *keys.EdX25519Key) (*http.Request, error) {
-	return newRequest(context.TODO(), method, urs, body, tm, keys.Rand32(), key)
+	return newRequest(context.TODO(), method, urs, body, nil, tm, keys.Rand32(), key)
 }
 
 // NewRequestWithContext returns new authorized/signed HTTP request with context.
 func NewRequestWithContext(ctx context.Context, method string, urs string, body io.Reader, tm time.Time, key *keys.EdX25519Key) (*http.Request, error) {
-	return newRequest(ctx, method, urs, body, tm, keys.Rand32(), key)
+	return newRequest(ctx, method, urs, body, nil, tm, keys.Rand32(), key)
 }
 
-func newRequest(ctx context.Context, method string, urs string, body io.Reader, tm time.Time, nonce *[32]byte, key *keys.EdX25519Key) (*http.Request, error) {
-	auth, err := newAuth(method, urs, tm, nonce, key)
+// NewRequestWithHeader returns new authorized/signed HTTP request with
+// headers. The headers are set on the request, and the AuthHeaders are signed.
+func NewRequestWithHeader(ctx context.Context, method string, urs string, body io.Reader, header http.Header, tm time.Time, key *keys.EdX25519Key) (*http.Request, error) {
+	return newRequest(ctx, method, urs, body, header, tm, keys.Rand32(), key)
+}
+
+func newRequest(ctx context.Context, method string, urs string, body io.Reader, header http.Header, tm time.Time, nonce *[32]byte, key *keys.EdX25519Key) (*http.Request, error) {
+	var b []byte
+	if body != nil {
+		rb, err := ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		b = rb
+	}
+	auth, err := newAuth(method, urs, b, header, tm, nonce, key)
 	if err != nil {
 		return nil, err
 	}
 	logger.Infof("Auth for %s", auth.Message)
-	req, err := http.NewRequestWithContext(ctx, method, auth.URL.String(), body)
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, auth.URL.String(), reqBody)
 	if err != nil {
 		return nil, err
 	}
+	for name, values := range header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
 	req.Header.Set("Authorization", auth.Header())
+	req.Header.Set("Content-Digest", ContentDigest(b))
 	return req, nil
 }
 
@@ -88,8 +178,14 @@ type AuthResult struct {
 	URL       *url.URL
 	Nonce     string
 	Timestamp time.Time
+	// V1 if authorized using the deprecated scheme.
+	V1 bool
 }
 
+// ErrNonceCollision is returned by CheckAuthorization if the nonce was
+// already used.
+var ErrNonceCollision error = NewCodeError(ErrCodeNonceCollision, "nonce collision")
+
 // MemCache ...
 type MemCache interface {
 	// Get returns value at key.
@@ -101,7 +197,15 @@ type MemCache interface {
 }
 
 // CheckAuthorization checks auth header.
-func CheckAuthorization(ctx context.Context, method string, urs string, auth string, mc MemCache, now time.Time) (*AuthResult, error) {
+// The header and body of the request are needed for v2 auth.
+// The v1 scheme is accepted until v1Deadline, or if v1Deadline is zero.
+func CheckAuthorization(ctx context.Context, method string, urs string, auth string, header http.Header, body []byte, mc MemCache, now time.Time, v1Deadline time.Time) (*AuthResult, error) {
+	v1 := true
+	if strings.HasPrefix(auth, "v2 ") {
+		v1 = false
+		auth = strings.TrimPrefix(auth, "v2 ")
+	}
+
 	fields := strings.Split(auth, ":")
 	if len(fields) != 2 {
 		return nil, errors.Errorf("too many fields")
@@ -129,7 +233,23 @@ func CheckAuthorization(ctx context.Context, method string, urs string, auth str
 		return nil, err
 	}
 
-	msg := method + "," + url.String()
+	var msg string
+	if v1 {
+		if !v1Deadline.IsZero() && !now.Before(v1Deadline) {
+			return nil, NewCodeError(ErrCodeAuthInvalid, "v1 auth is no longer supported")
+		}
+		if v1Deadline.IsZero() {
+			logger.Warningf("Deprecated (v1) auth from %s, accepted since no v1 deadline is set", kid)
+		} else {
+			logger.Warningf("Deprecated (v1) auth from %s, accepted until %s", kid, v1Deadline.Format(time.RFC3339))
+		}
+		msg = authMessageV1(method, url)
+	} else {
+		if digest := header.Get("Content-Digest"); digest != "" && digest != ContentDigest(body) {
+			return nil, NewCodeError(ErrCodeContentDigestMismatch, "content digest mismatch")
+		}
+		msg = authMessage(method, url, body, header)
+	}
 	logger.Infof("Checking auth for %s %s", msg, auth)
 	if err := spk.VerifyDetached(sigBytes, []byte(msg)); err != nil {
 		return nil, err
@@ -155,7 +275,7 @@ func CheckAuthorization(ctx context.Context, method string, urs string, auth str
 		return nil, err
 	}
 	if val != "" {
-		return nil, errors.Errorf("nonce collision")
+		return nil, ErrNonceCollision
 	}
 	if err := mc.Set(ctx, nonce, "1"); err != nil {
 		return nil, err
@@ -179,7 +299,7 @@ func CheckAuthorization(ctx context.Context, method string, urs string, auth str
 		td = td * -1
 	}
 	if td > 30*time.Minute {
-		return nil, errors.Errorf("timestamp is invalid, diff %s", td)
+		return nil, NewCodeError(ErrCodeAuthExpired, "timestamp is invalid, diff %s", td)
 	}
 
 	logger.Infof("Auth OK %s", kid)
@@ -189,5 +309,6 @@ func CheckAuthorization(ctx context.Context, method string, urs string, auth str
 		URL:       url,
 		Nonce:     nonce,
 		Timestamp: tm,
+		V1:        v1,
 	}, nil
 }
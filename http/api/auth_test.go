@@ -3,7 +3,9 @@ package api
 import (
 	"bytes"
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys/util"
@@ -16,13 +18,128 @@ func TestAuth(t *testing.T) {
 	tm := util.TimeFromMillis(123456789000)
 	nonce := keys.Bytes32(bytes.Repeat([]byte{0x01}, 32))
 	urs := "https://keys.pub/message?version=123456789001"
-	auth, err := newAuth("POST", urs, tm, nonce, alice)
+	auth, err := newAuth("POST", urs, []byte("hi"), nil, tm, nonce, alice)
 	require.NoError(t, err)
-	require.Equal(t, "kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077:sDMBYMJT7OPY1S1eP1I5jmpUSLi4QGAdg2UVooPEkHQwcie8EhfCFZeyeR7D71DkJ6vTb1bOXShmqyOqIk7l7h", auth.Header())
+	require.Equal(t, "v2 kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077:ccTOOfcGidpvM6GihCNs2GGQ2CpRG1w7kSoScgHvB4Nb5lUdakmRIiqzvBmLh8nhqleeGRPuq6FIxH8c4SLw49", auth.Header())
 	require.Equal(t, "https://keys.pub/message?nonce=0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29&ts=123456789000&version=123456789001", auth.URL.String())
+	require.Equal(t, "v2\nPOST\nhttps://keys.pub/message?nonce=0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29&ts=123456789000&version=123456789001\nsha-256=j0NDRmSPa5bfid2pAcUXaxCm2Dlh3TwayItZstwyeqQ=\ncontent-type:", auth.Message)
 
-	req, err := newRequest(context.TODO(), "POST", urs, nil, tm, nonce, alice)
+	req, err := newRequest(context.TODO(), "POST", urs, bytes.NewReader([]byte("hi")), nil, tm, nonce, alice)
 	require.NoError(t, err)
 	require.Equal(t, "https://keys.pub/message?nonce=0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29&ts=123456789000&version=123456789001", req.URL.String())
-	require.Equal(t, "kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077:sDMBYMJT7OPY1S1eP1I5jmpUSLi4QGAdg2UVooPEkHQwcie8EhfCFZeyeR7D71DkJ6vTb1bOXShmqyOqIk7l7h", req.Header.Get("Authorization"))
+	require.Equal(t, "v2 kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077:ccTOOfcGidpvM6GihCNs2GGQ2CpRG1w7kSoScgHvB4Nb5lUdakmRIiqzvBmLh8nhqleeGRPuq6FIxH8c4SLw49", req.Header.Get("Authorization"))
+	require.Equal(t, "sha-256=j0NDRmSPa5bfid2pAcUXaxCm2Dlh3TwayItZstwyeqQ=", req.Header.Get("Content-Digest"))
+}
+
+func TestRequestWithHeader(t *testing.T) {
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	mc := &memCache{kv: map[string]string{}}
+	tm := util.TimeFromMillis(1234567890000)
+	body := []byte(`{"msg":"hi"}`)
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	req, err := NewRequestWithHeader(context.TODO(), "POST", "https://keys.pub/msgs", bytes.NewReader(body), header, tm, alice)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	_, err = CheckAuthorization(context.TODO(), "POST", req.URL.String(), req.Header.Get("Authorization"), req.Header, body, mc, tm, time.Time{})
+	require.NoError(t, err)
+
+	// Header changed
+	req, err = NewRequestWithHeader(context.TODO(), "POST", "https://keys.pub/msgs", bytes.NewReader(body), header, tm, alice)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "text/plain")
+	_, err = CheckAuthorization(context.TODO(), "POST", req.URL.String(), req.Header.Get("Authorization"), req.Header, body, mc, tm, time.Time{})
+	require.EqualError(t, err, "verify failed")
+}
+
+func TestAuthV1(t *testing.T) {
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	tm := util.TimeFromMillis(123456789000)
+	nonce := keys.Bytes32(bytes.Repeat([]byte{0x01}, 32))
+	urs := "https://keys.pub/message?version=123456789001"
+	auth, err := newAuthV1("POST", urs, tm, nonce, alice)
+	require.NoError(t, err)
+	require.Equal(t, "kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077:sDMBYMJT7OPY1S1eP1I5jmpUSLi4QGAdg2UVooPEkHQwcie8EhfCFZeyeR7D71DkJ6vTb1bOXShmqyOqIk7l7h", auth.Header())
+	require.Equal(t, "https://keys.pub/message?nonce=0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29&ts=123456789000&version=123456789001", auth.URL.String())
+}
+
+type memCache struct {
+	kv map[string]string
+}
+
+func (m *memCache) Get(ctx context.Context, k string) (string, error) {
+	return m.kv[k], nil
+}
+
+func (m *memCache) Set(ctx context.Context, k string, v string) error {
+	m.kv[k] = v
+	return nil
+}
+
+func (m *memCache) Expire(ctx context.Context, k string, dt time.Duration) error {
+	return nil
+}
+
+func TestCheckAuthorization(t *testing.T) {
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	mc := &memCache{kv: map[string]string{}}
+	tm := util.TimeFromMillis(1234567890000)
+	urs := "https://keys.pub/msgs"
+	body := []byte("hi")
+	header := http.Header{}
+	header.Set("Content-Type", "text/plain")
+
+	auth, err := NewAuthWithBody("POST", urs, body, header, tm, alice)
+	require.NoError(t, err)
+	res, err := CheckAuthorization(context.TODO(), "POST", auth.URL.String(), auth.Header(), header, body, mc, tm, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), res.KID)
+	require.False(t, res.V1)
+
+	// Nonce collision
+	_, err = CheckAuthorization(context.TODO(), "POST", auth.URL.String(), auth.Header(), header, body, mc, tm, time.Time{})
+	require.Equal(t, ErrNonceCollision, err)
+	require.Equal(t, ErrCodeNonceCollision, ErrorCodeOf(err))
+
+	// Different body
+	auth, err = NewAuthWithBody("POST", urs, body, header, tm, alice)
+	require.NoError(t, err)
+	_, err = CheckAuthorization(context.TODO(), "POST", auth.URL.String(), auth.Header(), header, []byte("hi2"), mc, tm, time.Time{})
+	require.EqualError(t, err, "verify failed")
+
+	// Different body (with digest)
+	header.Set("Content-Digest", ContentDigest(body))
+	_, err = CheckAuthorization(context.TODO(), "POST", auth.URL.String(), auth.Header(), header, []byte("hi2"), mc, tm, time.Time{})
+	require.EqualError(t, err, "content digest mismatch")
+	require.Equal(t, ErrCodeContentDigestMismatch, ErrorCodeOf(err))
+	header.Del("Content-Digest")
+
+	// Different header
+	header2 := http.Header{}
+	header2.Set("Content-Type", "application/json")
+	_, err = CheckAuthorization(context.TODO(), "POST", auth.URL.String(), auth.Header(), header2, body, mc, tm, time.Time{})
+	require.EqualError(t, err, "verify failed")
+
+	// V1
+	authV1, err := NewAuthV1("POST", urs, tm, alice)
+	require.NoError(t, err)
+	res, err = CheckAuthorization(context.TODO(), "POST", authV1.URL.String(), authV1.Header(), nil, nil, mc, tm, time.Time{})
+	require.NoError(t, err)
+	require.True(t, res.V1)
+
+	// V1 (before deadline)
+	deadline := tm.Add(time.Hour)
+	authV1, err = NewAuthV1("POST", urs, tm, alice)
+	require.NoError(t, err)
+	_, err = CheckAuthorization(context.TODO(), "POST", authV1.URL.String(), authV1.Header(), nil, nil, mc, tm, deadline)
+	require.NoError(t, err)
+
+	// V1 (after deadline)
+	now := deadline.Add(time.Second)
+	authV1, err = NewAuthV1("POST", urs, now, alice)
+	require.NoError(t, err)
+	_, err = CheckAuthorization(context.TODO(), "POST", authV1.URL.String(), authV1.Header(), nil, nil, mc, now, deadline)
+	require.EqualError(t, err, "v1 auth is no longer supported")
 }
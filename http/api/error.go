@@ -0,0 +1,125 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ErrorCode is a stable, machine-readable error code, so clients don't have
+// to match on error messages.
+type ErrorCode string
+
+// Error codes.
+const (
+	// ErrCodeBadRequest for an invalid request.
+	ErrCodeBadRequest ErrorCode = "bad-request"
+	// ErrCodeUnauthorized if authorization is missing.
+	ErrCodeUnauthorized ErrorCode = "unauthorized"
+	// ErrCodeForbidden if authorization was invalid or isn't allowed.
+	ErrCodeForbidden ErrorCode = "forbidden"
+	// ErrCodeNotFound if resource wasn't found.
+	ErrCodeNotFound ErrorCode = "not-found"
+	// ErrCodeMethodNotAllowed if method isn't supported for the resource.
+	ErrCodeMethodNotAllowed ErrorCode = "method-not-allowed"
+	// ErrCodeConflict if resource already exists.
+	ErrCodeConflict ErrorCode = "conflict"
+	// ErrCodeEntityTooLarge if the request (or resource) is too large.
+	ErrCodeEntityTooLarge ErrorCode = "entity-too-large"
+	// ErrCodeTooManyRequests if rate limited.
+	ErrCodeTooManyRequests ErrorCode = "too-many-requests"
+	// ErrCodeInternal for an internal server error.
+	ErrCodeInternal ErrorCode = "internal"
+
+	// ErrCodeAuthMissing if Authorization header is missing.
+	ErrCodeAuthMissing ErrorCode = "auth-missing"
+	// ErrCodeAuthInvalid if Authorization header is invalid or didn't verify.
+	ErrCodeAuthInvalid ErrorCode = "auth-invalid"
+	// ErrCodeAuthExpired if the auth timestamp is outside the allowed window.
+	ErrCodeAuthExpired ErrorCode = "auth-expired"
+	// ErrCodeAuthForbidden if authorized with a key that isn't allowed for the
+	// resource.
+	ErrCodeAuthForbidden ErrorCode = "auth-forbidden"
+	// ErrCodeNonceCollision if the auth nonce was already used.
+	ErrCodeNonceCollision ErrorCode = "nonce-collision"
+	// ErrCodeContentDigestMismatch if Content-Digest doesn't match the body.
+	ErrCodeContentDigestMismatch ErrorCode = "content-digest-mismatch"
+
+	// ErrCodeStatementExists if sigchain statement already exists.
+	ErrCodeStatementExists ErrorCode = "statement-exists"
+	// ErrCodeSigchainLimit if the sigchain has too many statements.
+	ErrCodeSigchainLimit ErrorCode = "sigchain-limit"
+	// ErrCodeAccessDenied if denied by access controls.
+	ErrCodeAccessDenied ErrorCode = "access-denied"
+)
+
+// ErrorCodes are all the error codes.
+var ErrorCodes = []ErrorCode{
+	ErrCodeBadRequest,
+	ErrCodeUnauthorized,
+	ErrCodeForbidden,
+	ErrCodeNotFound,
+	ErrCodeMethodNotAllowed,
+	ErrCodeConflict,
+	ErrCodeEntityTooLarge,
+	ErrCodeTooManyRequests,
+	ErrCodeInternal,
+	ErrCodeAuthMissing,
+	ErrCodeAuthInvalid,
+	ErrCodeAuthExpired,
+	ErrCodeAuthForbidden,
+	ErrCodeNonceCollision,
+	ErrCodeContentDigestMismatch,
+	ErrCodeStatementExists,
+	ErrCodeSigchainLimit,
+	ErrCodeAccessDenied,
+}
+
+// ErrorCodeForStatus returns the default error code for a HTTP status.
+func ErrorCodeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrCodeForbidden
+	case http.StatusNotFound:
+		return ErrCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return ErrCodeMethodNotAllowed
+	case http.StatusConflict:
+		return ErrCodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return ErrCodeEntityTooLarge
+	case http.StatusTooManyRequests:
+		return ErrCodeTooManyRequests
+	default:
+		return ErrCodeInternal
+	}
+}
+
+// CodeError is an error with an ErrorCode.
+type CodeError struct {
+	Code    ErrorCode
+	Message string
+}
+
+// NewCodeError creates an error with an ErrorCode.
+func NewCodeError(code ErrorCode, format string, args ...interface{}) *CodeError {
+	return &CodeError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *CodeError) Error() string {
+	return e.Message
+}
+
+// ErrorCodeOf returns the ErrorCode for an error (or its cause), or empty if
+// it doesn't have one.
+func ErrorCodeOf(err error) ErrorCode {
+	if ce, ok := errors.Cause(err).(*CodeError); ok {
+		return ce.Code
+	}
+	return ""
+}
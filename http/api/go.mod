@@ -3,6 +3,7 @@ module github.com/keys-pub/keysd/http/api
 go 1.12
 
 require (
+	filippo.io/edwards25519 v1.0.0 // indirect
 	github.com/keys-pub/keys v0.0.0-20200414165426-6b7f7009114b
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.4.0
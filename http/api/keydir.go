@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+)
+
+// Sigchain statement types for the key directory.
+const (
+	// DeviceStatementType links an EdX25519 device key to a sigchain.
+	DeviceStatementType = "device"
+	// EncryptKeyStatementType links an X25519 encryption key to a sigchain.
+	EncryptKeyStatementType = "encrypt-key"
+)
+
+// KeyLink is the statement data linking a device or encryption key to a
+// sigchain. Linked keys are unlinked by revoking the statement.
+type KeyLink struct {
+	// KID of the linked key.
+	KID keys.ID `json:"kid"`
+	// Sig is a signature by the linked key of the sigchain KID (see
+	// deviceLinkMessage and encryptKeyLinkMessage), so a key can't be linked
+	// without its consent. Encryption keys sign with XEdDSA.
+	Sig []byte `json:"sig,omitempty"`
+}
+
+func deviceLinkMessage(kid keys.ID) []byte {
+	return []byte("device-link:" + kid.String())
+}
+
+func encryptKeyLinkMessage(kid keys.ID) []byte {
+	return []byte("encrypt-key-link:" + kid.String())
+}
+
+// NewDeviceStatement creates a sigchain statement linking a device key.
+func NewDeviceStatement(sc *keys.Sigchain, device *keys.EdX25519Key, sk *keys.EdX25519Key, ts time.Time) (*keys.Statement, error) {
+	if device.ID() == sc.KID() {
+		return nil, errors.Errorf("can't link sigchain key as a device")
+	}
+	link := &KeyLink{
+		KID: device.ID(),
+		Sig: device.SignDetached(deviceLinkMessage(sc.KID())),
+	}
+	b, err := json.Marshal(link)
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewSigchainStatement(sc, b, sk, DeviceStatementType, ts)
+}
+
+// NewEncryptKeyStatement creates a sigchain statement linking an X25519
+// encryption key.
+func NewEncryptKeyStatement(sc *keys.Sigchain, key *keys.X25519Key, sk *keys.EdX25519Key, ts time.Time) (*keys.Statement, error) {
+	sig, err := xeddsaSign(key, encryptKeyLinkMessage(sc.KID()))
+	if err != nil {
+		return nil, err
+	}
+	link := &KeyLink{
+		KID: key.ID(),
+		Sig: sig,
+	}
+	b, err := json.Marshal(link)
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewSigchainStatement(sc, b, sk, EncryptKeyStatementType, ts)
+}
+
+// IsKeyLinkStatement returns true if the statement is a device or encryption
+// key statement.
+func IsKeyLinkStatement(st *keys.Statement) bool {
+	return st.Type == DeviceStatementType || st.Type == EncryptKeyStatementType
+}
+
+// VerifyKeyLinkStatement checks the data of a device or encryption key
+// statement.
+func VerifyKeyLinkStatement(st *keys.Statement) (*KeyLink, error) {
+	var link KeyLink
+	if err := json.Unmarshal(st.Data, &link); err != nil {
+		return nil, errors.Wrapf(err, "invalid key link")
+	}
+	if link.KID == "" {
+		return nil, errors.Errorf("invalid key link: no kid")
+	}
+	if link.KID == st.KID {
+		return nil, errors.Errorf("invalid key link: can't link sigchain key")
+	}
+	switch st.Type {
+	case DeviceStatementType:
+		spk, err := keys.NewEdX25519PublicKeyFromID(link.KID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid device key")
+		}
+		if err := spk.VerifyDetached(link.Sig, deviceLinkMessage(st.KID)); err != nil {
+			return nil, errors.Errorf("invalid device key signature")
+		}
+	case EncryptKeyStatementType:
+		if link.KID.PublicKeyType() != keys.X25519Public {
+			return nil, errors.Errorf("invalid encryption key type %s", link.KID.PublicKeyType())
+		}
+		if err := xeddsaVerify(link.KID, link.Sig, encryptKeyLinkMessage(st.KID)); err != nil {
+			return nil, errors.Errorf("invalid encryption key signature")
+		}
+	default:
+		return nil, errors.Errorf("not a key link statement")
+	}
+	return &link, nil
+}
+
+// KeyDirectory describes the active (not revoked) device and encryption keys
+// linked to a sigchain.
+type KeyDirectory struct {
+	KID         keys.ID   `json:"kid"`
+	Devices     []keys.ID `json:"devices,omitempty"`
+	EncryptKeys []keys.ID `json:"encryptKeys,omitempty"`
+}
+
+// NewKeyDirectory returns the KeyDirectory for a sigchain.
+func NewKeyDirectory(sc *keys.Sigchain) (*KeyDirectory, error) {
+	dir := &KeyDirectory{KID: sc.KID()}
+	for _, st := range sc.FindAll(DeviceStatementType) {
+		link, err := VerifyKeyLinkStatement(st)
+		if err != nil {
+			return nil, err
+		}
+		dir.Devices = append(dir.Devices, link.KID)
+	}
+	for _, st := range sc.FindAll(EncryptKeyStatementType) {
+		link, err := VerifyKeyLinkStatement(st)
+		if err != nil {
+			return nil, err
+		}
+		dir.EncryptKeys = append(dir.EncryptKeys, link.KID)
+	}
+	return dir, nil
+}
+
+// Recipients returns the keys to encrypt to, the sigchain KID and all linked
+// keys.
+func (d KeyDirectory) Recipients() []keys.ID {
+	kids := []keys.ID{d.KID}
+	kids = append(kids, d.Devices...)
+	kids = append(kids, d.EncryptKeys...)
+	return kids
+}
+
+// HasDevice returns true if kid is a linked device key (or the sigchain key).
+func (d KeyDirectory) HasDevice(kid keys.ID) bool {
+	if kid == d.KID {
+		return true
+	}
+	for _, device := range d.Devices {
+		if device == kid {
+			return true
+		}
+	}
+	return false
+}
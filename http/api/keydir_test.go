@@ -0,0 +1,81 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyDirectory(t *testing.T) {
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	device := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	ek := keys.NewX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+	tm := util.TimeFromMillis(1234567890000)
+
+	sc := keys.NewSigchain(alice.ID())
+
+	st, err := NewDeviceStatement(sc, device, alice, tm)
+	require.NoError(t, err)
+	require.Equal(t, DeviceStatementType, st.Type)
+	link, err := VerifyKeyLinkStatement(st)
+	require.NoError(t, err)
+	require.Equal(t, device.ID(), link.KID)
+	err = sc.Add(st)
+	require.NoError(t, err)
+
+	st, err = NewEncryptKeyStatement(sc, ek, alice, tm)
+	require.NoError(t, err)
+	link, err = VerifyKeyLinkStatement(st)
+	require.NoError(t, err)
+	require.Equal(t, ek.ID(), link.KID)
+	err = sc.Add(st)
+	require.NoError(t, err)
+
+	_, err = NewDeviceStatement(sc, alice, alice, tm)
+	require.EqualError(t, err, "can't link sigchain key as a device")
+
+	dir, err := NewKeyDirectory(sc)
+	require.NoError(t, err)
+	require.Equal(t, []keys.ID{device.ID()}, dir.Devices)
+	require.Equal(t, []keys.ID{ek.ID()}, dir.EncryptKeys)
+	require.Equal(t, []keys.ID{alice.ID(), device.ID(), ek.ID()}, dir.Recipients())
+	require.True(t, dir.HasDevice(device.ID()))
+	require.True(t, dir.HasDevice(alice.ID()))
+
+	// Revoke device
+	_, err = sc.Revoke(1, alice)
+	require.NoError(t, err)
+	dir, err = NewKeyDirectory(sc)
+	require.NoError(t, err)
+	require.Empty(t, dir.Devices)
+	require.False(t, dir.HasDevice(device.ID()))
+	require.Equal(t, []keys.ID{alice.ID(), ek.ID()}, dir.Recipients())
+
+	// Device signature for another sigchain
+	bob := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x04}, 32)))
+	scb := keys.NewSigchain(bob.ID())
+	st, err = NewDeviceStatement(scb, device, bob, tm)
+	require.NoError(t, err)
+	st.KID = alice.ID()
+	_, err = VerifyKeyLinkStatement(st)
+	require.EqualError(t, err, "invalid device key signature")
+
+	// Encryption key signature for another sigchain
+	st, err = NewEncryptKeyStatement(scb, ek, bob, tm)
+	require.NoError(t, err)
+	st.KID = alice.ID()
+	_, err = VerifyKeyLinkStatement(st)
+	require.EqualError(t, err, "invalid encryption key signature")
+
+	// Encryption key without consent
+	b, err := json.Marshal(&KeyLink{KID: ek.ID()})
+	require.NoError(t, err)
+	st, err = keys.NewSigchainStatement(scb, b, bob, EncryptKeyStatementType, tm)
+	require.NoError(t, err)
+	_, err = VerifyKeyLinkStatement(st)
+	require.EqualError(t, err, "invalid encryption key signature")
+}
@@ -0,0 +1,28 @@
+package api
+
+// VaultItem is an (encrypted) item in a vault.
+type VaultItem struct {
+	Data []byte `json:"data"`
+	ID   string `json:"id"`
+}
+
+// CreateVaultItemResponse ...
+type CreateVaultItemResponse struct {
+	ID string `json:"id"`
+}
+
+// VaultResponse is the response from vault changes.
+type VaultResponse struct {
+	Items    []*VaultItem        `json:"items"`
+	Metadata map[string]Metadata `json:"md,omitempty"`
+	Version  string              `json:"version"`
+}
+
+// MetadataFor returns metadata for VaultItem.
+func (r VaultResponse) MetadataFor(item *VaultItem) Metadata {
+	md, ok := r.Metadata[item.ID]
+	if !ok {
+		return Metadata{}
+	}
+	return md
+}
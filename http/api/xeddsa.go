@@ -0,0 +1,122 @@
+package api
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+)
+
+// X25519 keys can't sign, so consent for linking an encryption key is an
+// XEdDSA signature (https://signal.org/docs/specifications/xeddsa/), which
+// verifies as a regular Ed25519 signature for the Edwards form of the X25519
+// public key. Scalar and point arithmetic is constant time (edwards25519).
+
+// xeddsaKeyPair returns the Edwards key pair (a, A) for an X25519 private
+// key, with a negated if needed so that the sign bit of A is 0, since that's
+// all the Montgomery (X25519) public key determines.
+func xeddsaKeyPair(key *keys.X25519Key) (*edwards25519.Scalar, []byte, error) {
+	a, err := edwards25519.NewScalar().SetBytesWithClamping(key.PrivateKey()[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	A := new(edwards25519.Point).ScalarBaseMult(a).Bytes()
+	sign := int(A[31] >> 7)
+
+	ab := a.Bytes()
+	subtle.ConstantTimeCopy(sign, ab, edwards25519.NewScalar().Negate(a).Bytes())
+	if _, err := a.SetCanonicalBytes(ab); err != nil {
+		return nil, nil, err
+	}
+	A[31] &= 0x7f
+	return a, A, nil
+}
+
+// xeddsaSign signs a message with an X25519 key.
+func xeddsaSign(key *keys.X25519Key, msg []byte) ([]byte, error) {
+	z := make([]byte, 64)
+	if _, err := rand.Read(z); err != nil {
+		return nil, err
+	}
+	return xeddsaSignWithRandom(key, msg, z)
+}
+
+func xeddsaSignWithRandom(key *keys.X25519Key, msg []byte, z []byte) ([]byte, error) {
+	a, A, err := xeddsaKeyPair(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// r = hash1(a || M || Z) (mod q)
+	h := sha512.New()
+	prefix := bytes.Repeat([]byte{0xff}, 32)
+	prefix[0] = 0xfe
+	_, _ = h.Write(prefix)
+	_, _ = h.Write(a.Bytes())
+	_, _ = h.Write(msg)
+	_, _ = h.Write(z)
+	r, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	R := new(edwards25519.Point).ScalarBaseMult(r).Bytes()
+
+	// h = hash(R || A || M) (mod q), s = r + ha (mod q)
+	h.Reset()
+	_, _ = h.Write(R)
+	_, _ = h.Write(A)
+	_, _ = h.Write(msg)
+	c, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	s := edwards25519.NewScalar().MultiplyAdd(c, a, r)
+
+	return append(R, s.Bytes()...), nil
+}
+
+// xeddsaPublicKey returns the Edwards public key (with sign bit 0) for a
+// Montgomery (X25519) public key, y = (u - 1) / (u + 1).
+func xeddsaPublicKey(pk []byte) ([]byte, error) {
+	u, err := new(field.Element).SetBytes(pk)
+	if err != nil {
+		return nil, errors.Errorf("invalid public key")
+	}
+	// Reject u >= p (not canonical).
+	if !bytes.Equal(u.Bytes(), pk) {
+		return nil, errors.Errorf("invalid public key")
+	}
+	one := new(field.Element).One()
+	d := new(field.Element).Add(u, one)
+	if d.Equal(new(field.Element).Zero()) == 1 {
+		return nil, errors.Errorf("invalid public key")
+	}
+	y := new(field.Element).Subtract(u, one)
+	y.Multiply(y, d.Invert(d))
+	return y.Bytes(), nil
+}
+
+// xeddsaVerify verifies a signature from xeddsaSign by the X25519 key kid.
+func xeddsaVerify(kid keys.ID, sig []byte, msg []byte) error {
+	pk, err := keys.NewX25519PublicKeyFromID(kid)
+	if err != nil {
+		return err
+	}
+	if len(sig) != ed25519.SignatureSize {
+		return errors.Errorf("invalid signature length")
+	}
+	A, err := xeddsaPublicKey(pk.Bytes())
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(ed25519.PublicKey(A), msg, sig) {
+		return errors.Errorf("verify failed")
+	}
+	return nil
+}
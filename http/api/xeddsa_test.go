@@ -0,0 +1,81 @@
+package api
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXEdDSA(t *testing.T) {
+	msg := []byte("test message")
+	// Seeds cover both sign bits for the Edwards public key.
+	for i := byte(1); i <= 8; i++ {
+		key := keys.NewX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{i}, 32)))
+		sig, err := xeddsaSign(key, msg)
+		require.NoError(t, err)
+		err = xeddsaVerify(key.ID(), sig, msg)
+		require.NoError(t, err)
+
+		err = xeddsaVerify(key.ID(), sig, []byte("other message"))
+		require.EqualError(t, err, "verify failed")
+		other := keys.NewX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{i + 0x10}, 32)))
+		err = xeddsaVerify(other.ID(), sig, msg)
+		require.EqualError(t, err, "verify failed")
+	}
+
+	key := keys.GenerateX25519Key()
+	err := xeddsaVerify(key.ID(), []byte{0x01}, msg)
+	require.EqualError(t, err, "invalid signature length")
+}
+
+func TestXEdDSAVectors(t *testing.T) {
+	// Identity key pair and signature (of the ephemeral public key) from
+	// libsignal (CurveTest.testSignature).
+	priv := testHex("c097248412e58bf05df487968205132794178e367637f5818f81e0e6ce73e865")
+	pub := testHex("ab7e717d4a163b7d9a1d8071dfe9dcf8cdcd1cea3339b6356be84d887e322c64")
+	msg := testHex("05edce9d9c415ca78cb7252e72c2c4a554d3eb29485a0e1d503118d1a82d99fb4a")
+	sig := testHex("5de88ca9a89b4a115da79109c67c9c7464a3e4180274f1cb8c63c2984e286dfbede82deb9dcd9fae0bfbb821569b3d9001bd8130cd11d486cef047bd60b86e88")
+
+	key := keys.NewX25519KeyFromPrivateKey(keys.Bytes32(priv))
+	require.Equal(t, pub, key.PublicKey().Bytes())
+
+	A, err := xeddsaPublicKey(pub)
+	require.NoError(t, err)
+	_, keyA, err := xeddsaKeyPair(key)
+	require.NoError(t, err)
+	require.Equal(t, A, keyA)
+
+	// This signature predates XEdDSA and carries the sign bit of the Edwards
+	// public key in the top bit of s (XEdDSA always uses sign bit 0).
+	signed := append([]byte{}, A...)
+	signed[31] |= sig[63] & 0x80
+	s := append([]byte{}, sig...)
+	s[63] &= 0x7f
+	require.True(t, ed25519.Verify(ed25519.PublicKey(signed), msg, s))
+	require.EqualError(t, xeddsaVerify(key.ID(), sig, msg), "verify failed")
+
+	// XEdDSA signatures from this key verify (as Ed25519) with A.
+	xsig, err := xeddsaSignWithRandom(key, msg, make([]byte, 64))
+	require.NoError(t, err)
+	require.True(t, ed25519.Verify(ed25519.PublicKey(A), msg, xsig))
+	require.NoError(t, xeddsaVerify(key.ID(), xsig, msg))
+
+	// u >= p
+	_, err = xeddsaPublicKey(testHex("edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"))
+	require.EqualError(t, err, "invalid public key")
+	// u = p - 1 (u + 1 = 0)
+	_, err = xeddsaPublicKey(testHex("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"))
+	require.EqualError(t, err, "invalid public key")
+}
+
+func testHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -66,7 +66,9 @@ func defaultHTTPClient() *http.Client {
 type ErrResponse struct {
 	StatusCode int
 	Message    string
-	URL        *url.URL
+	// Code is the (machine-readable) error code from the server.
+	Code api.ErrorCode
+	URL  *url.URL
 }
 
 func (e ErrResponse) Error() string {
@@ -103,6 +105,7 @@ func checkResponse(resp *http.Response) error {
 	}
 	if respVal.Error != nil {
 		err.Message = respVal.Error.Message
+		err.Code = respVal.Error.Code
 	}
 
 	return err
@@ -4,6 +4,7 @@ go 1.12
 
 require (
 	github.com/gorilla/websocket v1.4.2
+	github.com/keybase/saltpack v0.0.0-20190828020936-3f47e8e2e6ec
 	github.com/keys-pub/keys v0.0.0-20200506185058-697fd4757490
 	github.com/keys-pub/keysd/http/api v0.0.0-20200414165929-c63be6975df3
 	github.com/keys-pub/keysd/http/server v0.0.0-20200501185525-9bcc9dde28dd
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keysd/http/api"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,6 +34,14 @@ func TestSigchain(t *testing.T) {
 	psiErr2 := client.PutSigchainStatement(context.TODO(), st2)
 	require.NoError(t, psiErr2)
 
+	// Put again (conflict)
+	err = client.PutSigchainStatement(context.TODO(), st2)
+	require.Error(t, err)
+	errResp, ok := err.(ErrResponse)
+	require.True(t, ok)
+	require.Equal(t, 409, errResp.StatusCode)
+	require.Equal(t, api.ErrCodeStatementExists, errResp.Code)
+
 	scResp, err := client.Sigchain(context.TODO(), alice.ID())
 	require.NoError(t, err)
 	sc, err = scResp.Sigchain()
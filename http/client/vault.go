@@ -0,0 +1,122 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keys/saltpack"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/pkg/errors"
+)
+
+// VaultItem from server, decrypted.
+type VaultItem struct {
+	ID   string
+	Data []byte
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// VaultSave saves data to the vault, encrypted (and signed) to the key.
+func (c *Client) VaultSave(ctx context.Context, kid keys.ID, b []byte) (*api.CreateVaultItemResponse, error) {
+	key, err := c.ks.EdX25519Key(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, keys.NewErrNotFound(kid.String())
+	}
+	sp := saltpack.NewSaltpack(c.ks)
+	encrypted, err := sp.Signcrypt(b, key, key.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	path := ds.Path("vault", key.ID())
+	doc, err := c.postDocument(ctx, path, url.Values{}, key, bytes.NewReader(encrypted))
+	if err != nil {
+		return nil, err
+	}
+	var resp api.CreateVaultItemResponse
+	if err := json.Unmarshal(doc.Data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// VaultOpts options for Vault.
+type VaultOpts struct {
+	// Version to list from (inclusive)
+	Version string
+	// Limit by
+	Limit int
+}
+
+// Vault returns decrypted vault items, in the order they were saved, and the
+// version to use for the next request.
+// Returns nil items if the vault doesn't exist.
+func (c *Client) Vault(ctx context.Context, kid keys.ID, opts *VaultOpts) ([]*VaultItem, string, error) {
+	key, err := c.ks.EdX25519Key(kid)
+	if err != nil {
+		return nil, "", err
+	}
+	if key == nil {
+		return nil, "", keys.NewErrNotFound(kid.String())
+	}
+	if opts == nil {
+		opts = &VaultOpts{}
+	}
+
+	params := url.Values{}
+	params.Add("include", "md")
+	if opts.Version != "" {
+		params.Add("version", opts.Version)
+	}
+	if opts.Limit != 0 {
+		params.Add("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+
+	path := ds.Path("vault", key.ID())
+	doc, err := c.getDocument(ctx, path, params, key)
+	if err != nil {
+		return nil, "", err
+	}
+	if doc == nil {
+		return nil, "", nil
+	}
+
+	var resp api.VaultResponse
+	if err := json.Unmarshal(doc.Data, &resp); err != nil {
+		return nil, "", err
+	}
+
+	sp := saltpack.NewSaltpack(c.ks)
+	items := make([]*VaultItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		decrypted, pk, err := sp.SigncryptOpen(item.Data)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "failed to decrypt vault item %s", item.ID)
+		}
+		if pk == nil {
+			return nil, "", errors.Errorf("vault item %s has no signer", item.ID)
+		}
+		if pk.ID() != key.ID() {
+			return nil, "", errors.Errorf("vault item %s has invalid signer %s", item.ID, pk.ID())
+		}
+		items = append(items, &VaultItem{
+			ID:        item.ID,
+			Data:      decrypted,
+			CreatedAt: resp.MetadataFor(item).CreatedAt,
+			UpdatedAt: resp.MetadataFor(item).UpdatedAt,
+		})
+	}
+
+	return items, resp.Version, nil
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"testing"
+
+	ksaltpack "github.com/keybase/saltpack"
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keys/saltpack"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVault(t *testing.T) {
+	env := testEnv(t, logger)
+	defer env.closeFn()
+
+	ks := keys.NewMemStore(true)
+	client := testClient(t, env, ks)
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	err := ks.SaveEdX25519Key(alice)
+	require.NoError(t, err)
+
+	// Vault (empty)
+	items, version, err := client.Vault(context.TODO(), alice.ID(), nil)
+	require.NoError(t, err)
+	require.Nil(t, items)
+	require.Empty(t, version)
+
+	// VaultSave
+	resp, err := client.VaultSave(context.TODO(), alice.ID(), []byte("item1"))
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.ID)
+
+	// Vault
+	items, version, err = client.Vault(context.TODO(), alice.ID(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	require.Equal(t, resp.ID, items[0].ID)
+	require.Equal(t, []byte("item1"), items[0].Data)
+	require.NotEmpty(t, version)
+
+	// VaultSave
+	_, err = client.VaultSave(context.TODO(), alice.ID(), []byte("item2"))
+	require.NoError(t, err)
+
+	// Vault (from version)
+	items, _, err = client.Vault(context.TODO(), alice.ID(), &VaultOpts{Version: version})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+	require.Equal(t, []byte("item1"), items[0].Data)
+	require.Equal(t, []byte("item2"), items[1].Data)
+
+	// VaultSave (key not found)
+	bob := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	_, err = client.VaultSave(context.TODO(), bob.ID(), []byte("item3"))
+	require.EqualError(t, err, keys.NewErrNotFound(bob.ID().String()).Error())
+}
+
+func TestVaultAnonymousItem(t *testing.T) {
+	env := testEnv(t, logger)
+	defer env.closeFn()
+
+	ks := keys.NewMemStore(true)
+	client := testClient(t, env, ks)
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	err := ks.SaveEdX25519Key(alice)
+	require.NoError(t, err)
+
+	// Signcrypt (anonymous sender) to alice and save directly to the vault.
+	sp := saltpack.NewSaltpack(ks)
+	recipient := sp.LookupBoxPublicKey(alice.X25519Key().PublicKey().Bytes()[:])
+	encrypted, err := ksaltpack.SigncryptSeal([]byte("anonymous"), sp, nil, []ksaltpack.BoxPublicKey{recipient}, nil)
+	require.NoError(t, err)
+	doc, err := client.postDocument(context.TODO(), ds.Path("vault", alice.ID()), url.Values{}, alice, bytes.NewReader(encrypted))
+	require.NoError(t, err)
+	require.NotNil(t, doc)
+
+	_, _, err = client.Vault(context.TODO(), alice.ID(), nil)
+	require.Error(t, err)
+	require.Regexp(t, `^vault item .* has no signer$`, err.Error())
+}
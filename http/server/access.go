@@ -3,6 +3,9 @@ package server
 import (
 	"fmt"
 	"net/http"
+
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/labstack/echo/v4"
 )
 
 // AccessResource is resource for access control.
@@ -11,6 +14,8 @@ type AccessResource string
 const (
 	// SigchainResource for sigchain.
 	SigchainResource AccessResource = "sigchain"
+	// VaultResource for vault.
+	VaultResource AccessResource = "vault"
 )
 
 func (r AccessResource) String() string {
@@ -25,6 +30,8 @@ const (
 	Put AccessAction = "put"
 	// Post action.
 	Post AccessAction = "post"
+	// Get action.
+	Get AccessAction = "get"
 )
 
 // Access returns whether to allow or deny.
@@ -77,3 +84,11 @@ type AccessFn func(c AccessContext, resource AccessResource, action AccessAction
 func (s *Server) SetAccessFn(fn AccessFn) {
 	s.accessFn = fn
 }
+
+func errAccessDenied(c echo.Context, access Access) error {
+	code := api.ErrCodeAccessDenied
+	if access.StatusCode == http.StatusTooManyRequests {
+		code = api.ErrCodeTooManyRequests
+	}
+	return ErrStatus(c, access.StatusCode, api.NewCodeError(code, "%s", access.Message))
+}
@@ -14,9 +14,9 @@ func (s *Server) adminCheck(c echo.Context) error {
 	request := c.Request()
 	ctx := request.Context()
 
-	auth, status, err := checkAuth(c, s.URL, s.nowFn(), s.mc)
+	auth, status, err := checkAuth(c, s.URL, s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 	if !s.isAdmin(auth.KID) {
 		return ErrForbidden(c, errors.Errorf("not authorized"))
@@ -38,14 +38,14 @@ func TestAdminCheck(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusForbidden, code)
-	require.Equal(t, `{"error":{"code":403,"message":"not authorized"}}`, body)
+	require.Equal(t, `{"error":{"code":403,"message":"not authorized","errorCode":"forbidden"}}`, body)
 
 	// POST /admin/check/all
 	req, err = api.NewRequest("POST", "/admin/check/"+alice.ID().String(), nil, clock.Now(), bob)
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusForbidden, code)
-	require.Equal(t, `{"error":{"code":403,"message":"not authorized"}}`, body)
+	require.Equal(t, `{"error":{"code":403,"message":"not authorized","errorCode":"forbidden"}}`, body)
 
 	// Add admin
 	srv.Server.SetAdmins([]keys.ID{bob.ID()})
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"time"
 
@@ -10,24 +13,49 @@ import (
 	"github.com/pkg/errors"
 )
 
-func checkAuth(c echo.Context, baseURL string, now time.Time, mc MemCache) (*api.AuthResult, int, error) {
+// maxAuthBodySize is the maximum size of a request body read for auth.
+const maxAuthBodySize = 64 * 1024
+
+func checkAuth(c echo.Context, baseURL string, now time.Time, mc MemCache, metrics *Metrics, v1Deadline time.Time) (*api.AuthResult, int, error) {
 	request := c.Request()
 	auth := request.Header.Get("Authorization")
 	if auth == "" {
-		return nil, http.StatusUnauthorized, errors.Errorf("missing Authorization header")
+		metrics.authFailure("missing")
+		return nil, http.StatusUnauthorized, api.NewCodeError(api.ErrCodeAuthMissing, "missing Authorization header")
 	}
 
 	url := baseURL + c.Request().URL.String()
 
-	authRes, err := api.CheckAuthorization(request.Context(), request.Method, url, auth, mc, now)
+	// Read the body (for v2 auth) and replace it so handlers can read it too.
+	var body []byte
+	if request.Body != nil {
+		b, err := ioutil.ReadAll(io.LimitReader(request.Body, maxAuthBodySize+1))
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		if len(b) > maxAuthBodySize {
+			return nil, http.StatusRequestEntityTooLarge, api.NewCodeError(api.ErrCodeEntityTooLarge, "request body too large (greater than %d bytes)", maxAuthBodySize)
+		}
+		body = b
+		request.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+
+	authRes, err := api.CheckAuthorization(request.Context(), request.Method, url, auth, request.Header, body, mc, now, v1Deadline)
 	if err != nil {
+		if errors.Cause(err) == api.ErrNonceCollision {
+			metrics.nonceCollision()
+		}
+		metrics.authFailure("invalid")
+		if api.ErrorCodeOf(err) == "" {
+			err = api.NewCodeError(api.ErrCodeAuthInvalid, "%s", err.Error())
+		}
 		return nil, http.StatusForbidden, err
 	}
 	return authRes, 0, nil
 }
 
-func authorize(c echo.Context, baseURL string, param string, now time.Time, mc MemCache) (keys.ID, int, error) {
-	authRes, status, err := checkAuth(c, baseURL, now, mc)
+func authorize(c echo.Context, baseURL string, param string, now time.Time, mc MemCache, metrics *Metrics, v1Deadline time.Time) (keys.ID, int, error) {
+	authRes, status, err := checkAuth(c, baseURL, now, mc, metrics, v1Deadline)
 	if err != nil {
 		return "", status, err
 	}
@@ -40,7 +68,8 @@ func authorize(c echo.Context, baseURL string, param string, now time.Time, mc M
 		}
 
 		if kid != kidAuth {
-			return "", http.StatusForbidden, errors.Errorf("invalid " + param)
+			metrics.authFailure("forbidden")
+			return "", http.StatusForbidden, api.NewCodeError(api.ErrCodeAuthForbidden, "invalid %s", param)
 		}
 	}
 
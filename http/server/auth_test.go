@@ -0,0 +1,105 @@
+package server_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keys/util"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/keys-pub/keysd/http/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthBody(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	charlie := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	// POST /msgs/:kid/:rid
+	req, err := api.NewRequest("POST", ds.Path("msgs", alice.ID(), charlie.ID()), bytes.NewReader([]byte("test1")), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, _ := srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+
+	// POST /msgs/:kid/:rid (body swapped)
+	req, err = api.NewRequest("POST", ds.Path("msgs", alice.ID(), charlie.ID()), bytes.NewReader([]byte("test2")), clock.Now(), alice)
+	require.NoError(t, err)
+	swapped, err := http.NewRequest("POST", req.URL.String(), bytes.NewReader([]byte("swapped")))
+	require.NoError(t, err)
+	swapped.Header.Set("Authorization", req.Header.Get("Authorization"))
+	code, _, body := srv.Serve(swapped)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, `{"error":{"code":403,"message":"verify failed","errorCode":"auth-invalid"}}`, body)
+
+	// POST /msgs/:kid/:rid (body swapped, with digest)
+	swapped, err = http.NewRequest("POST", req.URL.String(), bytes.NewReader([]byte("swapped")))
+	require.NoError(t, err)
+	swapped.Header.Set("Authorization", req.Header.Get("Authorization"))
+	swapped.Header.Set("Content-Digest", req.Header.Get("Content-Digest"))
+	code, _, body = srv.Serve(swapped)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, `{"error":{"code":403,"message":"content digest mismatch","errorCode":"content-digest-mismatch"}}`, body)
+
+	// POST /msgs/:kid/:rid (v1)
+	auth, err := api.NewAuthV1("POST", ds.Path("msgs", alice.ID(), charlie.ID()), clock.Now(), alice)
+	require.NoError(t, err)
+	req, err = http.NewRequest("POST", auth.URL.String(), bytes.NewReader([]byte("test3")))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", auth.Header())
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+
+	// POST /msgs/:kid/:rid (v1, after deadline)
+	srv.Server.SetAuthV1Deadline(clock.Now())
+	auth, err = api.NewAuthV1("POST", ds.Path("msgs", alice.ID(), charlie.ID()), clock.Now(), alice)
+	require.NoError(t, err)
+	req, err = http.NewRequest("POST", auth.URL.String(), bytes.NewReader([]byte("test4")))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", auth.Header())
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, `{"error":{"code":403,"message":"v1 auth is no longer supported","errorCode":"auth-invalid"}}`, body)
+
+	// POST /msgs/:kid/:rid (body too large)
+	req, err = api.NewRequest("POST", ds.Path("msgs", alice.ID(), charlie.ID()), bytes.NewReader(bytes.Repeat([]byte{0x01}, 64*1024+1)), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"request body too large (greater than 65536 bytes)","errorCode":"entity-too-large"}}`, body)
+}
+
+func TestAuthV1DefaultDeadline(t *testing.T) {
+	clock := newClockAt(util.TimeToMillis(server.DefaultAuthV1Deadline))
+	env := newEnvWithFire(t, testFire(t, clock), clock)
+	srv := newTestServer(t, env)
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	charlie := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	// POST /msgs/:kid/:rid (v1, after default deadline)
+	auth, err := api.NewAuthV1("POST", ds.Path("msgs", alice.ID(), charlie.ID()), clock.Now(), alice)
+	require.NoError(t, err)
+	req, err := http.NewRequest("POST", auth.URL.String(), bytes.NewReader([]byte("test1")))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", auth.Header())
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, `{"error":{"code":403,"message":"v1 auth is no longer supported","errorCode":"auth-invalid"}}`, body)
+
+	// POST /msgs/:kid/:rid (v1, no deadline)
+	srv.Server.SetAuthV1Deadline(time.Time{})
+	auth, err = api.NewAuthV1("POST", ds.Path("msgs", alice.ID(), charlie.ID()), clock.Now(), alice)
+	require.NoError(t, err)
+	req, err = http.NewRequest("POST", auth.URL.String(), bytes.NewReader([]byte("test2")))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", auth.Header())
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+}
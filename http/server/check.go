@@ -3,9 +3,7 @@ package server
 import (
 	"net/http"
 
-	"github.com/keys-pub/keysd/http/api"
 	"github.com/labstack/echo/v4"
-	"github.com/pkg/errors"
 )
 
 func (s *Server) check(c echo.Context) error {
@@ -15,15 +13,9 @@ func (s *Server) check(c echo.Context) error {
 	ctx := request.Context()
 
 	// Auth
-	auth := request.Header.Get("Authorization")
-	if auth == "" {
-		return ErrUnauthorized(c, errors.Errorf("missing Authorization header"))
-	}
-	now := s.nowFn()
-	url := s.URL + c.Request().URL.String()
-	authRes, err := api.CheckAuthorization(ctx, request.Method, url, auth, s.mc, now)
+	authRes, status, err := checkAuth(c, s.URL, s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrForbidden(c, err)
+		return ErrStatus(c, status, err)
 	}
 	kid := authRes.KID
 
@@ -27,9 +27,9 @@ func (s *Server) putDisco(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 	ctx := c.Request().Context()
 
-	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	recipient := c.Param("rid")
@@ -95,9 +95,9 @@ func (s *Server) getDisco(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 	ctx := c.Request().Context()
 
-	rid, status, err := authorize(c, s.URL, "rid", s.nowFn(), s.mc)
+	rid, status, err := authorize(c, s.URL, "rid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	sender := c.Param("kid")
@@ -136,9 +136,9 @@ func (s *Server) deleteDisco(c echo.Context) error {
 	ctx := c.Request().Context()
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 
-	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	recipient := c.Param("rid")
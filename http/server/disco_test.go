@@ -41,7 +41,7 @@ func TestDisco(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"resource not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"resource not found","errorCode":"not-found"}}`, body)
 
 	// PUT /disco/:kid/:rid/offer (alice to charlie, 1m)
 	req, err = api.NewRequest("PUT", ds.Path("disco", alice.ID(), charlie.ID(), "offer")+"?expire=1m", bytes.NewReader([]byte("hi")), env.clock.Now(), alice)
@@ -55,7 +55,7 @@ func TestDisco(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusForbidden, code)
-	require.Equal(t, `{"error":{"code":403,"message":"invalid kid"}}`, body)
+	require.Equal(t, `{"error":{"code":403,"message":"invalid kid","errorCode":"auth-forbidden"}}`, body)
 
 	// DEL /disco/:kid/:rid
 	req, err = api.NewRequest("DELETE", ds.Path("disco", alice.ID(), charlie.ID()), nil, env.clock.Now(), alice)
@@ -69,7 +69,7 @@ func TestDisco(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"resource not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"resource not found","errorCode":"not-found"}}`, body)
 
 	// PUT /disco/:kid/:rid/offer (expire 1ms)
 	req, err = api.NewRequest("PUT", ds.Path("disco", alice.ID(), charlie.ID(), "offer")+"?expire=1ms", bytes.NewReader([]byte("hi")), env.clock.Now(), alice)
@@ -84,7 +84,7 @@ func TestDisco(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"resource not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"resource not found","errorCode":"not-found"}}`, body)
 
 	// PUT /disco/:kid/:rid/offer (alice to alice, 1m)
 	req, err = api.NewRequest("PUT", ds.Path("disco", alice.ID(), alice.ID(), "offer")+"?expire=1m", bytes.NewReader([]byte("hi")), env.clock.Now(), alice)
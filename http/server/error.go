@@ -5,23 +5,19 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/keys-pub/keysd/http/api"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 )
 
-type errResponse struct {
-	Code    int    `json:"code,omitempty"`
-	Message string `json:"message,omitempty"`
-}
-
-type response struct {
-	Error *errResponse `json:"error,omitempty"`
-}
-
-func newErrorResponse(msg string, code int) *response {
-	return &response{
-		Error: &errResponse{
+func newErrorResponse(msg string, status int, code api.ErrorCode) *api.Response {
+	if code == "" {
+		code = api.ErrorCodeForStatus(status)
+	}
+	return &api.Response{
+		Error: &api.Error{
 			Message: msg,
+			Status:  status,
 			Code:    code,
 		},
 	}
@@ -29,27 +25,33 @@ func newErrorResponse(msg string, code int) *response {
 
 // ErrResponse is a generate error response.
 func ErrResponse(c echo.Context, status int, msg string) error {
-	return JSON(c, status, newErrorResponse(msg, status))
+	return JSON(c, status, newErrorResponse(msg, status, ""))
+}
+
+// ErrStatus is an error response for status, using the error code from err
+// (see api.CodeError) or the default code for the status.
+func ErrStatus(c echo.Context, status int, err error) error {
+	return JSON(c, status, newErrorResponse(err.Error(), status, api.ErrorCodeOf(err)))
 }
 
 // ErrBadRequest response.
 func ErrBadRequest(c echo.Context, err error) error {
-	return ErrResponse(c, http.StatusBadRequest, err.Error())
+	return ErrStatus(c, http.StatusBadRequest, err)
 }
 
 // ErrEntityTooLarge response.
 func ErrEntityTooLarge(c echo.Context, err error) error {
-	return ErrResponse(c, http.StatusRequestEntityTooLarge, err.Error())
+	return ErrStatus(c, http.StatusRequestEntityTooLarge, err)
 }
 
 // ErrForbidden response.
 func ErrForbidden(c echo.Context, err error) error {
-	return ErrResponse(c, http.StatusForbidden, err.Error())
+	return ErrStatus(c, http.StatusForbidden, err)
 }
 
 // ErrConflict response.
 func ErrConflict(c echo.Context, err error) error {
-	return ErrResponse(c, http.StatusConflict, err.Error())
+	return ErrStatus(c, http.StatusConflict, err)
 }
 
 // ErrNotFound response.
@@ -57,43 +59,33 @@ func ErrNotFound(c echo.Context, err error) error {
 	if err == nil {
 		err = errors.Errorf("resource not found")
 	}
-	return ErrResponse(c, http.StatusNotFound, err.Error())
+	return ErrStatus(c, http.StatusNotFound, err)
 }
 
 // ErrUnauthorized response.
 func ErrUnauthorized(c echo.Context, err error) error {
-	return ErrResponse(c, http.StatusUnauthorized, err.Error())
+	return ErrStatus(c, http.StatusUnauthorized, err)
 }
 
 func (s *Server) internalError(c echo.Context, err error) error {
 	s.logger.Errorf("Internal error: %v", err)
-	return ErrResponse(c, http.StatusInternalServerError, err.Error())
+	return ErrStatus(c, http.StatusInternalServerError, err)
 }
 
 // ErrorHandler returns error handler that returns in the format:
-// {"error": {"message": "error message", status: 500}}".
+// {"error": {"code": 500, "message": "error message", "errorCode": "internal"}}".
 func ErrorHandler(err error, c echo.Context) {
 	c.Logger().Infof("Error: %v", err)
 
 	code := http.StatusInternalServerError
-	var resp *response
+	var resp *api.Response
 
 	if he, ok := err.(*echo.HTTPError); ok {
 		code = he.Code
 		msg := he.Message
-		resp = &response{
-			Error: &errResponse{
-				Message: strings.ToLower(fmt.Sprintf("%s", msg)),
-				Code:    code,
-			},
-		}
+		resp = newErrorResponse(strings.ToLower(fmt.Sprintf("%s", msg)), code, "")
 	} else {
-		resp = &response{
-			Error: &errResponse{
-				Message: strings.ToLower(http.StatusText(code)),
-				Code:    code,
-			},
-		}
+		resp = newErrorResponse(strings.ToLower(http.StatusText(code)), code, "")
 	}
 
 	// Send response
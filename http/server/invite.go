@@ -21,9 +21,9 @@ func (s *Server) postInvite(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 	ctx := c.Request().Context()
 
-	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 	recipient := c.Param("rid")
 	if recipient == "" {
@@ -82,9 +82,9 @@ func (s *Server) getInvite(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 	ctx := c.Request().Context()
 
-	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	key := fmt.Sprintf("code %s", c.QueryParam("code"))
@@ -54,5 +54,5 @@ func TestInvite(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"code not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"code not found","errorCode":"not-found"}}`, body)
 }
@@ -23,9 +23,9 @@ type message struct {
 func (s *Server) postMessage(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 
-	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	recipient := c.Param("rid")
@@ -111,9 +111,9 @@ func (s *Server) postMessage(c echo.Context) error {
 func (s *Server) listMessages(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 
-	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	recipient := c.Param("rid")
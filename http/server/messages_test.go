@@ -33,14 +33,14 @@ func testMessages(t *testing.T, env *env) {
 	require.NoError(t, err)
 	code, _, body := srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"messages not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"messages not found","errorCode":"not-found"}}`, body)
 
 	// POST /msgs/:kid/:rid (no body)
 	req, err = api.NewRequest("POST", ds.Path("msgs", alice.ID(), charlie.ID()), nil, clock.Now(), alice)
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	expected := `{"error":{"code":400,"message":"missing body"}}`
+	expected := `{"error":{"code":400,"message":"missing body","errorCode":"bad-request"}}`
 	require.Equal(t, expected, body)
 
 	// POST /msgs/:kid/:rid
@@ -58,7 +58,7 @@ func testMessages(t *testing.T, env *env) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusMethodNotAllowed, code)
-	require.Equal(t, `{"error":{"code":405,"message":"method not allowed"}}`, body)
+	require.Equal(t, `{"error":{"code":405,"message":"method not allowed","errorCode":"method-not-allowed"}}`, body)
 
 	// GET /msgs/:kid/:rid (alice)
 	req, err = api.NewRequest("GET", ds.Path("msgs", alice.ID(), charlie.ID()), nil, clock.Now(), alice)
@@ -167,7 +167,7 @@ func testMessages(t *testing.T, env *env) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	require.Equal(t, `{"error":{"code":400,"message":"message too large (greater than 16KiB)"}}`, body)
+	require.Equal(t, `{"error":{"code":400,"message":"message too large (greater than 16KiB)","errorCode":"bad-request"}}`, body)
 }
 
 func TestMessagesAuth(t *testing.T) {
@@ -185,14 +185,14 @@ func TestMessagesAuth(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body := srv.Serve(req)
 	require.Equal(t, http.StatusUnauthorized, code)
-	require.Equal(t, `{"error":{"code":401,"message":"missing Authorization header"}}`, body)
+	require.Equal(t, `{"error":{"code":401,"message":"missing Authorization header","errorCode":"auth-missing"}}`, body)
 
 	// GET /msgs/:kid/:rid
 	req, err = api.NewRequest("GET", ds.Path("msgs", alice.ID(), charlie.ID()), nil, clock.Now(), alice)
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"messages not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"messages not found","errorCode":"not-found"}}`, body)
 
 	// Replay last request
 	reqReplay, err := http.NewRequest("GET", req.URL.String(), nil)
@@ -200,7 +200,7 @@ func TestMessagesAuth(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(reqReplay)
 	require.Equal(t, http.StatusForbidden, code)
-	require.Equal(t, `{"error":{"code":403,"message":"nonce collision"}}`, body)
+	require.Equal(t, `{"error":{"code":403,"message":"nonce collision","errorCode":"nonce-collision"}}`, body)
 
 	// GET /msgs/:kid/:rid (invalid authorization)
 	authHeader := req.Header.Get("Authorization")
@@ -211,12 +211,12 @@ func TestMessagesAuth(t *testing.T) {
 	req.Header.Set("Authorization", randKey.ID().String()+":"+sig)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusForbidden, code)
-	require.Equal(t, `{"error":{"code":403,"message":"verify failed"}}`, body)
+	require.Equal(t, `{"error":{"code":403,"message":"verify failed","errorCode":"auth-invalid"}}`, body)
 
 	// POST /msgs/:kid/:rid (invalid recipient)
 	req, err = api.NewRequest("POST", ds.Path("msgs", bob.ID(), charlie.ID()), bytes.NewReader([]byte("hi")), clock.Now(), alice)
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusForbidden, code)
-	require.Equal(t, `{"error":{"code":403,"message":"invalid kid"}}`, body)
+	require.Equal(t, `{"error":{"code":403,"message":"invalid kid","errorCode":"auth-forbidden"}}`, body)
 }
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics for a Server (or PubSubServer), exposed in the Prometheus text
+// format at /metrics (requires the internal auth token, see
+// Server.SetInternalAuth), or with Handler (for serving on a private port).
+//
+// Metrics can be nil (disabled), see SetMetrics.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests        *prometheus.CounterVec
+	latency         *prometheus.HistogramVec
+	authFailures    *prometheus.CounterVec
+	nonceCollisions prometheus.Counter
+	accessDenied    *prometheus.CounterVec
+	tasks           *prometheus.CounterVec
+}
+
+// NewMetrics creates Metrics with its own registry.
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "keys_http_requests_total",
+			Help: "Number of HTTP requests by method, route and status code.",
+		}, []string{"method", "route", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "keys_http_request_duration_seconds",
+			Help:    "HTTP request latency by method and route.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		authFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "keys_http_auth_failures_total",
+			Help: "Number of failed request authorizations by reason.",
+		}, []string{"reason"}),
+		nonceCollisions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "keys_http_auth_nonce_collisions_total",
+			Help: "Number of requests rejected for re-using a nonce.",
+		}),
+		accessDenied: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "keys_http_access_denied_total",
+			Help: "Number of requests denied by access control (including rate limits) by resource, action and status code.",
+		}, []string{"resource", "action", "code"}),
+		tasks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "keys_http_tasks_total",
+			Help: "Number of tasks run by name and result.",
+		}, []string{"task", "result"}),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.latency,
+		m.authFailures,
+		m.nonceCollisions,
+		m.accessDenied,
+		m.tasks,
+	)
+	return m
+}
+
+// Registry for registering additional collectors, or nil if disabled.
+func (m *Metrics) Registry() *prometheus.Registry {
+	if m == nil {
+		return nil
+	}
+	return m.registry
+}
+
+// Handler returns http.Handler for the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	if m == nil {
+		return http.NotFoundHandler()
+	}
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Middleware records request counts and latency by route.
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	if m == nil {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Let the error handler write the response so we record the
+				// status code that was actually sent.
+				c.Error(err)
+			}
+			method := c.Request().Method
+			route := c.Path()
+			code := strconv.Itoa(c.Response().Status)
+			m.requests.WithLabelValues(method, route, code).Inc()
+			m.latency.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+			return nil
+		}
+	}
+}
+
+func (m *Metrics) authFailure(reason string) {
+	if m == nil {
+		return
+	}
+	m.authFailures.WithLabelValues(reason).Inc()
+}
+
+func (m *Metrics) nonceCollision() {
+	if m == nil {
+		return
+	}
+	m.nonceCollisions.Inc()
+}
+
+func (m *Metrics) accessDeny(resource AccessResource, action AccessAction, access Access) {
+	if m == nil {
+		return
+	}
+	m.accessDenied.WithLabelValues(resource.String(), string(action), strconv.Itoa(access.StatusCode)).Inc()
+}
+
+func (m *Metrics) taskResult(task string, err error) {
+	if m == nil {
+		return
+	}
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.tasks.WithLabelValues(task, result).Inc()
+}
+
+func (s *Server) getMetrics(c echo.Context) error {
+	if err := s.checkInternalAuth(c); err != nil {
+		return ErrForbidden(c, err)
+	}
+	s.metrics.Handler().ServeHTTP(c.Response(), c.Request())
+	return nil
+}
@@ -0,0 +1,87 @@
+package server_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/keys-pub/keysd/http/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	charlie := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	// GET /msgs/:kid/:rid
+	req, err := api.NewRequest("GET", ds.Path("msgs", alice.ID(), charlie.ID()), nil, clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, _ := srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+
+	// GET /msgs/:kid/:rid (nonce collision)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+
+	// GET /msgs/:kid/:rid (no auth)
+	req, err = http.NewRequest("GET", ds.Path("msgs", alice.ID(), charlie.ID()), nil)
+	require.NoError(t, err)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	// GET /metrics (no auth)
+	req, err = http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+
+	// GET /metrics
+	srv.Server.SetInternalAuth("testtoken")
+	req, err = http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "testtoken")
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+
+	lines := strings.Split(body, "\n")
+	require.Contains(t, lines, `keys_http_requests_total{code="404",method="GET",route="/msgs/:kid/:rid"} 1`)
+	require.Contains(t, lines, `keys_http_requests_total{code="403",method="GET",route="/msgs/:kid/:rid"} 1`)
+	require.Contains(t, lines, `keys_http_requests_total{code="401",method="GET",route="/msgs/:kid/:rid"} 1`)
+	require.Contains(t, lines, `keys_http_request_duration_seconds_count{method="GET",route="/msgs/:kid/:rid"} 3`)
+	require.Contains(t, lines, `keys_http_auth_failures_total{reason="invalid"} 1`)
+	require.Contains(t, lines, `keys_http_auth_failures_total{reason="missing"} 1`)
+	require.Contains(t, lines, `keys_http_auth_nonce_collisions_total 1`)
+}
+
+func TestMetricsDisabled(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	srv.Server.SetMetrics(nil)
+	srv.Handler = server.NewHandler(srv.Server)
+	srv.Server.SetInternalAuth("testtoken")
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	charlie := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	req, err := api.NewRequest("GET", ds.Path("msgs", alice.ID(), charlie.ID()), nil, clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, _ := srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+
+	req, err = http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "testtoken")
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+}
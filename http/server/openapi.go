@@ -0,0 +1,279 @@
+package server
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/labstack/echo/v4"
+)
+
+// operation describes a route for the OpenAPI document.
+type operation struct {
+	ID      string
+	Summary string
+	Tag     string
+	// Auth is the security scheme, keysAuth (api.NewRequest) or internalAuth
+	// (tasks), or empty if none.
+	Auth string
+	// Body is the request content type, if the request has a body.
+	Body string
+	// Response content type.
+	Response string
+	// Query parameters.
+	Query []string
+}
+
+const (
+	mimeJSON   = "application/json"
+	mimeBinary = "application/octet-stream"
+	mimeText   = "text/plain"
+)
+
+// operations for routes, by "METHOD /path", for Server and PubSubServer.
+// If you add a route, add it here (TestOpenAPI checks this).
+var operations = map[string]operation{
+	"GET /sigchain/:kid/:seq": {ID: "getSigchainStatement", Summary: "Get sigchain statement", Tag: "sigchain", Response: mimeJSON},
+	"PUT /sigchain/:kid/:seq": {ID: "putSigchainStatement", Summary: "Put sigchain statement", Tag: "sigchain", Body: mimeJSON, Response: mimeJSON},
+	"GET /sigchain/:kid":      {ID: "getSigchain", Summary: "Get sigchain", Tag: "sigchain", Response: mimeJSON, Query: []string{"include"}},
+	"GET /:kid":               {ID: "getSigchainAlias", Summary: "Get sigchain (alias)", Tag: "sigchain", Response: mimeJSON, Query: []string{"include"}},
+	"GET /:kid/:seq":          {ID: "getSigchainStatementAlias", Summary: "Get sigchain statement (alias)", Tag: "sigchain", Response: mimeJSON},
+	"PUT /:kid/:seq":          {ID: "putSigchainStatementAlias", Summary: "Put sigchain statement (alias)", Tag: "sigchain", Body: mimeJSON, Response: mimeJSON},
+
+	"POST /check": {ID: "check", Summary: "Check user statements for the authorized key", Tag: "user", Auth: "keysAuth", Response: mimeJSON},
+
+	"GET /user/search": {ID: "getUserSearch", Summary: "Search users", Tag: "user", Response: mimeJSON, Query: []string{"q", "limit"}},
+	"GET /user/:kid":   {ID: "getUser", Summary: "Get user", Tag: "user", Response: mimeJSON},
+
+	"POST /task/check/:kid":       {ID: "taskCheck", Summary: "Check user (task)", Tag: "tasks", Auth: "internalAuth", Response: mimeText},
+	"POST /task/expired":          {ID: "taskExpired", Summary: "Delete expired messages (task)", Tag: "tasks", Auth: "internalAuth", Response: mimeText},
+	"GET /task/create/check/:kid": {ID: "createTaskCheck", Summary: "Create check task", Tag: "tasks", Response: mimeText},
+	"POST /cron/check":            {ID: "cronCheck", Summary: "Create check tasks (cron)", Tag: "tasks", Response: mimeText},
+	"POST /cron/expired":          {ID: "cronExpired", Summary: "Create expired task (cron)", Tag: "tasks", Response: mimeText},
+
+	"POST /msgs/:kid/:rid": {ID: "postMessage", Summary: "Post message", Tag: "messages", Auth: "keysAuth", Body: mimeBinary, Response: mimeJSON, Query: []string{"expire"}},
+	"GET /msgs/:kid/:rid":  {ID: "listMessages", Summary: "List messages", Tag: "messages", Auth: "keysAuth", Response: mimeJSON, Query: []string{"version", "limit", "direction", "include"}},
+
+	"PUT /disco/:kid/:rid/:type": {ID: "putDisco", Summary: "Put discovery offer or answer", Tag: "disco", Auth: "keysAuth", Body: mimeBinary, Response: mimeJSON, Query: []string{"expire"}},
+	"GET /disco/:kid/:rid/:type": {ID: "getDisco", Summary: "Get discovery offer or answer", Tag: "disco", Auth: "keysAuth", Response: mimeBinary},
+	"DELETE /disco/:kid/:rid":    {ID: "deleteDisco", Summary: "Delete discovery", Tag: "disco", Auth: "keysAuth", Response: mimeJSON},
+
+	"POST /invite/:kid/:rid": {ID: "postInvite", Summary: "Create invite", Tag: "invite", Auth: "keysAuth", Response: mimeJSON},
+	"GET /invite":            {ID: "getInvite", Summary: "Get invite", Tag: "invite", Auth: "keysAuth", Response: mimeJSON, Query: []string{"code"}},
+
+	"POST /vault/:kid": {ID: "postVault", Summary: "Save vault item", Tag: "vault", Auth: "keysAuth", Body: mimeBinary, Response: mimeJSON},
+	"GET /vault/:kid":  {ID: "listVault", Summary: "List vault items", Tag: "vault", Auth: "keysAuth", Response: mimeJSON, Query: []string{"version", "limit", "direction", "include"}},
+
+	"POST /admin/check/:kid": {ID: "adminCheck", Summary: "Check user (admin)", Tag: "admin", Auth: "keysAuth", Response: mimeJSON},
+
+	"POST /publish/:kid/:rid": {ID: "publish", Summary: "Publish", Tag: "pubsub", Auth: "keysAuth", Body: mimeBinary, Response: mimeJSON},
+	"GET /subscribe/:kid":     {ID: "subscribe", Summary: "Subscribe (websocket)", Tag: "pubsub", Auth: "keysAuth"},
+	"GET /wsecho":             {ID: "wsEcho", Summary: "Echo (websocket)", Tag: "pubsub"},
+
+	"GET /metrics":      {ID: "getMetrics", Summary: "Prometheus metrics", Tag: "other", Auth: "internalAuth", Response: mimeText},
+	"GET /openapi.json": {ID: "getOpenAPI", Summary: "OpenAPI document", Tag: "other", Response: mimeJSON},
+}
+
+type openAPI struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       openAPIInfo                      `json:"info"`
+	Paths      map[string]map[string]*openAPIOp `json:"paths"`
+	Components openAPIComponents                `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIOp struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Tags        []string                    `json:"tags,omitempty"`
+	Parameters  []openAPIParam              `json:"parameters,omitempty"`
+	Security    []map[string][]string       `json:"security,omitempty"`
+	RequestBody *openAPIBody                `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParam struct {
+	Name     string        `json:"name"`
+	In       string        `json:"in"`
+	Required bool          `json:"required,omitempty"`
+	Schema   openAPISchema `json:"schema"`
+}
+
+type openAPIBody struct {
+	Required bool                        `json:"required,omitempty"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref        string                   `json:"$ref,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+	Format     string                   `json:"format,omitempty"`
+	Enum       []string                 `json:"enum,omitempty"`
+	Properties map[string]openAPISchema `json:"properties,omitempty"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]openAPISchema         `json:"schemas"`
+	SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes"`
+}
+
+type openAPISecurityScheme struct {
+	Type        string `json:"type"`
+	In          string `json:"in"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+var pathParamRe = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// newOpenAPI generates an OpenAPI document for routes.
+// Routes without an operation are skipped (and logged).
+func newOpenAPI(routes []*echo.Route, logger Logger) *openAPI {
+	doc := &openAPI{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:   "keys.pub",
+			Version: "1",
+		},
+		Paths:      map[string]map[string]*openAPIOp{},
+		Components: openAPIComponentsDefault(),
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Path+routes[i].Method < routes[j].Path+routes[j].Method
+	})
+	for _, route := range routes {
+		op, ok := operations[route.Method+" "+route.Path]
+		if !ok {
+			logger.Errorf("No OpenAPI operation for %s %s", route.Method, route.Path)
+			continue
+		}
+		path := pathParamRe.ReplaceAllString(route.Path, "{$1}")
+		if _, ok := doc.Paths[path]; !ok {
+			doc.Paths[path] = map[string]*openAPIOp{}
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = newOpenAPIOp(route.Path, op)
+	}
+	return doc
+}
+
+func newOpenAPIOp(path string, op operation) *openAPIOp {
+	out := &openAPIOp{
+		OperationID: op.ID,
+		Summary:     op.Summary,
+		Responses:   map[string]*openAPIResponse{},
+	}
+	if op.Tag != "" {
+		out.Tags = []string{op.Tag}
+	}
+	for _, m := range pathParamRe.FindAllStringSubmatch(path, -1) {
+		out.Parameters = append(out.Parameters, openAPIParam{
+			Name:     m[1],
+			In:       "path",
+			Required: true,
+			Schema:   openAPISchema{Type: "string"},
+		})
+	}
+	for _, q := range op.Query {
+		out.Parameters = append(out.Parameters, openAPIParam{
+			Name:   q,
+			In:     "query",
+			Schema: openAPISchema{Type: "string"},
+		})
+	}
+	if op.Auth != "" {
+		out.Security = []map[string][]string{{op.Auth: []string{}}}
+	}
+	if op.Body != "" {
+		out.RequestBody = &openAPIBody{
+			Required: true,
+			Content:  map[string]openAPIMediaType{op.Body: {Schema: schemaForContentType(op.Body)}},
+		}
+	}
+
+	ok := &openAPIResponse{Description: "OK"}
+	if op.Response != "" {
+		ok.Content = map[string]openAPIMediaType{op.Response: {Schema: schemaForContentType(op.Response)}}
+	}
+	out.Responses["200"] = ok
+	out.Responses["default"] = &openAPIResponse{
+		Description: "Error",
+		Content: map[string]openAPIMediaType{
+			mimeJSON: {Schema: openAPISchema{Ref: "#/components/schemas/Response"}},
+		},
+	}
+	return out
+}
+
+func schemaForContentType(contentType string) openAPISchema {
+	switch contentType {
+	case mimeJSON:
+		return openAPISchema{Type: "object"}
+	case mimeBinary:
+		return openAPISchema{Type: "string", Format: "binary"}
+	default:
+		return openAPISchema{Type: "string"}
+	}
+}
+
+func openAPIComponentsDefault() openAPIComponents {
+	codes := make([]string, 0, len(api.ErrorCodes))
+	for _, code := range api.ErrorCodes {
+		codes = append(codes, string(code))
+	}
+	return openAPIComponents{
+		Schemas: map[string]openAPISchema{
+			"Error": {
+				Type: "object",
+				Properties: map[string]openAPISchema{
+					"code":      {Type: "integer"},
+					"message":   {Type: "string"},
+					"errorCode": {Type: "string", Enum: codes},
+				},
+			},
+			"Response": {
+				Type: "object",
+				Properties: map[string]openAPISchema{
+					"error": {Ref: "#/components/schemas/Error"},
+				},
+			},
+		},
+		SecuritySchemes: map[string]openAPISecurityScheme{
+			"keysAuth": {
+				Type:        "apiKey",
+				In:          "header",
+				Name:        "Authorization",
+				Description: "Signed request (see api.NewRequest), with nonce and ts query parameters.",
+			},
+			"internalAuth": {
+				Type:        "apiKey",
+				In:          "header",
+				Name:        "Authorization",
+				Description: "Internal auth token, for tasks.",
+			},
+		},
+	}
+}
+
+func (s *Server) getOpenAPI(c echo.Context) error {
+	return JSON(c, http.StatusOK, newOpenAPI(c.Echo().Routes(), s.logger))
+}
+
+func (s *PubSubServer) getOpenAPI(c echo.Context) error {
+	return JSON(c, http.StatusOK, newOpenAPI(c.Echo().Routes(), s.logger))
+}
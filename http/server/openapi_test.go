@@ -0,0 +1,84 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type openAPIDoc struct {
+	OpenAPI string `json:"openapi"`
+	Paths   map[string]map[string]struct {
+		OperationID string                `json:"operationId"`
+		Security    []map[string][]string `json:"security"`
+	} `json:"paths"`
+}
+
+func TestOpenAPI(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	pubSub := newTestPubSubServer(t, env)
+
+	e := echo.New()
+	srv.Server.AddRoutes(e)
+	testOpenAPI(t, e.Routes(), srv.Serve)
+
+	e = echo.New()
+	pubSub.Server.AddRoutes(e)
+	testOpenAPI(t, e.Routes(), pubSub.Serve)
+}
+
+var pathParamRe = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+func testOpenAPI(t *testing.T, routes []*echo.Route, serve func(req *http.Request) (int, http.Header, string)) {
+	req, err := http.NewRequest("GET", "/openapi.json", nil)
+	require.NoError(t, err)
+	code, _, body := serve(req)
+	require.Equal(t, http.StatusOK, code)
+
+	var doc openAPIDoc
+	err = json.Unmarshal([]byte(body), &doc)
+	require.NoError(t, err)
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+
+	ids := map[string]bool{}
+	for _, route := range routes {
+		path := pathParamRe.ReplaceAllString(route.Path, "{$1}")
+		op, ok := doc.Paths[path][strings.ToLower(route.Method)]
+		require.True(t, ok, "missing OpenAPI operation for %s %s", route.Method, route.Path)
+		require.NotEmpty(t, op.OperationID)
+		require.False(t, ids[op.OperationID], "duplicate operationId %s", op.OperationID)
+		ids[op.OperationID] = true
+
+		if len(op.Security) == 0 {
+			continue
+		}
+		// Check the handler requires auth
+		urs := pathParamRe.ReplaceAllString(route.Path, "kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077")
+		req, err := http.NewRequest(route.Method, urs, nil)
+		require.NoError(t, err)
+		code, _, body := serve(req)
+		var resp api.Response
+		err = json.Unmarshal([]byte(body), &resp)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Error, "%s %s", route.Method, route.Path)
+		if _, ok := op.Security[0]["keysAuth"]; ok {
+			require.Equal(t, http.StatusUnauthorized, code, "%s %s", route.Method, route.Path)
+			require.Equal(t, api.ErrCodeAuthMissing, resp.Error.Code)
+		} else {
+			require.Equal(t, http.StatusForbidden, code, "%s %s", route.Method, route.Path)
+			require.Equal(t, api.ErrCodeForbidden, resp.Error.Code)
+		}
+	}
+	count := 0
+	for _, ops := range doc.Paths {
+		count += len(ops)
+	}
+	require.Equal(t, len(routes), count)
+}
@@ -21,20 +21,27 @@ type PubSub interface {
 
 // PubSubServer implements PubSub.
 type PubSubServer struct {
-	pubSub PubSub
-	mc     MemCache
-	logger Logger
-	nowFn  func() time.Time
+	pubSub  PubSub
+	mc      MemCache
+	logger  Logger
+	nowFn   func() time.Time
+	metrics *Metrics
 
 	URL string
+
+	internalAuth string
+
+	authV1Deadline time.Time
 }
 
 // NewPubSubServer creates a PubSubServer.
 func NewPubSubServer(pubSub PubSub, mc MemCache, logger Logger) *PubSubServer {
 	return &PubSubServer{
-		pubSub: pubSub,
-		mc:     mc,
-		logger: logger,
+		pubSub:         pubSub,
+		mc:             mc,
+		logger:         logger,
+		metrics:        NewMetrics(),
+		authV1Deadline: DefaultAuthV1Deadline,
 	}
 }
 
@@ -43,6 +50,24 @@ func (s *PubSubServer) SetNowFn(nowFn func() time.Time) {
 	s.nowFn = nowFn
 }
 
+// SetAuthV1Deadline sets when the deprecated v1 auth scheme is no longer
+// accepted (DefaultAuthV1Deadline by default). If zero, v1 auth is always
+// accepted (with a warning logged for each request).
+func (s *PubSubServer) SetAuthV1Deadline(deadline time.Time) {
+	s.authV1Deadline = deadline
+}
+
+// SetInternalAuth for authorizing internal requests, like metrics.
+func (s *PubSubServer) SetInternalAuth(internalAuth string) {
+	s.internalAuth = internalAuth
+}
+
+// SetMetrics sets metrics (if you want to share them with a Server), or nil to
+// disable.
+func (s *PubSubServer) SetMetrics(metrics *Metrics) {
+	s.metrics = metrics
+}
+
 // NewPubSubHandler returns http.Handler for Server.
 func NewPubSubHandler(s *PubSubServer) http.Handler {
 	return newPubSubHandler(s)
@@ -51,6 +76,7 @@ func NewPubSubHandler(s *PubSubServer) http.Handler {
 func newPubSubHandler(s *PubSubServer) *echo.Echo {
 	e := echo.New()
 	e.HTTPErrorHandler = ErrorHandler
+	e.Use(s.metrics.Middleware())
 	s.AddRoutes(e)
 	return e
 }
@@ -62,6 +88,12 @@ func (s *PubSubServer) AddRoutes(e *echo.Echo) {
 	e.GET("/subscribe/:kid", s.subscribe)
 
 	e.GET("/wsecho", s.wsEcho)
+
+	// Metrics
+	e.GET("/metrics", s.getMetrics)
+
+	// OpenAPI
+	e.GET("/openapi.json", s.getOpenAPI)
 }
 
 // TODO: Whitelist publish recipients by default
@@ -70,9 +102,9 @@ func (s *PubSubServer) publish(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 	ctx := c.Request().Context()
 
-	_, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	_, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	recipient := c.Param("rid")
@@ -107,9 +139,17 @@ func (s *PubSubServer) publish(c echo.Context) error {
 	return JSON(c, http.StatusOK, resp)
 }
 
+func (s *PubSubServer) getMetrics(c echo.Context) error {
+	if err := checkInternalAuth(c, s.internalAuth); err != nil {
+		return ErrForbidden(c, err)
+	}
+	s.metrics.Handler().ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
 func (s *PubSubServer) internalError(c echo.Context, err error) error {
 	s.logger.Errorf("Internal error: %v", err)
-	return ErrResponse(c, http.StatusInternalServerError, err.Error())
+	return ErrStatus(c, http.StatusInternalServerError, err)
 }
 
 var (
@@ -123,10 +163,10 @@ var (
 func (s *PubSubServer) subscribe(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 
-	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc)
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
 	if err != nil {
 		s.logger.Errorf("Authorize error: %v", err)
-		return ErrResponse(c, status, err.Error())
+		return ErrStatus(c, status, err)
 	}
 
 	subCtx, cancel := context.WithCancel(c.Request().Context())
@@ -19,10 +19,11 @@ import (
 
 // Server ...
 type Server struct {
-	fi     Fire
-	mc     MemCache
-	nowFn  func() time.Time
-	logger Logger
+	fi      Fire
+	mc      MemCache
+	nowFn   func() time.Time
+	logger  Logger
+	metrics *Metrics
 
 	// URL (base) of form http(s)://host:port with no trailing slash to help
 	// authorization checks in testing where the host is ambiguous.
@@ -34,6 +35,8 @@ type Server struct {
 	tasks        Tasks
 	internalAuth string
 
+	authV1Deadline time.Time
+
 	admins []keys.ID
 }
 
@@ -43,15 +46,21 @@ type Fire interface {
 	ds.Changes
 }
 
+// DefaultAuthV1Deadline is when the deprecated v1 auth scheme is no longer
+// accepted, unless changed with SetAuthV1Deadline.
+var DefaultAuthV1Deadline = time.Date(2020, time.September, 1, 0, 0, 0, 0, time.UTC)
+
 // NewServer creates a Server.
 func NewServer(fi Fire, mc MemCache, users *user.Store, logger Logger) *Server {
 	return &Server{
-		fi:     fi,
-		mc:     mc,
-		nowFn:  time.Now,
-		tasks:  newUnsetTasks(),
-		users:  users,
-		logger: logger,
+		fi:             fi,
+		mc:             mc,
+		nowFn:          time.Now,
+		tasks:          newUnsetTasks(),
+		users:          users,
+		logger:         logger,
+		metrics:        NewMetrics(),
+		authV1Deadline: DefaultAuthV1Deadline,
 		accessFn: func(c AccessContext, resource AccessResource, action AccessAction) Access {
 			return AccessDeny("no access set")
 		},
@@ -63,6 +72,13 @@ func (s *Server) SetInternalAuth(internalAuth string) {
 	s.internalAuth = internalAuth
 }
 
+// SetAuthV1Deadline sets when the deprecated v1 auth scheme is no longer
+// accepted (DefaultAuthV1Deadline by default). If zero, v1 auth is always
+// accepted (with a warning logged for each request).
+func (s *Server) SetAuthV1Deadline(deadline time.Time) {
+	s.authV1Deadline = deadline
+}
+
 // SetAdmins sets authorized admins.
 func (s *Server) SetAdmins(admins []keys.ID) {
 	s.admins = admins
@@ -73,6 +89,17 @@ func (s *Server) SetTasks(tasks Tasks) {
 	s.tasks = tasks
 }
 
+// SetMetrics sets metrics (if you want to share them with a PubSubServer), or
+// nil to disable.
+func (s *Server) SetMetrics(metrics *Metrics) {
+	s.metrics = metrics
+}
+
+// Metrics returns server metrics.
+func (s *Server) Metrics() *Metrics {
+	return s.metrics
+}
+
 // NewHandler returns http.Handler for Server.
 func NewHandler(s *Server) http.Handler {
 	return newHandler(s)
@@ -81,6 +108,7 @@ func NewHandler(s *Server) http.Handler {
 func newHandler(s *Server) *echo.Echo {
 	e := echo.New()
 	e.HTTPErrorHandler = ErrorHandler
+	e.Use(s.metrics.Middleware())
 	s.AddRoutes(e)
 	return e
 }
@@ -118,6 +146,10 @@ func (s *Server) AddRoutes(e *echo.Echo) {
 	e.POST("/invite/:kid/:rid", s.postInvite)
 	e.GET("/invite", s.getInvite)
 
+	// Vault
+	e.POST("/vault/:kid", s.postVault)
+	e.GET("/vault/:kid", s.listVault)
+
 	// Sigchain (aliases)
 	e.GET("/:kid", s.getSigchain)
 	e.GET("/:kid/:seq", s.getSigchainStatement)
@@ -125,6 +157,12 @@ func (s *Server) AddRoutes(e *echo.Echo) {
 
 	// Admin
 	e.POST("/admin/check/:kid", s.adminCheck)
+
+	// Metrics
+	e.GET("/metrics", s.getMetrics)
+
+	// OpenAPI
+	e.GET("/openapi.json", s.getOpenAPI)
 }
 
 // SetNowFn sets clock Now function.
@@ -149,15 +187,21 @@ func JSON(c echo.Context, status int, i interface{}) error {
 }
 
 func (s *Server) checkInternalAuth(c echo.Context) error {
-	if s.internalAuth == "" {
-		return ErrForbidden(c, errors.Errorf("no auth token set on server"))
+	return checkInternalAuth(c, s.internalAuth)
+}
+
+// checkInternalAuth returns an error if the request doesn't have the internal
+// auth token (respond with ErrForbidden).
+func checkInternalAuth(c echo.Context, internalAuth string) error {
+	if internalAuth == "" {
+		return errors.Errorf("no auth token set on server")
 	}
 	auth := c.Request().Header.Get("Authorization")
 	if auth == "" {
-		return ErrForbidden(c, errors.Errorf("no auth token specified"))
+		return errors.Errorf("no auth token specified")
 	}
-	if auth != s.internalAuth {
-		return ErrForbidden(c, errors.Errorf("invalid auth token"))
+	if auth != internalAuth {
+		return errors.Errorf("invalid auth token")
 	}
 	return nil
 }
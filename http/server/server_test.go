@@ -243,6 +243,8 @@ func TestAccess(t *testing.T) {
 					return server.AccessDenyTooManyRequests("sigchain deny test")
 				}
 			}
+		case server.VaultResource:
+			return server.AccessDeny("vault deny test")
 		}
 		return server.AccessAllow()
 	})
@@ -272,7 +274,7 @@ func TestAccess(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusTooManyRequests, code)
-	require.Equal(t, `{"error":{"code":429,"message":"sigchain deny test"}}`, body)
+	require.Equal(t, `{"error":{"code":429,"message":"sigchain deny test","errorCode":"too-many-requests"}}`, body)
 
 	bob := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
 
@@ -295,7 +297,7 @@ func TestAccess(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusForbidden, code)
-	require.Equal(t, `{"error":{"code":403,"message":"no auth token specified"}}`, body)
+	require.Equal(t, `{"error":{"code":403,"message":"no auth token specified","errorCode":"forbidden"}}`, body)
 
 	// Set internal auth token
 	srv.Server.SetInternalAuth("testtoken")
@@ -307,4 +309,28 @@ func TestAccess(t *testing.T) {
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusOK, code)
 	require.Equal(t, "", body)
+
+	// POST /vault/:kid (deny)
+	req, err = http.NewRequest("POST", "/vault/"+alice.ID().String(), bytes.NewReader([]byte("test")))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"vault deny test","errorCode":"access-denied"}}`, body)
+
+	// GET /vault/:kid (deny)
+	req, err = http.NewRequest("GET", "/vault/"+alice.ID().String(), nil)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"vault deny test","errorCode":"access-denied"}}`, body)
+
+	// GET /metrics
+	req, err = http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "testtoken")
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, `keys_http_access_denied_total{action="put",code="429",resource="sigchain"} 1`)
+	require.Contains(t, body, `keys_http_access_denied_total{action="get",code="400",resource="vault"} 1`)
+	require.Contains(t, body, `keys_http_tasks_total{result="ok",task="check"}`)
 }
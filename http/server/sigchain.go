@@ -145,11 +145,12 @@ func (s *Server) putSigchainStatement(c echo.Context) error {
 		return s.internalError(c, err)
 	}
 	if exists {
-		return ErrConflict(c, errors.Errorf("statement already exists"))
+		return ErrConflict(c, api.NewCodeError(api.ErrCodeStatementExists, "statement already exists"))
 	}
 
 	if access := s.accessFn(c, SigchainResource, Put); !access.Allow {
-		return ErrResponse(c, access.StatusCode, access.Message)
+		s.metrics.accessDeny(SigchainResource, Put, access)
+		return errAccessDenied(c, access)
 	}
 
 	sc, _, err := s.sigchain(c, st.KID)
@@ -159,13 +160,18 @@ func (s *Server) putSigchainStatement(c echo.Context) error {
 
 	if sc.Length() >= 128 {
 		// TODO: Increase limits
-		return ErrEntityTooLarge(c, errors.Errorf("sigchain limit reached, contact gabriel@github to bump the limits"))
+		return ErrEntityTooLarge(c, api.NewCodeError(api.ErrCodeSigchainLimit, "sigchain limit reached, contact gabriel@github to bump the limits"))
 	}
 
 	prev := sc.Last()
 	if err := sc.VerifyStatement(st, prev); err != nil {
 		return ErrBadRequest(c, err)
 	}
+	if api.IsKeyLinkStatement(st) {
+		if _, err := api.VerifyKeyLinkStatement(st); err != nil {
+			return ErrBadRequest(c, err)
+		}
+	}
 	if err := sc.Add(st); err != nil {
 		return ErrBadRequest(c, err)
 	}
@@ -2,12 +2,14 @@ package server_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keysd/http/api"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,7 +29,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body := srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	expected := `{"error":{"code":404,"message":"resource not found"}}`
+	expected := `{"error":{"code":404,"message":"resource not found","errorCode":"not-found"}}`
 	require.Equal(t, expected, body)
 
 	// PUT /sigchains (method not allowed)
@@ -35,7 +37,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusMethodNotAllowed, code)
-	expected = `{"error":{"code":405,"message":"method not allowed"}}`
+	expected = `{"error":{"code":405,"message":"method not allowed","errorCode":"method-not-allowed"}}`
 	require.Equal(t, expected, body)
 
 	// Alice sign "testing"
@@ -59,7 +61,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusConflict, code)
-	expected = `{"error":{"code":409,"message":"statement already exists"}}`
+	expected = `{"error":{"code":409,"message":"statement already exists","errorCode":"statement-exists"}}`
 	require.Equal(t, expected, body)
 
 	// Bob sign "testing"
@@ -74,7 +76,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	expected = `{"error":{"code":400,"message":"invalid kid"}}`
+	expected = `{"error":{"code":400,"message":"invalid kid","errorCode":"bad-request"}}`
 	require.Equal(t, expected, body)
 
 	// PUT /sigchain/:kid/:seq (empty json)
@@ -82,7 +84,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	expected = `{"error":{"code":400,"message":"not enough bytes for statement"}}`
+	expected = `{"error":{"code":400,"message":"not enough bytes for statement","errorCode":"bad-request"}}`
 	require.Equal(t, expected, body)
 
 	// PUT /sigchain/:kid/:seq (no body)
@@ -90,7 +92,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	expected = `{"error":{"code":400,"message":"missing body"}}`
+	expected = `{"error":{"code":400,"message":"missing body","errorCode":"bad-request"}}`
 	require.Equal(t, expected, body)
 
 	// GET /sigchain/:kid/:seq
@@ -118,7 +120,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"sigchain not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"sigchain not found","errorCode":"not-found"}}`, body)
 
 	// GET /sigchain/:kid?include=md
 	req, err = http.NewRequest("GET", fmt.Sprintf("/sigchain/%s?include=md", alice.ID()), nil)
@@ -184,7 +186,7 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	require.Equal(t, `{"error":{"code":400,"message":"invalid kid"}}`, body)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid kid","errorCode":"bad-request"}}`, body)
 
 	// Alice sign large message
 	large := bytes.Repeat([]byte{0x01}, 17*1024)
@@ -200,19 +202,92 @@ func TestSigchains(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	require.Equal(t, `{"error":{"code":400,"message":"too much data for sigchain statement (greater than 16KiB)"}}`, body)
+	require.Equal(t, `{"error":{"code":400,"message":"too much data for sigchain statement (greater than 16KiB)","errorCode":"bad-request"}}`, body)
 
 	// GET /foo/bar
 	req, err = http.NewRequest("GET", "/foo/bar", nil)
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"invalid ID: separator '1' at invalid position: pos=-1, len=3"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"invalid ID: separator '1' at invalid position: pos=-1, len=3","errorCode":"not-found"}}`, body)
 
 	// GET /:kid/bar
 	req, err = http.NewRequest("GET", ds.Path(alice.ID(), "bar"), nil)
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"strconv.Atoi: parsing \"bar\": invalid syntax"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"strconv.Atoi: parsing \"bar\": invalid syntax","errorCode":"not-found"}}`, body)
+}
+
+func TestSigchainKeyLinks(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	device := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x04}, 32)))
+
+	// Device statement with invalid link signature
+	sca := keys.NewSigchain(alice.ID())
+	link := &api.KeyLink{KID: device.ID(), Sig: alice.SignDetached([]byte("device-link:" + alice.ID().String()))}
+	data, err := json.Marshal(link)
+	require.NoError(t, err)
+	st, err := keys.NewSigchainStatement(sca, data, alice, api.DeviceStatementType, clock.Now())
+	require.NoError(t, err)
+	b, err := st.Bytes()
+	require.NoError(t, err)
+
+	// PUT /sigchain/:kid/:seq (invalid device signature)
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/sigchain/%s/1", alice.ID()), bytes.NewReader(b))
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid device key signature","errorCode":"bad-request"}}`, body)
+
+	// Device statement
+	st, err = api.NewDeviceStatement(sca, device, alice, clock.Now())
+	require.NoError(t, err)
+	err = sca.Add(st)
+	require.NoError(t, err)
+	b, err = st.Bytes()
+	require.NoError(t, err)
+
+	// PUT /sigchain/:kid/:seq
+	req, err = http.NewRequest("PUT", fmt.Sprintf("/sigchain/%s/1", alice.ID()), bytes.NewReader(b))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "{}", body)
+
+	// Encrypt key statement (with EdX25519 key)
+	link = &api.KeyLink{KID: device.ID()}
+	data, err = json.Marshal(link)
+	require.NoError(t, err)
+	st, err = keys.NewSigchainStatement(sca, data, alice, api.EncryptKeyStatementType, clock.Now())
+	require.NoError(t, err)
+	b, err = st.Bytes()
+	require.NoError(t, err)
+
+	// PUT /sigchain/:kid/:seq (invalid encryption key)
+	req, err = http.NewRequest("PUT", fmt.Sprintf("/sigchain/%s/2", alice.ID()), bytes.NewReader(b))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid encryption key type ed25519-public","errorCode":"bad-request"}}`, body)
+
+	// Encrypt key statement (without signature)
+	link = &api.KeyLink{KID: keys.GenerateX25519Key().ID()}
+	data, err = json.Marshal(link)
+	require.NoError(t, err)
+	st, err = keys.NewSigchainStatement(sca, data, alice, api.EncryptKeyStatementType, clock.Now())
+	require.NoError(t, err)
+	b, err = st.Bytes()
+	require.NoError(t, err)
+
+	// PUT /sigchain/:kid/:seq (no consent)
+	req, err = http.NewRequest("PUT", fmt.Sprintf("/sigchain/%s/2", alice.ID()), bytes.NewReader(b))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid encryption key signature","errorCode":"bad-request"}}`, body)
 }
@@ -74,7 +74,7 @@ func (s *Server) taskCheck(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	if err := s.checkInternalAuth(c); err != nil {
-		return err
+		return ErrForbidden(c, err)
 	}
 
 	kid, err := keys.ParseID(c.Param("kid"))
@@ -82,7 +82,9 @@ func (s *Server) taskCheck(c echo.Context) error {
 		return ErrBadRequest(c, err)
 	}
 
-	if _, err := s.users.Update(ctx, kid); err != nil {
+	_, err = s.users.Update(ctx, kid)
+	s.metrics.taskResult("check", err)
+	if err != nil {
 		return s.internalError(c, err)
 	}
 	return c.String(http.StatusOK, "")
@@ -133,42 +135,48 @@ func (s *Server) taskExpired(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	if err := s.checkInternalAuth(c); err != nil {
-		return err
+		return ErrForbidden(c, err)
 	}
 
-	iter, err := s.fi.Documents(ctx, ds.Path("msgs"), nil)
+	err := s.deleteExpiredMessages(ctx)
+	s.metrics.taskResult("expired", err)
 	if err != nil {
 		return s.internalError(c, err)
 	}
+
+	return c.String(http.StatusOK, "")
+}
+
+func (s *Server) deleteExpiredMessages(ctx context.Context) error {
+	iter, err := s.fi.Documents(ctx, ds.Path("msgs"), nil)
+	if err != nil {
+		return err
+	}
 	defer iter.Release()
 	paths := []string{}
 	for {
 		doc, err := iter.Next()
 		if err != nil {
-			return s.internalError(c, err)
+			return err
 		}
 		if doc == nil {
 			break
 		}
 		var msg message
 		if err := json.Unmarshal(doc.Data, &msg); err != nil {
-			return s.internalError(c, err)
+			return err
 		}
 
 		ok, err := s.checkMessage(&msg, doc)
 		if err != nil {
-			return s.internalError(c, err)
+			return err
 		}
 		if !ok {
 			paths = append(paths, doc.Path)
 		}
 	}
 
-	if err := s.fi.DeleteAll(ctx, paths); err != nil {
-		return s.internalError(c, err)
-	}
-
-	return c.String(http.StatusOK, "")
+	return s.fi.DeleteAll(ctx, paths)
 }
 
 func (s *Server) cronExpired(c echo.Context) error {
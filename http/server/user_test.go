@@ -77,7 +77,7 @@ func TestUserSearch(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusNotFound, code)
-	require.Equal(t, `{"error":{"code":404,"message":"user not found"}}`, body)
+	require.Equal(t, `{"error":{"code":404,"message":"user not found","errorCode":"not-found"}}`, body)
 }
 
 func TestUserDuplicate(t *testing.T) {
@@ -116,5 +116,5 @@ func TestUserDuplicate(t *testing.T) {
 	require.NoError(t, err)
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusBadRequest, code)
-	require.Equal(t, `{"error":{"code":400,"message":"user already exists with key kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077, revoke or remove that before changing keys"}}`, body)
+	require.Equal(t, `{"error":{"code":400,"message":"user already exists with key kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077, revoke or remove that before changing keys","errorCode":"bad-request"}}`, body)
 }
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// vaultItem is an opaque (encrypted) blob, stored in a change feed so clients
+// can sync from a version.
+type vaultItem struct {
+	ID   string `json:"id"`
+	Data []byte `json:"data"`
+}
+
+func (s *Server) postVault(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+
+	if access := s.accessFn(c, VaultResource, Post); !access.Allow {
+		s.metrics.accessDeny(VaultResource, Post, access)
+		return errAccessDenied(c, access)
+	}
+
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
+	if err != nil {
+		return ErrStatus(c, status, err)
+	}
+
+	if c.Request().Body == nil {
+		return ErrBadRequest(c, errors.Errorf("missing body"))
+	}
+
+	b, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if len(b) == 0 {
+		return ErrBadRequest(c, errors.Errorf("missing body"))
+	}
+	if len(b) > 16*1024 {
+		// TODO: Check length before reading data
+		return ErrEntityTooLarge(c, api.NewCodeError(api.ErrCodeEntityTooLarge, "vault item too large (greater than 16KiB)"))
+	}
+
+	id := keys.Rand3262()
+	item := vaultItem{
+		ID:   id,
+		Data: b,
+	}
+	mb, err := json.Marshal(item)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+
+	ctx := c.Request().Context()
+
+	path := ds.Path("vault", id)
+	if err := s.fi.Create(ctx, path, mb); err != nil {
+		return s.internalError(c, err)
+	}
+
+	cpath := fmt.Sprintf("vault-%s", kid)
+	if err := s.fi.ChangeAdd(ctx, cpath, id, path); err != nil {
+		return s.internalError(c, err)
+	}
+
+	resp := api.CreateVaultItemResponse{
+		ID: id,
+	}
+	return JSON(c, http.StatusOK, resp)
+}
+
+func (s *Server) listVault(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+
+	if access := s.accessFn(c, VaultResource, Get); !access.Allow {
+		s.metrics.accessDeny(VaultResource, Get, access)
+		return errAccessDenied(c, access)
+	}
+
+	kid, status, err := authorize(c, s.URL, "kid", s.nowFn(), s.mc, s.metrics, s.authV1Deadline)
+	if err != nil {
+		return ErrStatus(c, status, err)
+	}
+
+	path := fmt.Sprintf("vault-%s", kid)
+
+	chgs, err := s.changes(c, path)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if chgs.errBadRequest != nil {
+		return ErrResponse(c, http.StatusBadRequest, chgs.errBadRequest.Error())
+	}
+	if len(chgs.docs) == 0 && chgs.version == 0 {
+		return ErrNotFound(c, errors.Errorf("vault not found"))
+	}
+
+	items := make([]*api.VaultItem, 0, len(chgs.docs))
+	md := make(map[string]api.Metadata, len(chgs.docs))
+	for _, doc := range chgs.docs {
+		var item vaultItem
+		if err := json.Unmarshal(doc.Data, &item); err != nil {
+			return s.internalError(c, err)
+		}
+		items = append(items, &api.VaultItem{
+			ID:   item.ID,
+			Data: item.Data,
+		})
+		md[item.ID] = api.Metadata{
+			CreatedAt: doc.CreatedAt,
+			UpdatedAt: doc.UpdatedAt,
+		}
+	}
+
+	resp := api.VaultResponse{
+		Items:   items,
+		Version: fmt.Sprintf("%d", chgs.versionNext),
+	}
+	fields := ds.NewStringSetSplit(c.QueryParam("include"), ",")
+	if fields.Contains("md") {
+		resp.Metadata = md
+	}
+	return JSON(c, http.StatusOK, resp)
+}
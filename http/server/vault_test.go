@@ -0,0 +1,90 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVault(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	charlie := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	// GET /vault/:kid (not found)
+	req, err := api.NewRequest("GET", ds.Path("vault", alice.ID()), nil, clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, `{"error":{"code":404,"message":"vault not found","errorCode":"not-found"}}`, body)
+
+	// POST /vault/:kid (no body)
+	req, err = api.NewRequest("POST", ds.Path("vault", alice.ID()), nil, clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"missing body","errorCode":"bad-request"}}`, body)
+
+	// POST /vault/:kid (too large)
+	req, err = api.NewRequest("POST", ds.Path("vault", alice.ID()), bytes.NewReader(bytes.Repeat([]byte{0x01}, 16*1024+1)), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"vault item too large (greater than 16KiB)","errorCode":"entity-too-large"}}`, body)
+
+	// POST /vault/:kid
+	req, err = api.NewRequest("POST", ds.Path("vault", alice.ID()), bytes.NewReader([]byte("test1")), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	var createResp api.CreateVaultItemResponse
+	err = json.Unmarshal([]byte(body), &createResp)
+	require.NoError(t, err)
+	require.NotEmpty(t, createResp.ID)
+
+	// POST /vault/:kid (charlie, invalid auth)
+	req, err = api.NewRequest("POST", ds.Path("vault", alice.ID()), bytes.NewReader([]byte("test1")), clock.Now(), charlie)
+	require.NoError(t, err)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+
+	// GET /vault/:kid
+	req, err = api.NewRequest("GET", ds.Path("vault", alice.ID()), nil, clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	var resp api.VaultResponse
+	err = json.Unmarshal([]byte(body), &resp)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Items))
+	require.Equal(t, createResp.ID, resp.Items[0].ID)
+	require.Equal(t, []byte("test1"), resp.Items[0].Data)
+	version := resp.Version
+
+	// POST /vault/:kid
+	req, err = api.NewRequest("POST", ds.Path("vault", alice.ID()), bytes.NewReader([]byte("test2")), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+
+	// GET /vault/:kid?version= (version is inclusive)
+	req, err = api.NewRequest("GET", ds.Path("vault", alice.ID())+"?version="+version, nil, clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	resp = api.VaultResponse{}
+	err = json.Unmarshal([]byte(body), &resp)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Items))
+	require.Equal(t, createResp.ID, resp.Items[0].ID)
+	require.Equal(t, []byte("test2"), resp.Items[1].Data)
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/bech32"
+	"github.com/pkg/errors"
+)
+
+// Age (https://age-encryption.org/v1) encryption for X25519 recipients, using
+// filippo.io/age, so we can read and write files for people using age.
+// Keyring X25519 keys (and EdX25519 keys, converted) are age identities, and
+// age recipients (age1...) are X25519 public keys.
+//
+// Age is anonymous, there is no sender.
+
+const (
+	ageIntro        = "age-encryption.org/v1"
+	ageHRP          = "age"
+	ageSecretKeyHRP = "age-secret-key-"
+	ageArmorBegin   = armor.Header
+	ageArmorEnd     = armor.Footer
+)
+
+// isAgeEncrypted returns true if the (start of the) data is age encrypted,
+// armored or not.
+func isAgeEncrypted(b []byte) bool {
+	if bytes.HasPrefix(b, []byte(ageIntro+"\n")) {
+		return true
+	}
+	return bytes.HasPrefix(bytes.TrimLeft(b, " \t\r\n"), []byte(ageArmorBegin))
+}
+
+// ageRecipient returns the age recipient (age1...) for a X25519 public key.
+func ageRecipient(bpk *keys.X25519PublicKey) (string, error) {
+	return bech32.Encode(ageHRP, bpk.Bytes())
+}
+
+// parseAgeRecipient parses an age recipient (age1...).
+func parseAgeRecipient(s string) (*keys.X25519PublicKey, error) {
+	hrp, b, err := bech32.Decode(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid age recipient %s", s)
+	}
+	if hrp != ageHRP || len(b) != 32 {
+		return nil, errors.Errorf("invalid age recipient %s", s)
+	}
+	return keys.NewX25519PublicKey(keys.Bytes32(b)), nil
+}
+
+func isAgeRecipient(s string) bool {
+	return strings.HasPrefix(s, ageHRP+"1")
+}
+
+func ageX25519Recipients(recipients []*keys.X25519PublicKey) ([]age.Recipient, error) {
+	out := make([]age.Recipient, 0, len(recipients))
+	for _, bpk := range recipients {
+		s, err := ageRecipient(bpk)
+		if err != nil {
+			return nil, err
+		}
+		r, err := age.ParseX25519Recipient(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func ageX25519Identities(identities []*keys.X25519Key) ([]age.Identity, error) {
+	out := make([]age.Identity, 0, len(identities))
+	for _, bk := range identities {
+		s, err := bech32.Encode(ageSecretKeyHRP, bk.PrivateKey()[:])
+		if err != nil {
+			return nil, err
+		}
+		id, err := age.ParseX25519Identity(strings.ToUpper(s))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+type ageEncryptWriter struct {
+	w     io.WriteCloser
+	armor io.WriteCloser
+}
+
+// newAgeEncryptWriter returns a writer that encrypts to age recipients. The
+// writer must be closed to finish the file.
+func newAgeEncryptWriter(w io.Writer, recipients []*keys.X25519PublicKey, armored bool) (io.WriteCloser, error) {
+	if len(recipients) == 0 {
+		return nil, errors.Errorf("no recipients specified")
+	}
+	ars, err := ageX25519Recipients(recipients)
+	if err != nil {
+		return nil, err
+	}
+	e := &ageEncryptWriter{}
+	if armored {
+		e.armor = armor.NewWriter(w)
+		w = e.armor
+	}
+	e.w, err = age.Encrypt(w, ars...)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *ageEncryptWriter) Write(p []byte) (int, error) {
+	return e.w.Write(p)
+}
+
+func (e *ageEncryptWriter) Close() error {
+	if err := e.w.Close(); err != nil {
+		return err
+	}
+	if e.armor != nil {
+		return e.armor.Close()
+	}
+	return nil
+}
+
+// newAgeDecryptReader returns a reader that decrypts an age file, armored or
+// not, with the first matching identity.
+func newAgeDecryptReader(r io.Reader, identities []*keys.X25519Key) (io.Reader, error) {
+	ids, err := ageX25519Identities(identities)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, errors.Errorf("no key found for age recipients")
+	}
+	br := bufio.NewReader(r)
+	peek, _ := br.Peek(len(ageIntro))
+	var in io.Reader = br
+	if !bytes.Equal(peek, []byte(ageIntro)) {
+		in = armor.NewReader(br)
+	}
+	out, err := age.Decrypt(in, ids...)
+	if err != nil {
+		if _, ok := err.(*age.NoIdentityMatchError); ok {
+			return nil, errors.Errorf("no key found for age recipients")
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+func ageEncrypt(b []byte, recipients []*keys.X25519PublicKey, armored bool) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := newAgeEncryptWriter(&buf, recipients, armored)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func ageDecrypt(b []byte, identities []*keys.X25519Key) ([]byte, error) {
+	r, err := newAgeDecryptReader(bytes.NewReader(b), identities)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ageIdentities returns the keyring keys to try as age identities.
+func (s *service) ageIdentities() ([]*keys.X25519Key, error) {
+	bks, err := s.ks.X25519Keys()
+	if err != nil {
+		return nil, err
+	}
+	sks, err := s.ks.EdX25519Keys()
+	if err != nil {
+		return nil, err
+	}
+	for _, sk := range sks {
+		bks = append(bks, sk.X25519Key())
+	}
+	return bks, nil
+}
+
+// ageRecipients resolves recipients, which are age recipients (age1...) or
+// identities (kid, user), to X25519 public keys.
+func (s *service) ageRecipients(ctx context.Context, recipients []string) ([]*keys.X25519PublicKey, error) {
+	out := []*keys.X25519PublicKey{}
+	ids := []string{}
+	for _, r := range recipients {
+		if isAgeRecipient(r) {
+			bpk, err := parseAgeRecipient(r)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, bpk)
+			continue
+		}
+		ids = append(ids, r)
+	}
+	if len(ids) > 0 {
+		kids, err := s.parseIdentities(ctx, ids, true)
+		if err != nil {
+			return nil, err
+		}
+		// Include linked device and encryption keys.
+		kids, err = s.expandRecipients(kids)
+		if err != nil {
+			return nil, err
+		}
+		for _, kid := range kids {
+			bpk, err := x25519PublicKeyForID(kid)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, bpk)
+		}
+	}
+	return out, nil
+}
+
+func x25519PublicKeyForID(kid keys.ID) (*keys.X25519PublicKey, error) {
+	switch kid.PublicKeyType() {
+	case keys.EdX25519Public:
+		return keys.NewX25519PublicKeyFromEdX25519ID(kid)
+	case keys.X25519Public:
+		return keys.NewX25519PublicKeyFromID(kid)
+	default:
+		return nil, errors.Errorf("unsupported key type for %s", kid)
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/bech32"
+	"github.com/stretchr/testify/require"
+)
+
+// ageChunkSize is the age payload chunk size.
+const ageChunkSize = 64 * 1024
+
+func TestAgeDecryptVector(t *testing.T) {
+	// Encrypted with age (filippo.io/age) v1.0.0-rc.3.
+	key := keys.NewX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x42}, 32)))
+	recipient, err := ageRecipient(key.PublicKey())
+	require.NoError(t, err)
+	require.Equal(t, "age1zvkyg2lqzraa2lnjvqej32nkuu0ues2s82hzrye869xeexvn73equnujwj", recipient)
+
+	bpk, err := parseAgeRecipient(recipient)
+	require.NoError(t, err)
+	require.Equal(t, key.ID(), bpk.ID())
+
+	encrypted := `-----BEGIN AGE ENCRYPTED FILE-----
+YWdlLWVuY3J5cHRpb24ub3JnL3YxCi0+IFgyNTUxOSAvcjVObHRpeXFzUmFGbm5I
+WHpnMk42OGtYWGladlpwYkcxMTJ6RmQzaDI0CkR4Q2RvQXBja1I4UU03VDhBY0NZ
+WWFxWnpTNS92N2daQjN6UXg4Yk94aDAKLS0tIE1HS0RXcGhpNzQ4T0pFRHI0YmI1
+aDBaYkZnckt1NnovWEs0cWVoSk05VFkKd2YYWcP0sY/kcCNK22NU3ukbwyd76zTH
+hTRFKJ7SRWhtmrDNwZGpI6o=
+-----END AGE ENCRYPTED FILE-----
+`
+	require.True(t, isAgeEncrypted([]byte(encrypted)))
+	out, err := ageDecrypt([]byte(encrypted), []*keys.X25519Key{key})
+	require.NoError(t, err)
+	require.Equal(t, "hello age", string(out))
+
+	_, err = ageDecrypt([]byte(encrypted), []*keys.X25519Key{keys.GenerateX25519Key()})
+	require.EqualError(t, err, "no key found for age recipients")
+}
+
+func TestAgeDecryptExample(t *testing.T) {
+	// From filippo.io/age testdata (example_keys.txt, example.age).
+	hrp, b, err := bech32.Decode(strings.ToLower("AGE-SECRET-KEY-184JMZMVQH3E6U0PSL869004Y3U2NYV7R30EU99CSEDNPH02YUVFSZW44VU"))
+	require.NoError(t, err)
+	require.Equal(t, ageSecretKeyHRP, hrp)
+	key := keys.NewX25519KeyFromPrivateKey(keys.Bytes32(b))
+
+	encrypted, err := hex.DecodeString("6167652d656e6372797074696f6e2e6f72672f76310a2d3e20583235353139203868726c4d2b5a4247334464346646322b613538337a64544957446b382f5234316b43595a7376775457340a794f345059646c4d57444a2b437867554e527159355a30542f6d2b6733464368356a4978474c62435658630a2d2d2d20492f696d65765a7a79383132304a537a6d4a6e6d6e2f4b4d6b3370354131315638334e6b34316d394e50450a70c5e53624a1520753f92c5ad10ecab273ba4d6117807713e83820417a1df2ca08182272c8f85c857734a1311a3b75e98d0eaf")
+	require.NoError(t, err)
+	require.True(t, isAgeEncrypted(encrypted))
+	out, err := ageDecrypt(encrypted, []*keys.X25519Key{keys.GenerateX25519Key(), key})
+	require.NoError(t, err)
+	require.Equal(t, "Black lives matter.", string(out))
+}
+
+func TestAgeEncrypt(t *testing.T) {
+	alice := keys.GenerateX25519Key()
+	bob := keys.GenerateX25519Key()
+	charlie := keys.GenerateX25519Key()
+
+	sizes := []int{0, 1, ageChunkSize - 1, ageChunkSize, ageChunkSize + 1, 2 * ageChunkSize, (2 * ageChunkSize) + 5}
+	for _, size := range sizes {
+		for _, armored := range []bool{true, false} {
+			b := bytes.Repeat([]byte{0x01}, size)
+			encrypted, err := ageEncrypt(b, []*keys.X25519PublicKey{alice.PublicKey(), bob.PublicKey()}, armored)
+			require.NoError(t, err)
+			require.True(t, isAgeEncrypted(encrypted))
+			if armored {
+				require.True(t, strings.HasPrefix(string(encrypted), ageArmorBegin+"\n"))
+				require.True(t, strings.HasSuffix(string(encrypted), "\n"+ageArmorEnd+"\n"))
+			}
+
+			out, err := ageDecrypt(encrypted, []*keys.X25519Key{bob})
+			require.NoError(t, err, "size %d", size)
+			require.Equal(t, b, out)
+
+			_, err = ageDecrypt(encrypted, []*keys.X25519Key{charlie})
+			require.EqualError(t, err, "no key found for age recipients")
+		}
+	}
+}
+
+func TestAgeEncryptStream(t *testing.T) {
+	key := keys.GenerateX25519Key()
+	b := bytes.Repeat([]byte{0x01}, (2*ageChunkSize)+5)
+	var buf bytes.Buffer
+	w, err := newAgeEncryptWriter(&buf, []*keys.X25519PublicKey{key.PublicKey()}, false)
+	require.NoError(t, err)
+	for i := 0; i < len(b); i += 1000 {
+		e := i + 1000
+		if e > len(b) {
+			e = len(b)
+		}
+		_, err = w.Write(b[i:e])
+		require.NoError(t, err)
+	}
+	err = w.Close()
+	require.NoError(t, err)
+
+	r, err := newAgeDecryptReader(bytes.NewReader(buf.Bytes()), []*keys.X25519Key{key})
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, b, out)
+}
+
+func TestAgeDecryptInvalid(t *testing.T) {
+	key := keys.GenerateX25519Key()
+	b := bytes.Repeat([]byte{0x01}, (2*ageChunkSize)+5)
+	encrypted, err := ageEncrypt(b, []*keys.X25519PublicKey{key.PublicKey()}, false)
+	require.NoError(t, err)
+
+	// Truncated (missing last chunk)
+	_, err = ageDecrypt(encrypted[:len(encrypted)-21], []*keys.X25519Key{key})
+	require.Error(t, err)
+
+	// Modified header
+	modified := bytes.Replace(encrypted, []byte("X25519"), []byte("X25518"), 1)
+	_, err = ageDecrypt(modified, []*keys.X25519Key{key})
+	require.Error(t, err)
+
+	// Modified payload
+	modified = append([]byte{}, encrypted...)
+	modified[len(modified)-1] ^= 0x01
+	_, err = ageDecrypt(modified, []*keys.X25519Key{key})
+	require.Error(t, err)
+
+	_, err = ageDecrypt([]byte("age-encryption.org/v1\n"), []*keys.X25519Key{key})
+	require.Error(t, err)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// armorWidth is the line width for armored (base64) output.
+const armorWidth = 64
+
+// armorWriter writes base64 between begin and end lines, like PEM, as used by
+// password encryption and age.
+type armorWriter struct {
+	w   io.Writer
+	enc io.WriteCloser
+	end string
+	col int
+}
+
+func newArmorWriter(w io.Writer, begin string, end string) (io.WriteCloser, error) {
+	if _, err := io.WriteString(w, begin+"\n"); err != nil {
+		return nil, err
+	}
+	a := &armorWriter{w: w, end: end}
+	a.enc = base64.NewEncoder(base64.StdEncoding, lineWriterFunc(a.writeLine))
+	return a, nil
+}
+
+type lineWriterFunc func(p []byte) (int, error)
+
+func (f lineWriterFunc) Write(p []byte) (int, error) { return f(p) }
+
+// writeLine writes base64, wrapping lines at armorWidth.
+func (a *armorWriter) writeLine(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		i := armorWidth - a.col
+		if i > len(p) {
+			i = len(p)
+		}
+		if _, err := a.w.Write(p[:i]); err != nil {
+			return 0, err
+		}
+		a.col += i
+		p = p[i:]
+		if a.col == armorWidth {
+			if _, err := a.w.Write([]byte("\n")); err != nil {
+				return 0, err
+			}
+			a.col = 0
+		}
+	}
+	return n, nil
+}
+
+func (a *armorWriter) Write(p []byte) (int, error) {
+	return a.enc.Write(p)
+}
+
+func (a *armorWriter) Close() error {
+	if err := a.enc.Close(); err != nil {
+		return err
+	}
+	end := a.end + "\n"
+	if a.col > 0 {
+		end = "\n" + end
+	}
+	_, err := io.WriteString(a.w, end)
+	return err
+}
+
+// armorReader reads the base64 lines between begin and end.
+type armorReader struct {
+	r    *bufio.Reader
+	end  string
+	buf  []byte
+	done bool
+}
+
+func newArmorReader(r *bufio.Reader, begin string, end string) (io.Reader, error) {
+	for {
+		line, err := r.ReadString('\n')
+		s := strings.TrimSpace(line)
+		if s == begin {
+			break
+		}
+		if s != "" || err != nil {
+			return nil, errors.Errorf("invalid data")
+		}
+	}
+	return base64.NewDecoder(base64.StdEncoding, &armorReader{r: r, end: end}), nil
+}
+
+func (a *armorReader) Read(p []byte) (int, error) {
+	for len(a.buf) == 0 {
+		if a.done {
+			return 0, io.EOF
+		}
+		line, err := a.r.ReadString('\n')
+		s := strings.TrimSpace(line)
+		if s == a.end {
+			if err := a.checkTrailing(); err != nil {
+				return 0, err
+			}
+			a.done = true
+			continue
+		}
+		if strings.HasPrefix(s, "-----") {
+			return 0, errors.Errorf("invalid data (missing end of armored message)")
+		}
+		a.buf = []byte(s)
+		if err == io.EOF {
+			if len(a.buf) == 0 {
+				return 0, errors.Errorf("invalid data (missing end of armored message)")
+			}
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, a.buf)
+	a.buf = a.buf[n:]
+	return n, nil
+}
+
+// checkTrailing checks there is only whitespace after the end line.
+func (a *armorReader) checkTrailing() error {
+	for {
+		line, err := a.r.ReadString('\n')
+		if strings.TrimSpace(line) != "" {
+			return errors.Errorf("invalid data (after end of armored message)")
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
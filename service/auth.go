@@ -22,9 +22,15 @@ import (
 type auth struct {
 	sync.Mutex
 	cfg       *Config
+	st        keyring.Store
 	keyring   *keyring.Keyring
 	tokens    map[string]string
 	whitelist *ds.StringSet
+	metrics   *metrics
+
+	// key is the keyring key, while unlocked, for provisioning other auth
+	// methods.
+	key keyring.SecretKey
 }
 
 func keyringService(cfg *Config) string {
@@ -49,6 +55,7 @@ func newAuth(cfg *Config, st keyring.Store) (*auth, error) {
 
 	return &auth{
 		cfg:       cfg,
+		st:        st,
 		keyring:   kr,
 		tokens:    map[string]string{},
 		whitelist: whitelist,
@@ -61,10 +68,29 @@ func (a *auth) lock() error {
 	if err := a.keyring.Lock(); err != nil {
 		return err
 	}
+	a.Mutex.Lock()
 	a.tokens = map[string]string{}
+	a.key = nil
+	a.Mutex.Unlock()
 	return nil
 }
 
+// reset removes the keyring auth (and salt), so auth setup is needed again.
+func (a *auth) reset() error {
+	logger.Infof("Resetting auth")
+	if err := a.keyring.Reset(); err != nil {
+		return err
+	}
+	return a.lock()
+}
+
+// locked returns true if there are no unlocked clients.
+func (a *auth) locked() bool {
+	a.Mutex.Lock()
+	defer a.Mutex.Unlock()
+	return len(a.tokens) == 0
+}
+
 func (a *auth) verifyPassword(password string) (keyring.Auth, error) {
 	salt, err := a.keyring.Salt()
 	if err != nil {
@@ -90,11 +116,20 @@ func (a *auth) unlock(password string, client string) (string, keyring.Auth, err
 		return "", nil, errors.Wrapf(err, "failed to unlock")
 	}
 
+	token := a.unlocked(auth, client)
+	return token, auth, nil
+}
+
+// unlocked returns a new token for the client, after the keyring was unlocked
+// with auth.
+func (a *auth) unlocked(auth keyring.Auth, client string) string {
 	token := generateToken()
+	a.Mutex.Lock()
 	a.tokens[client] = token
+	a.key = auth.Key()
+	a.Mutex.Unlock()
 	logger.Infof("Unlocked")
-
-	return token, auth, nil
+	return token
 }
 
 func generateToken() string {
@@ -122,25 +157,43 @@ func (a *auth) authorize(ctx context.Context, method string) error {
 		return nil
 	}
 
-	logger.Infof("Authorize %s", method)
+	caller := "tcp"
+	if cred := peerCredFromContext(ctx); cred != nil {
+		caller = cred.String()
+	}
+
+	logger.Infof("Authorize %s (%s)", method, caller)
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		if len(md["authorization"]) == 0 {
-			logger.Warningf("Auth token missing from request")
+			logger.Warningf("Auth token missing from request (%s)", caller)
+			a.metrics.authFailure("missing")
 			return status.Error(codes.Unauthenticated, "authorization missing")
 		}
 		token := md["authorization"][0]
-		for _, t := range a.tokens {
-			if t == token {
-				return nil
-			}
+		if a.hasToken(token) {
+			return nil
 		}
 
-		logger.Infof("Invalid auth token")
+		logger.Infof("Invalid auth token (%s)", caller)
+		a.metrics.authFailure("invalid")
 		return status.Error(codes.Unauthenticated, "invalid token")
 	}
+	a.metrics.authFailure("missing")
 	return status.Error(codes.Unauthenticated, "no authorization in context")
 }
 
+// hasToken returns true if token was issued to an unlocked client.
+func (a *auth) hasToken(token string) bool {
+	a.Mutex.Lock()
+	defer a.Mutex.Unlock()
+	for _, t := range a.tokens {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
 type clientAuth struct {
 	token string
 }
@@ -181,12 +234,35 @@ func (s *service) AuthSetup(ctx context.Context, req *AuthSetupRequest) (*AuthSe
 	if !setupNeeded {
 		return nil, errors.Errorf("auth already setup")
 	}
+	switch req.Type {
+	case UnknownAuth, PasswordAuth:
+	case FIDO2HMACSecretAuth:
+		if s.fido2 == nil {
+			return nil, errors.Errorf("fido2 is not available")
+		}
+		if req.Device == "" {
+			return nil, errors.Errorf("no device specified")
+		}
+	default:
+		return nil, errors.Errorf("unsupported auth type %s", req.Type)
+	}
 
 	token, auth, err := s.auth.unlock(req.Password, req.Client)
 	if err != nil {
 		return nil, err
 	}
 
+	// The password is always set up, FIDO2 is in addition to it.
+	if req.Type == FIDO2HMACSecretAuth {
+		if _, err := s.auth.provisionFIDO2(ctx, s.fido2, req.Device, req.PIN, s.Now()); err != nil {
+			// Undo the password setup, so auth setup can be tried again.
+			if resetErr := s.auth.reset(); resetErr != nil {
+				logger.Errorf("Failed to reset auth: %v", resetErr)
+			}
+			return nil, err
+		}
+	}
+
 	// If setting up auth, and local database exists we should nuke it since the
 	// pre-existing key is different. The database will be rebuilt on Open.
 	path, err := s.cfg.AppPath(dbFilename, false)
@@ -214,8 +290,17 @@ func (s *service) AuthSetup(ctx context.Context, req *AuthSetupRequest) (*AuthSe
 
 // AuthUnlock (RPC) ...
 func (s *service) AuthUnlock(ctx context.Context, req *AuthUnlockRequest) (*AuthUnlockResponse, error) {
-	if req.Password == "" {
-		return nil, errors.Errorf("no password specified")
+	switch req.Type {
+	case UnknownAuth, PasswordAuth:
+		if req.Password == "" {
+			return nil, errors.Errorf("no password specified")
+		}
+	case FIDO2HMACSecretAuth:
+		if s.fido2 == nil {
+			return nil, errors.Errorf("fido2 is not available")
+		}
+	default:
+		return nil, errors.Errorf("unsupported auth type %s", req.Type)
 	}
 
 	setupNeeded, err := s.isAuthSetupNeeded()
@@ -226,7 +311,13 @@ func (s *service) AuthUnlock(ctx context.Context, req *AuthUnlockRequest) (*Auth
 		return nil, errors.Errorf("auth setup needed")
 	}
 
-	token, auth, err := s.auth.unlock(req.Password, req.Client)
+	var token string
+	var auth keyring.Auth
+	if req.Type == FIDO2HMACSecretAuth {
+		token, auth, err = s.auth.unlockFIDO2(ctx, s.fido2, req.Device, req.PIN, req.Client)
+	} else {
+		token, auth, err = s.auth.unlock(req.Password, req.Client)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -131,3 +133,37 @@ func TestAuthSetup(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, setupResp.AuthToken)
 }
+
+func TestAuthorizeConcurrent(t *testing.T) {
+	cfg, closeFn := testConfig(t, "KeysTest", "", "mem")
+	defer closeFn()
+	st, err := newKeyringStore(cfg)
+	require.NoError(t, err)
+	auth, err := newAuth(cfg, st)
+	require.NoError(t, err)
+	defer func() { _ = auth.keyring.Reset() }()
+
+	token, ka, err := auth.unlock("password123", "test")
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.MD{
+		"authorization": []string{token},
+	})
+
+	// Run with -race to check tokens access.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			_ = auth.unlocked(ka, fmt.Sprintf("client%d", i))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = auth.authorize(ctx, "/service.Keys/SomeMethod")
+		}()
+	}
+	wg.Wait()
+
+	err = auth.authorize(ctx, "/service.Keys/SomeMethod")
+	require.NoError(t, err)
+}
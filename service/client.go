@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -85,6 +86,22 @@ func connectLocal(cfg *Config, authToken string) (*grpc.ClientConn, error) {
 
 	opts = append(opts, grpc.WithTransportCredentials(creds))
 	opts = append(opts, grpc.WithPerRPCCredentials(newClientAuth(authToken)))
+
+	// Prefer the unix socket, if the service is listening on it.
+	socketPath, err := cfg.socketPath(false)
+	if err != nil {
+		return nil, err
+	}
+	if socketAvailable(socketPath) {
+		logger.Infof("Opening connection: %s", socketPath)
+		dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", addr)
+		}
+		opts = append(opts, grpc.WithContextDialer(dialer))
+		return grpc.Dial(socketPath, opts...)
+	}
+
 	addr := fmt.Sprintf("127.0.0.1:%d", cfg.Port())
 	logger.Infof("Opening connection: %s", addr)
 	return grpc.Dial(addr, opts...)
@@ -138,6 +155,13 @@ func RunClient(build Build) {
 }
 
 func runClient(build Build, args []string, client *Client, errorFn func(err error)) {
+	if isSSHKeygen(args) {
+		if err := runSSHKeygen(build, args[1:], client); err != nil {
+			errorFn(err)
+		}
+		return
+	}
+
 	app := cli.NewApp()
 	app.Name = "keys"
 	app.Version = build.String()
@@ -180,10 +204,16 @@ func runClient(build Build, args []string, client *Client, errorFn func(err erro
 	cmds = append(cmds, userCommands(client)...)
 	cmds = append(cmds, keyCommands(client)...)
 	cmds = append(cmds, configCommands(client)...)
+	cmds = append(cmds, keyringCommands(client)...)
 	cmds = append(cmds, logCommands(client)...)
 	cmds = append(cmds, wormholeCommands(client)...)
 	cmds = append(cmds, fido2Commands(client)...)
 	cmds = append(cmds, adminCommands(client)...)
+	cmds = append(cmds, vaultCommands(client)...)
+	cmds = append(cmds, secretCommands(client)...)
+	cmds = append(cmds, sshCommands(client)...)
+	cmds = append(cmds, ageCommands(client)...)
+	cmds = append(cmds, trustCommands(client)...)
 	sort.Slice(cmds, func(i, j int) bool {
 		return cmds[i].Name < cmds[j].Name
 	})
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/keys-pub/keysd/fido2"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
@@ -32,6 +33,9 @@ func newTestRPCClient(t *testing.T, srvc *service, env *testEnv, appName string)
 
 	server := grpc.NewServer()
 	RegisterKeysServer(server, srvc)
+	if srvc.fido2 != nil {
+		fido2.RegisterAuthenticatorsServer(server, srvc.fido2)
+	}
 	go func() {
 		serveErr := server.Serve(listener.lis)
 		require.NoError(t, serveErr)
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/keys-pub/keys"
+	"github.com/urfave/cli"
+)
+
+func ageCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "age",
+			Usage: "age (age-encryption.org)",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:  "recipient",
+					Usage: "Show age recipients (age1...) for X25519 or EdX25519 keys",
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{Name: "kid, k", Usage: "keys, defaults to all X25519 and EdX25519 keys in the keyring"},
+					},
+					Action: func(c *cli.Context) error {
+						kids := []keys.ID{}
+						for _, s := range c.StringSlice("kid") {
+							kid, err := keys.ParseID(s)
+							if err != nil {
+								return err
+							}
+							kids = append(kids, kid)
+						}
+						if len(kids) == 0 {
+							resp, err := client.KeysClient().Keys(context.TODO(), &KeysRequest{Types: []KeyType{X25519, EdX25519}})
+							if err != nil {
+								return err
+							}
+							for _, key := range resp.Keys {
+								kids = append(kids, keys.ID(key.ID))
+							}
+						}
+						for _, kid := range kids {
+							bpk, err := x25519PublicKeyForID(kid)
+							if err != nil {
+								return err
+							}
+							recipient, err := ageRecipient(bpk)
+							if err != nil {
+								return err
+							}
+							fmt.Printf("%s %s\n", recipient, kid)
+						}
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/keys-pub/keys/util"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/status"
 )
 
 func authCommands(client *Client) []cli.Command {
@@ -19,8 +22,60 @@ func authCommands(client *Client) []cli.Command {
 				cli.BoolFlag{Name: "token", Usage: "output token only"},
 				cli.BoolFlag{Name: "force", Usage: "force recovery"},
 				cli.StringFlag{Name: "client", Value: "cli", Hidden: true},
+				cli.StringFlag{Name: "fido2", Usage: "FIDO2 device (hmac-secret), in addition to a password on setup"},
+				cli.StringFlag{Name: "pin", Usage: "FIDO2 PIN"},
 			},
 			Aliases: []string{"unlock"},
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:  "provision",
+					Usage: "Provision a FIDO2 device (hmac-secret) to unlock with",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "device, d", Usage: "device"},
+						cli.StringFlag{Name: "pin", Usage: "PIN"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().AuthProvision(context.TODO(), &AuthProvisionRequest{
+							Type:   FIDO2HMACSecretAuth,
+							Device: c.String("device"),
+							PIN:    c.String("pin"),
+						})
+						if err != nil {
+							return err
+						}
+						fmt.Println(resp.Provision.ID)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:      "deprovision",
+					Usage:     "Remove a provisioned auth",
+					ArgsUsage: "id",
+					Action: func(c *cli.Context) error {
+						if c.NArg() != 1 {
+							return errors.Errorf("specify id")
+						}
+						_, err := client.KeysClient().AuthDeprovision(context.TODO(), &AuthDeprovisionRequest{
+							ID: c.Args().First(),
+						})
+						return err
+					},
+				},
+				cli.Command{
+					Name:  "provisions",
+					Usage: "List provisioned auth",
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().AuthProvisions(context.TODO(), &AuthProvisionsRequest{})
+						if err != nil {
+							return err
+						}
+						for _, provision := range resp.Provisions {
+							fmt.Printf("%s %s pin=%t %s\n", provision.ID, provision.Type, provision.PIN, util.TimeFromMillis(provision.CreatedAt).Format(time.RFC3339))
+						}
+						return nil
+					},
+				},
+			},
 			Action: func(c *cli.Context) error {
 				if !c.GlobalBool("test") {
 					if err := checkForAppConflict(); err != nil {
@@ -54,14 +109,27 @@ func authCommands(client *Client) []cli.Command {
 						password = p
 					}
 
-					setupResp, err := client.KeysClient().AuthSetup(context.TODO(), &AuthSetupRequest{
+					req := &AuthSetupRequest{
 						Password: password,
-					})
+					}
+					if device := c.String("fido2"); device != "" {
+						req.Type = FIDO2HMACSecretAuth
+						req.Device = device
+						req.PIN = c.String("pin")
+					}
+					setupResp, err := client.KeysClient().AuthSetup(context.TODO(), req)
 					if err != nil {
 						return err
 					}
 					authToken = setupResp.AuthToken
 
+				} else if device := c.String("fido2"); device != "" {
+					logger.Infof("Auth unlock (FIDO2)...")
+					token, err := authUnlockFIDO2(client, device, c.String("pin"), clientName)
+					if err != nil {
+						return err
+					}
+					authToken = token
 				} else {
 					if len(password) == 0 {
 						p, err := readPassword("Enter your password:")
@@ -114,3 +182,26 @@ func authCommands(client *Client) []cli.Command {
 		},
 	}
 }
+
+// authUnlockFIDO2 unlocks with a FIDO2 device, asking for the PIN if needed.
+func authUnlockFIDO2(client *Client, device string, pin string, clientName string) (string, error) {
+	req := &AuthUnlockRequest{
+		Type:   FIDO2HMACSecretAuth,
+		Device: device,
+		PIN:    pin,
+		Client: clientName,
+	}
+	resp, err := client.KeysClient().AuthUnlock(context.TODO(), req)
+	if err != nil && pin == "" && status.Convert(err).Message() == "pin required" {
+		p, readErr := readPassword("Enter your PIN:")
+		if readErr != nil {
+			return "", readErr
+		}
+		req.PIN = p
+		resp, err = client.KeysClient().AuthUnlock(context.TODO(), req)
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp.AuthToken, nil
+}
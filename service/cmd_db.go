@@ -70,6 +70,30 @@ func dbCommands(client *Client) []cli.Command {
 						return nil
 					},
 				},
+				cli.Command{
+					Name:  "migrate",
+					Usage: "Run pending db migrations (migrations also run when the service starts)",
+					Flags: []cli.Flag{
+						cli.BoolFlag{Name: "dry-run", Usage: "only show pending migrations"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().DBMigrate(context.TODO(), &DBMigrateRequest{
+							DryRun: c.Bool("dry-run"),
+						})
+						if err != nil {
+							return err
+						}
+						fmt.Printf("schema: %d (latest %d)\n", resp.Version, resp.Latest)
+						if len(resp.Migrations) == 0 {
+							fmt.Printf("No pending migrations.\n")
+							return nil
+						}
+						for _, m := range resp.Migrations {
+							fmt.Printf("%d: %s\n", m.Version, m.Description)
+						}
+						return nil
+					},
+				},
 			},
 		},
 	}
@@ -6,10 +6,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"syscall"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 func modeFromString(s string) (EncryptMode, error) {
@@ -18,6 +21,10 @@ func modeFromString(s string) (EncryptMode, error) {
 		return EncryptV2, nil
 	case "signcrypt":
 		return SigncryptV1, nil
+	case "password":
+		return PasswordV1, nil
+	case "age":
+		return AgeEncryptMode, nil
 	default:
 		return DefaultEncryptMode, errors.Errorf("invalid mode %s", s)
 	}
@@ -34,17 +41,19 @@ func encryptCommands(client *Client) []cli.Command {
 				cli.BoolFlag{Name: "armor, a", Usage: "armored"},
 				cli.StringFlag{Name: "in, i", Usage: "file to read"},
 				cli.StringFlag{Name: "out, o", Usage: "file to write (defaults to {in}.enc"},
-				cli.StringFlag{Name: "mode, m", Usage: "encryption mode: encrypt (default) or signcrypt"},
+				cli.StringFlag{Name: "mode, m", Usage: "encryption mode: encrypt (default), signcrypt, password or age"},
+				cli.BoolFlag{Name: "password", Usage: "encrypt with a password (instead of recipients)"},
+				cli.BoolFlag{Name: "password-stdin", Usage: "encrypt with a password read from stdin, requires -in"},
 			},
 			Action: func(c *cli.Context) error {
-				if c.String("in") != "" {
-					return encryptFileForCLI(c, client)
-				}
-
-				mode, err := modeFromString(c.String("mode"))
+				mode, password, err := encryptModeForCLI(c)
 				if err != nil {
 					return err
 				}
+				if c.String("in") != "" {
+					return encryptFile(client, c.StringSlice("recipient"), c.String("sender"), c.Bool("armor"), mode, password, c.String("in"), c.String("out"))
+				}
+
 				reader := bufio.NewReader(os.Stdin)
 				writer := os.Stdout
 
@@ -58,6 +67,7 @@ func encryptCommands(client *Client) []cli.Command {
 					Sender:     c.String("sender"),
 					Armored:    c.Bool("armor"),
 					Mode:       mode,
+					Password:   password,
 				}); err != nil {
 					return err
 				}
@@ -105,7 +115,9 @@ func encryptCommands(client *Client) []cli.Command {
 				cli.BoolFlag{Name: "armor, a", Usage: "armored"},
 				cli.StringFlag{Name: "in, i", Usage: "file to read"},
 				cli.StringFlag{Name: "out, o", Usage: "file to write"},
-				cli.StringFlag{Name: "mode, m", Usage: "encryption mode: encrypt (default) or signcrypt"},
+				cli.StringFlag{Name: "mode, m", Usage: "encryption mode: encrypt (default), signcrypt, password or age"},
+				cli.BoolFlag{Name: "password-stdin", Usage: "read the password (if encrypted with a password) from stdin, requires -in"},
+				cli.StringFlag{Name: "trust", Usage: "trust policy for the sender (see keys trust)"},
 			},
 			Action: func(c *cli.Context) error {
 				if c.String("in") != "" {
@@ -125,10 +137,20 @@ func encryptCommands(client *Client) []cli.Command {
 				reader := bufio.NewReader(os.Stdin)
 				writer := os.Stdout
 
+				peek, _ := reader.Peek(detectSize)
+				password, err := decryptPasswordForCLI(c, peek)
+				if err != nil {
+					return err
+				}
+
 				decryptClient, err := NewDecryptStreamClient(context.TODO(), client.KeysClient(), c.Bool("armor"), mode)
 				if err != nil {
 					return err
 				}
+				// The first message has the password and trust policy.
+				if err := decryptClient.Send(&DecryptInput{Password: password, Trust: c.String("trust")}); err != nil {
+					return err
+				}
 				var openErr error
 				go func() {
 					_, inErr := readFrom(reader, 1024*1024, func(b []byte) error {
@@ -184,15 +206,92 @@ func encryptCommands(client *Client) []cli.Command {
 	}
 }
 
-func encryptFileForCLI(c *cli.Context, client *Client) error {
+func encryptModeForCLI(c *cli.Context) (EncryptMode, string, error) {
 	mode, err := modeFromString(c.String("mode"))
 	if err != nil {
-		return err
+		return DefaultEncryptMode, "", err
+	}
+	if c.Bool("password") || c.Bool("password-stdin") {
+		if c.String("mode") != "" && mode != PasswordV1 {
+			return DefaultEncryptMode, "", errors.Errorf("password isn't supported with mode %s", c.String("mode"))
+		}
+		mode = PasswordV1
+	}
+	if mode != PasswordV1 {
+		return mode, "", nil
+	}
+	password, err := encryptPasswordForCLI(c)
+	if err != nil {
+		return DefaultEncryptMode, "", err
+	}
+	return mode, password, nil
+}
+
+// encryptPasswordEnv is the environment variable with the password for
+// encrypting, if not read from stdin or the terminal.
+const encryptPasswordEnv = "KEYS_ENCRYPT_PASSWORD"
+
+// encryptPasswordForCLI returns the password for password encryption from
+// stdin (-password-stdin), the environment (KEYS_ENCRYPT_PASSWORD) or the
+// terminal.
+func encryptPasswordForCLI(c *cli.Context) (string, error) {
+	if c.Bool("password-stdin") {
+		password, err := readPasswordStdin(c)
+		if err != nil {
+			return "", err
+		}
+		if password == "" {
+			return "", errors.Errorf("empty password")
+		}
+		return password, nil
 	}
-	return encryptFile(client, c.StringSlice("recipient"), c.String("sender"), c.Bool("armor"), mode, c.String("in"), c.String("out"))
+	if password := os.Getenv(encryptPasswordEnv); password != "" {
+		return password, nil
+	}
+	if !terminal.IsTerminal(int(syscall.Stdin)) {
+		return "", errors.Errorf("password required, use -password-stdin (with -in) or set %s", encryptPasswordEnv)
+	}
+	return readVerifyPassword("Create a password:")
+}
+
+// readPasswordStdin reads a password line from stdin, which requires the
+// input to be a file (-in).
+func readPasswordStdin(c *cli.Context) (string, error) {
+	if c.String("in") == "" {
+		return "", errors.Errorf("-password-stdin requires -in")
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", errors.Wrapf(err, "failed to read password from stdin")
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// decryptPasswordEnv is the environment variable with the password for
+// decrypting, if not read from stdin or the terminal.
+const decryptPasswordEnv = "KEYS_DECRYPT_PASSWORD"
+
+// decryptPasswordForCLI returns the password, if the input is password
+// encrypted, from stdin (-password-stdin), the environment
+// (KEYS_DECRYPT_PASSWORD) or the terminal. The password isn't an option, so it
+// doesn't show up in the process list or shell history.
+func decryptPasswordForCLI(c *cli.Context, peek []byte) (string, error) {
+	if c.Bool("password-stdin") {
+		return readPasswordStdin(c)
+	}
+	if !isPasswordEncrypted(peek) {
+		return "", nil
+	}
+	if password := os.Getenv(decryptPasswordEnv); password != "" {
+		return password, nil
+	}
+	if !terminal.IsTerminal(int(syscall.Stdin)) {
+		return "", errors.Errorf("password required, use -password-stdin (with -in) or set %s", decryptPasswordEnv)
+	}
+	return readPassword("Enter the password:")
 }
 
-func encryptFile(client *Client, recipients []string, sender string, armored bool, mode EncryptMode, in string, out string) error {
+func encryptFile(client *Client, recipients []string, sender string, armored bool, mode EncryptMode, password string, in string, out string) error {
 	in, err := filepath.Abs(in)
 	if err != nil {
 		return err
@@ -214,6 +313,7 @@ func encryptFile(client *Client, recipients []string, sender string, armored boo
 		Sender:     sender,
 		Armored:    armored,
 		Mode:       mode,
+		Password:   password,
 		In:         in,
 		Out:        out,
 	}); err != nil {
@@ -239,10 +339,34 @@ func decryptFileForCLI(c *cli.Context, client *Client) (*DecryptFileOutput, erro
 	if err != nil {
 		return nil, err
 	}
-	return decryptFile(client, c.Bool("armor"), mode, c.String("in"), c.String("out"))
+	peek, err := readFileStart(c.String("in"), detectSize)
+	if err != nil {
+		return nil, err
+	}
+	password, err := decryptPasswordForCLI(c, peek)
+	if err != nil {
+		return nil, err
+	}
+	return decryptFile(client, c.Bool("armor"), mode, password, c.String("trust"), c.String("in"), c.String("out"))
+}
+
+func readFileStart(path string, n int) ([]byte, error) {
+	f, err := os.Open(path) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	b := make([]byte, n)
+	i, err := io.ReadFull(f, b)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	return b[:i], nil
 }
 
-func decryptFile(client *Client, armored bool, mode EncryptMode, in string, out string) (*DecryptFileOutput, error) {
+func decryptFile(client *Client, armored bool, mode EncryptMode, password string, trust string, in string, out string) (*DecryptFileOutput, error) {
 	if in == "" {
 		return nil, errors.Errorf("in not specified")
 	}
@@ -263,10 +387,12 @@ func decryptFile(client *Client, armored bool, mode EncryptMode, in string, out
 	}
 
 	if err := decryptClient.Send(&DecryptFileInput{
-		Armored: armored,
-		Mode:    mode,
-		In:      in,
-		Out:     out,
+		Armored:  armored,
+		Mode:     mode,
+		Password: password,
+		Trust:    trust,
+		In:       in,
+		Out:      out,
 	}); err != nil {
 		return nil, err
 	}
@@ -70,6 +70,58 @@ func TestEncryptDecryptCommand(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, string(in), "test message")
 
+	// Password (env)
+	os.Setenv(encryptPasswordEnv, "testpassword")
+	defer os.Unsetenv(encryptPasswordEnv)
+	argsEncrypt = append(cmd, "encrypt", "-password", "-in", inPath)
+	runClient(build, argsEncrypt, client, errorFn)
+	require.NoError(t, clientErr)
+	os.Remove(inPath)
+
+	os.Setenv(decryptPasswordEnv, "testpassword")
+	defer os.Unsetenv(decryptPasswordEnv)
+	argsDecrypt = append(cmd, "decrypt", "-in", outPath)
+	runClient(build, argsDecrypt, client, errorFn)
+	require.NoError(t, clientErr)
+
+	in, err = ioutil.ReadFile(inPath)
+	require.NoError(t, err)
+	require.Equal(t, string(in), "test message")
+	os.Unsetenv(encryptPasswordEnv)
+	os.Unsetenv(decryptPasswordEnv)
+
+	// Password (stdin)
+	dir, err := ioutil.TempDir("", "KeysTest-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stdin := os.Stdin
+	defer func() { os.Stdin = stdin }()
+	os.Stdin = testStdin(t, dir, "testpassword2\n")
+	argsEncrypt = append(cmd, "encrypt", "-password-stdin", "-in", inPath)
+	runClient(build, argsEncrypt, client, errorFn)
+	require.NoError(t, clientErr)
+	os.Remove(inPath)
+
+	os.Stdin = testStdin(t, dir, "testpassword2\n")
+	argsDecrypt = append(cmd, "decrypt", "-password-stdin", "-in", outPath)
+	runClient(build, argsDecrypt, client, errorFn)
+	require.NoError(t, clientErr)
+
+	in, err = ioutil.ReadFile(inPath)
+	require.NoError(t, err)
+	require.Equal(t, string(in), "test message")
+
+	os.Stdin = testStdin(t, dir, "\n")
+	argsEncrypt = append(cmd, "encrypt", "-password-stdin", "-in", inPath)
+	runClient(build, argsEncrypt, client, errorFn)
+	require.EqualError(t, clientErr, "empty password")
+	clientErr = nil
+
+	argsEncrypt = append(cmd, "encrypt", "-password-stdin")
+	runClient(build, argsEncrypt, client, errorFn)
+	require.EqualError(t, clientErr, "-password-stdin requires -in")
+	clientErr = nil
+
 	// Not found
 	argsEncrypt = append(cmd, "encrypt", "-r", alice.ID().String(), "-r", bob.ID().String(), "-in", inPath+".notfound")
 	runClient(build, argsEncrypt, client, errorFn)
@@ -81,3 +133,13 @@ func TestEncryptDecryptCommand(t *testing.T) {
 	}
 	clientErr = nil
 }
+
+func testStdin(t *testing.T, dir string, s string) *os.File {
+	f, err := ioutil.TempFile(dir, "stdin-")
+	require.NoError(t, err)
+	_, err = f.WriteString(s)
+	require.NoError(t, err)
+	_, err = f.Seek(0, 0)
+	require.NoError(t, err)
+	return f
+}
@@ -1,15 +1,33 @@
 package service
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
 
+	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keysd/fido2"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
 
+// Byte values (credential IDs, salts, client data hashes) are base64 (std)
+// encoded, for input and output.
+
 func fido2Commands(client *Client) []cli.Command {
+	deviceFlag := cli.StringFlag{Name: "device, d", Usage: "device, defaults to the only device"}
+	pinFlag := cli.StringFlag{Name: "pin", Usage: "PIN, prompted for if needed"}
+	jsonFlag := cli.BoolFlag{Name: "json", Usage: "output JSON"}
+
 	return []cli.Command{
 		cli.Command{
 			Name:  "fido2",
@@ -18,52 +36,71 @@ func fido2Commands(client *Client) []cli.Command {
 				cli.Command{
 					Name:  "devices",
 					Usage: "Show devices",
-					Flags: []cli.Flag{},
+					Flags: []cli.Flag{jsonFlag},
 					Action: func(c *cli.Context) error {
 						req := &fido2.DevicesRequest{}
 						resp, err := client.FIDO2Client().Devices(context.TODO(), req)
 						if err != nil {
 							return err
 						}
-						printResponse(resp)
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						printFIDO2(func(w io.Writer) { fmtFIDO2Devices(w, resp.Devices) })
 						return nil
 					},
 				},
 				cli.Command{
 					Name:  "device-info",
 					Usage: "Device info",
-					Flags: []cli.Flag{
-						cli.StringFlag{Name: "device, d", Usage: "Device"},
-					},
+					Flags: []cli.Flag{deviceFlag, jsonFlag},
 					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
 						req := fido2.DeviceInfoRequest{
-							Device: c.String("device"),
+							Device: device,
 						}
 						resp, err := client.FIDO2Client().DeviceInfo(context.TODO(), &req)
 						if err != nil {
 							return err
 						}
-						printResponse(resp)
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						printFIDO2(func(w io.Writer) { fmtFIDO2DeviceInfo(w, resp.Info) })
 						return nil
 					},
 				},
 				cli.Command{
 					Name:  "credentials-info",
 					Usage: "Credentials info",
-					Flags: []cli.Flag{
-						cli.StringFlag{Name: "device, d", Usage: "Device"},
-						cli.StringFlag{Name: "pin", Usage: "PIN"},
-					},
+					Flags: []cli.Flag{deviceFlag, pinFlag, jsonFlag},
 					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						pin, err := fido2PIN(c.String("pin"), true)
+						if err != nil {
+							return err
+						}
 						req := fido2.CredentialsInfoRequest{
-							Device: c.String("device"),
-							PIN:    c.String("pin"),
+							Device: device,
+							PIN:    pin,
 						}
 						resp, err := client.FIDO2Client().CredentialsInfo(context.TODO(), &req)
 						if err != nil {
 							return err
 						}
-						printResponse(resp)
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						fmt.Printf("existing: %d\nremaining: %d\n", resp.Info.RKExisting, resp.Info.RKRemaining)
 						return nil
 					},
 				},
@@ -71,19 +108,293 @@ func fido2Commands(client *Client) []cli.Command {
 					Name:  "credentials",
 					Usage: "Credentials",
 					Flags: []cli.Flag{
-						cli.StringFlag{Name: "device, d", Usage: "Device"},
-						cli.StringFlag{Name: "pin", Usage: "PIN"},
+						deviceFlag,
+						pinFlag,
+						cli.StringFlag{Name: "rp", Usage: "relying party (id)"},
+						jsonFlag,
 					},
 					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						pin, err := fido2PIN(c.String("pin"), true)
+						if err != nil {
+							return err
+						}
 						req := fido2.CredentialsRequest{
-							Device: c.String("device"),
-							PIN:    c.String("pin"),
+							Device: device,
+							PIN:    pin,
+							RPID:   c.String("rp"),
 						}
 						resp, err := client.FIDO2Client().Credentials(context.TODO(), &req)
 						if err != nil {
 							return err
 						}
-						printResponse(resp)
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						printFIDO2(func(w io.Writer) { fmtFIDO2Credentials(w, resp.Credentials) })
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "relying-parties",
+					Usage: "Relying parties",
+					Flags: []cli.Flag{deviceFlag, pinFlag, jsonFlag},
+					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						pin, err := fido2PIN(c.String("pin"), true)
+						if err != nil {
+							return err
+						}
+						resp, err := client.FIDO2Client().RelyingParties(context.TODO(), &fido2.RelyingPartiesRequest{
+							Device: device,
+							PIN:    pin,
+						})
+						if err != nil {
+							return err
+						}
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						printFIDO2(func(w io.Writer) { fmtFIDO2RelyingParties(w, resp.Parties) })
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "make-credential",
+					Usage: "Make credential",
+					Flags: []cli.Flag{
+						deviceFlag,
+						pinFlag,
+						cli.StringFlag{Name: "rp", Usage: "relying party (id)"},
+						cli.StringFlag{Name: "rp-name", Usage: "relying party name"},
+						cli.StringFlag{Name: "user", Usage: "user name"},
+						cli.StringFlag{Name: "user-id", Usage: "user id (base64), defaults to random"},
+						cli.StringFlag{Name: "type, t", Value: "es256", Usage: "type: es256, eddsa, rs256"},
+						cli.StringFlag{Name: "client-data-hash", Usage: "client data hash (base64), defaults to random"},
+						cli.StringSliceFlag{Name: "extension", Usage: "extension: hmac-secret, credProtect"},
+						cli.BoolFlag{Name: "rk", Usage: "resident key"},
+						cli.BoolFlag{Name: "uv", Usage: "user verification"},
+						jsonFlag,
+					},
+					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						if c.String("rp") == "" {
+							return errors.Errorf("specify -rp")
+						}
+						userID, err := decodeFIDO2Bytes("user-id", c.String("user-id"), 16)
+						if err != nil {
+							return err
+						}
+						cdh, err := decodeFIDO2Bytes("client-data-hash", c.String("client-data-hash"), 32)
+						if err != nil {
+							return err
+						}
+						pin := c.String("pin")
+						if pin == "" {
+							// PIN is required if set on the device.
+							clientPin, err := fido2ClientPIN(client, device)
+							if err != nil {
+								return err
+							}
+							if pin, err = fido2PIN(pin, clientPin || c.Bool("uv")); err != nil {
+								return err
+							}
+						}
+						resp, err := client.FIDO2Client().MakeCredential(context.TODO(), &fido2.MakeCredentialRequest{
+							Device:         device,
+							ClientDataHash: cdh,
+							RP:             &fido2.RelyingParty{ID: c.String("rp"), Name: c.String("rp-name")},
+							User:           &fido2.User{ID: userID, Name: c.String("user")},
+							Type:           c.String("type"),
+							PIN:            pin,
+							Extensions:     c.StringSlice("extension"),
+							RK:             fido2Option(c.Bool("rk")),
+							UV:             fido2Option(c.Bool("uv")),
+						})
+						if err != nil {
+							return err
+						}
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						printFIDO2(func(w io.Writer) { fmtFIDO2Attestation(w, resp.Attestation) })
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "assertion",
+					Usage: "Get assertion",
+					Flags: []cli.Flag{
+						deviceFlag,
+						pinFlag,
+						cli.StringFlag{Name: "rp", Usage: "relying party (id)"},
+						cli.StringFlag{Name: "cred-id", Usage: "credential id (base64), or empty for resident credentials"},
+						cli.StringFlag{Name: "client-data-hash", Usage: "client data hash (base64), defaults to random"},
+						cli.StringSliceFlag{Name: "extension", Usage: "extension: hmac-secret"},
+						cli.StringFlag{Name: "hmac-salt", Usage: "hmac-secret salt (base64, 32 or 64 bytes)"},
+						cli.BoolFlag{Name: "uv", Usage: "user verification (with PIN)"},
+						cli.BoolFlag{Name: "no-up", Usage: "no user presence"},
+						jsonFlag,
+					},
+					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						if c.String("rp") == "" {
+							return errors.Errorf("specify -rp")
+						}
+						credID, err := decodeFIDO2Bytes("cred-id", c.String("cred-id"), 0)
+						if err != nil {
+							return err
+						}
+						cdh, err := decodeFIDO2Bytes("client-data-hash", c.String("client-data-hash"), 32)
+						if err != nil {
+							return err
+						}
+						salt, err := decodeFIDO2Bytes("hmac-salt", c.String("hmac-salt"), 0)
+						if err != nil {
+							return err
+						}
+						extensions := c.StringSlice("extension")
+						if len(salt) > 0 && !hasString(extensions, "hmac-secret") {
+							extensions = append(extensions, "hmac-secret")
+						}
+						pin, err := fido2PIN(c.String("pin"), c.Bool("uv"))
+						if err != nil {
+							return err
+						}
+						up := ""
+						if c.Bool("no-up") {
+							up = "false"
+						}
+						resp, err := client.FIDO2Client().Assertion(context.TODO(), &fido2.AssertionRequest{
+							Device:         device,
+							RPID:           c.String("rp"),
+							ClientDataHash: cdh,
+							CredID:         credID,
+							PIN:            pin,
+							Extensions:     extensions,
+							UV:             fido2Option(c.Bool("uv")),
+							UP:             up,
+							HMACSalt:       salt,
+						})
+						if err != nil {
+							return err
+						}
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						printFIDO2(func(w io.Writer) { fmtFIDO2Assertion(w, resp.Assertion) })
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "set-pin",
+					Usage: "Set or change the PIN",
+					Flags: []cli.Flag{
+						deviceFlag,
+						cli.StringFlag{Name: "pin", Usage: "new PIN, prompted for if not specified"},
+						cli.StringFlag{Name: "old-pin", Usage: "current PIN, prompted for if set on the device"},
+					},
+					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						oldPIN := c.String("old-pin")
+						if oldPIN == "" {
+							clientPin, err := fido2ClientPIN(client, device)
+							if err != nil {
+								return err
+							}
+							if clientPin {
+								if oldPIN, err = readPassword("Enter your current PIN:"); err != nil {
+									return err
+								}
+							}
+						}
+						pin := c.String("pin")
+						if pin == "" {
+							if pin, err = readVerifyPIN(); err != nil {
+								return err
+							}
+						}
+						if _, err := client.FIDO2Client().SetPIN(context.TODO(), &fido2.SetPINRequest{
+							Device: device,
+							PIN:    pin,
+							OldPIN: oldPIN,
+						}); err != nil {
+							return err
+						}
+						fmt.Fprintf(os.Stderr, "PIN set.\n")
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "retry-count",
+					Usage: "PIN retries remaining",
+					Flags: []cli.Flag{deviceFlag, jsonFlag},
+					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						resp, err := client.FIDO2Client().RetryCount(context.TODO(), &fido2.RetryCountRequest{
+							Device: device,
+						})
+						if err != nil {
+							return err
+						}
+						if c.Bool("json") {
+							printResponse(resp)
+							return nil
+						}
+						fmt.Println(resp.Count)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "reset",
+					Usage: "Reset device, removing all credentials and the PIN",
+					Flags: []cli.Flag{
+						deviceFlag,
+						cli.BoolFlag{Name: "force", Usage: "don't ask for confirmation"},
+					},
+					Action: func(c *cli.Context) error {
+						device, err := fido2Device(client, c.String("device"))
+						if err != nil {
+							return err
+						}
+						if !c.Bool("force") {
+							fmt.Fprintf(os.Stderr, "This removes all credentials and the PIN from %s.\nType \"reset\" to confirm: ", device)
+							line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+							if err != nil && err != io.EOF {
+								return err
+							}
+							if strings.TrimSpace(line) != "reset" {
+								return errors.Errorf("reset cancelled")
+							}
+						}
+						if _, err := client.FIDO2Client().Reset(context.TODO(), &fido2.ResetRequest{
+							Device: device,
+						}); err != nil {
+							return err
+						}
+						fmt.Fprintf(os.Stderr, "Reset %s.\n", device)
 						return nil
 					},
 				},
@@ -92,6 +403,158 @@ func fido2Commands(client *Client) []cli.Command {
 	}
 }
 
+// fido2Device returns the device, or the only device if not specified.
+func fido2Device(client *Client, device string) (string, error) {
+	if device != "" {
+		return device, nil
+	}
+	resp, err := client.FIDO2Client().Devices(context.TODO(), &fido2.DevicesRequest{})
+	if err != nil {
+		return "", err
+	}
+	switch len(resp.Devices) {
+	case 0:
+		return "", errors.Errorf("no devices found")
+	case 1:
+		return resp.Devices[0].Path, nil
+	default:
+		return "", errors.Errorf("multiple devices found, specify -device")
+	}
+}
+
+// fido2ClientPIN returns true if the device has a PIN set.
+func fido2ClientPIN(client *Client, device string) (bool, error) {
+	resp, err := client.FIDO2Client().DeviceInfo(context.TODO(), &fido2.DeviceInfoRequest{Device: device})
+	if err != nil {
+		return false, err
+	}
+	for _, opt := range resp.Info.Options {
+		if opt.Name == "clientPin" {
+			return opt.Value == "true", nil
+		}
+	}
+	return false, nil
+}
+
+// fido2PIN returns the PIN, prompting for it if not specified and required.
+func fido2PIN(pin string, required bool) (string, error) {
+	if pin != "" || !required {
+		return pin, nil
+	}
+	return readPassword("Enter your PIN:")
+}
+
+func readVerifyPIN() (string, error) {
+	pin, err := readPassword("Enter a new PIN:")
+	if err != nil {
+		return "", err
+	}
+	pin2, err := readPassword("Re-enter the PIN:")
+	if err != nil {
+		return "", err
+	}
+	if pin != pin2 {
+		return "", errors.Errorf("PINs don't match")
+	}
+	return pin, nil
+}
+
+func fido2Option(b bool) string {
+	if b {
+		return "true"
+	}
+	return ""
+}
+
+// decodeFIDO2Bytes decodes base64 (std) flag value. If empty, returns random
+// bytes of length n (or nil if n is 0). The client data hash is random,
+// since we aren't a web client, see the WebAuthn spec for clientDataJSON.
+func decodeFIDO2Bytes(name string, s string, n int) ([]byte, error) {
+	if s == "" {
+		if n == 0 {
+			return nil, nil
+		}
+		if name == "client-data-hash" {
+			h := sha256.Sum256(keys.RandBytes(32))
+			return h[:], nil
+		}
+		return keys.RandBytes(n), nil
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", name)
+	}
+	return b, nil
+}
+
+func printFIDO2(fn func(w io.Writer)) {
+	out := &bytes.Buffer{}
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 1, ' ', 0)
+	fn(w)
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+	fmt.Print(out.String())
+}
+
+func fmtFIDO2Devices(w io.Writer, devices []*fido2.Device) {
+	for _, device := range devices {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", device.Path, device.Product, device.Manufacturer)
+	}
+}
+
+func fmtFIDO2DeviceInfo(w io.Writer, info *fido2.DeviceInfo) {
+	fmt.Fprintf(w, "versions:\t%s\n", strings.Join(info.Versions, ", "))
+	fmt.Fprintf(w, "extensions:\t%s\n", strings.Join(info.Extensions, ", "))
+	fmt.Fprintf(w, "aaguid:\t%s\n", hex.EncodeToString(info.AAGUID))
+	opts := make([]string, 0, len(info.Options))
+	for _, opt := range info.Options {
+		opts = append(opts, opt.Name+"="+opt.Value)
+	}
+	fmt.Fprintf(w, "options:\t%s\n", strings.Join(opts, ", "))
+}
+
+func fmtFIDO2Credentials(w io.Writer, creds []*fido2.Credential) {
+	for _, cred := range creds {
+		rp, user := "", ""
+		if cred.RP != nil {
+			rp = cred.RP.ID
+		}
+		if cred.User != nil {
+			user = cred.User.Name
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rp, user, cred.Type, base64.StdEncoding.EncodeToString(cred.ID))
+	}
+}
+
+func fmtFIDO2RelyingParties(w io.Writer, rps []*fido2.RelyingParty) {
+	for _, rp := range rps {
+		fmt.Fprintf(w, "%s\t%s\n", rp.ID, rp.Name)
+	}
+}
+
+func fmtFIDO2Attestation(w io.Writer, att *fido2.Attestation) {
+	fmt.Fprintf(w, "cred-id:\t%s\n", base64.StdEncoding.EncodeToString(att.CredID))
+	fmt.Fprintf(w, "type:\t%s\n", att.CredType)
+	fmt.Fprintf(w, "format:\t%s\n", att.Format)
+	fmt.Fprintf(w, "pub-key:\t%s\n", base64.StdEncoding.EncodeToString(att.PubKey))
+	fmt.Fprintf(w, "client-data-hash:\t%s\n", base64.StdEncoding.EncodeToString(att.ClientDataHash))
+	fmt.Fprintf(w, "auth-data:\t%s\n", base64.StdEncoding.EncodeToString(att.AuthData))
+	fmt.Fprintf(w, "sig:\t%s\n", base64.StdEncoding.EncodeToString(att.Sig))
+	if len(att.Cert) > 0 {
+		fmt.Fprintf(w, "cert:\t%s\n", base64.StdEncoding.EncodeToString(att.Cert))
+	}
+}
+
+func fmtFIDO2Assertion(w io.Writer, assertion *fido2.Assertion) {
+	fmt.Fprintf(w, "auth-data:\t%s\n", base64.StdEncoding.EncodeToString(assertion.AuthData))
+	fmt.Fprintf(w, "sig:\t%s\n", base64.StdEncoding.EncodeToString(assertion.Sig))
+	if len(assertion.HMACSecret) > 0 {
+		fmt.Fprintf(w, "hmac-secret:\t%s\n", base64.StdEncoding.EncodeToString(assertion.HMACSecret))
+	}
+}
+
 func printResponse(i interface{}) {
 	b, err := json.MarshalIndent(i, "", "  ")
 	if err != nil {
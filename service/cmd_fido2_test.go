@@ -0,0 +1,70 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	"github.com/keys-pub/keysd/fido2/virtual"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFIDO2Commands(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	defer closeFn()
+
+	fas, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+	service.fido2 = fas
+
+	client, closeClFn := newTestRPCClient(t, service, env, appName)
+	defer closeClFn()
+
+	var clientErr error
+	errorFn := func(err error) {
+		clientErr = err
+	}
+
+	build := Build{Version: VersionDev}
+	cmd := append(os.Args[0:1], "-app", appName, "fido2")
+
+	// Device is auto-selected
+	runClient(build, append(cmd, "devices"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "device-info"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "device-info", "-device", "unknown"), client, errorFn)
+	require.EqualError(t, clientErr, "rpc error: code = NotFound desc = device not found unknown")
+	clientErr = nil
+
+	runClient(build, append(cmd, "set-pin", "-pin", "12345"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "retry-count", "-json"), client, errorFn)
+	require.NoError(t, clientErr)
+
+	runClient(build, append(cmd, "make-credential", "-pin", "12345", "-rp", "keys.pub", "-user", "alice", "-rk", "-extension", "hmac-secret"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "make-credential", "-pin", "12345"), client, errorFn)
+	require.EqualError(t, clientErr, "specify -rp")
+	clientErr = nil
+
+	runClient(build, append(cmd, "credentials", "-pin", "12345", "-rp", "keys.pub"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "credentials-info", "-pin", "12345"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "relying-parties", "-pin", "12345"), client, errorFn)
+	require.NoError(t, clientErr)
+
+	salt := "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+	runClient(build, append(cmd, "assertion", "-rp", "keys.pub", "-hmac-salt", salt), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "assertion", "-rp", "keys.pub", "-cred-id", "invalid!"), client, errorFn)
+	require.EqualError(t, clientErr, "invalid cred-id: illegal base64 data at input byte 7")
+	clientErr = nil
+
+	runClient(build, append(cmd, "reset", "-force"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "credentials-info", "-pin", "12345"), client, errorFn)
+	require.EqualError(t, clientErr, "rpc error: code = FailedPrecondition desc = pin not set")
+}
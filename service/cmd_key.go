@@ -80,7 +80,9 @@ func keyCommands(client *Client) []cli.Command {
 			Name:  "generate",
 			Usage: "Generate a key",
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "type, t", Usage: "type (edx25519, x25519)"},
+				cli.StringFlag{Name: "type, t", Usage: "type (edx25519, x25519, fido2-es256, fido2-eddsa)"},
+				cli.StringFlag{Name: "device, d", Usage: "device (fido2 types), defaults to the only device"},
+				cli.StringFlag{Name: "pin", Usage: "PIN (fido2 types), prompted for if needed"},
 			},
 			Action: func(c *cli.Context) error {
 				var typ KeyType
@@ -89,6 +91,10 @@ func keyCommands(client *Client) []cli.Command {
 					typ = EdX25519
 				case "x25519":
 					typ = X25519
+				case "fido2-es256":
+					typ = FIDO2ES256
+				case "fido2-eddsa":
+					typ = FIDO2EdDSA
 				default:
 					return errors.Errorf("unrecognized key type")
 				}
@@ -96,6 +102,24 @@ func keyCommands(client *Client) []cli.Command {
 				req := &KeyGenerateRequest{
 					Type: typ,
 				}
+				if typ == FIDO2ES256 || typ == FIDO2EdDSA {
+					device, err := fido2Device(client, c.String("device"))
+					if err != nil {
+						return err
+					}
+					pin := c.String("pin")
+					if pin == "" {
+						clientPin, err := fido2ClientPIN(client, device)
+						if err != nil {
+							return err
+						}
+						if pin, err = fido2PIN(pin, clientPin); err != nil {
+							return err
+						}
+					}
+					req.Device = device
+					req.PIN = pin
+				}
 				resp, err := client.KeysClient().KeyGenerate(context.TODO(), req)
 				if err != nil {
 					return err
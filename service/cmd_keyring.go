@@ -0,0 +1,96 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func keyringCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "keyring",
+			Usage: "Keyring",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:  "migrate",
+					Usage: "Move keyring items to another keyring type",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "to", Usage: "keyring type (default, fs, efs, secret-service)"},
+						cli.BoolFlag{Name: "force", Usage: "migrate even if the destination has items"},
+					},
+					Action: func(c *cli.Context) error {
+						cfg, err := config(c)
+						if err != nil {
+							return err
+						}
+						to, err := parseKeyringType(c.String("to"))
+						if err != nil {
+							return err
+						}
+						from := cfg.Get(keyringTypeKey, "")
+						if to == from {
+							return errors.Errorf("keyring is already %s", keyringTypeName(to))
+						}
+						if to == "mem" {
+							return errors.Errorf("can't migrate to mem keyring")
+						}
+
+						// Stop so the keyring isn't modified while migrating (if running)
+						if err := stop(cfg); err != nil {
+							if errors.Cause(err) != errNotRunning {
+								return err
+							}
+						} else {
+							fmt.Printf("Service stopped.\n")
+						}
+
+						fromSt, err := newKeyringStoreType(cfg, from)
+						if err != nil {
+							return err
+						}
+						toSt, err := newKeyringStoreType(cfg, to)
+						if err != nil {
+							return err
+						}
+						n, err := migrateKeyring(keyringService(cfg), fromSt, toSt, c.Bool("force"))
+						if err != nil {
+							return err
+						}
+
+						cfg.Set(keyringTypeKey, to)
+						if err := cfg.Save(); err != nil {
+							return err
+						}
+						fmt.Printf("Migrated %d item(s) from %s to %s keyring.\n", n, keyringTypeName(from), keyringTypeName(to))
+						fmt.Printf("Items in the %s keyring were not removed.\n", keyringTypeName(from))
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseKeyringType(s string) (string, error) {
+	switch s {
+	case "":
+		return "", errors.Errorf("specify -to")
+	case "default":
+		return "", nil
+	}
+	for _, kt := range keyringTypes {
+		if s == kt {
+			return kt, nil
+		}
+	}
+	return "", errors.Errorf("unknown keyring type %s", s)
+}
+
+func keyringTypeName(kt string) string {
+	if kt == "" {
+		return "default"
+	}
+	return kt
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/keys-pub/keys/util"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func secretCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "secret",
+			Usage: "Secrets",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:  "import",
+					Usage: "Import secrets from a password manager export",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "format, f", Usage: "format (1password-csv, bitwarden-json, bitwarden-csv, keepass-xml, chrome-csv)"},
+						cli.StringFlag{Name: "in, i", Usage: "file to read"},
+						cli.BoolFlag{Name: "dry-run", Usage: "preview, without importing"},
+					},
+					Action: func(c *cli.Context) error {
+						format, err := parseSecretsImportFormat(c.String("format"))
+						if err != nil {
+							return err
+						}
+						var b []byte
+						if c.String("in") != "" {
+							path, err := filepath.Abs(c.String("in"))
+							if err != nil {
+								return err
+							}
+							in, err := ioutil.ReadFile(path) // #nosec
+							if err != nil {
+								return err
+							}
+							b = in
+						} else {
+							in, err := ioutil.ReadAll(bufio.NewReader(os.Stdin))
+							if err != nil {
+								return err
+							}
+							b = in
+						}
+						resp, err := client.KeysClient().SecretsImport(context.TODO(), &SecretsImportRequest{
+							Data:   b,
+							Format: format,
+							DryRun: c.Bool("dry-run"),
+						})
+						if err != nil {
+							return err
+						}
+						fmtSecretImports(resp.Secrets)
+						if c.Bool("dry-run") {
+							fmt.Printf("Would import %d secret(s), skip %d duplicate(s).\n", resp.Imported, resp.Duplicates)
+							return nil
+						}
+						fmt.Printf("Imported %d secret(s), skipped %d duplicate(s).\n", resp.Imported, resp.Duplicates)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:      "history",
+					Usage:     "Show prior versions of a secret",
+					ArgsUsage: "id",
+					Action: func(c *cli.Context) error {
+						if c.NArg() != 1 {
+							return errors.Errorf("specify a secret id")
+						}
+						resp, err := client.KeysClient().SecretHistory(context.TODO(), &SecretHistoryRequest{
+							ID: c.Args().First(),
+						})
+						if err != nil {
+							return err
+						}
+						fmtSecretVersions(resp.Versions)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:      "restore",
+					Usage:     "Restore a prior version of a secret",
+					ArgsUsage: "id",
+					Flags: []cli.Flag{
+						cli.Int64Flag{Name: "version, v", Usage: "version (see history)"},
+					},
+					Action: func(c *cli.Context) error {
+						if c.NArg() != 1 {
+							return errors.Errorf("specify a secret id")
+						}
+						if c.Int64("version") == 0 {
+							return errors.Errorf("specify -version")
+						}
+						resp, err := client.KeysClient().SecretRestore(context.TODO(), &SecretRestoreRequest{
+							ID:      c.Args().First(),
+							Version: c.Int64("version"),
+						})
+						if err != nil {
+							return err
+						}
+						fmt.Printf("Restored %s (version %d).\n", resp.Secret.ID, c.Int64("version"))
+						return nil
+					},
+				},
+				cli.Command{
+					Name:      "otp",
+					Usage:     "Show the current code for an OTP secret",
+					ArgsUsage: "id",
+					Action: func(c *cli.Context) error {
+						if c.NArg() != 1 {
+							return errors.Errorf("specify a secret id")
+						}
+						resp, err := client.KeysClient().OTPCode(context.TODO(), &OTPCodeRequest{
+							ID: c.Args().First(),
+						})
+						if err != nil {
+							return err
+						}
+						if resp.Type == "hotp" {
+							fmt.Printf("%s (counter %d)\n", resp.Code, resp.Counter)
+							return nil
+						}
+						fmt.Printf("%s (%ds remaining)\n", resp.Code, resp.Remaining)
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseSecretsImportFormat(s string) (SecretsImportFormat, error) {
+	switch s {
+	case "1password-csv":
+		return OnePasswordCSV, nil
+	case "bitwarden-json":
+		return BitwardenJSON, nil
+	case "bitwarden-csv":
+		return BitwardenCSV, nil
+	case "keepass-xml":
+		return KeePassXML, nil
+	case "chrome-csv":
+		return ChromeCSV, nil
+	case "":
+		return UnknownImportFormat, errors.Errorf("specify -format")
+	default:
+		return UnknownImportFormat, errors.Errorf("unsupported import format %s", s)
+	}
+}
+
+func fmtSecretImports(imports []*SecretImport) {
+	out := &bytes.Buffer{}
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 1, ' ', 0)
+	for _, imp := range imports {
+		sec := imp.Secret
+		status := "new"
+		if imp.Duplicate != "" {
+			status = "duplicate " + imp.Duplicate
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", string(secretTypeFromRPC(sec.Type)), sec.Name, sec.Username, sec.URL, status)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+	fmt.Print(out.String())
+}
+
+func fmtSecretVersions(versions []*SecretVersion) {
+	out := &bytes.Buffer{}
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 1, ' ', 0)
+	for _, version := range versions {
+		fmtSecretVersion(w, version)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+	fmt.Print(out.String())
+}
+
+func fmtSecretVersion(w io.Writer, version *SecretVersion) {
+	replaced := util.TimeFromMillis(version.ReplacedAt).Format("2006-01-02 15:04")
+	sec := version.Secret
+	fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", version.Version, replaced, sec.Name, sec.Username, sec.URL)
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretOTPCommand(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	client, closeClFn := newTestRPCClient(t, service, env, appName)
+	defer closeClFn()
+	defer closeFn()
+
+	testAuthSetup(t, service)
+	ctx := context.TODO()
+
+	var clientErr error
+	errorFn := func(err error) {
+		clientErr = err
+	}
+
+	build := Build{Version: VersionDev}
+	cmd := append(os.Args[0:1], "-app", appName, "secret", "otp")
+
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "Bank", Type: OTPSecret, Password: "otpauth://hotp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&counter=0"},
+	})
+	require.NoError(t, err)
+
+	runClient(build, append(cmd, saveResp.Secret.ID), client, errorFn)
+	require.NoError(t, clientErr)
+	secretResp, err := service.Secret(ctx, &SecretRequest{ID: saveResp.Secret.ID})
+	require.NoError(t, err)
+	require.Contains(t, secretResp.Secret.Password, "counter=1")
+
+	runClient(build, cmd, client, errorFn)
+	require.EqualError(t, clientErr, "specify a secret id")
+	clientErr = nil
+
+	passwordID := testSaveSecret(t, service, &Secret{Name: "Password", Type: PasswordSecret, Password: "password"})
+	runClient(build, append(cmd, passwordID), client, errorFn)
+	require.EqualError(t, clientErr, "rpc error: code = Unknown desc = secret "+passwordID+" isn't an otp secret")
+}
@@ -84,10 +84,12 @@ func sigchainCommands(client *Client) []cli.Command {
 					Subcommands: []cli.Command{
 						cli.Command{
 							Name:      "add",
-							Usage:     "Add a signed statement to a sigchain (from stdin)",
+							Usage:     "Add a signed statement to a sigchain (from stdin), or link a device or encryption key",
 							ArgsUsage: "stdin",
 							Flags: []cli.Flag{
 								cli.StringFlag{Name: "kid, k"},
+								cli.StringFlag{Name: "type, t", Usage: "statement type: device, encrypt-key, fido2-key (empty for data from stdin)"},
+								cli.StringFlag{Name: "link", Usage: "device (EdX25519), encryption (X25519) or FIDO2 key to link"},
 								cli.BoolFlag{Name: "local", Usage: "Don't save to the key server"},
 							},
 							Action: func(c *cli.Context) error {
@@ -95,19 +97,25 @@ func sigchainCommands(client *Client) []cli.Command {
 									return errors.Errorf("input is from stdin, not as an argument")
 								}
 
-								r := bufio.NewReader(os.Stdin)
-								b, err := ioutil.ReadAll(r)
-								if err != nil {
-									return err
-								}
-								if len(b) > 16*1024 {
-									return errors.Errorf("sigchain data restricted to 16KB")
+								var b []byte
+								if c.String("type") == "" {
+									r := bufio.NewReader(os.Stdin)
+									in, err := ioutil.ReadAll(r)
+									if err != nil {
+										return err
+									}
+									if len(in) > 16*1024 {
+										return errors.Errorf("sigchain data restricted to 16KB")
+									}
+									b = in
 								}
 
 								resp, err := client.KeysClient().StatementCreate(context.TODO(), &StatementCreateRequest{
-									KID:   c.String("kid"),
-									Data:  b,
-									Local: c.Bool("local"),
+									KID:     c.String("kid"),
+									Data:    b,
+									Type:    c.String("type"),
+									LinkKID: c.String("link"),
+									Local:   c.Bool("local"),
 								})
 								if err != nil {
 									return err
@@ -3,11 +3,14 @@ package service
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/keys-pub/keys"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
@@ -22,8 +25,22 @@ func signCommands(client *Client) []cli.Command {
 				cli.StringFlag{Name: "mode, m", Value: "", Usage: "override defaults, armor | binary | attached | detached | armor,attached ..."},
 				cli.StringFlag{Name: "in, i", Usage: "file to read"},
 				cli.StringFlag{Name: "out, o", Usage: "file to write, defaults to {in}.sig (detached) or {in}.signed (attached)"},
+				cli.BoolFlag{Name: "multi", Usage: "multi-signature, which other signers can add to (--append)"},
+				cli.StringFlag{Name: "append, a", Usage: "multi-signature file to add to"},
+				cli.StringFlag{Name: "dir, d", Usage: "directory to sign (manifest), defaults out to {dir}/.keys-manifest"},
+				cli.StringSliceFlag{Name: "ignore", Usage: "ignore pattern (dir), in addition to {dir}/.keysignore"},
 			},
 			Action: func(c *cli.Context) error {
+				if c.String("dir") != "" {
+					return signDirForCLI(c, client)
+				}
+				if c.Bool("multi") || c.String("append") != "" {
+					return multiSignForCLI(c, client)
+				}
+				if isFIDO2KeyID(keys.ID(c.String("signer"))) {
+					return fido2SignForCLI(c, client)
+				}
+
 				mode, err := parseMode(c.String("mode"), false)
 				if err != nil {
 					return err
@@ -40,19 +57,23 @@ func signCommands(client *Client) []cli.Command {
 }
 
 func signStdin(c *cli.Context, client *Client, mode signMode) error {
+	detached := mode.isDetached(stdIn)
+	return signStream(client, &SignInput{
+		Signer:   c.String("signer"),
+		Armored:  mode.isArmored(stdIn, detached),
+		Detached: detached,
+	})
+}
+
+func signStream(client *Client, init *SignInput) error {
 	reader := bufio.NewReader(os.Stdin)
 	writer := os.Stdout
-	detached := mode.isDetached(stdIn)
 
 	signClient, streamErr := client.KeysClient().SignStream(context.TODO())
 	if streamErr != nil {
 		return streamErr
 	}
-	if err := signClient.Send(&SignInput{
-		Signer:   c.String("signer"),
-		Armored:  mode.isArmored(stdIn, detached),
-		Detached: detached,
-	}); err != nil {
+	if err := signClient.Send(init); err != nil {
 		return err
 	}
 
@@ -95,10 +116,83 @@ func signStdin(c *cli.Context, client *Client, mode signMode) error {
 
 func signFileForCLI(c *cli.Context, client *Client, mode signMode) error {
 	detached := mode.isDetached(fileIn)
-	return signFile(client, c.String("signer"), mode.isArmored(fileIn, detached), detached, c.String("in"), c.String("out"))
+	return signFile(client, c.String("signer"), mode.isArmored(fileIn, detached), detached, DefaultSignFormat, nil, c.String("in"), c.String("out"))
+}
+
+// multiSignForCLI creates or adds to a multi-signature. If appending to a file
+// signature, the output defaults to the existing signature file.
+func multiSignForCLI(c *cli.Context, client *Client) error {
+	if c.String("mode") != "" {
+		return errors.Errorf("mode isn't supported for multi-signatures")
+	}
+	var sig []byte
+	if c.String("append") != "" {
+		b, err := ioutil.ReadFile(c.String("append"))
+		if err != nil {
+			return err
+		}
+		sig = b
+	}
+	if c.String("in") != "" {
+		out := c.String("out")
+		if out == "" {
+			out = c.String("append")
+		}
+		return signFile(client, c.String("signer"), true, true, MultiSignFormat, sig, c.String("in"), out)
+	}
+	return signStream(client, &SignInput{
+		Signer: c.String("signer"),
+		Format: MultiSignFormat,
+		Sig:    sig,
+	})
+}
+
+// fido2SignForCLI signs with a FIDO2 key (detached and armored).
+func fido2SignForCLI(c *cli.Context, client *Client) error {
+	if c.String("mode") != "" {
+		return errors.Errorf("mode isn't supported for FIDO2 signatures")
+	}
+	if c.String("in") != "" {
+		return signFile(client, c.String("signer"), true, true, FIDO2SignFormat, nil, c.String("in"), c.String("out"))
+	}
+	return signStream(client, &SignInput{
+		Signer: c.String("signer"),
+		Format: FIDO2SignFormat,
+	})
+}
+
+func signDirForCLI(c *cli.Context, client *Client) error {
+	if c.String("in") != "" || c.String("mode") != "" || c.Bool("multi") || c.String("append") != "" {
+		return errors.Errorf("dir can't be used with in, mode, multi or append")
+	}
+	resp, err := signDir(client, c.String("signer"), c.String("dir"), c.String("out"), c.StringSlice("ignore"))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Signed %d file(s), manifest %s\n", resp.Files, resp.Out)
+	return nil
+}
+
+func signDir(client *Client, signer string, dir string, out string, ignore []string) (*SignDirResponse, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if out != "" {
+		out, err = filepath.Abs(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client.KeysClient().SignDir(context.TODO(), &SignDirRequest{
+		Dir:    dir,
+		Out:    out,
+		Signer: signer,
+		Ignore: ignore,
+	})
 }
 
-func signFile(client *Client, signer string, armored bool, detached bool, in string, out string) error {
+func signFile(client *Client, signer string, armored bool, detached bool, format SignFormat, sig []byte, in string, out string) error {
 	in, err := filepath.Abs(in)
 	if err != nil {
 		return err
@@ -119,6 +213,8 @@ func signFile(client *Client, signer string, armored bool, detached bool, in str
 		Signer:   signer,
 		Armored:  armored,
 		Detached: detached,
+		Format:   format,
+		Sig:      sig,
 		In:       in,
 		Out:      out,
 	}); err != nil {
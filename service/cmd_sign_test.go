@@ -3,6 +3,7 @@ package service
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -88,3 +89,134 @@ func TestSignVerifyCommand(t *testing.T) {
 	require.Equal(t, string(in), "test message")
 
 }
+
+func TestMultiSignVerifyCommand(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	client, closeClFn := newTestRPCClient(t, service, env, appName)
+	defer closeClFn()
+	defer closeFn()
+
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+
+	var clientErr error
+	errorFn := func(err error) {
+		clientErr = err
+	}
+
+	build := Build{Version: VersionDev}
+
+	inPath := writeTestFile(t)
+	sigPath := inPath + ".sig"
+	defer os.Remove(inPath)
+	defer os.Remove(sigPath)
+
+	cmd := append(os.Args[0:1], "-app", appName)
+
+	argsSign := append(cmd, "sign", "-multi", "-s", alice.ID().String(), "-in", inPath)
+	runClient(build, argsSign, client, errorFn)
+	require.NoError(t, clientErr)
+	sig, err := ioutil.ReadFile(sigPath)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(sig), multiSigBegin))
+
+	argsSign = append(cmd, "sign", "-s", bob.ID().String(), "-in", inPath, "-append", sigPath)
+	runClient(build, argsSign, client, errorFn)
+	require.NoError(t, clientErr)
+
+	argsVerify := append(cmd, "verify", "-s", alice.ID().String(), "-s", bob.ID().String(), "-in", inPath)
+	runClient(build, argsVerify, client, errorFn)
+	require.NoError(t, clientErr)
+
+	argsVerify = append(cmd, "verify", "-s", alice.ID().String(), "-s", charlie.ID().String(), "-t", "1", "-in", inPath)
+	runClient(build, argsVerify, client, errorFn)
+	require.NoError(t, clientErr)
+
+	argsVerify = append(cmd, "verify", "-s", alice.ID().String(), "-s", charlie.ID().String(), "-in", inPath)
+	runClient(build, argsVerify, client, errorFn)
+	require.EqualError(t, clientErr, "rpc error: code = Unknown desc = signature policy not satisfied, 1 of 2 required signers")
+}
+
+func TestVerifyTrustCommand(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	client, closeClFn := newTestRPCClient(t, service, env, appName)
+	defer closeClFn()
+	defer closeFn()
+
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	var clientErr error
+	errorFn := func(err error) {
+		clientErr = err
+	}
+
+	build := Build{Version: VersionDev}
+
+	inPath := writeTestFile(t)
+	sigPath := inPath + ".sig"
+	defer os.Remove(inPath)
+	defer os.Remove(sigPath)
+
+	cmd := append(os.Args[0:1], "-app", appName)
+
+	runClient(build, append(cmd, "sign", "-s", alice.ID().String(), "-in", inPath), client, errorFn)
+	require.NoError(t, clientErr)
+
+	runClient(build, append(cmd, "trust", "set", "-s", alice.ID().String(), "-expire", "24h", "release"), client, errorFn)
+	require.NoError(t, clientErr)
+	runClient(build, append(cmd, "trust", "set", "-s", bob.ID().String(), "other"), client, errorFn)
+	require.NoError(t, clientErr)
+
+	runClient(build, append(cmd, "verify", "-trust", "release", "-in", inPath), client, errorFn)
+	require.NoError(t, clientErr)
+
+	runClient(build, append(cmd, "verify", "-trust", "other", "-in", inPath), client, errorFn)
+	require.EqualError(t, clientErr, "rpc error: code = FailedPrecondition desc = untrusted signer "+alice.ID().String()+" (trust policy other)")
+}
+
+func TestSignVerifyDirCommand(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	client, closeClFn := newTestRPCClient(t, service, env, appName)
+	defer closeClFn()
+	defer closeFn()
+
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	var clientErr error
+	errorFn := func(err error) {
+		clientErr = err
+	}
+
+	build := Build{Version: VersionDev}
+
+	dir, err := ioutil.TempDir("", "keys-manifest-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	err = ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0600)
+	require.NoError(t, err)
+
+	cmd := append(os.Args[0:1], "-app", appName)
+
+	runClient(build, append(cmd, "sign", "-s", alice.ID().String(), "-dir", dir), client, errorFn)
+	require.NoError(t, clientErr)
+
+	runClient(build, append(cmd, "verify", "-s", alice.ID().String(), "-dir", dir), client, errorFn)
+	require.NoError(t, clientErr)
+
+	runClient(build, append(cmd, "verify", "-s", bob.ID().String(), "-dir", dir), client, errorFn)
+	require.EqualError(t, clientErr, "invalid signer, expected "+bob.ID().String()+", was "+alice.ID().String())
+
+	err = ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("a2"), 0600)
+	require.NoError(t, err)
+	runClient(build, append(cmd, "verify", "-s", alice.ID().String(), "-dir", dir), client, errorFn)
+	require.EqualError(t, clientErr, "directory doesn't match manifest")
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh"
+)
+
+func sshCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "ssh-agent",
+			Usage: "Print SSH_AUTH_SOCK for the ssh agent, eval $(keys ssh-agent)",
+			Action: func(c *cli.Context) error {
+				resp, err := client.KeysClient().SSHAgent(context.TODO(), &SSHAgentRequest{})
+				if err != nil {
+					return err
+				}
+				fmt.Printf("SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", resp.Path)
+				return nil
+			},
+		},
+		cli.Command{
+			Name:  "ssh",
+			Usage: "SSH",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:  "pubkey",
+					Usage: "Show authorized_keys lines for EdX25519 keys",
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{Name: "kid, k", Usage: "keys, defaults to all EdX25519 keys in the keyring"},
+					},
+					Action: func(c *cli.Context) error {
+						kids := []keys.ID{}
+						for _, s := range c.StringSlice("kid") {
+							kid, err := keys.ParseID(s)
+							if err != nil {
+								return err
+							}
+							kids = append(kids, kid)
+						}
+						if len(kids) == 0 {
+							resp, err := client.KeysClient().Keys(context.TODO(), &KeysRequest{Types: []KeyType{EdX25519}})
+							if err != nil {
+								return err
+							}
+							for _, key := range resp.Keys {
+								kids = append(kids, keys.ID(key.ID))
+							}
+						}
+						for _, kid := range kids {
+							spk, err := keys.NewEdX25519PublicKeyFromID(kid)
+							if err != nil {
+								return err
+							}
+							line, err := sshAuthorizedKey(spk)
+							if err != nil {
+								return err
+							}
+							fmt.Println(line)
+						}
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
+
+// isSSHKeygen returns true if args are ssh-keygen -Y style arguments, so keys
+// can be used as git's gpg.ssh.program, for example:
+//
+//	git config gpg.format ssh
+//	git config gpg.ssh.program keys
+//	git config user.signingkey "key::$(keys ssh pubkey -k <kid>)"
+//
+// A signer's principals are from the allowed signers file (-f), as set by
+// gpg.ssh.allowedSignersFile, and its verified keys.pub user. Signatures from
+// keys without either fail verification.
+func isSSHKeygen(args []string) bool {
+	return len(args) > 1 && strings.HasPrefix(args[1], "-Y")
+}
+
+func runSSHKeygen(build Build, args []string, client *Client) error {
+	cfg, err := NewConfig("Keys")
+	if err != nil {
+		return err
+	}
+	if err := connect(cfg, client, build, os.Getenv("KEYS_AUTH"), true); err != nil {
+		return err
+	}
+	return sshKeygen(client, args, os.Stdin, os.Stdout)
+}
+
+type sshKeygenArgs struct {
+	op        string
+	namespace string
+	file      string
+	principal string
+	sig       string
+	files     []string
+}
+
+func parseSSHKeygenArgs(args []string) (*sshKeygenArgs, error) {
+	out := &sshKeygenArgs{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if len(arg) < 2 || arg[0] != '-' {
+			out.files = append(out.files, arg)
+			continue
+		}
+		opt := arg[1]
+		switch opt {
+		case 'U', 'q':
+			continue
+		case 'Y', 'n', 'f', 'I', 's', 'O':
+		default:
+			return nil, errors.Errorf("unsupported option %s", arg)
+		}
+		val := arg[2:]
+		if val == "" {
+			i++
+			if i >= len(args) {
+				return nil, errors.Errorf("missing value for -%c", opt)
+			}
+			val = args[i]
+		}
+		switch opt {
+		case 'Y':
+			out.op = val
+		case 'n':
+			out.namespace = val
+		case 'f':
+			out.file = val
+		case 'I':
+			out.principal = val
+		case 's':
+			out.sig = val
+		}
+	}
+	return out, nil
+}
+
+func sshKeygen(client *Client, args []string, stdin io.Reader, stdout io.Writer) error {
+	kargs, err := parseSSHKeygenArgs(args)
+	if err != nil {
+		return err
+	}
+	switch kargs.op {
+	case "sign":
+		return sshKeygenSign(client, kargs, stdin, stdout)
+	case "verify", "check-novalidate":
+		return sshKeygenVerify(client, kargs, stdin, stdout)
+	case "find-principals":
+		return sshKeygenFindPrincipals(client, kargs, stdout)
+	default:
+		return errors.Errorf("unsupported -Y %s", kargs.op)
+	}
+}
+
+// sshKeygenSigner returns the key for -f, which is a file with a kid or a
+// SSH public key, or a kid.
+func sshKeygenSigner(path string) (keys.ID, error) {
+	b, err := ioutil.ReadFile(path) // #nosec
+	if err != nil {
+		if kid, parseErr := keys.ParseID(path); parseErr == nil {
+			return kid, nil
+		}
+		return "", err
+	}
+	s := strings.TrimSpace(string(b))
+	if kid, err := keys.ParseID(s); err == nil {
+		return kid, nil
+	}
+	spk, err := keys.ParseSSHPublicKey(s)
+	if err != nil {
+		return "", err
+	}
+	return spk.ID(), nil
+}
+
+func sshKeygenSign(client *Client, args *sshKeygenArgs, stdin io.Reader, stdout io.Writer) error {
+	if args.file == "" {
+		return errors.Errorf("no key specified (-f)")
+	}
+	kid, err := sshKeygenSigner(args.file)
+	if err != nil {
+		return err
+	}
+	sign := func(b []byte) ([]byte, error) {
+		resp, err := client.KeysClient().Sign(context.TODO(), &SignRequest{
+			Data:      b,
+			Signer:    kid.String(),
+			Armored:   true,
+			Detached:  true,
+			Format:    SSHSignFormat,
+			Namespace: args.namespace,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}
+
+	if len(args.files) == 0 {
+		b, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+		sig, err := sign(b)
+		if err != nil {
+			return err
+		}
+		_, err = stdout.Write(sig)
+		return err
+	}
+	for _, path := range args.files {
+		b, err := ioutil.ReadFile(path) // #nosec
+		if err != nil {
+			return err
+		}
+		sig, err := sign(b)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path+".sig", sig, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sshAllowedSigners returns the principals for a key in an allowed signers
+// file, see ALLOWED SIGNERS in ssh-keygen(1).
+func sshAllowedSigners(path string, kid keys.ID) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	b, err := ioutil.ReadFile(path) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	principals := []string{}
+	for _, line := range strings.Split(string(b), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		for i := 1; i < len(fields)-1; i++ {
+			if fields[i] != ssh.KeyAlgoED25519 {
+				continue
+			}
+			spk, err := keys.ParseSSHPublicKey(fields[i] + " " + fields[i+1])
+			if err != nil || spk.ID() != kid {
+				break
+			}
+			principals = append(principals, strings.Split(fields[0], ",")...)
+			break
+		}
+	}
+	return principals, nil
+}
+
+// sshKeygenPrincipals returns the principals for a signer, from the allowed
+// signers file and the verified user for the key.
+func sshKeygenPrincipals(path string, key *Key) ([]string, error) {
+	principals, err := sshAllowedSigners(path, keys.ID(key.ID))
+	if err != nil {
+		return nil, err
+	}
+	if key.User != nil && key.User.Status == UserStatusOK {
+		principals = append(principals, key.User.ID)
+	}
+	if len(principals) == 0 {
+		return nil, errors.Errorf("no allowed signer or verified user for %s", key.ID)
+	}
+	return principals, nil
+}
+
+func sshFingerprint(kid string) (string, error) {
+	spk, err := keys.NewEdX25519PublicKeyFromID(keys.ID(kid))
+	if err != nil {
+		return "", err
+	}
+	pk, err := sshPublicKey(spk)
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintSHA256(pk), nil
+}
+
+func sshKeygenVerify(client *Client, args *sshKeygenArgs, stdin io.Reader, stdout io.Writer) error {
+	if args.sig == "" {
+		return errors.Errorf("no signature specified (-s)")
+	}
+	sig, err := ioutil.ReadFile(args.sig)
+	if err != nil {
+		return err
+	}
+	b, err := ioutil.ReadAll(stdin)
+	if err != nil {
+		return err
+	}
+	resp, err := client.KeysClient().VerifyDetached(context.TODO(), &VerifyDetachedRequest{
+		Data:      b,
+		Sig:       sig,
+		Armored:   true,
+		Format:    SSHSignFormat,
+		Namespace: args.namespace,
+	})
+	if err != nil {
+		return err
+	}
+	fingerprint, err := sshFingerprint(resp.Signer.ID)
+	if err != nil {
+		return err
+	}
+	if args.op == "check-novalidate" {
+		fmt.Fprintf(stdout, "Good %q signature with ED25519 key %s\n", args.namespace, fingerprint)
+		return nil
+	}
+	principals, err := sshKeygenPrincipals(args.file, resp.Signer)
+	if err != nil {
+		return err
+	}
+	principal := principals[0]
+	if args.principal != "" {
+		if !containsString(principals, args.principal) {
+			return errors.Errorf("signature is from %s, not %s", strings.Join(principals, ","), args.principal)
+		}
+		principal = args.principal
+	}
+	fmt.Fprintf(stdout, "Good %q signature for %s with ED25519 key %s\n", args.namespace, principal, fingerprint)
+	return nil
+}
+
+func sshKeygenFindPrincipals(client *Client, args *sshKeygenArgs, stdout io.Writer) error {
+	if args.sig == "" {
+		return errors.Errorf("no signature specified (-s)")
+	}
+	sig, err := ioutil.ReadFile(args.sig)
+	if err != nil {
+		return err
+	}
+	kid, err := sshSigSigner(sig, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.KeysClient().Key(context.TODO(), &KeyRequest{Identity: kid.String()})
+	if err != nil {
+		return err
+	}
+	if resp.Key == nil {
+		return keys.NewErrNotFound(kid.String())
+	}
+	principals, err := sshKeygenPrincipals(args.file, resp.Key)
+	if err != nil {
+		return err
+	}
+	for _, principal := range principals {
+		fmt.Fprintln(stdout, principal)
+	}
+	return nil
+}
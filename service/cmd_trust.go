@@ -0,0 +1,115 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/keys-pub/keys/util"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func trustCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "trust",
+			Usage: "Trust policies (for verify)",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:      "set",
+					Usage:     "Create or update a trust policy",
+					ArgsUsage: "name",
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{Name: "signer, s", Usage: "trusted signer, kid or user (alice@github or github:alice)"},
+						cli.StringFlag{Name: "expire", Usage: "expire date (2006-01-02) or duration (720h)"},
+					},
+					Action: func(c *cli.Context) error {
+						if c.NArg() != 1 {
+							return errors.Errorf("specify a policy name")
+						}
+						expire, err := parseExpire(c.String("expire"), time.Now())
+						if err != nil {
+							return err
+						}
+						resp, err := client.KeysClient().TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+							Policy: &TrustPolicy{
+								Name:    c.Args().First(),
+								Signers: c.StringSlice("signer"),
+								Expire:  expire,
+							},
+						})
+						if err != nil {
+							return err
+						}
+						fmtTrustPolicies([]*TrustPolicy{resp.Policy})
+						return nil
+					},
+				},
+				cli.Command{
+					Name:      "remove",
+					Usage:     "Remove a trust policy",
+					ArgsUsage: "name",
+					Action: func(c *cli.Context) error {
+						if c.NArg() != 1 {
+							return errors.Errorf("specify a policy name")
+						}
+						_, err := client.KeysClient().TrustPolicyRemove(context.TODO(), &TrustPolicyRemoveRequest{
+							Name: c.Args().First(),
+						})
+						return err
+					},
+				},
+			},
+			Action: func(c *cli.Context) error {
+				resp, err := client.KeysClient().TrustPolicies(context.TODO(), &TrustPoliciesRequest{})
+				if err != nil {
+					return err
+				}
+				fmtTrustPolicies(resp.Policies)
+				return nil
+			},
+		},
+	}
+}
+
+// parseExpire parses a date or duration (from now) into ms since epoch, or 0
+// if not specified.
+func parseExpire(s string, now time.Time) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return util.TimeToMillis(t), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid expire %q, should be a date (2006-01-02) or duration (720h)", s)
+	}
+	return util.TimeToMillis(now.Add(d)), nil
+}
+
+func fmtTrustPolicies(policies []*TrustPolicy) {
+	out := &bytes.Buffer{}
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 1, ' ', 0)
+	for _, policy := range policies {
+		fmtTrustPolicy(w, policy)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+	fmt.Print(out.String())
+}
+
+func fmtTrustPolicy(w io.Writer, policy *TrustPolicy) {
+	expire := ""
+	if policy.Expire != 0 {
+		expire = "expires " + util.TimeFromMillis(policy.Expire).Format("2006-01-02 15:04")
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\n", policy.Name, strings.Join(policy.Signers, ","), expire)
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli"
+)
+
+func vaultCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "vault",
+			Usage: "Sync secrets between devices",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:  "sync",
+					Usage: "Sync secrets with the key server (encrypted to your key)",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "kid, k", Usage: "key to encrypt to, to enable syncing"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().VaultSync(context.TODO(), &VaultSyncRequest{
+							KID: c.String("kid"),
+						})
+						if err != nil {
+							return err
+						}
+						for _, conflict := range resp.Conflicts {
+							fmt.Printf("conflict: %s\n", conflict.ID)
+						}
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -22,15 +23,23 @@ func verifyCommands(client *Client) []cli.Command {
 			Name:  "verify",
 			Usage: "Verify",
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "signer, s", Usage: "expected signer"},
+				cli.StringSliceFlag{Name: "signer, s", Usage: "expected signer (or signers for multi-signatures)"},
+				cli.IntFlag{Name: "threshold, t", Usage: "number of signers required (multi-signatures), defaults to all"},
+				cli.StringFlag{Name: "trust", Usage: "trust policy (see keys trust)"},
 				cli.StringFlag{Name: "mode, m", Value: "", Usage: "mode: armor, binary, attached, detached"},
 				cli.StringFlag{Name: "sig, x", Usage: "signature file (if detached)"},
 				cli.StringFlag{Name: "in, i", Usage: "file to read"},
 				cli.StringFlag{Name: "out, o", Usage: "file to write (if attached), defaults to {in} without .signed"},
+				cli.StringFlag{Name: "dir, d", Usage: "directory to verify (manifest)"},
+				cli.StringFlag{Name: "manifest", Usage: "manifest file (dir), defaults to {dir}/.keys-manifest"},
 			},
 			Action: func(c *cli.Context) error {
 				logger.Debugf("Verify (cmd)")
 
+				if c.String("dir") != "" {
+					return verifyDirForCLI(c, client)
+				}
+
 				// TODO: Error if out path already exists
 
 				mode, err := parseMode(c.String("mode"), c.String("sig") != "")
@@ -38,13 +47,21 @@ func verifyCommands(client *Client) []cli.Command {
 					return err
 				}
 
-				signer := c.String("signer")
-				if signer == "" {
-					return errors.Errorf("specify -s (-signer) to verify")
+				signers := c.StringSlice("signer")
+				trust := c.String("trust")
+				if len(signers) == 0 && trust == "" {
+					return errors.Errorf("specify -s (-signer) or -trust to verify")
+				}
+				var policy *SignPolicy
+				if len(signers) > 0 {
+					policy = &SignPolicy{
+						Signers:   signers,
+						Threshold: int32(c.Int("threshold")),
+					}
 				}
 
 				if c.String("in") != "" {
-					if _, err := verifyFileForCLI(c, client, mode, signer); err != nil {
+					if _, err := verifyFileForCLI(c, client, mode, policy, trust); err != nil {
 						return err
 					}
 					return nil
@@ -58,7 +75,7 @@ func verifyCommands(client *Client) []cli.Command {
 					if !mode.isDetached(stdIn) {
 						return errors.Errorf("sig is only for detached mode")
 					}
-					if err := verifyDetachedStream(client, mode.isArmored(stdIn, false), reader, sigFile, signer); err != nil {
+					if err := verifyDetachedStream(client, mode.isArmored(stdIn, false), reader, sigFile, policy, trust); err != nil {
 						return err
 					}
 					return nil
@@ -67,6 +84,10 @@ func verifyCommands(client *Client) []cli.Command {
 				if mode.isDetached(stdIn) {
 					return errors.Errorf("detached mode without sig")
 				}
+				signer, err := attachedSigner(policy)
+				if err != nil {
+					return err
+				}
 
 				armored := mode.isArmored(stdIn, false)
 				logger.Debugf("Verify stream (cmd) armored=%t, detatched=false", armored)
@@ -74,6 +95,10 @@ func verifyCommands(client *Client) []cli.Command {
 				if err != nil {
 					return err
 				}
+				// The first message has the trust policy.
+				if err := verifyClient.Send(&VerifyInput{Trust: trust}); err != nil {
+					return err
+				}
 				var outErr error
 				go func() {
 					_, inErr := readFrom(reader, 1024*1024, func(b []byte) error {
@@ -130,7 +155,87 @@ func verifyCommands(client *Client) []cli.Command {
 	}
 }
 
-func verifyFileForCLI(c *cli.Context, client *Client, mode signMode, signer string) (string, error) {
+// attachedSigner returns the expected signer for attached signatures, which
+// only have a single signer, or empty if no signer was specified.
+func attachedSigner(policy *SignPolicy) (string, error) {
+	if policy == nil {
+		return "", nil
+	}
+	if len(policy.Signers) != 1 || policy.Threshold != 0 {
+		return "", errors.Errorf("multiple signers are only supported for (detached) multi-signatures")
+	}
+	return policy.Signers[0], nil
+}
+
+func verifyDirForCLI(c *cli.Context, client *Client) error {
+	signers := c.StringSlice("signer")
+	trust := c.String("trust")
+	if len(signers) == 0 && trust == "" {
+		return errors.Errorf("specify -s (-signer) or -trust to verify")
+	}
+	if len(signers) > 1 {
+		return errors.Errorf("dir manifests only have a single signer")
+	}
+	signer := ""
+	if len(signers) == 1 {
+		signer = signers[0]
+	}
+	resp, err := verifyDir(client, c.String("dir"), c.String("manifest"), signer, trust)
+	if err != nil {
+		return err
+	}
+	fmtVerifyDir(os.Stdout, resp)
+	if len(resp.Added) > 0 || len(resp.Removed) > 0 || len(resp.Modified) > 0 {
+		return errors.Errorf("directory doesn't match manifest")
+	}
+	return nil
+}
+
+func verifyDir(client *Client, dir string, manifest string, signer string, trust string) (*VerifyDirResponse, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if manifest != "" {
+		manifest, err = filepath.Abs(manifest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := client.KeysClient().VerifyDir(context.TODO(), &VerifyDirRequest{
+		Dir:      dir,
+		Manifest: manifest,
+		Trust:    trust,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if signer != "" {
+		if err := checkSigner(resp.Signer, signer); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func fmtVerifyDir(w io.Writer, resp *VerifyDirResponse) {
+	signer := resp.Signer.ID
+	if resp.Signer.User != nil {
+		signer = signer + " " + resp.Signer.User.ID
+	}
+	fmt.Fprintf(w, "Signer: %s\n", signer)
+	for _, p := range resp.Added {
+		fmt.Fprintf(w, "+ %s\n", p)
+	}
+	for _, p := range resp.Removed {
+		fmt.Fprintf(w, "- %s\n", p)
+	}
+	for _, p := range resp.Modified {
+		fmt.Fprintf(w, "M %s\n", p)
+	}
+}
+
+func verifyFileForCLI(c *cli.Context, client *Client, mode signMode, policy *SignPolicy, trust string) (string, error) {
 	sigFile := c.String("sig")
 	in := c.String("in")
 	detached := mode.isDetached(fileIn)
@@ -139,16 +244,20 @@ func verifyFileForCLI(c *cli.Context, client *Client, mode signMode, signer stri
 		if sigFile == "" {
 			sigFile = in + ".sig"
 		}
-		if err := verifyDetachedFile(client, mode.isArmored(fileIn, detached), in, sigFile, signer); err != nil {
+		if err := verifyDetachedFile(client, mode.isArmored(fileIn, detached), in, sigFile, policy, trust); err != nil {
 			return "", err
 		}
 		return "", nil
 	}
 
-	return verifyFile(client, mode.isArmored(fileIn, detached), in, c.String("out"), signer)
+	signer, err := attachedSigner(policy)
+	if err != nil {
+		return "", err
+	}
+	return verifyFile(client, mode.isArmored(fileIn, detached), in, c.String("out"), signer, trust)
 }
 
-func verifyFile(client *Client, armored bool, in string, out string, signer string) (string, error) {
+func verifyFile(client *Client, armored bool, in string, out string, signer string, trust string) (string, error) {
 	logger.Debugf("Verify file (cmd) in=%s, out=%s, armored=%t, detached=false", in, out, armored)
 	if in == "" {
 		return "", errors.Errorf("in not specified")
@@ -173,6 +282,7 @@ func verifyFile(client *Client, armored bool, in string, out string, signer stri
 		Armored: armored,
 		In:      in,
 		Out:     out,
+		Trust:   trust,
 	}); err != nil {
 		return "", err
 	}
@@ -192,14 +302,16 @@ func verifyFile(client *Client, armored bool, in string, out string, signer stri
 	// 	return err
 	// }
 
-	if err := checkSigner(resp.Signer, signer); err != nil {
-		return "", err
+	if signer != "" {
+		if err := checkSigner(resp.Signer, signer); err != nil {
+			return "", err
+		}
 	}
 
 	return resp.Out, nil
 }
 
-func verifyDetachedFile(client *Client, armored bool, in string, sigFile string, signer string) error {
+func verifyDetachedFile(client *Client, armored bool, in string, sigFile string, policy *SignPolicy, trust string) error {
 	logger.Debugf("Verify detached file (cmd) in=%s, sig=%s, armored=%t", in, sigFile, armored)
 	if in == "" {
 		return errors.Errorf("in not specified")
@@ -223,23 +335,20 @@ func verifyDetachedFile(client *Client, armored bool, in string, sigFile string,
 		Armored: armored,
 		In:      in,
 		Sig:     sig,
+		Policy:  policy,
+		Trust:   trust,
 	}); err != nil {
 		return err
 	}
 
-	resp, recvErr := verifyClient.CloseAndRecv()
-	if recvErr != nil {
-		return recvErr
-	}
-
-	if err := checkSigner(resp.Signer, signer); err != nil {
+	if _, err := verifyClient.CloseAndRecv(); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func verifyDetachedStream(client *Client, armored bool, reader io.Reader, sigFile string, signer string) error {
+func verifyDetachedStream(client *Client, armored bool, reader io.Reader, sigFile string, policy *SignPolicy, trust string) error {
 	logger.Debugf("Verify detached stream (cmd) sig=%s, armored=%t", sigFile, armored)
 	sig, err := ioutil.ReadFile(sigFile) // #nosec
 	if err != nil {
@@ -262,6 +371,8 @@ func verifyDetachedStream(client *Client, armored bool, reader io.Reader, sigFil
 					Armored: armored,
 					Sig:     sig,
 					Data:    b,
+					Policy:  policy,
+					Trust:   trust,
 				}
 				sentSig = true
 			} else {
@@ -290,11 +401,6 @@ func verifyDetachedStream(client *Client, armored bool, reader io.Reader, sigFil
 		return errors.Errorf("no response")
 	}
 
-	// TODO: Send expected signer in request
-	if err := checkSigner(resp.Signer, signer); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -28,10 +28,16 @@ const serverKey = "server"
 const portKey = "port"
 const logLevelKey = "logLevel"
 const keyringTypeKey = "keyring"
+const metricsPortKey = "metricsPort"
+const sshAgentKey = "sshAgent"
+const gatewayPortKey = "gatewayPort"
+const fido2Key = "fido2"
+const fido2InsecureKey = "fido2Insecure"
+const secretHistoryKey = "secretHistory"
 
 // TODO: Deprecate keyring type? Use fs fallback if no system keyring available automatically.
 
-var configKeys = []string{serverKey, portKey, logLevelKey, keyringTypeKey}
+var configKeys = []string{serverKey, portKey, logLevelKey, keyringTypeKey, metricsPortKey, sshAgentKey, gatewayPortKey, fido2Key, fido2InsecureKey, secretHistoryKey}
 
 // IsKey returns true if config key is recognized.
 func (c Config) IsKey(s string) bool {
@@ -48,6 +54,42 @@ func (c Config) Port() int {
 	return c.GetInt(portKey, 22405)
 }
 
+// MetricsPort to serve metrics on (localhost only), or 0 if disabled.
+func (c Config) MetricsPort() int {
+	return c.GetInt(metricsPortKey, 0)
+}
+
+// GatewayPort to serve the HTTP/JSON gateway on (localhost only), or 0 if
+// disabled.
+func (c Config) GatewayPort() int {
+	return c.GetInt(gatewayPortKey, 0)
+}
+
+// SecretHistory is the number of prior versions to keep for each secret, or 0
+// to keep none.
+func (c Config) SecretHistory() int {
+	return c.GetInt(secretHistoryKey, 20)
+}
+
+// SSHAgent returns true if the service should serve the SSH agent protocol.
+func (c *Config) SSHAgent() bool {
+	return c.GetBool(sshAgentKey)
+}
+
+// FIDO2 authenticators to use, "plugin" (default) to load fido2.so (libfido2),
+// or "virtual" for a software authenticator (for testing, requires
+// fido2Insecure).
+func (c *Config) FIDO2() string {
+	return c.Get(fido2Key, "plugin")
+}
+
+// FIDO2Insecure returns true if insecure (software) FIDO2 authenticators are
+// allowed, which is required to use "virtual" for FIDO2, for development and
+// testing only.
+func (c *Config) FIDO2Insecure() bool {
+	return c.GetBool(fido2InsecureKey)
+}
+
 // Server to connect to.
 func (c Config) Server() string {
 	return c.Get(serverKey, "https://keys.pub")
@@ -108,6 +150,11 @@ func (c Config) certPath(makeDir bool) (string, error) {
 	return c.AppPath("ca.pem", makeDir)
 }
 
+// socketPath is the unix socket the service listens on (Linux only).
+func (c Config) socketPath(makeDir bool) (string, error) {
+	return c.AppPath("keysd.sock", makeDir)
+}
+
 // SupportPath ...
 func SupportPath(appName string, fileName string, makeDir bool) (string, error) {
 	switch runtime.GOOS {
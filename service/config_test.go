@@ -1,9 +1,11 @@
 package service
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/keys-pub/keysd/fido2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,3 +35,28 @@ func TestConfig(t *testing.T) {
 	require.Equal(t, "mem", cfg2.Get("keyring", ""))
 	require.True(t, cfg2.GetBool("disableSymlinkCheck"))
 }
+
+func TestConfigFIDO2(t *testing.T) {
+	cfg, err := NewConfig("KeysTest-" + randName())
+	require.NoError(t, err)
+	defer func() {
+		removeErr := os.RemoveAll(cfg.AppDir())
+		require.NoError(t, removeErr)
+	}()
+	require.Equal(t, "plugin", cfg.FIDO2())
+
+	cfg.Set(fido2Key, "virtual")
+	_, err = fido2Server(cfg)
+	require.EqualError(t, err, "virtual fido2 is insecure (for testing only), run `keys config set fido2Insecure true` to allow it")
+
+	cfg.SetBool(fido2InsecureKey, true)
+	server, err := fido2Server(cfg)
+	require.NoError(t, err)
+	resp, err := server.Devices(context.TODO(), &fido2.DevicesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Devices))
+
+	cfg.Set(fido2Key, "usb")
+	_, err = fido2Server(cfg)
+	require.EqualError(t, err, "unknown fido2 config \"usb\", should be plugin or virtual")
+}
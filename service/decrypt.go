@@ -22,15 +22,39 @@ func (s *service) findSender(ctx context.Context, kid keys.ID) (*Key, error) {
 	if err != nil {
 		return nil, err
 	}
-	kid = k
+	kid, err = s.resolveKeyOwner(ctx, k)
+	if err != nil {
+		return nil, err
+	}
 	return s.loadKey(ctx, kid)
 }
 
+// detectSize is the number of bytes needed to detect the encryption mode.
+const detectSize = 64
+
+// detectEncryptMode returns the encryption mode for formats we can detect
+// from the start of the data (password and age), or DefaultEncryptMode.
+func detectEncryptMode(b []byte) EncryptMode {
+	switch {
+	case isPasswordEncrypted(b):
+		return PasswordV1
+	case isAgeEncrypted(b):
+		return AgeEncryptMode
+	default:
+		return DefaultEncryptMode
+	}
+}
+
 // Decrypt (RPC) data.
 func (s *service) Decrypt(ctx context.Context, req *DecryptRequest) (*DecryptResponse, error) {
 	sp := saltpack.NewSaltpack(s.ks)
 
 	mode := req.Mode
+	if mode != SigncryptV1 {
+		if detected := detectEncryptMode(req.Data); detected != DefaultEncryptMode {
+			mode = detected
+		}
+	}
 	if mode == DefaultEncryptMode {
 		mode = EncryptV2
 	}
@@ -67,6 +91,14 @@ func (s *service) Decrypt(ctx context.Context, req *DecryptRequest) (*DecryptRes
 				kid = sender.ID()
 			}
 		}
+	case PasswordV1:
+		decrypted, err = passwordDecrypt(req.Data, req.Password)
+	case AgeEncryptMode:
+		identities, idErr := s.ageIdentities()
+		if idErr != nil {
+			return nil, idErr
+		}
+		decrypted, err = ageDecrypt(req.Data, identities)
 	default:
 		return nil, errors.Errorf("unsupported mode %s", mode)
 	}
@@ -82,6 +114,9 @@ func (s *service) Decrypt(ctx context.Context, req *DecryptRequest) (*DecryptRes
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkTrust(ctx, req.Trust, senderKey); err != nil {
+		return nil, err
+	}
 
 	return &DecryptResponse{
 		Data:   decrypted,
@@ -112,7 +147,7 @@ func (s *service) DecryptFile(srv Keys_DecryptFileServer) error {
 		return errors.Errorf("file already exists %s", out)
 	}
 
-	sender, err := s.decryptWriteInOut(srv.Context(), req.In, out, req.Mode, req.Armored)
+	sender, err := s.decryptWriteInOut(srv.Context(), req.In, out, req.Mode, req.Armored, req.Password, req.Trust)
 	if err != nil {
 		return err
 	}
@@ -137,7 +172,7 @@ type DecryptStreamClient interface {
 // NewDecryptStreamClient returns DecryptStreamClient based on options.
 func NewDecryptStreamClient(ctx context.Context, cl KeysClient, armored bool, mode EncryptMode) (DecryptStreamClient, error) {
 	switch mode {
-	case DefaultEncryptMode, EncryptV2:
+	case DefaultEncryptMode, EncryptV2, PasswordV1, AgeEncryptMode:
 		if armored {
 			return cl.DecryptArmoredStream(ctx)
 		}
@@ -179,6 +214,14 @@ type decryptStreamServer interface {
 }
 
 func (s *service) decryptStream(srv decryptStreamServer, mode EncryptMode, armored bool) error {
+	// The first message may include the password and trust policy.
+	req, err := srv.Recv()
+	if err == io.EOF {
+		req = &DecryptInput{}
+	} else if err != nil {
+		return err
+	}
+
 	recvFn := func() ([]byte, error) {
 		req, recvErr := srv.Recv()
 		if recvErr != nil {
@@ -188,8 +231,11 @@ func (s *service) decryptStream(srv decryptStreamServer, mode EncryptMode, armor
 	}
 
 	reader := newStreamReader(srv.Context(), recvFn)
+	if err := reader.write(req.Data); err != nil {
+		return err
+	}
 
-	streamReader, kid, err := s.decryptReader(srv.Context(), reader, mode, armored)
+	streamReader, kid, err := s.decryptReader(srv.Context(), reader, mode, armored, req.Password)
 	if err != nil {
 		return err
 	}
@@ -198,6 +244,10 @@ func (s *service) decryptStream(srv decryptStreamServer, mode EncryptMode, armor
 	if err != nil {
 		return err
 	}
+	// Check trust before sending any output.
+	if err := s.checkTrust(srv.Context(), req.Trust, sender); err != nil {
+		return err
+	}
 
 	sendFn := func(b []byte, sender *Key) error {
 		resp := DecryptOutput{
@@ -209,7 +259,16 @@ func (s *service) decryptStream(srv decryptStreamServer, mode EncryptMode, armor
 	return s.readFromStream(srv.Context(), streamReader, sender, sendFn)
 }
 
-func (s *service) decryptReader(ctx context.Context, reader io.Reader, mode EncryptMode, armored bool) (io.Reader, keys.ID, error) {
+func (s *service) decryptReader(ctx context.Context, reader io.Reader, mode EncryptMode, armored bool, password string) (io.Reader, keys.ID, error) {
+	if mode != SigncryptV1 {
+		br := bufio.NewReader(reader)
+		peek, _ := br.Peek(detectSize)
+		if detected := detectEncryptMode(peek); detected != DefaultEncryptMode {
+			mode = detected
+		}
+		reader = br
+	}
+
 	sp := saltpack.NewSaltpack(s.ks)
 	var out io.Reader
 	var kid keys.ID
@@ -241,6 +300,14 @@ func (s *service) decryptReader(ctx context.Context, reader io.Reader, mode Encr
 				kid = sender.ID()
 			}
 		}
+	case PasswordV1:
+		out, err = newPasswordDecryptReader(reader, password)
+	case AgeEncryptMode:
+		identities, idErr := s.ageIdentities()
+		if idErr != nil {
+			return nil, "", idErr
+		}
+		out, err = newAgeDecryptReader(reader, identities)
 	default:
 		return nil, "", errors.Errorf("unsupported mode %s", mode)
 	}
@@ -248,7 +315,7 @@ func (s *service) decryptReader(ctx context.Context, reader io.Reader, mode Encr
 	return out, kid, err
 }
 
-func (s *service) decryptWriteInOut(ctx context.Context, in string, out string, mode EncryptMode, armored bool) (*Key, error) {
+func (s *service) decryptWriteInOut(ctx context.Context, in string, out string, mode EncryptMode, armored bool, password string, trust string) (*Key, error) {
 	inFile, err := os.Open(in) // #nosec
 	if err != nil {
 		return nil, err
@@ -258,10 +325,19 @@ func (s *service) decryptWriteInOut(ctx context.Context, in string, out string,
 	}()
 	reader := bufio.NewReader(inFile)
 
-	decReader, kid, err := s.decryptReader(ctx, reader, mode, armored)
+	decReader, kid, err := s.decryptReader(ctx, reader, mode, armored, password)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := s.findSender(ctx, kid)
 	if err != nil {
 		return nil, err
 	}
+	// Check trust before writing any output.
+	if err := s.checkTrust(ctx, trust, sender); err != nil {
+		return nil, err
+	}
+
 	outTmp := out + ".tmp"
 	outFile, err := os.Create(outTmp)
 	if err != nil {
@@ -291,10 +367,5 @@ func (s *service) decryptWriteInOut(ctx context.Context, in string, out string,
 		return nil, err
 	}
 
-	sender, err := s.findSender(ctx, kid)
-	if err != nil {
-		return nil, err
-	}
-
 	return sender, nil
 }
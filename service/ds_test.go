@@ -30,6 +30,7 @@ func TestDocuments(t *testing.T) {
 
 	expectedCols := []*Collection{
 		&Collection{Path: "/kid"},
+		&Collection{Path: "/schema"},
 		&Collection{Path: "/sigchain"},
 		&Collection{Path: "/user"},
 	}
@@ -16,17 +16,52 @@ type encrypt struct {
 	recipients []keys.ID
 	sender     keys.ID
 	mode       EncryptMode
+	password   string
+	// ageRecipients for age mode.
+	ageRecipients []*keys.X25519PublicKey
 }
 
-func (s *service) newEncrypt(ctx context.Context, recipients []string, sender string, mode EncryptMode) (*encrypt, error) {
+func (s *service) newEncrypt(ctx context.Context, recipients []string, sender string, mode EncryptMode, password string) (*encrypt, error) {
+	if mode == DefaultEncryptMode && password != "" {
+		mode = PasswordV1
+	}
+	if mode == PasswordV1 {
+		if password == "" {
+			return nil, errors.Errorf("no password specified")
+		}
+		if len(recipients) != 0 || sender != "" {
+			return nil, errors.Errorf("recipients and sender aren't supported with password mode")
+		}
+		return &encrypt{mode: mode, password: password}, nil
+	}
+	if password != "" {
+		return nil, errors.Errorf("password is only supported with password mode")
+	}
+
 	if len(recipients) == 0 {
 		return nil, errors.Errorf("no recipients specified")
 	}
 
+	if mode == AgeEncryptMode {
+		if sender != "" {
+			return nil, errors.Errorf("sender isn't supported with age mode")
+		}
+		ageRecipients, err := s.ageRecipients(ctx, recipients)
+		if err != nil {
+			return nil, err
+		}
+		return &encrypt{mode: mode, ageRecipients: ageRecipients}, nil
+	}
+
 	identities, err := s.parseIdentities(ctx, recipients, true)
 	if err != nil {
 		return nil, err
 	}
+	// Include linked device and encryption keys.
+	identities, err = s.expandRecipients(identities)
+	if err != nil {
+		return nil, err
+	}
 
 	if mode == DefaultEncryptMode {
 		mode = EncryptV2
@@ -50,7 +85,7 @@ func (s *service) newEncrypt(ctx context.Context, recipients []string, sender st
 
 // Encrypt (RPC) data.
 func (s *service) Encrypt(ctx context.Context, req *EncryptRequest) (*EncryptResponse, error) {
-	enc, err := s.newEncrypt(ctx, req.Recipients, req.Sender, req.Mode)
+	enc, err := s.newEncrypt(ctx, req.Recipients, req.Sender, req.Mode, req.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +135,18 @@ func (s *service) Encrypt(ctx context.Context, req *EncryptRequest) (*EncryptRes
 			}
 			out = data
 		}
+	case PasswordV1:
+		data, err := passwordEncrypt(req.Data, enc.password, req.Armored)
+		if err != nil {
+			return nil, err
+		}
+		out = data
+	case AgeEncryptMode:
+		data, err := ageEncrypt(req.Data, enc.ageRecipients, req.Armored)
+		if err != nil {
+			return nil, err
+		}
+		out = data
 	default:
 		return nil, errors.Errorf("unsupported mode %s", enc.mode)
 	}
@@ -209,6 +256,20 @@ func (s *service) encryptWriter(ctx context.Context, w io.Writer, enc *encrypt,
 			}
 			stream = s
 		}
+	case PasswordV1:
+		logger.Infof("Password encrypt stream")
+		s, err := newPasswordEncryptWriter(w, enc.password, armored)
+		if err != nil {
+			return nil, err
+		}
+		stream = s
+	case AgeEncryptMode:
+		logger.Infof("Age encrypt stream for %d recipient(s)", len(enc.ageRecipients))
+		s, err := newAgeEncryptWriter(w, enc.ageRecipients, armored)
+		if err != nil {
+			return nil, err
+		}
+		stream = s
 	default:
 		return nil, errors.Errorf("unsupported mode %s", enc.mode)
 	}
@@ -230,7 +291,7 @@ func (s *service) EncryptFile(srv Keys_EncryptFileServer) error {
 		out = in + ".enc"
 	}
 
-	enc, err := s.newEncrypt(srv.Context(), req.Recipients, req.Sender, req.Mode)
+	enc, err := s.newEncrypt(srv.Context(), req.Recipients, req.Sender, req.Mode, req.Password)
 	if err != nil {
 		return err
 	}
@@ -275,7 +336,7 @@ func (s *service) EncryptStream(srv Keys_EncryptStreamServer) error {
 				return errors.Errorf("stream already initialized")
 			}
 
-			enc, err := s.newEncrypt(ctx, req.Recipients, req.Sender, req.Mode)
+			enc, err := s.newEncrypt(ctx, req.Recipients, req.Sender, req.Mode, req.Password)
 			if err != nil {
 				return err
 			}
@@ -288,7 +349,7 @@ func (s *service) EncryptStream(srv Keys_EncryptStreamServer) error {
 
 		} else {
 			// Make sure request only sends data after init
-			if len(req.Recipients) != 0 || req.Sender != "" {
+			if len(req.Recipients) != 0 || req.Sender != "" || req.Password != "" {
 				return errors.Errorf("after stream is initalized, only data should be sent")
 			}
 		}
@@ -301,7 +301,7 @@ func testDecryptStream(t *testing.T, env *testEnv, service *service, b []byte, m
 		} else {
 			streamClient, clientErr = client.KeysClient().SigncryptOpenStream(ctx)
 		}
-	case EncryptV2:
+	case EncryptV2, AgeEncryptMode:
 		if armored {
 			streamClient, clientErr = client.KeysClient().DecryptArmoredStream(ctx)
 		} else {
@@ -424,7 +424,7 @@ func TestEncryptDecryptFile(t *testing.T) {
 	aliceClient, aliceClientCloseFn := newTestRPCClient(t, aliceService, env, "")
 	defer aliceClientCloseFn()
 
-	err := encryptFile(aliceClient, []string{bob.ID().String()}, alice.ID().String(), true, EncryptV2, inPath, outPath)
+	err := encryptFile(aliceClient, []string{bob.ID().String()}, alice.ID().String(), true, EncryptV2, "", inPath, outPath)
 	require.NoError(t, err)
 
 	// encrypted, err := ioutil.ReadFile(outPath)
@@ -434,7 +434,7 @@ func TestEncryptDecryptFile(t *testing.T) {
 	bobClient, bobClientCloseFn := newTestRPCClient(t, bobService, env, "")
 	defer bobClientCloseFn()
 
-	dec, err := decryptFile(bobClient, true, EncryptV2, outPath, decryptedPath)
+	dec, err := decryptFile(bobClient, true, EncryptV2, "", "", outPath, decryptedPath)
 	require.NoError(t, err)
 	require.NotNil(t, dec.Sender)
 	require.Equal(t, alice.ID().String(), dec.Sender.ID)
@@ -445,7 +445,7 @@ func TestEncryptDecryptFile(t *testing.T) {
 	os.Remove(decryptedPath)
 
 	// Test nextPath
-	// dec, err = decryptFile(bobClient, true, EncryptV2, outPath, "")
+	// dec, err = decryptFile(bobClient, true, EncryptV2, "", "", outPath, "")
 	// require.NoError(t, err)
 	// require.Equal(t, inPath+"-1", dec.Out)
 	// os.Remove(dec.Out)
@@ -493,3 +493,222 @@ func TestEncryptUnverified(t *testing.T) {
 	})
 	require.EqualError(t, err, "user bob@github has failed status connection-fail")
 }
+
+func TestEncryptDecryptPassword(t *testing.T) {
+	env := newTestEnv(t)
+	aliceService, aliceCloseFn := newTestService(t, env, "")
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+
+	bobService, bobCloseFn := newTestService(t, env, "")
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+
+	message := "Hey bob"
+	for _, armored := range []bool{true, false} {
+		encryptResp, err := aliceService.Encrypt(context.TODO(), &EncryptRequest{
+			Data:     []byte(message),
+			Mode:     PasswordV1,
+			Password: "bobpassword",
+			Armored:  armored,
+		})
+		require.NoError(t, err)
+		require.True(t, isPasswordEncrypted(encryptResp.Data))
+
+		// Autodetect (bob has no keys)
+		decryptResp, err := bobService.Decrypt(context.TODO(), &DecryptRequest{
+			Data:     encryptResp.Data,
+			Password: "bobpassword",
+		})
+		require.NoError(t, err)
+		require.Equal(t, message, string(decryptResp.Data))
+		require.Nil(t, decryptResp.Sender)
+
+		_, err = bobService.Decrypt(context.TODO(), &DecryptRequest{
+			Data:     encryptResp.Data,
+			Password: "invalidpassword",
+		})
+		require.EqualError(t, err, "failed to decrypt, invalid password or data")
+
+		_, err = bobService.Decrypt(context.TODO(), &DecryptRequest{
+			Data: encryptResp.Data,
+		})
+		require.EqualError(t, err, "no password specified")
+	}
+
+	// Password implies password mode
+	encryptResp, err := aliceService.Encrypt(context.TODO(), &EncryptRequest{
+		Data:     []byte(message),
+		Password: "bobpassword",
+	})
+	require.NoError(t, err)
+	require.True(t, isPasswordEncrypted(encryptResp.Data))
+
+	_, err = aliceService.Encrypt(context.TODO(), &EncryptRequest{
+		Data: []byte(message),
+		Mode: PasswordV1,
+	})
+	require.EqualError(t, err, "no password specified")
+
+	_, err = aliceService.Encrypt(context.TODO(), &EncryptRequest{
+		Data:       []byte(message),
+		Mode:       PasswordV1,
+		Password:   "bobpassword",
+		Recipients: []string{bob.ID().String()},
+	})
+	require.EqualError(t, err, "recipients and sender aren't supported with password mode")
+
+	_, err = aliceService.Encrypt(context.TODO(), &EncryptRequest{
+		Data:       []byte(message),
+		Mode:       EncryptV2,
+		Password:   "bobpassword",
+		Recipients: []string{bob.ID().String()},
+	})
+	require.EqualError(t, err, "password is only supported with password mode")
+}
+
+func TestEncryptDecryptPasswordStream(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	client, clientCloseFn := newTestRPCClient(t, service, env, "")
+	defer clientCloseFn()
+
+	plaintext := bytes.Repeat([]byte{0x31}, (1024*1024)+5)
+	for _, armored := range []bool{true, false} {
+		encryptClient, err := client.KeysClient().EncryptStream(context.TODO())
+		require.NoError(t, err)
+		err = encryptClient.Send(&EncryptInput{Password: "testpassword", Armored: armored})
+		require.NoError(t, err)
+		err = encryptClient.Send(&EncryptInput{Data: plaintext})
+		require.NoError(t, err)
+		err = encryptClient.CloseSend()
+		require.NoError(t, err)
+		var encrypted bytes.Buffer
+		for {
+			resp, err := encryptClient.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			encrypted.Write(resp.Data)
+		}
+
+		// Autodetect from DecryptStream, even if armored isn't specified
+		decryptClient, err := NewDecryptStreamClient(context.TODO(), client.KeysClient(), false, DefaultEncryptMode)
+		require.NoError(t, err)
+		err = decryptClient.Send(&DecryptInput{Data: encrypted.Bytes(), Password: "testpassword"})
+		require.NoError(t, err)
+		err = decryptClient.CloseSend()
+		require.NoError(t, err)
+		var decrypted bytes.Buffer
+		for {
+			resp, err := decryptClient.Recv()
+			require.NoError(t, err)
+			if len(resp.Data) == 0 {
+				break
+			}
+			decrypted.Write(resp.Data)
+		}
+		require.Equal(t, plaintext, decrypted.Bytes())
+	}
+}
+
+func TestEncryptDecryptPasswordFile(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	client, clientCloseFn := newTestRPCClient(t, service, env, "")
+	defer clientCloseFn()
+
+	b := []byte("test message")
+	inPath := keys.RandTempPath("")
+	outPath := inPath + ".enc"
+	decryptedPath := inPath + ".dec"
+	defer os.Remove(inPath)
+	defer os.Remove(outPath)
+	defer os.Remove(decryptedPath)
+
+	err := ioutil.WriteFile(inPath, b, 0644)
+	require.NoError(t, err)
+
+	err = encryptFile(client, nil, "", true, PasswordV1, "testpassword", inPath, outPath)
+	require.NoError(t, err)
+
+	start, err := readFileStart(outPath, detectSize)
+	require.NoError(t, err)
+	require.True(t, isPasswordEncrypted(start))
+
+	dec, err := decryptFile(client, false, DefaultEncryptMode, "testpassword", "", outPath, decryptedPath)
+	require.NoError(t, err)
+	require.Nil(t, dec.Sender)
+
+	bout, err := ioutil.ReadFile(decryptedPath)
+	require.NoError(t, err)
+	require.Equal(t, b, bout)
+}
+
+func TestEncryptDecryptAge(t *testing.T) {
+	env := newTestEnv(t)
+	aliceService, aliceCloseFn := newTestService(t, env, "")
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	testImportID(t, aliceService, bob.ID())
+
+	bobService, bobCloseFn := newTestService(t, env, "")
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+
+	bobRecipient, err := ageRecipient(bob.X25519Key().PublicKey())
+	require.NoError(t, err)
+
+	message := "Hey bob"
+	for _, recipient := range []string{bob.ID().String(), bobRecipient} {
+		for _, armored := range []bool{true, false} {
+			encryptResp, err := aliceService.Encrypt(context.TODO(), &EncryptRequest{
+				Data:       []byte(message),
+				Recipients: []string{recipient},
+				Mode:       AgeEncryptMode,
+				Armored:    armored,
+			})
+			require.NoError(t, err)
+			require.True(t, isAgeEncrypted(encryptResp.Data))
+
+			// Autodetect
+			decryptResp, err := bobService.Decrypt(context.TODO(), &DecryptRequest{
+				Data: encryptResp.Data,
+			})
+			require.NoError(t, err)
+			require.Equal(t, message, string(decryptResp.Data))
+			require.Nil(t, decryptResp.Sender)
+
+			_, err = aliceService.Decrypt(context.TODO(), &DecryptRequest{
+				Data: encryptResp.Data,
+			})
+			require.EqualError(t, err, "no key found for age recipients")
+		}
+	}
+
+	_, err = aliceService.Encrypt(context.TODO(), &EncryptRequest{
+		Data:       []byte(message),
+		Recipients: []string{bob.ID().String()},
+		Sender:     alice.ID().String(),
+		Mode:       AgeEncryptMode,
+	})
+	require.EqualError(t, err, "sender isn't supported with age mode")
+
+	plaintext := bytes.Repeat([]byte{0x31}, (1024*1024)+5)
+	for _, armored := range []bool{true, false} {
+		encrypted, err := testEncryptStream(t, env, aliceService, plaintext, "", []string{bob.ID().String()}, AgeEncryptMode, armored)
+		require.NoError(t, err)
+		require.True(t, isAgeEncrypted(encrypted))
+		out, sender, err := testDecryptStream(t, env, bobService, encrypted, AgeEncryptMode, armored)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, out)
+		require.Nil(t, sender)
+	}
+}
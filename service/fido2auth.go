@@ -0,0 +1,294 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/keyring"
+	"github.com/keys-pub/keys/util"
+	"github.com/keys-pub/keysd/fido2"
+	"github.com/keys-pub/keysd/fido2/virtual"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The keyring key is derived from the password (see auth.verifyPassword).
+// To unlock with a FIDO2 authenticator, the keyring key is encrypted with the
+// hmac-secret from a credential on the authenticator. The encrypted key,
+// credential ID and salt are saved in the keyring store as hidden items
+// (.auth-{id}), so they are available while locked. Any number of
+// authenticators can be provisioned, and the password always works.
+
+const fido2AuthRPID = "keys.pub"
+const authProvisionPrefix = ".auth-"
+
+type authProvision struct {
+	ID        string    `json:"id"`
+	Type      AuthType  `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FIDO2
+	CredID       []byte `json:"credId,omitempty"`
+	Salt         []byte `json:"salt,omitempty"`
+	AAGUID       []byte `json:"aaguid,omitempty"`
+	PIN          bool   `json:"pin,omitempty"`
+	EncryptedKey []byte `json:"ek,omitempty"`
+}
+
+func (p *authProvision) rpc() *AuthProvision {
+	return &AuthProvision{
+		ID:        p.ID,
+		Type:      p.Type,
+		AAGUID:    p.AAGUID,
+		PIN:       p.PIN,
+		CreatedAt: util.TimeToMillis(p.CreatedAt),
+	}
+}
+
+func (a *auth) provisions() ([]*authProvision, error) {
+	service := keyringService(a.cfg)
+	ids, err := a.st.IDs(service, authProvisionPrefix, true, false)
+	if err != nil {
+		return nil, err
+	}
+	provisions := make([]*authProvision, 0, len(ids))
+	for _, id := range ids {
+		b, err := a.st.Get(service, id)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil {
+			continue
+		}
+		var provision authProvision
+		if err := json.Unmarshal(b, &provision); err != nil {
+			return nil, errors.Errorf("failed to get auth provision")
+		}
+		provisions = append(provisions, &provision)
+	}
+	return provisions, nil
+}
+
+func (a *auth) saveProvision(provision *authProvision) error {
+	b, err := json.Marshal(provision)
+	if err != nil {
+		return err
+	}
+	return a.st.Set(keyringService(a.cfg), authProvisionPrefix+provision.ID, b, "")
+}
+
+func (a *auth) deprovision(id string) (bool, error) {
+	if id == "" || strings.Contains(id, "/") {
+		return false, errors.Errorf("invalid id")
+	}
+	return a.st.Delete(keyringService(a.cfg), authProvisionPrefix+id)
+}
+
+// provisionFIDO2 makes a hmac-secret credential on the device and saves the
+// keyring key, encrypted with the hmac-secret. Requires unlock.
+func (a *auth) provisionFIDO2(ctx context.Context, fas fido2.AuthenticatorsServer, device string, pin string, createdAt time.Time) (*authProvision, error) {
+	a.Mutex.Lock()
+	key := a.key
+	a.Mutex.Unlock()
+	if key == nil {
+		return nil, keyring.ErrLocked
+	}
+	if device == "" {
+		return nil, errors.Errorf("no device specified")
+	}
+
+	infoResp, err := fas.DeviceInfo(ctx, &fido2.DeviceInfoRequest{Device: device})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFIDO2Hardware(a.cfg, device, infoResp.Info.AAGUID); err != nil {
+		return nil, err
+	}
+	if !hasString(infoResp.Info.Extensions, "hmac-secret") {
+		return nil, errors.Errorf("device doesn't support hmac-secret")
+	}
+
+	logger.Infof("Provisioning FIDO2 hmac-secret (%s)...", device)
+	cdh := keys.RandBytes(32)
+	credResp, err := fas.MakeCredential(ctx, &fido2.MakeCredentialRequest{
+		Device:         device,
+		ClientDataHash: cdh,
+		RP:             &fido2.RelyingParty{ID: fido2AuthRPID, Name: "keys.pub"},
+		User:           &fido2.User{ID: keys.RandBytes(16), Name: a.cfg.AppName()},
+		Type:           "es256",
+		PIN:            pin,
+		Extensions:     []string{"hmac-secret"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	credID := credResp.Attestation.CredID
+
+	salt := keys.RandBytes(32)
+	secret, err := fido2HMACSecret(ctx, fas, device, credID, salt, pin)
+	if err != nil {
+		return nil, err
+	}
+
+	provision := &authProvision{
+		ID:           encoding.MustEncode(keys.RandBytes(16), encoding.Base62),
+		Type:         FIDO2HMACSecretAuth,
+		CreatedAt:    createdAt,
+		CredID:       credID,
+		Salt:         salt,
+		AAGUID:       infoResp.Info.AAGUID,
+		PIN:          pin != "",
+		EncryptedKey: keys.SecretBoxSeal(key[:], secret),
+	}
+	if err := a.saveProvision(provision); err != nil {
+		return nil, err
+	}
+	return provision, nil
+}
+
+// unlockFIDO2 unlocks with a provisioned credential on the device.
+func (a *auth) unlockFIDO2(ctx context.Context, fas fido2.AuthenticatorsServer, device string, pin string, client string) (string, keyring.Auth, error) {
+	logger.Infof("Unlock (FIDO2)")
+	if device == "" {
+		return "", nil, errors.Errorf("no device specified")
+	}
+	provisions, err := a.provisions()
+	if err != nil {
+		return "", nil, err
+	}
+
+	found := 0
+	pinRequired := false
+	for _, provision := range provisions {
+		if provision.Type != FIDO2HMACSecretAuth {
+			continue
+		}
+		found++
+		if provision.PIN && pin == "" {
+			pinRequired = true
+			continue
+		}
+		p := ""
+		if provision.PIN {
+			p = pin
+		}
+		secret, err := fido2HMACSecret(ctx, fas, device, provision.CredID, provision.Salt, p)
+		if err != nil {
+			if isFIDO2NoCredentials(err) {
+				continue
+			}
+			return "", nil, err
+		}
+		b, err := keys.SecretBoxOpen(provision.EncryptedKey, secret)
+		if err != nil || len(b) != 32 {
+			continue
+		}
+		auth := keyring.NewKeyAuth(keys.Bytes32(b))
+		if err := a.keyring.Unlock(auth); err != nil {
+			return "", nil, errors.Wrapf(err, "failed to unlock")
+		}
+		token := a.unlocked(auth, client)
+		return token, auth, nil
+	}
+
+	if found == 0 {
+		return "", nil, status.Error(codes.Unauthenticated, "no FIDO2 authenticators provisioned")
+	}
+	if pinRequired {
+		return "", nil, status.Error(codes.Unauthenticated, "pin required")
+	}
+	return "", nil, status.Error(codes.Unauthenticated, "no provisioned credential on device")
+}
+
+func fido2HMACSecret(ctx context.Context, fas fido2.AuthenticatorsServer, device string, credID []byte, salt []byte, pin string) (*[32]byte, error) {
+	resp, err := fas.Assertion(ctx, &fido2.AssertionRequest{
+		Device:         device,
+		RPID:           fido2AuthRPID,
+		ClientDataHash: keys.RandBytes(32),
+		CredID:         credID,
+		PIN:            pin,
+		Extensions:     []string{"hmac-secret"},
+		HMACSalt:       salt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Assertion.HMACSecret) != 32 {
+		return nil, errors.Errorf("invalid hmac-secret")
+	}
+	return keys.Bytes32(resp.Assertion.HMACSecret), nil
+}
+
+// isFIDO2NoCredentials returns true if the device doesn't have the credential.
+func isFIDO2NoCredentials(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// checkFIDO2Hardware returns an error if the device is the virtual (software)
+// authenticator, unless fido2Insecure is set.
+func checkFIDO2Hardware(cfg *Config, device string, aaguid []byte) error {
+	if bytes.Equal(aaguid, virtual.AAGUID) && !cfg.FIDO2Insecure() {
+		return errors.Errorf("device %s is not a hardware authenticator", device)
+	}
+	return nil
+}
+
+func hasString(strs []string, s string) bool {
+	for _, e := range strs {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthProvision (RPC) provisions an auth method (FIDO2 hmac-secret) to unlock
+// with.
+func (s *service) AuthProvision(ctx context.Context, req *AuthProvisionRequest) (*AuthProvisionResponse, error) {
+	if req.Type != FIDO2HMACSecretAuth {
+		return nil, errors.Errorf("unsupported auth type %s", req.Type)
+	}
+	if s.fido2 == nil {
+		return nil, errors.Errorf("fido2 is not available")
+	}
+	provision, err := s.auth.provisionFIDO2(ctx, s.fido2, req.Device, req.PIN, s.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &AuthProvisionResponse{
+		Provision: provision.rpc(),
+	}, nil
+}
+
+// AuthDeprovision (RPC) removes a provisioned auth method.
+func (s *service) AuthDeprovision(ctx context.Context, req *AuthDeprovisionRequest) (*AuthDeprovisionResponse, error) {
+	ok, err := s.auth.deprovision(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("auth provision %s not found", req.ID)
+	}
+	return &AuthDeprovisionResponse{}, nil
+}
+
+// AuthProvisions (RPC) lists provisioned auth methods.
+func (s *service) AuthProvisions(ctx context.Context, req *AuthProvisionsRequest) (*AuthProvisionsResponse, error) {
+	provisions, err := s.auth.provisions()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*AuthProvision, 0, len(provisions))
+	for _, provision := range provisions {
+		out = append(out, provision.rpc())
+	}
+	return &AuthProvisionsResponse{
+		Provisions: out,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keysd/fido2"
+	"github.com/keys-pub/keysd/fido2/virtual"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthFIDO2(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	ctx := context.TODO()
+
+	_, err := service.AuthSetup(ctx, &AuthSetupRequest{Password: "testpassword", Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath})
+	require.EqualError(t, err, "fido2 is not available")
+
+	fas, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+	service.fido2 = fas
+
+	// Virtual (software) authenticator is refused, and setup is rolled back
+	_, err = service.AuthSetup(ctx, &AuthSetupRequest{Password: "testpassword", Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath})
+	require.EqualError(t, err, "device "+virtual.DevicePath+" is not a hardware authenticator")
+	setupNeeded, err := service.isAuthSetupNeeded()
+	require.NoError(t, err)
+	require.True(t, setupNeeded)
+	require.True(t, service.auth.locked())
+
+	service.cfg.SetBool(fido2InsecureKey, true)
+
+	// Setup (password and FIDO2)
+	_, err = service.AuthSetup(ctx, &AuthSetupRequest{Password: "testpassword", Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath})
+	require.NoError(t, err)
+	testImportKey(t, service, alice)
+
+	provisionsResp, err := service.AuthProvisions(ctx, &AuthProvisionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(provisionsResp.Provisions))
+	first := provisionsResp.Provisions[0]
+	require.Equal(t, FIDO2HMACSecretAuth, first.Type)
+	require.Equal(t, virtual.AAGUID, first.AAGUID)
+	require.False(t, first.PIN)
+
+	testAuthLock(t, service)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath})
+	require.NoError(t, err)
+	testKeyExists(t, service, alice.ID().String())
+
+	// Provision with PIN
+	_, err = fas.SetPIN(ctx, &fido2.SetPINRequest{Device: virtual.DevicePath, PIN: "12345"})
+	require.NoError(t, err)
+	provisionResp, err := service.AuthProvision(ctx, &AuthProvisionRequest{Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath, PIN: "12345"})
+	require.NoError(t, err)
+	require.True(t, provisionResp.Provision.PIN)
+
+	_, err = service.AuthDeprovision(ctx, &AuthDeprovisionRequest{ID: first.ID})
+	require.NoError(t, err)
+	_, err = service.AuthDeprovision(ctx, &AuthDeprovisionRequest{ID: first.ID})
+	require.EqualError(t, err, "auth provision "+first.ID+" not found")
+	provisionsResp, err = service.AuthProvisions(ctx, &AuthProvisionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(provisionsResp.Provisions))
+
+	testAuthLock(t, service)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath})
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = pin required")
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath, PIN: "00000"})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = pin invalid")
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath, PIN: "12345"})
+	require.NoError(t, err)
+	testKeyExists(t, service, alice.ID().String())
+
+	// Password (fallback)
+	testAuthLock(t, service)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{Password: "testpassword"})
+	require.NoError(t, err)
+	testKeyExists(t, service, alice.ID().String())
+
+	// Reset authenticator
+	testAuthLock(t, service)
+	_, err = fas.Reset(ctx, &fido2.ResetRequest{Device: virtual.DevicePath})
+	require.NoError(t, err)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{Type: FIDO2HMACSecretAuth, Device: virtual.DevicePath, PIN: "12345"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = pin not set")
+}
+
+func testAuthLock(t *testing.T, service *service) {
+	_, err := service.AuthLock(context.TODO(), &AuthLockRequest{})
+	require.NoError(t, err)
+}
+
+func testKeyExists(t *testing.T, service *service, kid string) {
+	resp, err := service.Key(context.TODO(), &KeyRequest{Identity: kid})
+	require.NoError(t, err)
+	require.Equal(t, kid, resp.Key.ID)
+}
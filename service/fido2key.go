@@ -0,0 +1,578 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/keyring"
+	"github.com/keys-pub/keysd/fido2"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/pkg/errors"
+)
+
+// FIDO2 keys are signing keys whose private key is a resident (ES256 or EdDSA)
+// credential on a FIDO2 authenticator, and never leaves the device.
+//
+// A signature (FIDO2SignFormat) is an assertion, where the client data hash is
+// the SHA-256 of fido2SigContext and the SHA-512 digest of the data. The
+// signature is text:
+//
+//   -----BEGIN KEYS FIDO2 SIGNATURE-----
+//   kfe1... (base64 auth data) (base64 signature)
+//   -----END KEYS FIDO2 SIGNATURE-----
+//
+// The key ID is the public key (compressed for ES256), so a signature can be
+// verified from the ID alone. The keyring item for the key has the credential
+// ID, to find the credential on a device when signing.
+//
+// A FIDO2 key is linked to a sigchain with a fido2-key statement, which
+// includes a signature by the FIDO2 key of the sigchain KID (consent), like
+// device statements.
+
+const (
+	fido2ES256KeyHRP      = "kfe"
+	fido2EdDSAKeyHRP      = "kfd"
+	fido2SignRPID         = "keys.pub"
+	fido2SigContext       = "keys.pub/fido2sig/v1\x00"
+	fido2SigBegin         = "-----BEGIN KEYS FIDO2 SIGNATURE-----"
+	fido2SigEnd           = "-----END KEYS FIDO2 SIGNATURE-----"
+	fido2KeyStatementType = "fido2-key"
+)
+
+// FIDO2 key types.
+const (
+	fido2ES256 keys.KeyType = "fido2-es256"
+	fido2EdDSA keys.KeyType = "fido2-eddsa"
+)
+
+type fido2SignKey struct {
+	id  keys.ID
+	typ keys.KeyType
+	// publicKey as libfido2 returns it (x||y for es256).
+	publicKey []byte
+
+	// Credential (if saved)
+	CredID []byte `json:"credId"`
+	AAGUID []byte `json:"aaguid,omitempty"`
+}
+
+var _ keys.Key = &fido2SignKey{}
+
+func (k *fido2SignKey) ID() keys.ID {
+	return k.id
+}
+
+func (k *fido2SignKey) Type() keys.KeyType {
+	return k.typ
+}
+
+func (k *fido2SignKey) Bytes() []byte {
+	return k.publicKey
+}
+
+// credType is the FIDO2 credential type (es256, eddsa).
+func (k *fido2SignKey) credType() string {
+	if k.typ == fido2EdDSA {
+		return "eddsa"
+	}
+	return "es256"
+}
+
+func isFIDO2KeyType(t keys.KeyType) bool {
+	return t == fido2ES256 || t == fido2EdDSA
+}
+
+// newFIDO2Key returns a (public) FIDO2 key for a credential type and public
+// key.
+func newFIDO2Key(credType string, pk []byte) (*fido2SignKey, error) {
+	switch credType {
+	case "es256":
+		if len(pk) != 64 {
+			return nil, errors.Errorf("invalid es256 public key")
+		}
+		// Compressed point
+		b := append([]byte{0x02 | (pk[63] & 0x01)}, pk[:32]...)
+		kid, err := keys.NewID(fido2ES256KeyHRP, b)
+		if err != nil {
+			return nil, err
+		}
+		return &fido2SignKey{id: kid, typ: fido2ES256, publicKey: pk}, nil
+	case "eddsa":
+		if len(pk) != 32 {
+			return nil, errors.Errorf("invalid eddsa public key")
+		}
+		kid, err := keys.NewID(fido2EdDSAKeyHRP, pk)
+		if err != nil {
+			return nil, err
+		}
+		return &fido2SignKey{id: kid, typ: fido2EdDSA, publicKey: pk}, nil
+	default:
+		return nil, errors.Errorf("unsupported FIDO2 key type %s", credType)
+	}
+}
+
+// isFIDO2KeyID returns true if the ID is a FIDO2 key.
+func isFIDO2KeyID(kid keys.ID) bool {
+	hrp, _, err := kid.Decode()
+	if err != nil {
+		return false
+	}
+	return hrp == fido2ES256KeyHRP || hrp == fido2EdDSAKeyHRP
+}
+
+// newFIDO2KeyFromID returns the (public) FIDO2 key from an ID.
+func newFIDO2KeyFromID(kid keys.ID) (*fido2SignKey, error) {
+	hrp, b, err := kid.Decode()
+	if err != nil {
+		return nil, err
+	}
+	switch hrp {
+	case fido2ES256KeyHRP:
+		if len(b) != 33 || (b[0] != 0x02 && b[0] != 0x03) {
+			return nil, errors.Errorf("invalid FIDO2 key %s", kid)
+		}
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), b)
+		if x == nil {
+			return nil, errors.Errorf("invalid FIDO2 key %s", kid)
+		}
+		pk := append(padBytes(x.Bytes(), 32), padBytes(y.Bytes(), 32)...)
+		return &fido2SignKey{id: kid, typ: fido2ES256, publicKey: pk}, nil
+	case fido2EdDSAKeyHRP:
+		if len(b) != 32 {
+			return nil, errors.Errorf("invalid FIDO2 key %s", kid)
+		}
+		return &fido2SignKey{id: kid, typ: fido2EdDSA, publicKey: b}, nil
+	default:
+		return nil, errors.Errorf("not a FIDO2 key %s", kid)
+	}
+}
+
+func padBytes(b []byte, n int) []byte {
+	if len(b) >= n {
+		return b
+	}
+	return append(make([]byte, n-len(b)), b...)
+}
+
+// keyIDType returns the public key type for an ID, including FIDO2 keys.
+func keyIDType(kid keys.ID) keys.KeyType {
+	if isFIDO2KeyID(kid) {
+		k, err := newFIDO2KeyFromID(kid)
+		if err != nil {
+			return ""
+		}
+		return k.typ
+	}
+	return kid.PublicKeyType()
+}
+
+func fido2KeyForItem(item *keyring.Item) (*fido2SignKey, error) {
+	if !isFIDO2KeyType(keys.KeyType(item.Type)) {
+		return nil, nil
+	}
+	key, err := newFIDO2KeyFromID(keys.ID(item.ID))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(item.Data, key); err != nil {
+		return nil, errors.Errorf("invalid FIDO2 key item")
+	}
+	return key, nil
+}
+
+// fido2SignKey returns a saved FIDO2 key, or nil if not found.
+func (s *service) fido2SignKey(kid keys.ID) (*fido2SignKey, error) {
+	item, err := s.ks.Keyring().Get(kid.String())
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, nil
+	}
+	return fido2KeyForItem(item)
+}
+
+// fido2SignKeys returns saved FIDO2 keys, of types (if specified).
+func (s *service) fido2SignKeys(types []keys.KeyType) ([]*fido2SignKey, error) {
+	itemTypes := []string{}
+	for _, t := range []keys.KeyType{fido2ES256, fido2EdDSA} {
+		if len(types) == 0 || keyTypesContain(types, t) {
+			itemTypes = append(itemTypes, string(t))
+		}
+	}
+	if len(itemTypes) == 0 {
+		return []*fido2SignKey{}, nil
+	}
+	items, err := s.ks.Keyring().List(&keyring.ListOpts{Types: itemTypes})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*fido2SignKey, 0, len(items))
+	for _, item := range items {
+		key, err := fido2KeyForItem(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, key)
+	}
+	return out, nil
+}
+
+func keyTypesContain(types []keys.KeyType, t keys.KeyType) bool {
+	for _, typ := range types {
+		if typ == t {
+			return true
+		}
+	}
+	return false
+}
+
+// generateFIDO2Key makes a resident credential on the device and saves it as
+// a FIDO2 key.
+func (s *service) generateFIDO2Key(ctx context.Context, typ keys.KeyType, device string, pin string) (*fido2SignKey, error) {
+	if s.fido2 == nil {
+		return nil, errors.Errorf("fido2 is not available")
+	}
+	if device == "" {
+		return nil, errors.Errorf("no device specified")
+	}
+	credType := "es256"
+	if typ == fido2EdDSA {
+		credType = "eddsa"
+	}
+
+	infoResp, err := s.fido2.DeviceInfo(ctx, &fido2.DeviceInfoRequest{Device: device})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFIDO2Hardware(s.cfg, device, infoResp.Info.AAGUID); err != nil {
+		return nil, err
+	}
+
+	logger.Infof("Generating FIDO2 key (%s, %s)...", credType, device)
+	cdh := sha256.Sum256(keys.RandBytes(32))
+	resp, err := s.fido2.MakeCredential(ctx, &fido2.MakeCredentialRequest{
+		Device:         device,
+		ClientDataHash: cdh[:],
+		RP:             &fido2.RelyingParty{ID: fido2SignRPID, Name: "keys.pub"},
+		User:           &fido2.User{ID: keys.RandBytes(16), Name: "signing key"},
+		Type:           credType,
+		PIN:            pin,
+		RK:             "true",
+	})
+	if err != nil {
+		return nil, err
+	}
+	verified, err := fido2.VerifyAttestation(ctx, &fido2.VerifyAttestationRequest{
+		Attestation: resp.Attestation,
+		RPID:        fido2SignRPID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid attestation")
+	}
+
+	key, err := newFIDO2Key(verified.AuthData.CredType, verified.AuthData.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	key.CredID = verified.AuthData.CredID
+	key.AAGUID = verified.AuthData.AAGUID
+
+	b, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	item := keyring.NewItem(key.ID().String(), b, string(key.Type()), s.Now())
+	if err := s.ks.Keyring().Create(item); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// parseFIDO2Signer returns a saved FIDO2 key to sign with.
+func (s *service) parseFIDO2Signer(ctx context.Context, signer string) (*fido2SignKey, error) {
+	if signer == "" {
+		return nil, errors.Errorf("no signer specified")
+	}
+	kid, err := keys.ParseID(signer)
+	if err != nil {
+		return nil, err
+	}
+	if !isFIDO2KeyID(kid) {
+		return nil, errors.Errorf("signer %s isn't a FIDO2 key", kid)
+	}
+	key, err := s.fido2SignKey(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, keys.NewErrNotFound(kid.String())
+	}
+	return key, nil
+}
+
+func fido2ClientDataHash(h []byte) []byte {
+	cdh := sha256.Sum256(append([]byte(fido2SigContext), h...))
+	return cdh[:]
+}
+
+// fido2SignHash signs a (SHA-512) hash with a FIDO2 key, using the first
+// device that has the credential.
+func (s *service) fido2SignHash(ctx context.Context, key *fido2SignKey, h []byte) ([]byte, error) {
+	if s.fido2 == nil {
+		return nil, errors.Errorf("fido2 is not available")
+	}
+	devices, err := s.fido2.Devices(ctx, &fido2.DevicesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	cdh := fido2ClientDataHash(h)
+	for _, device := range devices.Devices {
+		resp, err := s.fido2.Assertion(ctx, &fido2.AssertionRequest{
+			Device:         device.Path,
+			RPID:           fido2SignRPID,
+			ClientDataHash: cdh,
+			CredID:         key.CredID,
+		})
+		if err != nil {
+			if isFIDO2NoCredentials(err) {
+				continue
+			}
+			return nil, err
+		}
+		return encodeFIDO2Sig(key.ID(), resp.Assertion.AuthData, resp.Assertion.Sig), nil
+	}
+	return nil, errors.Errorf("no device found for %s", key.ID())
+}
+
+func (s *service) fido2Sign(ctx context.Context, key *fido2SignKey, reader io.Reader) ([]byte, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, reader); err != nil {
+		return nil, err
+	}
+	return s.fido2SignHash(ctx, key, h.Sum(nil))
+}
+
+type fido2Sig struct {
+	KID      keys.ID
+	AuthData []byte
+	Sig      []byte
+}
+
+// isFIDO2Sig returns true if the signature is a FIDO2 signature.
+func isFIDO2Sig(b []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(b, " \t\r\n"), []byte(fido2SigBegin))
+}
+
+func encodeFIDO2Sig(kid keys.ID, authData []byte, sig []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(fido2SigBegin + "\n")
+	buf.WriteString(kid.String() + " " + base64.StdEncoding.EncodeToString(authData) + " " + base64.StdEncoding.EncodeToString(sig) + "\n")
+	buf.WriteString(fido2SigEnd + "\n")
+	return buf.Bytes()
+}
+
+func parseFIDO2Sig(b []byte) (*fido2Sig, error) {
+	if !isFIDO2Sig(b) {
+		return nil, errors.Errorf("invalid FIDO2 signature")
+	}
+	var out *fido2Sig
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	begin, end := false, false
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case !begin:
+			begin = line == fido2SigBegin
+			continue
+		case end:
+			return nil, errors.Errorf("invalid FIDO2 signature (data after end)")
+		case line == fido2SigEnd:
+			end = true
+			continue
+		case out != nil:
+			return nil, errors.Errorf("invalid FIDO2 signature (multiple signatures)")
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, errors.Errorf("invalid FIDO2 signature line")
+		}
+		kid, err := keys.ParseID(fields[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid FIDO2 signature kid")
+		}
+		authData, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid FIDO2 signature auth data")
+		}
+		sig, err := base64.StdEncoding.DecodeString(fields[2])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid FIDO2 signature")
+		}
+		out = &fido2Sig{KID: kid, AuthData: authData, Sig: sig}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !end {
+		return nil, errors.Errorf("invalid FIDO2 signature (missing end)")
+	}
+	if out == nil {
+		return nil, errors.Errorf("no signatures")
+	}
+	return out, nil
+}
+
+// fido2VerifyHash verifies a FIDO2 signature of a (SHA-512) hash, returning
+// the signer.
+func fido2VerifyHash(ctx context.Context, b []byte, h []byte) (keys.ID, error) {
+	sig, err := parseFIDO2Sig(b)
+	if err != nil {
+		return "", err
+	}
+	key, err := newFIDO2KeyFromID(sig.KID)
+	if err != nil {
+		return "", err
+	}
+	if _, err := fido2.VerifyAssertion(ctx, &fido2.VerifyAssertionRequest{
+		Assertion:      &fido2.Assertion{AuthData: sig.AuthData, Sig: sig.Sig},
+		RPID:           fido2SignRPID,
+		ClientDataHash: fido2ClientDataHash(h),
+		CredType:       key.credType(),
+		PubKey:         key.publicKey,
+	}); err != nil {
+		return "", errors.Wrapf(err, "invalid signature from %s", sig.KID)
+	}
+	return sig.KID, nil
+}
+
+func fido2Verify(ctx context.Context, sig []byte, reader io.Reader) (keys.ID, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, reader); err != nil {
+		return "", err
+	}
+	return fido2VerifyHash(ctx, sig, h.Sum(nil))
+}
+
+type fido2SignWriter struct {
+	ctx    context.Context
+	s      *service
+	w      io.Writer
+	key    *fido2SignKey
+	hash   hash.Hash
+	closed bool
+}
+
+// newFIDO2SignWriter returns a writer that outputs the FIDO2 signature on
+// Close.
+func (s *service) newFIDO2SignWriter(ctx context.Context, w io.Writer, key *fido2SignKey) io.WriteCloser {
+	return &fido2SignWriter{
+		ctx:  ctx,
+		s:    s,
+		w:    w,
+		key:  key,
+		hash: sha512.New(),
+	}
+}
+
+func (f *fido2SignWriter) Write(p []byte) (int, error) {
+	if f.closed {
+		return 0, errors.Errorf("write after close")
+	}
+	return f.hash.Write(p)
+}
+
+func (f *fido2SignWriter) Close() error {
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	out, err := f.s.fido2SignHash(f.ctx, f.key, f.hash.Sum(nil))
+	if err != nil {
+		return err
+	}
+	_, err = f.w.Write(out)
+	return err
+}
+
+func fido2LinkMessage(kid keys.ID) []byte {
+	return []byte("fido2-link:" + kid.String())
+}
+
+// newFIDO2KeyStatement creates a sigchain statement linking a FIDO2 key.
+func (s *service) newFIDO2KeyStatement(ctx context.Context, sc *keys.Sigchain, linkKID string, sk *keys.EdX25519Key) (*keys.Statement, error) {
+	key, err := s.parseFIDO2Signer(ctx, linkKID)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := s.fido2Sign(ctx, key, bytes.NewReader(fido2LinkMessage(sc.KID())))
+	if err != nil {
+		return nil, err
+	}
+	link := &api.KeyLink{
+		KID: key.ID(),
+		Sig: sig,
+	}
+	b, err := json.Marshal(link)
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewSigchainStatement(sc, b, sk, fido2KeyStatementType, s.Now())
+}
+
+// verifyFIDO2KeyStatement checks the data of a fido2-key statement.
+func verifyFIDO2KeyStatement(ctx context.Context, st *keys.Statement) (*api.KeyLink, error) {
+	var link api.KeyLink
+	if err := json.Unmarshal(st.Data, &link); err != nil {
+		return nil, errors.Wrapf(err, "invalid key link")
+	}
+	if !isFIDO2KeyID(link.KID) {
+		return nil, errors.Errorf("invalid key link: not a FIDO2 key")
+	}
+	h := sha512.Sum512(fido2LinkMessage(st.KID))
+	signer, err := fido2VerifyHash(ctx, link.Sig, h[:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid FIDO2 key signature")
+	}
+	if signer != link.KID {
+		return nil, errors.Errorf("invalid FIDO2 key signature, signed by %s", signer)
+	}
+	return &link, nil
+}
+
+func (s *service) fido2SignWriteInOut(ctx context.Context, in string, out string, key *fido2SignKey) error {
+	logger.Infof("Signing (fido2) %s to %s", in, out)
+	inFile, err := os.Open(in) // #nosec
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = inFile.Close()
+	}()
+	sig, err := s.fido2Sign(ctx, key, bufio.NewReader(inFile))
+	if err != nil {
+		return err
+	}
+	outTmp := out + ".tmp"
+	if err := ioutil.WriteFile(outTmp, sig, 0600); err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(outTmp)
+	}()
+	return os.Rename(outTmp, out)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keysd/fido2/virtual"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFIDO2KeySignVerify(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	ctx := context.TODO()
+
+	fas, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+	service.fido2 = fas
+
+	_, err = service.KeyGenerate(ctx, &KeyGenerateRequest{Type: FIDO2ES256, Device: virtual.DevicePath})
+	require.EqualError(t, err, "device "+virtual.DevicePath+" is not a hardware authenticator")
+	service.cfg.SetBool(fido2InsecureKey, true)
+
+	for _, typ := range []KeyType{FIDO2ES256, FIDO2EdDSA} {
+		genResp, err := service.KeyGenerate(ctx, &KeyGenerateRequest{Type: typ, Device: virtual.DevicePath})
+		require.NoError(t, err)
+		kid := keys.ID(genResp.KID)
+		require.True(t, isFIDO2KeyID(kid))
+
+		keysResp, err := service.Keys(ctx, &KeysRequest{})
+		require.NoError(t, err)
+		found := false
+		for _, key := range keysResp.Keys {
+			if key.ID == kid.String() {
+				require.Equal(t, typ, key.Type)
+				found = true
+			}
+		}
+		require.True(t, found)
+
+		message := []byte("I'm a FIDO2 key")
+		signResp, err := service.Sign(ctx, &SignRequest{Data: message, Signer: kid.String(), Format: FIDO2SignFormat})
+		require.NoError(t, err)
+		require.Equal(t, kid.String(), signResp.KID)
+
+		// Format is auto-detected
+		verifyResp, err := service.VerifyDetached(ctx, &VerifyDetachedRequest{Data: message, Sig: signResp.Data})
+		require.NoError(t, err)
+		require.Equal(t, kid.String(), verifyResp.Signer.ID)
+
+		_, err = service.VerifyDetached(ctx, &VerifyDetachedRequest{Data: []byte("I'm not"), Sig: signResp.Data})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid signature from "+kid.String())
+
+		// Link to alice
+		_, err = service.StatementCreate(ctx, &StatementCreateRequest{KID: alice.ID().String(), Type: fido2KeyStatementType, LinkKID: kid.String(), Local: true})
+		require.NoError(t, err)
+		owner, err := service.keyOwner(ctx, kid)
+		require.NoError(t, err)
+		require.Equal(t, alice.ID(), owner)
+	}
+
+	_, err = service.Sign(ctx, &SignRequest{Data: []byte("hi"), Signer: alice.ID().String(), Format: FIDO2SignFormat})
+	require.EqualError(t, err, "signer "+alice.ID().String()+" isn't a FIDO2 key")
+}
+
+func TestFIDO2KeyID(t *testing.T) {
+	fas, err := virtual.NewAuthenticatorsServer("")
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	for _, typ := range []keys.KeyType{fido2ES256, fido2EdDSA} {
+		env := newTestEnv(t)
+		service, closeFn := newTestService(t, env, "")
+		testAuthSetup(t, service)
+		service.fido2 = fas
+		service.cfg.SetBool(fido2InsecureKey, true)
+
+		key, err := service.generateFIDO2Key(ctx, typ, virtual.DevicePath, "")
+		require.NoError(t, err)
+		out, err := newFIDO2KeyFromID(key.ID())
+		require.NoError(t, err)
+		require.Equal(t, key.ID(), out.ID())
+		require.Equal(t, key.Type(), out.Type())
+		require.Equal(t, key.Bytes(), out.Bytes())
+		closeFn()
+	}
+
+	_, err = newFIDO2KeyFromID(alice.ID())
+	require.Error(t, err)
+
+	// X not on the curve
+	invalid, err := keys.NewID(fido2ES256KeyHRP, append([]byte{0x02}, bytes.Repeat([]byte{0xff}, 32)...))
+	require.NoError(t, err)
+	_, err = newFIDO2KeyFromID(invalid)
+	require.EqualError(t, err, "invalid FIDO2 key "+invalid.String())
+}
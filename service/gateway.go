@@ -0,0 +1,381 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// gateway is a HTTP/JSON gateway for the Keys service, for clients that can't
+// easily use gRPC (scripts, browser extensions), on a localhost-only port if
+// Config.GatewayPort is set.
+//
+// Each RPC is at POST /v1/{rpc}, in kebab-case, for example /v1/sign or
+// /v1/key-generate. Unary RPCs take and return a JSON message. Streaming RPCs
+// take and return newline-delimited JSON messages in (chunked) request and
+// response bodies. Since HTTP/1.1 handlers can't write while reading the
+// request, streamed responses are buffered until the request body is read.
+//
+// Requests are authorized with the auth token in the Authorization header,
+// the same as gRPC requests. The Host (and Origin, if any) must be local, so
+// web pages can't make requests.
+type gateway struct {
+	srv     KeysServer
+	auth    *auth
+	unary   map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
+}
+
+func newGateway(srv KeysServer, auth *auth) *gateway {
+	g := &gateway{
+		srv:     srv,
+		auth:    auth,
+		unary:   map[string]grpc.MethodDesc{},
+		streams: map[string]grpc.StreamDesc{},
+	}
+	for _, m := range _Keys_serviceDesc.Methods {
+		g.unary[gatewayPath(m.MethodName)] = m
+	}
+	for _, sd := range _Keys_serviceDesc.Streams {
+		g.streams[gatewayPath(sd.StreamName)] = sd
+	}
+	return g
+}
+
+// gatewayPath returns the path for a RPC, for example SignStream is
+// /v1/sign-stream and SSHAgent is /v1/ssh-agent.
+func gatewayPath(method string) string {
+	rs := []rune(method)
+	var sb strings.Builder
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) {
+			prevLower := unicode.IsLower(rs[i-1])
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if prevLower || (unicode.IsUpper(rs[i-1]) && nextLower) {
+				sb.WriteRune('-')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return "/v1/" + sb.String()
+}
+
+func fullMethod(name string) string {
+	return "/" + _Keys_serviceDesc.ServiceName + "/" + name
+}
+
+// listen for gateway requests on localhost.
+func (g *gateway) listen(port int) (net.Listener, error) {
+	logger.Infof("Listening for gateway requests on port %d", port)
+	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen for gateway")
+	}
+	return lis, nil
+}
+
+func (g *gateway) serve(lis net.Listener) {
+	if err := http.Serve(lis, g); err != nil {
+		logger.Infof("Gateway stopped: %v", err)
+	}
+}
+
+// maxGatewayBodySize is the max size of a unary request, the same as the
+// default max message size for gRPC.
+const maxGatewayBodySize = 4 * 1024 * 1024
+
+// isLocalhost checks the Host header, to prevent DNS rebinding.
+func isLocalhost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// isAllowedOrigin checks the Origin header (if any), so web pages can't make
+// requests. Local pages and browser extensions are allowed.
+func isAllowedOrigin(origin string) bool {
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "chrome-extension", "moz-extension", "safari-web-extension":
+		return true
+	case "http", "https":
+		return isLocalhost(u.Host)
+	default:
+		return false
+	}
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger.Infof("Gateway %s %s", r.Method, r.URL.Path)
+	if !isLocalhost(r.Host) {
+		writeGatewayError(w, status.Errorf(codes.PermissionDenied, "invalid host"))
+		return
+	}
+	if !isAllowedOrigin(r.Header.Get("Origin")) {
+		writeGatewayError(w, status.Errorf(codes.PermissionDenied, "invalid origin"))
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeGatewayError(w, status.Errorf(codes.Unimplemented, "method not allowed"))
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	md := metadata.MD{}
+	if token != "" {
+		md.Set("authorization", token)
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+
+	if m, ok := g.unary[r.URL.Path]; ok {
+		g.serveUnary(ctx, w, r, m)
+		return
+	}
+	if sd, ok := g.streams[r.URL.Path]; ok {
+		g.serveStream(ctx, w, r, sd)
+		return
+	}
+	writeGatewayError(w, status.Errorf(codes.NotFound, "not found"))
+}
+
+func unmarshalJSON(b []byte, m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return errors.Errorf("invalid message type %T", m)
+	}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(b), msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	return nil
+}
+
+func marshalJSON(m interface{}) ([]byte, error) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, errors.Errorf("invalid message type %T", m)
+	}
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *gateway) serveUnary(ctx context.Context, w http.ResponseWriter, r *http.Request, m grpc.MethodDesc) {
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, maxGatewayBodySize+1))
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	if len(b) > maxGatewayBodySize {
+		writeGatewayError(w, status.Errorf(codes.ResourceExhausted, "request too large (greater than %d bytes)", maxGatewayBodySize))
+		return
+	}
+	dec := func(v interface{}) error {
+		return unmarshalJSON(b, v)
+	}
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := g.auth.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	resp, err := m.Handler(g.srv, ctx, dec, interceptor)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	out, err := marshalJSON(resp)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(out)
+}
+
+func (g *gateway) serveStream(ctx context.Context, w http.ResponseWriter, r *http.Request, sd grpc.StreamDesc) {
+	if err := g.auth.authorize(ctx, fullMethod(sd.StreamName)); err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	stream := &gatewayStream{
+		ctx:           ctx,
+		dec:           json.NewDecoder(r.Body),
+		body:          r.Body,
+		w:             w,
+		clientStreams: sd.ClientStreams,
+	}
+	if err := sd.Handler(g.srv, stream); err != nil {
+		if !stream.wrote {
+			writeGatewayError(w, err)
+			return
+		}
+		// We already started the response, so the error is the last message.
+		b, _ := json.Marshal(newGatewayError(err))
+		_ = stream.write(append(b, '\n'))
+		return
+	}
+	_ = stream.flush()
+}
+
+// gatewayStream is a grpc.ServerStream for newline-delimited JSON messages
+// in HTTP request and response bodies.
+type gatewayStream struct {
+	ctx           context.Context
+	dec           *json.Decoder
+	body          io.ReadCloser
+	w             http.ResponseWriter
+	clientStreams bool
+
+	// eof is set once the request body has been read, after which we can
+	// write the response.
+	eof   bool
+	buf   bytes.Buffer
+	wrote bool
+}
+
+var _ grpc.ServerStream = &gatewayStream{}
+
+func (s *gatewayStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *gatewayStream) SetHeader(metadata.MD) error  { return nil }
+func (s *gatewayStream) SendHeader(metadata.MD) error { return nil }
+func (s *gatewayStream) SetTrailer(metadata.MD)       {}
+
+func (s *gatewayStream) RecvMsg(m interface{}) error {
+	if s.eof {
+		return io.EOF
+	}
+	var raw json.RawMessage
+	if err := s.dec.Decode(&raw); err != nil {
+		if err == io.EOF {
+			s.eof = true
+			if err := s.flush(); err != nil {
+				return err
+			}
+			return io.EOF
+		}
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	if err := unmarshalJSON(raw, m); err != nil {
+		return err
+	}
+	if !s.clientStreams {
+		// Only a single request for server streaming.
+		_, _ = io.Copy(ioutil.Discard, s.body)
+		s.eof = true
+	}
+	return nil
+}
+
+func (s *gatewayStream) SendMsg(m interface{}) error {
+	b, err := marshalJSON(m)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	if !s.eof {
+		_, err := s.buf.Write(b)
+		return err
+	}
+	if err := s.flush(); err != nil {
+		return err
+	}
+	return s.write(b)
+}
+
+func (s *gatewayStream) flush() error {
+	if s.buf.Len() == 0 {
+		return nil
+	}
+	b := s.buf.Bytes()
+	s.buf.Reset()
+	return s.write(b)
+}
+
+func (s *gatewayStream) write(b []byte) error {
+	if !s.wrote {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.wrote = true
+	}
+	if _, err := s.w.Write(b); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+type gatewayError struct {
+	Error *RPCError `json:"error"`
+}
+
+func newGatewayError(err error) *gatewayError {
+	st, _ := status.FromError(err)
+	return &gatewayError{Error: &RPCError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}}
+}
+
+func gatewayStatus(err error) int {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.Unimplemented:
+		return http.StatusMethodNotAllowed
+	case codes.ResourceExhausted:
+		return http.StatusRequestEntityTooLarge
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeGatewayError(w http.ResponseWriter, err error) {
+	logger.Infof("Gateway error: %v", err)
+	b, _ := json.Marshal(newGatewayError(err))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(gatewayStatus(err))
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayPath(t *testing.T) {
+	require.Equal(t, "/v1/sign", gatewayPath("Sign"))
+	require.Equal(t, "/v1/sign-stream", gatewayPath("SignStream"))
+	require.Equal(t, "/v1/ssh-agent", gatewayPath("SSHAgent"))
+	require.Equal(t, "/v1/db-migrate", gatewayPath("DBMigrate"))
+	require.Equal(t, "/v1/admin-sign-url", gatewayPath("AdminSignURL"))
+	require.Equal(t, "/v1/verify-detached-file", gatewayPath("VerifyDetachedFile"))
+}
+
+func testGatewayRequest(t *testing.T, srv *httptest.Server, path string, token string, body string) (int, []byte) {
+	req, err := http.NewRequest("POST", srv.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, b
+}
+
+func TestGateway(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	setup, err := service.AuthSetup(context.TODO(), &AuthSetupRequest{Password: "testpassword"})
+	require.NoError(t, err)
+	token := setup.AuthToken
+	testImportKey(t, service, alice)
+
+	srv := httptest.NewServer(newGateway(service, service.auth))
+	defer srv.Close()
+
+	// Unauthorized
+	code, body := testGatewayRequest(t, srv, "/v1/keys", "", "{}")
+	require.Equal(t, http.StatusUnauthorized, code)
+	require.Equal(t, `{"error":{"code":16,"message":"authorization missing"}}`, string(body))
+
+	code, _ = testGatewayRequest(t, srv, "/v1/keys", "badtoken", "{}")
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	// Not found
+	code, _ = testGatewayRequest(t, srv, "/v1/unknown", token, "{}")
+	require.Equal(t, http.StatusNotFound, code)
+
+	// Keys
+	code, body = testGatewayRequest(t, srv, "/v1/keys", token, "")
+	require.Equal(t, http.StatusOK, code)
+	var keysResp KeysResponse
+	err = jsonpb.Unmarshal(bytes.NewReader(body), &keysResp)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keysResp.Keys))
+	require.Equal(t, alice.ID().String(), keysResp.Keys[0].ID)
+
+	// Sign
+	code, body = testGatewayRequest(t, srv, "/v1/sign", token, `{"data":"aGk=","signer":"`+alice.ID().String()+`"}`)
+	require.Equal(t, http.StatusOK, code)
+	var signResp SignResponse
+	err = jsonpb.Unmarshal(bytes.NewReader(body), &signResp)
+	require.NoError(t, err)
+
+	verifyResp, err := service.Verify(context.TODO(), &VerifyRequest{Data: signResp.Data})
+	require.NoError(t, err)
+	require.Equal(t, []byte("hi"), verifyResp.Data)
+
+	// Invalid request
+	code, _ = testGatewayRequest(t, srv, "/v1/sign", token, `{"data":`)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	// Method
+	resp, err := srv.Client().Get(srv.URL + "/v1/keys")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestGatewayStream(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	setup, err := service.AuthSetup(context.TODO(), &AuthSetupRequest{Password: "testpassword"})
+	require.NoError(t, err)
+	token := setup.AuthToken
+	testImportKey(t, service, alice)
+
+	srv := httptest.NewServer(newGateway(service, service.auth))
+	defer srv.Close()
+
+	// Unauthorized
+	code, _ := testGatewayRequest(t, srv, "/v1/sign-stream", "", "")
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	in := `{"signer":"` + alice.ID().String() + `","armored":true}` + "\n" +
+		`{"data":"aGVsbG8g"}` + "\n" +
+		`{"data":"d29ybGQ="}` + "\n"
+	code, body := testGatewayRequest(t, srv, "/v1/sign-stream", token, in)
+	require.Equal(t, http.StatusOK, code)
+
+	var signed []byte
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		var out SignOutput
+		err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), &out)
+		require.NoError(t, err)
+		signed = append(signed, out.Data...)
+	}
+	require.NoError(t, scanner.Err())
+
+	verifyResp, err := service.Verify(context.TODO(), &VerifyRequest{Data: signed, Armored: true})
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello world"), verifyResp.Data)
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+
+	// Error in stream
+	code, body = testGatewayRequest(t, srv, "/v1/sign-stream", token, `{"signer":"invalid"}`+"\n")
+	require.Equal(t, http.StatusInternalServerError, code)
+	var gerr gatewayError
+	err = json.Unmarshal(body, &gerr)
+	require.NoError(t, err)
+	require.NotEmpty(t, gerr.Error.Message)
+}
+
+func TestGatewayHost(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+
+	gw := newGateway(service, service.auth)
+	req := httptest.NewRequest("POST", "http://attacker.example:22406/v1/keys", strings.NewReader("{}"))
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	require.True(t, isLocalhost("localhost:22406"))
+	require.True(t, isLocalhost("127.0.0.1:22406"))
+	require.True(t, isLocalhost("localhost"))
+	require.True(t, isLocalhost("[::1]:22406"))
+	require.True(t, isLocalhost("[::1]"))
+	require.False(t, isLocalhost("attacker.example"))
+	require.False(t, isLocalhost("[::2]:22406"))
+
+	// Origin
+	req = httptest.NewRequest("POST", "http://localhost:22406/v1/keys", strings.NewReader("{}"))
+	req.Header.Set("Origin", "https://attacker.example")
+	w = httptest.NewRecorder()
+	gw.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Contains(t, w.Body.String(), "invalid origin")
+
+	require.True(t, isAllowedOrigin(""))
+	require.True(t, isAllowedOrigin("http://localhost:3000"))
+	require.True(t, isAllowedOrigin("chrome-extension://abcdefghijklmnop"))
+	require.False(t, isAllowedOrigin("null"))
+	require.False(t, isAllowedOrigin("https://attacker.example"))
+	require.False(t, isAllowedOrigin("http://localhost.attacker.example"))
+}
+
+func TestGatewayBodySize(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+
+	gw := newGateway(service, service.auth)
+	body := `{"data":"` + strings.Repeat("a", maxGatewayBodySize) + `"}`
+	req := httptest.NewRequest("POST", "http://localhost:22406/v1/sign", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	require.Contains(t, w.Body.String(), "request too large")
+}
@@ -3,9 +3,12 @@ module github.com/keys-pub/keysd/service
 go 1.12
 
 require (
+	filippo.io/age v1.2.1
 	github.com/davecgh/go-spew v1.1.1
+	github.com/godbus/dbus v4.1.0+incompatible
 	github.com/gogo/protobuf v1.3.1
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.0
+	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
 	github.com/keys-pub/keys v0.0.0-20200508002023-134828881af2
 	github.com/keys-pub/keysd/db v0.0.0-20200413003215-f85e85366c95
 	github.com/keys-pub/keysd/fido2 v0.0.0-20200429024946-ecdf142d9dad
@@ -18,10 +21,11 @@ require (
 	github.com/mitchellh/go-ps v1.0.0
 	github.com/onsi/gomega v1.7.0 // indirect
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.6.0
 	github.com/sirupsen/logrus v1.5.0
 	github.com/stretchr/testify v1.5.1
 	github.com/urfave/cli v1.22.4
-	golang.org/x/crypto v0.0.0-20200406173513-056763e48d71
+	golang.org/x/crypto v0.24.0
 	google.golang.org/grpc v1.29.1
 )
 
@@ -7,7 +7,6 @@ import (
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys/keyring"
-	"github.com/pkg/errors"
 )
 
 // Item (RPC) returns an item for an ID.
@@ -26,9 +25,18 @@ func (s *service) Item(ctx context.Context, req *ItemRequest) (*ItemResponse, er
 }
 
 // Items (RPC) returns list of keyring items.
+// With a query, secrets match using the secret search index, and other items
+// match if the ID or type contains the query.
 func (s *service) Items(ctx context.Context, req *ItemsRequest) (*ItemsResponse, error) {
-	if req.Query != "" {
-		return nil, errors.Errorf("query not implemented")
+	query := strings.TrimSpace(req.Query)
+
+	var matches map[string]int
+	if query != "" {
+		_, scores, err := s.searchSecrets(ctx, query, nil)
+		if err != nil {
+			return nil, err
+		}
+		matches = scores
 	}
 
 	kr := s.ks.Keyring()
@@ -39,6 +47,9 @@ func (s *service) Items(ctx context.Context, req *ItemsRequest) (*ItemsResponse,
 
 	itemsOut := make([]*Item, 0, len(items))
 	for _, item := range items {
+		if query != "" && !itemMatches(item, query, matches) {
+			continue
+		}
 		itemsOut = append(itemsOut, itemToRPC(item))
 	}
 
@@ -54,6 +65,15 @@ func (s *service) Items(ctx context.Context, req *ItemsRequest) (*ItemsResponse,
 	}, nil
 }
 
+func itemMatches(item *keyring.Item, query string, secrets map[string]int) bool {
+	if item.Type == secretItemType {
+		_, ok := secrets[item.ID]
+		return ok
+	}
+	q := strings.ToLower(query)
+	return strings.Contains(strings.ToLower(item.ID), q) || strings.Contains(strings.ToLower(item.Type), q)
+}
+
 func itemToRPC(i *keyring.Item) *Item {
 	item := &Item{
 		ID:   i.ID,
@@ -46,12 +46,19 @@ func Emoji(key keys.Key) string {
 		return "🔑"
 	case keys.X25519Public:
 		return "🔑"
+	case fido2ES256, fido2EdDSA:
+		return "🔐"
 	default:
 		return "❓"
 	}
 }
 
 func (s *service) verifyKey(ctx context.Context, kid keys.ID) (*Key, error) {
+	// If signed by a linked device, verify as the identity that owns it.
+	kid, err := s.resolveKeyOwner(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.ensureVerified(ctx, kid); err != nil {
 		return nil, err
 	}
@@ -74,6 +81,8 @@ var keyTypeStrings = []string{
 	string(keys.EdX25519Public),
 	string(keys.X25519),
 	string(keys.X25519Public),
+	string(fido2ES256),
+	string(fido2EdDSA),
 }
 
 func parseKeyType(s string) (KeyType, error) {
@@ -86,6 +95,10 @@ func parseKeyType(s string) (KeyType, error) {
 		return X25519, nil
 	case string(keys.X25519Public):
 		return X25519Public, nil
+	case string(fido2ES256):
+		return FIDO2ES256, nil
+	case string(fido2EdDSA):
+		return FIDO2EdDSA, nil
 	default:
 		return UnknownKeyType, errors.Errorf("unsupported key type %s", s)
 	}
@@ -101,6 +114,10 @@ func keyTypeFromRPC(t KeyType) (keys.KeyType, error) {
 		return keys.X25519, nil
 	case X25519Public:
 		return keys.X25519Public, nil
+	case FIDO2ES256:
+		return fido2ES256, nil
+	case FIDO2EdDSA:
+		return fido2EdDSA, nil
 	default:
 		return "", errors.Errorf("unsupported key type")
 	}
@@ -116,6 +133,10 @@ func keyTypeToRPC(t keys.KeyType) KeyType {
 		return X25519
 	case keys.X25519Public:
 		return X25519Public
+	case fido2ES256:
+		return FIDO2ES256
+	case fido2EdDSA:
+		return FIDO2EdDSA
 	default:
 		return UnknownKeyType
 	}
@@ -147,8 +168,17 @@ func (s *service) keyIDToRPC(ctx context.Context, kid keys.ID) (*Key, error) {
 	if key != nil {
 		return s.keyToRPC(ctx, key)
 	}
+	if isFIDO2KeyID(kid) {
+		fk, err := s.fido2SignKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		if fk != nil {
+			return s.keyToRPC(ctx, fk)
+		}
+	}
 
-	typ := keyTypeToRPC(kid.PublicKeyType())
+	typ := keyTypeToRPC(keyIDType(kid))
 
 	out := &Key{
 		ID:    kid.String(),
@@ -233,6 +263,16 @@ func (s *service) KeyGenerate(ctx context.Context, req *KeyGenerateRequest) (*Ke
 			return nil, err
 		}
 		kid = key.ID()
+	case FIDO2ES256, FIDO2EdDSA:
+		typ, err := keyTypeFromRPC(req.Type)
+		if err != nil {
+			return nil, err
+		}
+		key, err := s.generateFIDO2Key(ctx, typ, req.Device, req.PIN)
+		if err != nil {
+			return nil, err
+		}
+		kid = key.ID()
 	default:
 		return nil, errors.Errorf("unknown key type %s", req.Type)
 	}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/pkg/errors"
+)
+
+// keyDirectory returns the linked device and encryption keys for a sigchain,
+// or nil if we don't have the sigchain.
+func (s *service) keyDirectory(kid keys.ID) (*api.KeyDirectory, error) {
+	sc, err := s.scs.Sigchain(kid)
+	if err != nil {
+		return nil, err
+	}
+	if sc == nil {
+		return nil, nil
+	}
+	return api.NewKeyDirectory(sc)
+}
+
+// indexKeyDirectory updates the local index of linked keys (including FIDO2
+// keys) to the sigchain (identity) that owns them, removing any that were
+// revoked.
+func (s *service) indexKeyDirectory(ctx context.Context, sc *keys.Sigchain) error {
+	if sc == nil {
+		return nil
+	}
+	for _, st := range sc.Statements() {
+		link, err := verifyKeyLink(ctx, st)
+		if err != nil {
+			// The server doesn't verify all link statements (fido2-key), so
+			// an invalid one is skipped instead of failing the update.
+			logger.Warningf("Skipping invalid key link statement %s: %v", st.Key(), err)
+			continue
+		}
+		if link == nil {
+			continue
+		}
+		path := ds.Path("keydir", link.KID)
+		owner, err := s.keyOwner(ctx, link.KID)
+		if err != nil {
+			return err
+		}
+		if sc.IsRevoked(st.Seq) {
+			if owner != sc.KID() {
+				continue
+			}
+			logger.Debugf("Unlink %s from %s", link.KID, sc.KID())
+			if _, err := s.db.Delete(ctx, path); err != nil {
+				return err
+			}
+			continue
+		}
+		if owner != "" && owner != sc.KID() {
+			// A key linked (and not revoked) in another sigchain stays with
+			// that sigchain, otherwise anyone could claim it.
+			linked, err := s.isKeyLinked(ctx, owner, link.KID)
+			if err != nil {
+				return err
+			}
+			if linked {
+				logger.Warningf("Not linking %s to %s, already linked to %s", link.KID, sc.KID(), owner)
+				continue
+			}
+		}
+		logger.Debugf("Link %s to %s", link.KID, sc.KID())
+		if err := s.db.Set(ctx, path, []byte(sc.KID().String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyKeyLink returns the verified link for a key link (or fido2-key)
+// statement, or nil if it isn't a link statement.
+func verifyKeyLink(ctx context.Context, st *keys.Statement) (*api.KeyLink, error) {
+	switch {
+	case api.IsKeyLinkStatement(st):
+		return api.VerifyKeyLinkStatement(st)
+	case st.Type == fido2KeyStatementType:
+		return verifyFIDO2KeyStatement(ctx, st)
+	default:
+		return nil, nil
+	}
+}
+
+// isKeyLinked returns true if the sigchain for owner has a valid (and not
+// revoked) link statement for kid.
+func (s *service) isKeyLinked(ctx context.Context, owner keys.ID, kid keys.ID) (bool, error) {
+	sc, err := s.scs.Sigchain(owner)
+	if err != nil {
+		return false, err
+	}
+	if sc == nil {
+		return false, nil
+	}
+	for _, st := range sc.Statements() {
+		if sc.IsRevoked(st.Seq) {
+			continue
+		}
+		link, err := verifyKeyLink(ctx, st)
+		if err != nil || link == nil {
+			continue
+		}
+		if link.KID == kid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// keyOwner returns the identity a device or encryption key is linked to, or
+// empty if the key isn't linked.
+func (s *service) keyOwner(ctx context.Context, kid keys.ID) (keys.ID, error) {
+	doc, err := s.db.Get(ctx, ds.Path("keydir", kid))
+	if err != nil {
+		return "", err
+	}
+	if doc == nil {
+		return "", nil
+	}
+	owner, err := keys.ParseID(string(doc.Data))
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid key directory entry for %s", kid)
+	}
+	return owner, nil
+}
+
+// resolveKeyOwner returns the identity for a linked key, or the key itself if
+// it isn't linked.
+func (s *service) resolveKeyOwner(ctx context.Context, kid keys.ID) (keys.ID, error) {
+	owner, err := s.keyOwner(ctx, kid)
+	if err != nil {
+		return "", err
+	}
+	if owner == "" {
+		return kid, nil
+	}
+	return owner, nil
+}
+
+// expandRecipients adds the linked device and encryption keys for each
+// recipient.
+func (s *service) expandRecipients(kids []keys.ID) ([]keys.ID, error) {
+	out := make([]keys.ID, 0, len(kids))
+	seen := map[keys.ID]bool{}
+	add := func(kid keys.ID) {
+		if seen[kid] {
+			return
+		}
+		seen[kid] = true
+		out = append(out, kid)
+	}
+	for _, kid := range kids {
+		add(kid)
+		dir, err := s.keyDirectory(kid)
+		if err != nil {
+			return nil, err
+		}
+		if dir == nil {
+			continue
+		}
+		for _, r := range dir.Recipients() {
+			add(r)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyDirectory(t *testing.T) {
+	// SetLogger(NewLogger(DebugLevel))
+	env := newTestEnv(t)
+	device := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x10}, 32)))
+	ek := keys.NewX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x11}, 32)))
+
+	aliceService, aliceCloseFn := newTestService(t, env, "")
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	testImportKey(t, aliceService, device)
+	err := aliceService.ks.SaveX25519Key(ek)
+	require.NoError(t, err)
+
+	deviceService, deviceCloseFn := newTestService(t, env, "")
+	defer deviceCloseFn()
+	testAuthSetup(t, deviceService)
+	testImportKey(t, deviceService, device)
+
+	bobService, bobCloseFn := newTestService(t, env, "")
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+
+	// Link device
+	var resp *StatementCreateResponse
+	resp, err = aliceService.StatementCreate(context.TODO(), &StatementCreateRequest{
+		KID:     alice.ID().String(),
+		Type:    api.DeviceStatementType,
+		LinkKID: device.ID().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.DeviceStatementType, resp.Statement.Type)
+	deviceSeq := resp.Statement.Seq
+
+	// Link encryption key
+	_, err = aliceService.StatementCreate(context.TODO(), &StatementCreateRequest{
+		KID:     alice.ID().String(),
+		Type:    api.EncryptKeyStatementType,
+		LinkKID: ek.ID().String(),
+	})
+	require.NoError(t, err)
+
+	// Link device (not in keyring)
+	_, err = aliceService.StatementCreate(context.TODO(), &StatementCreateRequest{
+		KID:     alice.ID().String(),
+		Type:    api.DeviceStatementType,
+		LinkKID: bob.ID().String(),
+	})
+	require.EqualError(t, err, keys.NewErrNotFound(bob.ID().String()).Error())
+
+	// Link encryption key (not in keyring)
+	other := keys.GenerateX25519Key()
+	_, err = aliceService.StatementCreate(context.TODO(), &StatementCreateRequest{
+		KID:     alice.ID().String(),
+		Type:    api.EncryptKeyStatementType,
+		LinkKID: other.ID().String(),
+	})
+	require.EqualError(t, err, keys.NewErrNotFound(other.ID().String()).Error())
+
+	// Link encryption key (not X25519)
+	_, err = aliceService.StatementCreate(context.TODO(), &StatementCreateRequest{
+		KID:     alice.ID().String(),
+		Type:    api.EncryptKeyStatementType,
+		LinkKID: device.ID().String(),
+	})
+	require.EqualError(t, err, "invalid encryption key type ed25519-public")
+
+	// Unsupported type
+	_, err = aliceService.StatementCreate(context.TODO(), &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "unknown",
+	})
+	require.EqualError(t, err, "unsupported statement type unknown")
+
+	testPull(t, bobService, alice.ID())
+
+	owner, err := bobService.keyOwner(context.TODO(), ek.ID())
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), owner)
+
+	// Link alice's encryption key to charlie, stays with alice
+	charlieService, charlieCloseFn := newTestService(t, env, "")
+	defer charlieCloseFn()
+	testAuthSetup(t, charlieService)
+	testImportKey(t, charlieService, charlie)
+	err = charlieService.ks.SaveX25519Key(ek)
+	require.NoError(t, err)
+	resp, err = charlieService.StatementCreate(context.TODO(), &StatementCreateRequest{
+		KID:     charlie.ID().String(),
+		Type:    api.EncryptKeyStatementType,
+		LinkKID: ek.ID().String(),
+	})
+	require.NoError(t, err)
+	testPull(t, bobService, charlie.ID())
+	owner, err = bobService.keyOwner(context.TODO(), ek.ID())
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), owner)
+
+	// Revoking charlie's link doesn't unlink it from alice
+	_, err = charlieService.StatementRevoke(context.TODO(), &StatementRevokeRequest{
+		KID: charlie.ID().String(),
+		Seq: resp.Statement.Seq,
+	})
+	require.NoError(t, err)
+	testPull(t, bobService, charlie.ID())
+	owner, err = bobService.keyOwner(context.TODO(), ek.ID())
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), owner)
+
+	// Encrypt (bob to alice)
+	enc, err := bobService.newEncrypt(context.TODO(), []string{alice.ID().String()}, bob.ID().String(), DefaultEncryptMode, "")
+	require.NoError(t, err)
+	require.Equal(t, []keys.ID{alice.ID(), device.ID(), ek.ID()}, enc.recipients)
+
+	encryptResp, err := bobService.Encrypt(context.TODO(), &EncryptRequest{
+		Data:       []byte("hi alice"),
+		Sender:     bob.ID().String(),
+		Recipients: []string{alice.ID().String()},
+	})
+	require.NoError(t, err)
+
+	// Decrypt (device)
+	decryptResp, err := deviceService.Decrypt(context.TODO(), &DecryptRequest{
+		Data: encryptResp.Data,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "hi alice", string(decryptResp.Data))
+
+	// Sign (device), verify (bob) as alice
+	signResp, err := deviceService.Sign(context.TODO(), &SignRequest{
+		Data:   []byte("from alice's device"),
+		Signer: device.ID().String(),
+	})
+	require.NoError(t, err)
+	verifyResp, err := bobService.Verify(context.TODO(), &VerifyRequest{Data: signResp.Data})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+
+	// Revoke device
+	_, err = aliceService.StatementRevoke(context.TODO(), &StatementRevokeRequest{
+		KID: alice.ID().String(),
+		Seq: deviceSeq,
+	})
+	require.NoError(t, err)
+
+	testPull(t, bobService, alice.ID())
+
+	enc, err = bobService.newEncrypt(context.TODO(), []string{alice.ID().String()}, bob.ID().String(), DefaultEncryptMode, "")
+	require.NoError(t, err)
+	require.Equal(t, []keys.ID{alice.ID(), ek.ID()}, enc.recipients)
+
+	verifyResp, err = bobService.Verify(context.TODO(), &VerifyRequest{Data: signResp.Data})
+	require.NoError(t, err)
+	require.Equal(t, device.ID().String(), verifyResp.Signer.ID)
+}
+
+func TestKeyDirectoryInvalidLink(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	ctx := context.TODO()
+
+	device := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x10}, 32)))
+	ek := keys.NewX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x11}, 32)))
+	fkid, err := keys.NewID(fido2EdDSAKeyHRP, bytes.Repeat([]byte{0x12}, 32))
+	require.NoError(t, err)
+
+	sc := keys.NewSigchain(alice.ID())
+	st, err := api.NewDeviceStatement(sc, device, alice, env.clock.Now())
+	require.NoError(t, err)
+	require.NoError(t, sc.Add(st))
+
+	// FIDO2 key link with an invalid signature (the server doesn't check it)
+	b, err := json.Marshal(&api.KeyLink{KID: fkid, Sig: []byte("invalid")})
+	require.NoError(t, err)
+	st, err = keys.NewSigchainStatement(sc, b, alice, fido2KeyStatementType, env.clock.Now())
+	require.NoError(t, err)
+	require.NoError(t, sc.Add(st))
+
+	st, err = api.NewEncryptKeyStatement(sc, ek, alice, env.clock.Now())
+	require.NoError(t, err)
+	require.NoError(t, sc.Add(st))
+
+	err = service.indexKeyDirectory(ctx, sc)
+	require.NoError(t, err)
+
+	owner, err := service.keyOwner(ctx, device.ID())
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), owner)
+	owner, err = service.keyOwner(ctx, ek.ID())
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), owner)
+	owner, err = service.keyOwner(ctx, fkid)
+	require.NoError(t, err)
+	require.Equal(t, keys.ID(""), owner)
+}
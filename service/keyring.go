@@ -1,12 +1,29 @@
 package service
 
 import (
+	"bytes"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/godbus/dbus"
 	"github.com/keys-pub/keys/keyring"
 	"github.com/pkg/errors"
 )
 
+// keyringTypes are the supported keyring (store) types, "" is the system
+// keyring (or fs if unavailable).
+var keyringTypes = []string{"", "fs", "efs", "secret-service", "mem"}
+
+// keyringPasswordEnv is the environment variable with the password for the
+// encrypted file (efs) keyring.
+const keyringPasswordEnv = "KEYS_KEYRING_PASSWORD"
+
 func newKeyringStore(cfg *Config) (keyring.Store, error) {
-	kt := cfg.Get(keyringTypeKey, "")
+	return newKeyringStoreType(cfg, cfg.Get(keyringTypeKey, ""))
+}
+
+func newKeyringStoreType(cfg *Config, kt string) (keyring.Store, error) {
 	switch kt {
 	case "":
 		logger.Infof("Keyring (default)")
@@ -20,6 +37,24 @@ func newKeyringStore(cfg *Config) (keyring.Store, error) {
 			return nil, err
 		}
 		return keyring.FS(dir)
+	case "efs":
+		logger.Infof("Keyring (efs)")
+		path, err := cfg.AppPath("keyring.efs", true)
+		if err != nil {
+			return nil, err
+		}
+		password := os.Getenv(keyringPasswordEnv)
+		if password == "" {
+			return nil, errors.Errorf("no keyring password, set %s", keyringPasswordEnv)
+		}
+		return newEFSStore(path, password)
+	case "secret-service":
+		logger.Infof("Keyring (secret-service)")
+		conn, err := dbus.SessionBus()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to connect to session bus")
+		}
+		return newSecretServiceStore(conn), nil
 	case "mem":
 		logger.Infof("Keyring (mem)")
 		return keyring.Mem(), nil
@@ -27,3 +62,110 @@ func newKeyringStore(cfg *Config) (keyring.Store, error) {
 		return nil, errors.Errorf("unknown keyring type %s", kt)
 	}
 }
+
+// Item IDs with these prefixes are reserved (for the keyring auth and salt) or
+// hidden (not listed), see keyring.Keyring.
+const (
+	keyringReservedPrefix = "#"
+	keyringHiddenPrefix   = "."
+)
+
+func filterKeyringIDs(ids []string, prefix string, showHidden bool, showReserved bool) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !showReserved && strings.HasPrefix(id, keyringReservedPrefix) {
+			continue
+		}
+		if !showHidden && strings.HasPrefix(id, keyringHiddenPrefix) {
+			continue
+		}
+		if prefix != "" && !strings.HasPrefix(id, prefix) {
+			continue
+		}
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// listKeyringItems lists (decrypted) items from a store, for stores that only
+// store bytes by ID.
+func listKeyringItems(st keyring.Store, service string, key keyring.SecretKey, opts *keyring.ListOpts) ([]*keyring.Item, error) {
+	if opts == nil {
+		opts = &keyring.ListOpts{}
+	}
+	if key == nil {
+		return nil, keyring.ErrLocked
+	}
+	ids, err := st.IDs(service, "", false, false)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*keyring.Item, 0, len(ids))
+	for _, id := range ids {
+		b, err := st.Get(service, id)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil {
+			continue
+		}
+		item, err := keyring.DecodeItem(b, key)
+		if err != nil {
+			return nil, err
+		}
+		if len(opts.Types) != 0 && !containsString(opts.Types, item.Type) {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// migrateKeyring copies all the (encrypted) items for service, including
+// reserved and hidden items, from one store to another, and checks them. The
+// destination store must be empty unless force is set. Items are not removed
+// from the source store.
+func migrateKeyring(service string, from keyring.Store, to keyring.Store, force bool) (int, error) {
+	existing, err := to.IDs(service, "", true, true)
+	if err != nil {
+		return 0, err
+	}
+	if len(existing) > 0 && !force {
+		return 0, errors.Errorf("keyring (%s) isn't empty", to.Name())
+	}
+
+	ids, err := from.IDs(service, "", true, true)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		b, err := from.Get(service, id)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get %s", id)
+		}
+		if b == nil {
+			continue
+		}
+		if err := to.Set(service, id, b, ""); err != nil {
+			return 0, errors.Wrapf(err, "failed to set %s", id)
+		}
+		out, err := to.Get(service, id)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to check %s", id)
+		}
+		if !bytes.Equal(b, out) {
+			return 0, errors.Errorf("failed to check %s, data mismatch", id)
+		}
+	}
+	return len(ids), nil
+}
+
+func containsString(strs []string, s string) bool {
+	for _, e := range strs {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
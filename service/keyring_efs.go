@@ -0,0 +1,204 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/keyring"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+// Encrypted file keyring (efs) is a keyring.Store for systems without a
+// system keyring (like headless servers).
+//
+// All items (for all services) are stored in a single file, encrypted with a
+// key derived from a password with scrypt:
+//
+//   magic (8) | logN (1) | r (1) | p (1) | salt (16) | nonce (24) | secretbox
+//
+// The secretbox is JSON, {service: {id: data}}. The salt is kept for the life
+// of the file (so the key is only derived once) and the nonce is random for
+// each write. The file is written to a temporary file and renamed.
+
+var efsMagic = []byte("KEYSKR\x00\x01")
+
+const (
+	efsLogN       = 15
+	efsR          = 8
+	efsP          = 1
+	efsHeaderSize = 8 + 3 + 16
+)
+
+type efsStore struct {
+	sync.Mutex
+	path   string
+	header []byte
+	key    *[32]byte
+	items  map[string]map[string][]byte
+}
+
+// newEFSStore opens (or creates) an encrypted file keyring at path.
+func newEFSStore(path string, password string) (*efsStore, error) {
+	b, err := ioutil.ReadFile(path) // #nosec
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		b = nil
+	}
+	if b == nil {
+		salt := keys.RandBytes(16)
+		header := append(append([]byte{}, efsMagic...), efsLogN, efsR, efsP)
+		header = append(header, salt...)
+		key, err := pwKey(password, salt, efsLogN, efsR, efsP)
+		if err != nil {
+			return nil, err
+		}
+		return &efsStore{path: path, header: header, key: key, items: map[string]map[string][]byte{}}, nil
+	}
+
+	if len(b) < efsHeaderSize+24+secretbox.Overhead || !bytes.Equal(b[:8], efsMagic) {
+		return nil, errors.Errorf("invalid keyring file")
+	}
+	header := b[:efsHeaderSize]
+	key, err := pwKey(password, header[11:], header[8], header[9], header[10])
+	if err != nil {
+		return nil, err
+	}
+	var nonce [24]byte
+	copy(nonce[:], b[efsHeaderSize:efsHeaderSize+24])
+	decrypted, ok := secretbox.Open(nil, b[efsHeaderSize+24:], &nonce, key)
+	if !ok {
+		return nil, errors.Errorf("invalid keyring password")
+	}
+	var items map[string]map[string][]byte
+	if err := json.Unmarshal(decrypted, &items); err != nil {
+		return nil, errors.Wrapf(err, "invalid keyring file")
+	}
+	if items == nil {
+		items = map[string]map[string][]byte{}
+	}
+	return &efsStore{path: path, header: header, key: key, items: items}, nil
+}
+
+func (k *efsStore) Name() string {
+	return "efs"
+}
+
+// save writes the file, requires lock.
+func (k *efsStore) save() error {
+	b, err := json.Marshal(k.items)
+	if err != nil {
+		return err
+	}
+	nonce := keys.Rand24()
+	out := append(append([]byte{}, k.header...), nonce[:]...)
+	out = secretbox.Seal(out, b, nonce, k.key)
+
+	if err := os.MkdirAll(filepath.Dir(k.path), 0700); err != nil {
+		return err
+	}
+	tmp := k.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, out, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write keyring")
+	}
+	if err := os.Rename(tmp, k.path); err != nil {
+		_ = os.Remove(tmp)
+		return errors.Wrapf(err, "failed to write keyring")
+	}
+	return nil
+}
+
+func (k *efsStore) Get(service string, id string) ([]byte, error) {
+	if id == "" {
+		return nil, errors.Errorf("failed to get keyring item: no id specified")
+	}
+	k.Lock()
+	defer k.Unlock()
+	b, ok := k.items[service][id]
+	if !ok {
+		return nil, nil
+	}
+	return append([]byte{}, b...), nil
+}
+
+func (k *efsStore) Set(service string, id string, data []byte, typ string) error {
+	if id == "" {
+		return errors.Errorf("no id specified")
+	}
+	k.Lock()
+	defer k.Unlock()
+	m, ok := k.items[service]
+	if !ok {
+		m = map[string][]byte{}
+		k.items[service] = m
+	}
+	prev, exists := m[id]
+	m[id] = append([]byte{}, data...)
+	if err := k.save(); err != nil {
+		if exists {
+			m[id] = prev
+		} else {
+			delete(m, id)
+		}
+		return err
+	}
+	return nil
+}
+
+func (k *efsStore) Delete(service string, id string) (bool, error) {
+	k.Lock()
+	defer k.Unlock()
+	prev, ok := k.items[service][id]
+	if !ok {
+		return false, nil
+	}
+	delete(k.items[service], id)
+	if err := k.save(); err != nil {
+		k.items[service][id] = prev
+		return true, err
+	}
+	return true, nil
+}
+
+func (k *efsStore) IDs(service string, prefix string, showHidden bool, showReserved bool) ([]string, error) {
+	k.Lock()
+	defer k.Unlock()
+	ids := make([]string, 0, len(k.items[service]))
+	for id := range k.items[service] {
+		ids = append(ids, id)
+	}
+	return filterKeyringIDs(ids, prefix, showHidden, showReserved), nil
+}
+
+func (k *efsStore) List(service string, key keyring.SecretKey, opts *keyring.ListOpts) ([]*keyring.Item, error) {
+	return listKeyringItems(k, service, key, opts)
+}
+
+func (k *efsStore) Exists(service string, id string) (bool, error) {
+	k.Lock()
+	defer k.Unlock()
+	_, ok := k.items[service][id]
+	return ok, nil
+}
+
+func (k *efsStore) Reset(service string) error {
+	k.Lock()
+	defer k.Unlock()
+	prev, ok := k.items[service]
+	if !ok {
+		return nil
+	}
+	delete(k.items, service)
+	if err := k.save(); err != nil {
+		k.items[service] = prev
+		return err
+	}
+	return nil
+}
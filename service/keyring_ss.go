@@ -0,0 +1,269 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/godbus/dbus"
+	"github.com/keys-pub/keys/keyring"
+	"github.com/pkg/errors"
+)
+
+// Secret Service (D-Bus) keyring store.
+//
+// Items are stored in the default collection with the attributes "service"
+// and "username" (the item ID), the same as the system keyring on linux, so
+// items are compatible with either. Unlike the system keyring, item IDs are
+// read from the attributes, so listing IDs doesn't require fetching secrets,
+// and the bus connection can be specified (for testing).
+//
+// See https://specifications.freedesktop.org/secret-service/.
+
+const (
+	ssName                = "org.freedesktop.secrets"
+	ssPath                = dbus.ObjectPath("/org/freedesktop/secrets")
+	ssServiceInterface    = "org.freedesktop.Secret.Service"
+	ssCollectionInterface = "org.freedesktop.Secret.Collection"
+	ssItemInterface       = "org.freedesktop.Secret.Item"
+	ssSessionInterface    = "org.freedesktop.Secret.Session"
+	ssPromptInterface     = "org.freedesktop.Secret.Prompt"
+	ssNoPrompt            = dbus.ObjectPath("/")
+)
+
+// ssSecret is the Secret struct (oayays).
+type ssSecret struct {
+	Session     dbus.ObjectPath
+	Parameters  []byte
+	Value       []byte
+	ContentType string
+}
+
+type secretServiceStore struct {
+	conn *dbus.Conn
+	// promptTimeout is how long to wait for a prompt to be completed.
+	promptTimeout time.Duration
+}
+
+func newSecretServiceStore(conn *dbus.Conn) *secretServiceStore {
+	return &secretServiceStore{conn: conn, promptTimeout: 5 * time.Minute}
+}
+
+func (k *secretServiceStore) Name() string {
+	return "secret-service"
+}
+
+func (k *secretServiceStore) service() dbus.BusObject {
+	return k.conn.Object(ssName, ssPath)
+}
+
+// prompt runs a prompt (if any), and returns the result. If the prompt isn't
+// completed (or dismissed) before the prompt timeout, it's dismissed.
+func (k *secretServiceStore) prompt(path dbus.ObjectPath) (dbus.Variant, error) {
+	if path == ssNoPrompt || path == "" {
+		return dbus.MakeVariant(""), nil
+	}
+
+	// The bus only sends us the Completed signal if we ask for it.
+	rule := fmt.Sprintf("type='signal',interface='%s',member='Completed',path='%s'", ssPromptInterface, path)
+	if err := k.conn.BusObject().Call("org.freedesktop.DBus.AddMatch", 0, rule).Err; err != nil {
+		return dbus.MakeVariant(""), errors.Wrapf(err, "failed to watch prompt")
+	}
+	defer k.conn.BusObject().Call("org.freedesktop.DBus.RemoveMatch", 0, rule)
+	signals := make(chan *dbus.Signal, 10)
+	k.conn.Signal(signals)
+	defer k.conn.RemoveSignal(signals)
+
+	if err := k.conn.Object(ssName, path).Call(ssPromptInterface+".Prompt", 0, "").Err; err != nil {
+		return dbus.MakeVariant(""), errors.Wrapf(err, "failed to prompt")
+	}
+	timeout := time.After(k.promptTimeout)
+	for {
+		select {
+		case signal := <-signals:
+			if signal.Path != path || signal.Name != ssPromptInterface+".Completed" || len(signal.Body) < 2 {
+				continue
+			}
+			if dismissed, _ := signal.Body[0].(bool); dismissed {
+				return dbus.MakeVariant(""), errors.Errorf("keyring prompt dismissed")
+			}
+			result, _ := signal.Body[1].(dbus.Variant)
+			return result, nil
+		case <-timeout:
+			k.conn.Object(ssName, path).Call(ssPromptInterface+".Dismiss", 0)
+			return dbus.MakeVariant(""), errors.Errorf("keyring prompt timed out")
+		}
+	}
+}
+
+// collection returns the (unlocked) default collection.
+func (k *secretServiceStore) collection() (dbus.BusObject, error) {
+	var path dbus.ObjectPath
+	if err := k.service().Call(ssServiceInterface+".ReadAlias", 0, "default").Store(&path); err != nil {
+		return nil, errors.Wrapf(err, "failed to find default collection")
+	}
+	if path == ssNoPrompt {
+		return nil, errors.Errorf("no default collection")
+	}
+
+	var unlocked []dbus.ObjectPath
+	var prompt dbus.ObjectPath
+	if err := k.service().Call(ssServiceInterface+".Unlock", 0, []dbus.ObjectPath{path}).Store(&unlocked, &prompt); err != nil {
+		return nil, errors.Wrapf(err, "failed to unlock collection")
+	}
+	if _, err := k.prompt(prompt); err != nil {
+		return nil, err
+	}
+	return k.conn.Object(ssName, path), nil
+}
+
+func (k *secretServiceStore) search(service string, id string) ([]dbus.ObjectPath, error) {
+	collection, err := k.collection()
+	if err != nil {
+		return nil, err
+	}
+	attributes := map[string]string{"service": service}
+	if id != "" {
+		attributes["username"] = id
+	}
+	var paths []dbus.ObjectPath
+	if err := collection.Call(ssCollectionInterface+".SearchItems", 0, attributes).Store(&paths); err != nil {
+		return nil, errors.Wrapf(err, "failed to search keyring")
+	}
+	return paths, nil
+}
+
+func (k *secretServiceStore) Get(service string, id string) ([]byte, error) {
+	if id == "" {
+		return nil, errors.Errorf("failed to get keyring item: no id specified")
+	}
+	paths, err := k.search(service, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	var output dbus.Variant
+	var session dbus.ObjectPath
+	if err := k.service().Call(ssServiceInterface+".OpenSession", 0, "plain", dbus.MakeVariant("")).Store(&output, &session); err != nil {
+		return nil, errors.Wrapf(err, "failed to open keyring session")
+	}
+	defer k.conn.Object(ssName, session).Call(ssSessionInterface+".Close", 0)
+
+	var secret ssSecret
+	if err := k.conn.Object(ssName, paths[0]).Call(ssItemInterface+".GetSecret", 0, session).Store(&secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to get keyring item")
+	}
+	return secret.Value, nil
+}
+
+func (k *secretServiceStore) Set(service string, id string, data []byte, typ string) error {
+	if id == "" {
+		return errors.Errorf("no id specified")
+	}
+	collection, err := k.collection()
+	if err != nil {
+		return err
+	}
+
+	var output dbus.Variant
+	var session dbus.ObjectPath
+	if err := k.service().Call(ssServiceInterface+".OpenSession", 0, "plain", dbus.MakeVariant("")).Store(&output, &session); err != nil {
+		return errors.Wrapf(err, "failed to open keyring session")
+	}
+	defer k.conn.Object(ssName, session).Call(ssSessionInterface+".Close", 0)
+
+	properties := map[string]dbus.Variant{
+		ssItemInterface + ".Label":      dbus.MakeVariant("Password for '" + id + "' on '" + service + "'"),
+		ssItemInterface + ".Attributes": dbus.MakeVariant(map[string]string{"service": service, "username": id}),
+	}
+	secret := ssSecret{
+		Session:     session,
+		Parameters:  []byte{},
+		Value:       data,
+		ContentType: "text/plain; charset=utf8",
+	}
+	var item, prompt dbus.ObjectPath
+	if err := collection.Call(ssCollectionInterface+".CreateItem", 0, properties, secret, true).Store(&item, &prompt); err != nil {
+		return errors.Wrapf(err, "failed to set keyring item")
+	}
+	if _, err := k.prompt(prompt); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (k *secretServiceStore) deletePath(path dbus.ObjectPath) error {
+	var prompt dbus.ObjectPath
+	if err := k.conn.Object(ssName, path).Call(ssItemInterface+".Delete", 0).Store(&prompt); err != nil {
+		return errors.Wrapf(err, "failed to delete keyring item")
+	}
+	if _, err := k.prompt(prompt); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (k *secretServiceStore) Delete(service string, id string) (bool, error) {
+	paths, err := k.search(service, id)
+	if err != nil {
+		return false, err
+	}
+	if len(paths) == 0 {
+		return false, nil
+	}
+	for _, path := range paths {
+		if err := k.deletePath(path); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func (k *secretServiceStore) IDs(service string, prefix string, showHidden bool, showReserved bool) ([]string, error) {
+	paths, err := k.search(service, "")
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(paths))
+	for _, path := range paths {
+		v, err := k.conn.Object(ssName, path).GetProperty(ssItemInterface + ".Attributes")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get keyring item attributes")
+		}
+		attributes, ok := v.Value().(map[string]string)
+		if !ok {
+			return nil, errors.Errorf("invalid keyring item attributes")
+		}
+		if id := attributes["username"]; id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return filterKeyringIDs(ids, prefix, showHidden, showReserved), nil
+}
+
+func (k *secretServiceStore) List(service string, key keyring.SecretKey, opts *keyring.ListOpts) ([]*keyring.Item, error) {
+	return listKeyringItems(k, service, key, opts)
+}
+
+func (k *secretServiceStore) Exists(service string, id string) (bool, error) {
+	paths, err := k.search(service, id)
+	if err != nil {
+		return false, err
+	}
+	return len(paths) > 0, nil
+}
+
+func (k *secretServiceStore) Reset(service string) error {
+	paths, err := k.search(service, "")
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := k.deletePath(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus"
+	"github.com/stretchr/testify/require"
+)
+
+// testBus starts a private dbus-daemon, skipping the test if unavailable.
+func testBus(t *testing.T) (string, func()) {
+	if _, err := exec.LookPath("dbus-daemon"); err != nil {
+		t.Skip("dbus-daemon unavailable")
+	}
+	dir, err := ioutil.TempDir("", "KeysTest")
+	require.NoError(t, err)
+	cfg := fmt.Sprintf(`<busconfig>
+  <type>session</type>
+  <listen>unix:path=%s</listen>
+  <auth>EXTERNAL</auth>
+  <policy context="default">
+    <allow send_destination="*" eavesdrop="true"/>
+    <allow eavesdrop="true"/>
+    <allow own="*"/>
+  </policy>
+</busconfig>`, filepath.Join(dir, "bus"))
+	cfgPath := filepath.Join(dir, "bus.conf")
+	err = ioutil.WriteFile(cfgPath, []byte(cfg), 0600)
+	require.NoError(t, err)
+
+	cmd := exec.Command("dbus-daemon", "--config-file="+cfgPath, "--nofork", "--print-address")
+	stdout, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	err = cmd.Start()
+	require.NoError(t, err)
+	closeFn := func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		_ = os.RemoveAll(dir)
+	}
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		closeFn()
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(address), closeFn
+}
+
+func testBusConn(t *testing.T, address string) *dbus.Conn {
+	conn, err := dbus.Dial(address)
+	require.NoError(t, err)
+	err = conn.Auth(nil)
+	require.NoError(t, err)
+	err = conn.Hello()
+	require.NoError(t, err)
+	return conn
+}
+
+// mockSecretService is a (minimal) Secret Service, with a single collection,
+// which is unlocked with a prompt if unlockPrompt is set.
+type mockSecretService struct {
+	sync.Mutex
+	conn         *dbus.Conn
+	items        map[dbus.ObjectPath]*mockSSItem
+	n            int
+	unlockPrompt *mockSSPrompt
+}
+
+const mockSSCollectionPath = dbus.ObjectPath("/org/freedesktop/secrets/collection/login")
+const mockSSSessionPath = dbus.ObjectPath("/org/freedesktop/secrets/session/1")
+
+func newMockSecretService(t *testing.T, conn *dbus.Conn) *mockSecretService {
+	m := &mockSecretService{conn: conn, items: map[dbus.ObjectPath]*mockSSItem{}}
+	require.NoError(t, conn.Export(mockSSService{m}, ssPath, ssServiceInterface))
+	require.NoError(t, conn.Export(mockSSCollection{m}, mockSSCollectionPath, ssCollectionInterface))
+	require.NoError(t, conn.Export(mockSSSession{}, mockSSSessionPath, ssSessionInterface))
+	reply, err := conn.RequestName(ssName, dbus.NameFlagDoNotQueue)
+	require.NoError(t, err)
+	require.Equal(t, dbus.RequestNameReplyPrimaryOwner, reply)
+	return m
+}
+
+type mockSSService struct {
+	m *mockSecretService
+}
+
+func (s mockSSService) OpenSession(algorithm string, input dbus.Variant) (dbus.Variant, dbus.ObjectPath, *dbus.Error) {
+	if algorithm != "plain" {
+		return dbus.MakeVariant(""), "", dbus.NewError("org.freedesktop.DBus.Error.NotSupported", nil)
+	}
+	return dbus.MakeVariant(""), mockSSSessionPath, nil
+}
+
+func (s mockSSService) ReadAlias(name string) (dbus.ObjectPath, *dbus.Error) {
+	if name != "default" {
+		return ssNoPrompt, nil
+	}
+	return mockSSCollectionPath, nil
+}
+
+func (s mockSSService) Unlock(objects []dbus.ObjectPath) ([]dbus.ObjectPath, dbus.ObjectPath, *dbus.Error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.m.unlockPrompt != nil {
+		return []dbus.ObjectPath{}, s.m.unlockPrompt.path, nil
+	}
+	return objects, ssNoPrompt, nil
+}
+
+const mockSSPromptPath = dbus.ObjectPath("/org/freedesktop/secrets/prompt/1")
+
+// mockSSPrompt completes (or is dismissed) when prompted, or if ignore is
+// set, waits for Dismiss.
+type mockSSPrompt struct {
+	sync.Mutex
+	conn      *dbus.Conn
+	path      dbus.ObjectPath
+	dismiss   bool
+	ignore    bool
+	dismissed bool
+}
+
+func newMockSSPrompt(t *testing.T, conn *dbus.Conn) *mockSSPrompt {
+	p := &mockSSPrompt{conn: conn, path: mockSSPromptPath}
+	require.NoError(t, conn.Export(p, p.path, ssPromptInterface))
+	return p
+}
+
+func (p *mockSSPrompt) Prompt(windowID string) *dbus.Error {
+	p.Lock()
+	defer p.Unlock()
+	if p.ignore {
+		return nil
+	}
+	result := dbus.MakeVariant([]dbus.ObjectPath{mockSSCollectionPath})
+	if err := p.conn.Emit(p.path, ssPromptInterface+".Completed", p.dismiss, result); err != nil {
+		return dbus.MakeFailedError(err)
+	}
+	return nil
+}
+
+func (p *mockSSPrompt) Dismiss() *dbus.Error {
+	p.Lock()
+	defer p.Unlock()
+	p.dismissed = true
+	return nil
+}
+
+type mockSSSession struct{}
+
+func (s mockSSSession) Close() *dbus.Error {
+	return nil
+}
+
+type mockSSCollection struct {
+	m *mockSecretService
+}
+
+func attributesMatch(attributes map[string]string, search map[string]string) bool {
+	for k, v := range search {
+		if attributes[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (c mockSSCollection) SearchItems(search map[string]string) ([]dbus.ObjectPath, *dbus.Error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	paths := []dbus.ObjectPath{}
+	for path, item := range c.m.items {
+		if attributesMatch(item.attributes, search) {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+func (c mockSSCollection) CreateItem(properties map[string]dbus.Variant, secret ssSecret, replace bool) (dbus.ObjectPath, dbus.ObjectPath, *dbus.Error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	attributes, _ := properties[ssItemInterface+".Attributes"].Value().(map[string]string)
+	if secret.Session != mockSSSessionPath {
+		return "", "", dbus.NewError("org.freedesktop.Secret.Error.NoSession", nil)
+	}
+	if replace {
+		for path, item := range c.m.items {
+			if attributesMatch(item.attributes, attributes) {
+				item.value = secret.Value
+				return path, ssNoPrompt, nil
+			}
+		}
+	}
+	c.m.n++
+	path := dbus.ObjectPath(fmt.Sprintf("%s/%d", mockSSCollectionPath, c.m.n))
+	item := &mockSSItem{m: c.m, path: path, attributes: attributes, value: secret.Value}
+	if err := c.m.conn.Export(item, path, ssItemInterface); err != nil {
+		return "", "", dbus.MakeFailedError(err)
+	}
+	if err := c.m.conn.Export(mockSSItemProperties{item}, path, "org.freedesktop.DBus.Properties"); err != nil {
+		return "", "", dbus.MakeFailedError(err)
+	}
+	c.m.items[path] = item
+	return path, ssNoPrompt, nil
+}
+
+type mockSSItem struct {
+	m          *mockSecretService
+	path       dbus.ObjectPath
+	attributes map[string]string
+	value      []byte
+}
+
+func (i *mockSSItem) GetSecret(session dbus.ObjectPath) (ssSecret, *dbus.Error) {
+	i.m.Lock()
+	defer i.m.Unlock()
+	return ssSecret{Session: session, Parameters: []byte{}, Value: i.value, ContentType: "text/plain"}, nil
+}
+
+func (i *mockSSItem) Delete() (dbus.ObjectPath, *dbus.Error) {
+	i.m.Lock()
+	defer i.m.Unlock()
+	delete(i.m.items, i.path)
+	_ = i.m.conn.Export(nil, i.path, ssItemInterface)
+	_ = i.m.conn.Export(nil, i.path, "org.freedesktop.DBus.Properties")
+	return ssNoPrompt, nil
+}
+
+type mockSSItemProperties struct {
+	item *mockSSItem
+}
+
+func (p mockSSItemProperties) Get(iface string, property string) (dbus.Variant, *dbus.Error) {
+	if iface != ssItemInterface || property != "Attributes" {
+		return dbus.MakeVariant(""), dbus.NewError("org.freedesktop.DBus.Error.UnknownProperty", nil)
+	}
+	return dbus.MakeVariant(p.item.attributes), nil
+}
+
+func TestSecretServiceStore(t *testing.T) {
+	address, closeFn := testBus(t)
+	defer closeFn()
+
+	mockConn := testBusConn(t, address)
+	defer mockConn.Close()
+	newMockSecretService(t, mockConn)
+
+	conn := testBusConn(t, address)
+	defer conn.Close()
+	st := newSecretServiceStore(conn)
+	require.Equal(t, "secret-service", st.Name())
+	testKeyringStore(t, st)
+
+	// Set replaces
+	err := st.Set("KeysTest", "key1", []byte("data1"), "")
+	require.NoError(t, err)
+	err = st.Set("KeysTest", "key1", []byte("data2"), "")
+	require.NoError(t, err)
+	b, err := st.Get("KeysTest", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data2"), b)
+	ids, err := st.IDs("KeysTest", "", true, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key1"}, ids)
+
+	// Migrate to efs and back
+	dir, err := ioutil.TempDir("", "KeysTest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	efs, err := newEFSStore(filepath.Join(dir, "keyring.efs"), "efspassword")
+	require.NoError(t, err)
+	n, err := migrateKeyring("KeysTest", st, efs, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	err = st.Reset("KeysTest")
+	require.NoError(t, err)
+	n, err = migrateKeyring("KeysTest", efs, st, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	b, err = st.Get("KeysTest", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data2"), b)
+}
+
+func TestSecretServiceStorePrompt(t *testing.T) {
+	address, closeFn := testBus(t)
+	defer closeFn()
+
+	mockConn := testBusConn(t, address)
+	defer mockConn.Close()
+	mock := newMockSecretService(t, mockConn)
+	prompt := newMockSSPrompt(t, mockConn)
+
+	conn := testBusConn(t, address)
+	defer conn.Close()
+	st := newSecretServiceStore(conn)
+	st.promptTimeout = time.Second
+
+	// Completed
+	result, err := st.prompt(mockSSPromptPath)
+	require.NoError(t, err)
+	require.Equal(t, []dbus.ObjectPath{mockSSCollectionPath}, result.Value())
+
+	// Unlock (with prompt)
+	mock.Lock()
+	mock.unlockPrompt = prompt
+	mock.Unlock()
+	err = st.Set("KeysTest", "key1", []byte("data1"), "")
+	require.NoError(t, err)
+	b, err := st.Get("KeysTest", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data1"), b)
+
+	// Dismissed
+	prompt.Lock()
+	prompt.dismiss = true
+	prompt.Unlock()
+	_, err = st.Get("KeysTest", "key1")
+	require.EqualError(t, err, "keyring prompt dismissed")
+
+	// Timeout
+	prompt.Lock()
+	prompt.ignore = true
+	prompt.Unlock()
+	st.promptTimeout = 100 * time.Millisecond
+	_, err = st.Get("KeysTest", "key1")
+	require.EqualError(t, err, "keyring prompt timed out")
+	prompt.Lock()
+	require.True(t, prompt.dismissed)
+	prompt.Unlock()
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys/keyring"
+	"github.com/stretchr/testify/require"
+)
+
+func testKeyringStore(t *testing.T, st keyring.Store) {
+	kr, err := keyring.New("KeysTest", st)
+	require.NoError(t, err)
+	err = kr.UnlockWithPassword("testpassword")
+	require.NoError(t, err)
+
+	err = kr.Create(keyring.NewItem("key1", []byte("password1"), "password", time.Now()))
+	require.NoError(t, err)
+	err = kr.Create(keyring.NewItem("key2", []byte("password2"), "", time.Now()))
+	require.NoError(t, err)
+	err = st.Set("KeysTest", ".hidden", []byte("hidden"), "")
+	require.NoError(t, err)
+
+	item, err := kr.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("password1"), item.Data)
+	require.Equal(t, "password", item.Type)
+
+	ids, err := st.IDs("KeysTest", "", false, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key1", "key2"}, ids)
+	ids, err = st.IDs("KeysTest", "", true, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"#auth", "#salt", ".hidden", "key1", "key2"}, ids)
+	ids, err = st.IDs("KeysTest", "key2", false, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key2"}, ids)
+
+	items, err := kr.List(&keyring.ListOpts{Types: []string{"password"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	require.Equal(t, "key1", items[0].ID)
+
+	ok, err := kr.Exists("key2")
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = kr.Delete("key2")
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = kr.Exists("key2")
+	require.NoError(t, err)
+	require.False(t, ok)
+	b, err := st.Get("KeysTest", "key2")
+	require.NoError(t, err)
+	require.Nil(t, b)
+
+	// Other service
+	ids, err = st.IDs("KeysTest2", "", true, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{}, ids)
+
+	err = kr.Reset()
+	require.NoError(t, err)
+	ids, err = st.IDs("KeysTest", "", true, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{}, ids)
+}
+
+func TestEFSStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "KeysTest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keyring.efs")
+
+	st, err := newEFSStore(path, "efspassword")
+	require.NoError(t, err)
+	testKeyringStore(t, st)
+
+	err = st.Set("KeysTest", "key1", []byte("data1"), "")
+	require.NoError(t, err)
+
+	// Reopen
+	st, err = newEFSStore(path, "efspassword")
+	require.NoError(t, err)
+	b, err := st.Get("KeysTest", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data1"), b)
+
+	_, err = newEFSStore(path, "invalidpassword")
+	require.EqualError(t, err, "invalid keyring password")
+
+	err = ioutil.WriteFile(path, []byte("invalid"), 0600)
+	require.NoError(t, err)
+	_, err = newEFSStore(path, "efspassword")
+	require.EqualError(t, err, "invalid keyring file")
+}
+
+func TestMigrateKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "KeysTest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	from := keyring.Mem()
+	kr, err := keyring.New("KeysTest", from)
+	require.NoError(t, err)
+	err = kr.UnlockWithPassword("testpassword")
+	require.NoError(t, err)
+	err = kr.Create(keyring.NewItem("key1", []byte("password1"), "", time.Now()))
+	require.NoError(t, err)
+	err = from.Set("KeysTest", ".cert-public", []byte("cert"), "")
+	require.NoError(t, err)
+
+	to, err := newEFSStore(filepath.Join(dir, "keyring.efs"), "efspassword")
+	require.NoError(t, err)
+	n, err := migrateKeyring("KeysTest", from, to, false)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+
+	kr2, err := keyring.New("KeysTest", to)
+	require.NoError(t, err)
+	err = kr2.UnlockWithPassword("invalidpassword")
+	require.EqualError(t, err, "invalid keyring auth")
+	err = kr2.UnlockWithPassword("testpassword")
+	require.NoError(t, err)
+	item, err := kr2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("password1"), item.Data)
+	b, err := to.Get("KeysTest", ".cert-public")
+	require.NoError(t, err)
+	require.Equal(t, []byte("cert"), b)
+
+	// Source is unchanged
+	item, err = kr.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("password1"), item.Data)
+
+	_, err = migrateKeyring("KeysTest", from, to, false)
+	require.EqualError(t, err, "keyring (efs) isn't empty")
+	n, err = migrateKeyring("KeysTest", from, to, true)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
@@ -30,6 +30,13 @@ func (s *service) Keys(ctx context.Context, req *KeysRequest) (*KeysResponse, er
 	if err != nil {
 		return nil, err
 	}
+	fks, err := s.fido2SignKeys(types)
+	if err != nil {
+		return nil, err
+	}
+	for _, fk := range fks {
+		ks = append(ks, fk)
+	}
 
 	keys, err := s.keys(ctx, ks, req.Query, sortField, sortDirection)
 	if err != nil {
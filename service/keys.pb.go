@@ -29,24 +29,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SignFormat int32
+
+const (
+	// Saltpack signature.
+	DefaultSignFormat SignFormat = 0
+	// SSH signature (SSHSIG), as used by ssh-keygen -Y sign and git. Always
+	// detached.
+	SSHSignFormat SignFormat = 1
+	// Multi-signature, a (text) signature that several keys can sign (add to).
+	// Always detached and armored.
+	MultiSignFormat SignFormat = 2
+	// FIDO2 signature (assertion) by a FIDO2 key. Always detached and armored.
+	FIDO2SignFormat SignFormat = 3
+)
+
+var SignFormat_name = map[int32]string{
+	0: "DEFAULT_SIGN_FORMAT",
+	1: "SSH_SIGN_FORMAT",
+	2: "MULTI_SIGN_FORMAT",
+	3: "FIDO2_SIGN_FORMAT",
+}
+
+var SignFormat_value = map[string]int32{
+	"DEFAULT_SIGN_FORMAT": 0,
+	"SSH_SIGN_FORMAT":     1,
+	"MULTI_SIGN_FORMAT":   2,
+	"FIDO2_SIGN_FORMAT":   3,
+}
+
+func (x SignFormat) String() string {
+	return proto.EnumName(SignFormat_name, int32(x))
+}
+
+func (SignFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{0}
+}
+
 type EncryptMode int32
 
 const (
 	DefaultEncryptMode EncryptMode = 0
 	EncryptV2          EncryptMode = 1
 	SigncryptV1        EncryptMode = 2
+	PasswordV1         EncryptMode = 3
+	// AGE is age (age-encryption.org/v1) for X25519 recipients.
+	AgeEncryptMode EncryptMode = 4
 )
 
 var EncryptMode_name = map[int32]string{
 	0: "DEFAULT_ENCRYPT_MODE",
 	1: "ENCRYPT_V2",
 	2: "SIGNCRYPT_V1",
+	3: "PASSWORD_V1",
+	4: "AGE",
 }
 
 var EncryptMode_value = map[string]int32{
 	"DEFAULT_ENCRYPT_MODE": 0,
 	"ENCRYPT_V2":           1,
 	"SIGNCRYPT_V1":         2,
+	"PASSWORD_V1":          3,
+	"AGE":                  4,
 }
 
 func (x EncryptMode) String() string {
@@ -54,7 +98,37 @@ func (x EncryptMode) String() string {
 }
 
 func (EncryptMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{0}
+	return fileDescriptor_9084e97af2346a26, []int{1}
+}
+
+type AuthType int32
+
+const (
+	UnknownAuth AuthType = 0
+	// PasswordAuth uses a password (argon2id).
+	PasswordAuth AuthType = 10
+	// FIDO2HMACSecretAuth uses a FIDO2 hmac-secret from an authenticator.
+	FIDO2HMACSecretAuth AuthType = 20
+)
+
+var AuthType_name = map[int32]string{
+	0:  "UNKNOWN_AUTH",
+	10: "PASSWORD_AUTH",
+	20: "FIDO2_HMAC_SECRET_AUTH",
+}
+
+var AuthType_value = map[string]int32{
+	"UNKNOWN_AUTH":           0,
+	"PASSWORD_AUTH":          10,
+	"FIDO2_HMAC_SECRET_AUTH": 20,
+}
+
+func (x AuthType) String() string {
+	return proto.EnumName(AuthType_name, int32(x))
+}
+
+func (AuthType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{2}
 }
 
 type ExportType int32
@@ -79,7 +153,7 @@ func (x ExportType) String() string {
 }
 
 func (ExportType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{1}
+	return fileDescriptor_9084e97af2346a26, []int{3}
 }
 
 type KeyType int32
@@ -90,6 +164,10 @@ const (
 	EdX25519Public KeyType = 11
 	X25519         KeyType = 20
 	X25519Public   KeyType = 21
+	// FIDO2 keys are (resident) credentials on a FIDO2 authenticator, the
+	// private key never leaves the device.
+	FIDO2ES256 KeyType = 30
+	FIDO2EdDSA KeyType = 31
 )
 
 var KeyType_name = map[int32]string{
@@ -98,6 +176,8 @@ var KeyType_name = map[int32]string{
 	11: "EDX25519_PUBLIC",
 	20: "X25519",
 	21: "X25519_PUBLIC",
+	30: "FIDO2_ES256",
+	31: "FIDO2_EDDSA",
 }
 
 var KeyType_value = map[string]int32{
@@ -106,6 +186,8 @@ var KeyType_value = map[string]int32{
 	"EDX25519_PUBLIC":  11,
 	"X25519":           20,
 	"X25519_PUBLIC":    21,
+	"FIDO2_ES256":      30,
+	"FIDO2_EDDSA":      31,
 }
 
 func (x KeyType) String() string {
@@ -113,7 +195,7 @@ func (x KeyType) String() string {
 }
 
 func (KeyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{2}
+	return fileDescriptor_9084e97af2346a26, []int{4}
 }
 
 type SortDirection int32
@@ -138,7 +220,7 @@ func (x SortDirection) String() string {
 }
 
 func (SortDirection) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{3}
+	return fileDescriptor_9084e97af2346a26, []int{5}
 }
 
 type SecretType int32
@@ -149,6 +231,8 @@ const (
 	ContactSecret  SecretType = 11
 	CardSecret     SecretType = 12
 	NoteSecret     SecretType = 13
+	// OTP_SECRET is a TOTP/HOTP secret, the password is an otpauth:// URI.
+	OTPSecret SecretType = 14
 )
 
 var SecretType_name = map[int32]string{
@@ -157,6 +241,7 @@ var SecretType_name = map[int32]string{
 	11: "CONTACT_SECRET",
 	12: "CARD_SECRET",
 	13: "NOTE_SECRET",
+	14: "OTP_SECRET",
 }
 
 var SecretType_value = map[string]int32{
@@ -165,6 +250,7 @@ var SecretType_value = map[string]int32{
 	"CONTACT_SECRET":      11,
 	"CARD_SECRET":         12,
 	"NOTE_SECRET":         13,
+	"OTP_SECRET":          14,
 }
 
 func (x SecretType) String() string {
@@ -172,7 +258,44 @@ func (x SecretType) String() string {
 }
 
 func (SecretType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{4}
+	return fileDescriptor_9084e97af2346a26, []int{6}
+}
+
+type SecretsImportFormat int32
+
+const (
+	UnknownImportFormat SecretsImportFormat = 0
+	OnePasswordCSV      SecretsImportFormat = 10
+	BitwardenJSON       SecretsImportFormat = 20
+	BitwardenCSV        SecretsImportFormat = 21
+	KeePassXML          SecretsImportFormat = 30
+	ChromeCSV           SecretsImportFormat = 40
+)
+
+var SecretsImportFormat_name = map[int32]string{
+	0:  "UNKNOWN_IMPORT_FORMAT",
+	10: "ONEPASSWORD_CSV",
+	20: "BITWARDEN_JSON",
+	21: "BITWARDEN_CSV",
+	30: "KEEPASS_XML",
+	40: "CHROME_CSV",
+}
+
+var SecretsImportFormat_value = map[string]int32{
+	"UNKNOWN_IMPORT_FORMAT": 0,
+	"ONEPASSWORD_CSV":       10,
+	"BITWARDEN_JSON":        20,
+	"BITWARDEN_CSV":         21,
+	"KEEPASS_XML":           30,
+	"CHROME_CSV":            40,
+}
+
+func (x SecretsImportFormat) String() string {
+	return proto.EnumName(SecretsImportFormat_name, int32(x))
+}
+
+func (SecretsImportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{7}
 }
 
 type Encoding int32
@@ -215,7 +338,7 @@ func (x Encoding) String() string {
 }
 
 func (Encoding) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{5}
+	return fileDescriptor_9084e97af2346a26, []int{8}
 }
 
 type UserStatus int32
@@ -255,7 +378,7 @@ func (x UserStatus) String() string {
 }
 
 func (UserStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{6}
+	return fileDescriptor_9084e97af2346a26, []int{9}
 }
 
 type WatchStatus int32
@@ -289,7 +412,7 @@ func (x WatchStatus) String() string {
 }
 
 func (WatchStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{7}
+	return fileDescriptor_9084e97af2346a26, []int{10}
 }
 
 type PrefKey int32
@@ -311,7 +434,7 @@ func (x PrefKey) String() string {
 }
 
 func (PrefKey) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{8}
+	return fileDescriptor_9084e97af2346a26, []int{11}
 }
 
 type WormholeStatus int32
@@ -357,7 +480,7 @@ func (x WormholeStatus) String() string {
 }
 
 func (WormholeStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{9}
+	return fileDescriptor_9084e97af2346a26, []int{12}
 }
 
 type ContentType int32
@@ -382,7 +505,7 @@ func (x ContentType) String() string {
 }
 
 func (ContentType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{10}
+	return fileDescriptor_9084e97af2346a26, []int{13}
 }
 
 type MessageType int32
@@ -410,7 +533,7 @@ func (x MessageType) String() string {
 }
 
 func (MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{11}
+	return fileDescriptor_9084e97af2346a26, []int{14}
 }
 
 type RPCError struct {
@@ -455,13 +578,64 @@ func (m *RPCError) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RPCError proto.InternalMessageInfo
 
+// SignPolicy is required signers for verifying (detached) signatures.
+type SignPolicy struct {
+	// Signers (KIDs or users, for example gabriel@github).
+	Signers []string `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	// Threshold is the number of signers required. If 0, all signers are
+	// required.
+	Threshold            int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPolicy) Reset()         { *m = SignPolicy{} }
+func (m *SignPolicy) String() string { return proto.CompactTextString(m) }
+func (*SignPolicy) ProtoMessage()    {}
+func (*SignPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{1}
+}
+func (m *SignPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPolicy.Merge(m, src)
+}
+func (m *SignPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPolicy proto.InternalMessageInfo
+
 type SignRequest struct {
 	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 	// Armored, if true, output will be armored.
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Detached, if true, output will be just the signature.
-	Detached             bool     `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	// Format of the signature.
+	Format SignFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignFormat" json:"format,omitempty"`
+	// Namespace (SSH format only), for example "git" or "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Sig is an existing signature to add to (multi format only).
+	Sig                  []byte   `protobuf:"bytes,14,opt,name=sig,proto3" json:"sig,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -471,7 +645,7 @@ func (m *SignRequest) Reset()         { *m = SignRequest{} }
 func (m *SignRequest) String() string { return proto.CompactTextString(m) }
 func (*SignRequest) ProtoMessage()    {}
 func (*SignRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{1}
+	return fileDescriptor_9084e97af2346a26, []int{2}
 }
 func (m *SignRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +687,7 @@ func (m *SignResponse) Reset()         { *m = SignResponse{} }
 func (m *SignResponse) String() string { return proto.CompactTextString(m) }
 func (*SignResponse) ProtoMessage()    {}
 func (*SignResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{2}
+	return fileDescriptor_9084e97af2346a26, []int{3}
 }
 func (m *SignResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +725,11 @@ type SignFileInput struct {
 	// Armored, if true, output will be armored.
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Detached, if true, output will be just the signature.
-	Detached             bool     `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	// Format of the signature.
+	Format SignFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignFormat" json:"format,omitempty"`
+	// Sig is an existing signature to add to (multi format only).
+	Sig                  []byte   `protobuf:"bytes,14,opt,name=sig,proto3" json:"sig,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -561,7 +739,7 @@ func (m *SignFileInput) Reset()         { *m = SignFileInput{} }
 func (m *SignFileInput) String() string { return proto.CompactTextString(m) }
 func (*SignFileInput) ProtoMessage()    {}
 func (*SignFileInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{3}
+	return fileDescriptor_9084e97af2346a26, []int{4}
 }
 func (m *SignFileInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +781,7 @@ func (m *SignFileOutput) Reset()         { *m = SignFileOutput{} }
 func (m *SignFileOutput) String() string { return proto.CompactTextString(m) }
 func (*SignFileOutput) ProtoMessage()    {}
 func (*SignFileOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{4}
+	return fileDescriptor_9084e97af2346a26, []int{5}
 }
 func (m *SignFileOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,13 +810,197 @@ func (m *SignFileOutput) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignFileOutput proto.InternalMessageInfo
 
+type SignDirRequest struct {
+	// Dir is the directory path.
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// Out is the manifest path, defaults to {dir}/.keys-manifest.
+	Out    string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Ignore patterns (in addition to .keysignore), for example "*.tmp" or
+	// "build/".
+	Ignore               []string `protobuf:"bytes,6,rep,name=ignore,proto3" json:"ignore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignDirRequest) Reset()         { *m = SignDirRequest{} }
+func (m *SignDirRequest) String() string { return proto.CompactTextString(m) }
+func (*SignDirRequest) ProtoMessage()    {}
+func (*SignDirRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{6}
+}
+func (m *SignDirRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignDirRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignDirRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignDirRequest.Merge(m, src)
+}
+func (m *SignDirRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignDirRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignDirRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignDirRequest proto.InternalMessageInfo
+
+type SignDirResponse struct {
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Out is the manifest path.
+	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	// Files is the number of files in the manifest.
+	Files                int32    `protobuf:"varint,3,opt,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignDirResponse) Reset()         { *m = SignDirResponse{} }
+func (m *SignDirResponse) String() string { return proto.CompactTextString(m) }
+func (*SignDirResponse) ProtoMessage()    {}
+func (*SignDirResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{7}
+}
+func (m *SignDirResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignDirResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignDirResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignDirResponse.Merge(m, src)
+}
+func (m *SignDirResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignDirResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignDirResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignDirResponse proto.InternalMessageInfo
+
+type VerifyDirRequest struct {
+	// Dir is the directory path.
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// Manifest path, defaults to {dir}/.keys-manifest.
+	Manifest string `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Trust policy name (optional).
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyDirRequest) Reset()         { *m = VerifyDirRequest{} }
+func (m *VerifyDirRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyDirRequest) ProtoMessage()    {}
+func (*VerifyDirRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{8}
+}
+func (m *VerifyDirRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDirRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyDirRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDirRequest.Merge(m, src)
+}
+func (m *VerifyDirRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDirRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDirRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDirRequest proto.InternalMessageInfo
+
+type VerifyDirResponse struct {
+	Signer *Key `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Added files, not in the manifest.
+	Added []string `protobuf:"bytes,2,rep,name=added,proto3" json:"added,omitempty"`
+	// Removed files, in the manifest but missing.
+	Removed []string `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+	// Modified files, with a different size or SHA-256 digest.
+	Modified             []string `protobuf:"bytes,4,rep,name=modified,proto3" json:"modified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyDirResponse) Reset()         { *m = VerifyDirResponse{} }
+func (m *VerifyDirResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyDirResponse) ProtoMessage()    {}
+func (*VerifyDirResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{9}
+}
+func (m *VerifyDirResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDirResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyDirResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDirResponse.Merge(m, src)
+}
+func (m *VerifyDirResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDirResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDirResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDirResponse proto.InternalMessageInfo
+
 type VerifyRequest struct {
 	// Data is verified output.
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	// Armored, if true, output will be armored.
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Detached signature.
-	Detached             bool     `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	// Trust policy name (optional).
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -648,7 +1010,7 @@ func (m *VerifyRequest) Reset()         { *m = VerifyRequest{} }
 func (m *VerifyRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyRequest) ProtoMessage()    {}
 func (*VerifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{5}
+	return fileDescriptor_9084e97af2346a26, []int{10}
 }
 func (m *VerifyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +1052,7 @@ func (m *VerifyResponse) Reset()         { *m = VerifyResponse{} }
 func (m *VerifyResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyResponse) ProtoMessage()    {}
 func (*VerifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{6}
+	return fileDescriptor_9084e97af2346a26, []int{11}
 }
 func (m *VerifyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +1086,15 @@ type VerifyDetachedRequest struct {
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Sig  []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
 	// Armored, if true, sig is armored.
-	Armored              bool     `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	// Format of the signature.
+	Format SignFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignFormat" json:"format,omitempty"`
+	// Namespace (SSH format only), for example "git" or "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Policy for signers (optional).
+	Policy *SignPolicy `protobuf:"bytes,14,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Trust policy name (optional).
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -734,7 +1104,7 @@ func (m *VerifyDetachedRequest) Reset()         { *m = VerifyDetachedRequest{} }
 func (m *VerifyDetachedRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyDetachedRequest) ProtoMessage()    {}
 func (*VerifyDetachedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{7}
+	return fileDescriptor_9084e97af2346a26, []int{12}
 }
 func (m *VerifyDetachedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,7 +1134,10 @@ func (m *VerifyDetachedRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_VerifyDetachedRequest proto.InternalMessageInfo
 
 type VerifyDetachedResponse struct {
-	Signer               *Key     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Signer (the first, for multi-signatures).
+	Signer *Key `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Signers, verified.
+	Signers              []*Key   `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -774,7 +1147,7 @@ func (m *VerifyDetachedResponse) Reset()         { *m = VerifyDetachedResponse{}
 func (m *VerifyDetachedResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyDetachedResponse) ProtoMessage()    {}
 func (*VerifyDetachedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{8}
+	return fileDescriptor_9084e97af2346a26, []int{13}
 }
 func (m *VerifyDetachedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +1178,9 @@ var xxx_messageInfo_VerifyDetachedResponse proto.InternalMessageInfo
 
 type VerifyInput struct {
 	// Data to verify.
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Trust policy name (optional, first message only).
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -815,7 +1190,7 @@ func (m *VerifyInput) Reset()         { *m = VerifyInput{} }
 func (m *VerifyInput) String() string { return proto.CompactTextString(m) }
 func (*VerifyInput) ProtoMessage()    {}
 func (*VerifyInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{9}
+	return fileDescriptor_9084e97af2346a26, []int{14}
 }
 func (m *VerifyInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,7 +1232,7 @@ func (m *VerifyOutput) Reset()         { *m = VerifyOutput{} }
 func (m *VerifyOutput) String() string { return proto.CompactTextString(m) }
 func (*VerifyOutput) ProtoMessage()    {}
 func (*VerifyOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{10}
+	return fileDescriptor_9084e97af2346a26, []int{15}
 }
 func (m *VerifyOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,7 +1267,9 @@ type VerifyFileInput struct {
 	// Out is output file path.
 	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
 	// Armored, if true, sig is armored.
-	Armored              bool     `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	// Trust policy name (optional).
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -902,7 +1279,7 @@ func (m *VerifyFileInput) Reset()         { *m = VerifyFileInput{} }
 func (m *VerifyFileInput) String() string { return proto.CompactTextString(m) }
 func (*VerifyFileInput) ProtoMessage()    {}
 func (*VerifyFileInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{11}
+	return fileDescriptor_9084e97af2346a26, []int{16}
 }
 func (m *VerifyFileInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +1320,7 @@ func (m *VerifyFileOutput) Reset()         { *m = VerifyFileOutput{} }
 func (m *VerifyFileOutput) String() string { return proto.CompactTextString(m) }
 func (*VerifyFileOutput) ProtoMessage()    {}
 func (*VerifyFileOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{12}
+	return fileDescriptor_9084e97af2346a26, []int{17}
 }
 func (m *VerifyFileOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1355,15 @@ type VerifyDetachedFileInput struct {
 	// Signature (detached).
 	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
 	// Armored, if true, sig is armored.
-	Armored              bool     `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	// Format of the signature.
+	Format SignFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignFormat" json:"format,omitempty"`
+	// Namespace (SSH format only), for example "git" or "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Policy for signers (optional).
+	Policy *SignPolicy `protobuf:"bytes,14,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Trust policy name (optional).
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -988,7 +1373,7 @@ func (m *VerifyDetachedFileInput) Reset()         { *m = VerifyDetachedFileInput
 func (m *VerifyDetachedFileInput) String() string { return proto.CompactTextString(m) }
 func (*VerifyDetachedFileInput) ProtoMessage()    {}
 func (*VerifyDetachedFileInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{13}
+	return fileDescriptor_9084e97af2346a26, []int{18}
 }
 func (m *VerifyDetachedFileInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,7 +1408,15 @@ type VerifyDetachedInput struct {
 	// Signature (detached).
 	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
 	// Armored, if true, sig is armored.
-	Armored              bool     `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	// Format of the signature.
+	Format SignFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignFormat" json:"format,omitempty"`
+	// Namespace (SSH format only), for example "git" or "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Policy for signers (optional).
+	Policy *SignPolicy `protobuf:"bytes,14,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Trust policy name (optional).
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1033,7 +1426,7 @@ func (m *VerifyDetachedInput) Reset()         { *m = VerifyDetachedInput{} }
 func (m *VerifyDetachedInput) String() string { return proto.CompactTextString(m) }
 func (*VerifyDetachedInput) ProtoMessage()    {}
 func (*VerifyDetachedInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{14}
+	return fileDescriptor_9084e97af2346a26, []int{19}
 }
 func (m *VerifyDetachedInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1088,7 +1481,7 @@ func (m *Statement) Reset()         { *m = Statement{} }
 func (m *Statement) String() string { return proto.CompactTextString(m) }
 func (*Statement) ProtoMessage()    {}
 func (*Statement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{15}
+	return fileDescriptor_9084e97af2346a26, []int{20}
 }
 func (m *Statement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,7 +1521,7 @@ func (m *SigchainRequest) Reset()         { *m = SigchainRequest{} }
 func (m *SigchainRequest) String() string { return proto.CompactTextString(m) }
 func (*SigchainRequest) ProtoMessage()    {}
 func (*SigchainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{16}
+	return fileDescriptor_9084e97af2346a26, []int{21}
 }
 func (m *SigchainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1169,7 +1562,7 @@ func (m *SigchainResponse) Reset()         { *m = SigchainResponse{} }
 func (m *SigchainResponse) String() string { return proto.CompactTextString(m) }
 func (*SigchainResponse) ProtoMessage()    {}
 func (*SigchainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{17}
+	return fileDescriptor_9084e97af2346a26, []int{22}
 }
 func (m *SigchainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,7 +1603,7 @@ func (m *StatementRequest) Reset()         { *m = StatementRequest{} }
 func (m *StatementRequest) String() string { return proto.CompactTextString(m) }
 func (*StatementRequest) ProtoMessage()    {}
 func (*StatementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{18}
+	return fileDescriptor_9084e97af2346a26, []int{23}
 }
 func (m *StatementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1643,7 @@ func (m *StatementResponse) Reset()         { *m = StatementResponse{} }
 func (m *StatementResponse) String() string { return proto.CompactTextString(m) }
 func (*StatementResponse) ProtoMessage()    {}
 func (*StatementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{19}
+	return fileDescriptor_9084e97af2346a26, []int{24}
 }
 func (m *StatementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,6 +1675,12 @@ var xxx_messageInfo_StatementResponse proto.InternalMessageInfo
 type StatementCreateRequest struct {
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	KID  string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Type of statement, empty for a generic statement, "device",
+	// "encrypt-key" or "fido2-key" to link a key (see LinkKID).
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// LinkKID is the device (EdX25519), encryption (X25519) or FIDO2 key to
+	// link.
+	LinkKID string `protobuf:"bytes,4,opt,name=linkKid,proto3" json:"linkKid,omitempty"`
 	// Local, if true, won't save to the current key server.
 	Local                bool     `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1293,7 +1692,7 @@ func (m *StatementCreateRequest) Reset()         { *m = StatementCreateRequest{}
 func (m *StatementCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*StatementCreateRequest) ProtoMessage()    {}
 func (*StatementCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{20}
+	return fileDescriptor_9084e97af2346a26, []int{25}
 }
 func (m *StatementCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1732,7 @@ func (m *StatementCreateResponse) Reset()         { *m = StatementCreateResponse
 func (m *StatementCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*StatementCreateResponse) ProtoMessage()    {}
 func (*StatementCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{21}
+	return fileDescriptor_9084e97af2346a26, []int{26}
 }
 func (m *StatementCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1776,7 @@ func (m *StatementRevokeRequest) Reset()         { *m = StatementRevokeRequest{}
 func (m *StatementRevokeRequest) String() string { return proto.CompactTextString(m) }
 func (*StatementRevokeRequest) ProtoMessage()    {}
 func (*StatementRevokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{22}
+	return fileDescriptor_9084e97af2346a26, []int{27}
 }
 func (m *StatementRevokeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1417,7 +1816,7 @@ func (m *StatementRevokeResponse) Reset()         { *m = StatementRevokeResponse
 func (m *StatementRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*StatementRevokeResponse) ProtoMessage()    {}
 func (*StatementRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{23}
+	return fileDescriptor_9084e97af2346a26, []int{28}
 }
 func (m *StatementRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1855,13 @@ type SignInput struct {
 	// Armored, if true, output will be armored.
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Detached, if true, output will be just the signature.
-	Detached             bool     `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	// Format of the signature.
+	Format SignFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignFormat" json:"format,omitempty"`
+	// Namespace (SSH format only), for example "git" or "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Sig is an existing signature to add to (multi format only).
+	Sig                  []byte   `protobuf:"bytes,14,opt,name=sig,proto3" json:"sig,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1466,7 +1871,7 @@ func (m *SignInput) Reset()         { *m = SignInput{} }
 func (m *SignInput) String() string { return proto.CompactTextString(m) }
 func (*SignInput) ProtoMessage()    {}
 func (*SignInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{24}
+	return fileDescriptor_9084e97af2346a26, []int{29}
 }
 func (m *SignInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1508,7 +1913,7 @@ func (m *SignOutput) Reset()         { *m = SignOutput{} }
 func (m *SignOutput) String() string { return proto.CompactTextString(m) }
 func (*SignOutput) ProtoMessage()    {}
 func (*SignOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{25}
+	return fileDescriptor_9084e97af2346a26, []int{30}
 }
 func (m *SignOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,17 +1952,19 @@ type EncryptRequest struct {
 	// Sender, or empty, if anonymous.
 	Sender string `protobuf:"bytes,12,opt,name=sender,proto3" json:"sender,omitempty"`
 	// Mode is the encryption mode.
-	Mode                 EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Mode EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// Password (for password mode), instead of recipients.
+	Password             string   `protobuf:"bytes,14,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EncryptRequest) Reset()         { *m = EncryptRequest{} }
 func (m *EncryptRequest) String() string { return proto.CompactTextString(m) }
 func (*EncryptRequest) ProtoMessage()    {}
 func (*EncryptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{26}
+	return fileDescriptor_9084e97af2346a26, []int{31}
 }
 func (m *EncryptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,7 +2004,7 @@ func (m *EncryptResponse) Reset()         { *m = EncryptResponse{} }
 func (m *EncryptResponse) String() string { return proto.CompactTextString(m) }
 func (*EncryptResponse) ProtoMessage()    {}
 func (*EncryptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{27}
+	return fileDescriptor_9084e97af2346a26, []int{32}
 }
 func (m *EncryptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1638,17 +2045,19 @@ type EncryptFileInput struct {
 	// Sender, or empty, if anonymous.
 	Sender string `protobuf:"bytes,12,opt,name=sender,proto3" json:"sender,omitempty"`
 	// Mode is the encryption mode.
-	Mode                 EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Mode EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// Password (for password mode), instead of recipients.
+	Password             string   `protobuf:"bytes,14,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EncryptFileInput) Reset()         { *m = EncryptFileInput{} }
 func (m *EncryptFileInput) String() string { return proto.CompactTextString(m) }
 func (*EncryptFileInput) ProtoMessage()    {}
 func (*EncryptFileInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{28}
+	return fileDescriptor_9084e97af2346a26, []int{33}
 }
 func (m *EncryptFileInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +2098,7 @@ func (m *EncryptFileOutput) Reset()         { *m = EncryptFileOutput{} }
 func (m *EncryptFileOutput) String() string { return proto.CompactTextString(m) }
 func (*EncryptFileOutput) ProtoMessage()    {}
 func (*EncryptFileOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{29}
+	return fileDescriptor_9084e97af2346a26, []int{34}
 }
 func (m *EncryptFileOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1728,17 +2137,19 @@ type EncryptInput struct {
 	// Sender, or empty, if anonymous.
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
 	// Mode is the encryption mode.
-	Mode                 EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Mode EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// Password (for password mode), instead of recipients.
+	Password             string   `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EncryptInput) Reset()         { *m = EncryptInput{} }
 func (m *EncryptInput) String() string { return proto.CompactTextString(m) }
 func (*EncryptInput) ProtoMessage()    {}
 func (*EncryptInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{30}
+	return fileDescriptor_9084e97af2346a26, []int{35}
 }
 func (m *EncryptInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1779,7 +2190,7 @@ func (m *EncryptOutput) Reset()         { *m = EncryptOutput{} }
 func (m *EncryptOutput) String() string { return proto.CompactTextString(m) }
 func (*EncryptOutput) ProtoMessage()    {}
 func (*EncryptOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{31}
+	return fileDescriptor_9084e97af2346a26, []int{36}
 }
 func (m *EncryptOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1814,17 +2225,21 @@ type DecryptRequest struct {
 	// Armored, if true, expects data to be armored.
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Mode is the encryption mode.
-	Mode                 EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Mode EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// Password, if encrypted with a password.
+	Password string `protobuf:"bytes,14,opt,name=password,proto3" json:"password,omitempty"`
+	// Trust policy name (optional), for the sender.
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DecryptRequest) Reset()         { *m = DecryptRequest{} }
 func (m *DecryptRequest) String() string { return proto.CompactTextString(m) }
 func (*DecryptRequest) ProtoMessage()    {}
 func (*DecryptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{32}
+	return fileDescriptor_9084e97af2346a26, []int{37}
 }
 func (m *DecryptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +2282,7 @@ func (m *DecryptResponse) Reset()         { *m = DecryptResponse{} }
 func (m *DecryptResponse) String() string { return proto.CompactTextString(m) }
 func (*DecryptResponse) ProtoMessage()    {}
 func (*DecryptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{33}
+	return fileDescriptor_9084e97af2346a26, []int{38}
 }
 func (m *DecryptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,17 +2319,21 @@ type DecryptFileInput struct {
 	// Armored, if true, expects file to be armored.
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Mode is the encryption mode.
-	Mode                 EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Mode EncryptMode `protobuf:"varint,13,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// Password, if encrypted with a password.
+	Password string `protobuf:"bytes,14,opt,name=password,proto3" json:"password,omitempty"`
+	// Trust policy name (optional), for the sender.
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DecryptFileInput) Reset()         { *m = DecryptFileInput{} }
 func (m *DecryptFileInput) String() string { return proto.CompactTextString(m) }
 func (*DecryptFileInput) ProtoMessage()    {}
 func (*DecryptFileInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{34}
+	return fileDescriptor_9084e97af2346a26, []int{39}
 }
 func (m *DecryptFileInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1957,7 +2376,7 @@ func (m *DecryptFileOutput) Reset()         { *m = DecryptFileOutput{} }
 func (m *DecryptFileOutput) String() string { return proto.CompactTextString(m) }
 func (*DecryptFileOutput) ProtoMessage()    {}
 func (*DecryptFileOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{35}
+	return fileDescriptor_9084e97af2346a26, []int{40}
 }
 func (m *DecryptFileOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1988,7 +2407,11 @@ var xxx_messageInfo_DecryptFileOutput proto.InternalMessageInfo
 
 type DecryptInput struct {
 	// Data, encrypted.
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Password, if encrypted with a password (first message only).
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Trust policy name (optional, first message only), for the sender.
+	Trust                string   `protobuf:"bytes,15,opt,name=trust,proto3" json:"trust,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1998,7 +2421,7 @@ func (m *DecryptInput) Reset()         { *m = DecryptInput{} }
 func (m *DecryptInput) String() string { return proto.CompactTextString(m) }
 func (*DecryptInput) ProtoMessage()    {}
 func (*DecryptInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{36}
+	return fileDescriptor_9084e97af2346a26, []int{41}
 }
 func (m *DecryptInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2040,7 +2463,7 @@ func (m *DecryptOutput) Reset()         { *m = DecryptOutput{} }
 func (m *DecryptOutput) String() string { return proto.CompactTextString(m) }
 func (*DecryptOutput) ProtoMessage()    {}
 func (*DecryptOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{37}
+	return fileDescriptor_9084e97af2346a26, []int{42}
 }
 func (m *DecryptOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2079,7 +2502,7 @@ func (m *RuntimeStatusRequest) Reset()         { *m = RuntimeStatusRequest{} }
 func (m *RuntimeStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*RuntimeStatusRequest) ProtoMessage()    {}
 func (*RuntimeStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{38}
+	return fileDescriptor_9084e97af2346a26, []int{43}
 }
 func (m *RuntimeStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2128,7 +2551,7 @@ func (m *RuntimeStatusResponse) Reset()         { *m = RuntimeStatusResponse{} }
 func (m *RuntimeStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*RuntimeStatusResponse) ProtoMessage()    {}
 func (*RuntimeStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{39}
+	return fileDescriptor_9084e97af2346a26, []int{44}
 }
 func (m *RuntimeStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,7 +2584,13 @@ type AuthSetupRequest struct {
 	// Password used to encrypt key backup.
 	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 	// Client name.
-	Client               string   `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
+	Client string `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
+	// Type of auth, in addition to the password (defaults to password only).
+	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	// Device (FIDO2) to provision.
+	Device string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	// PIN (FIDO2), if set on the device.
+	PIN                  string   `protobuf:"bytes,4,opt,name=pin,proto3" json:"pin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2171,7 +2600,7 @@ func (m *AuthSetupRequest) Reset()         { *m = AuthSetupRequest{} }
 func (m *AuthSetupRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthSetupRequest) ProtoMessage()    {}
 func (*AuthSetupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{40}
+	return fileDescriptor_9084e97af2346a26, []int{45}
 }
 func (m *AuthSetupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2211,7 +2640,7 @@ func (m *AuthSetupResponse) Reset()         { *m = AuthSetupResponse{} }
 func (m *AuthSetupResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthSetupResponse) ProtoMessage()    {}
 func (*AuthSetupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{41}
+	return fileDescriptor_9084e97af2346a26, []int{46}
 }
 func (m *AuthSetupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2244,7 +2673,13 @@ type AuthUnlockRequest struct {
 	// Password.
 	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 	// Client name.
-	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Client string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	// Type of auth (defaults to password).
+	Type AuthType `protobuf:"varint,3,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	// Device (FIDO2).
+	Device string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	// PIN (FIDO2), if used when provisioned.
+	PIN                  string   `protobuf:"bytes,5,opt,name=pin,proto3" json:"pin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2254,7 +2689,7 @@ func (m *AuthUnlockRequest) Reset()         { *m = AuthUnlockRequest{} }
 func (m *AuthUnlockRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthUnlockRequest) ProtoMessage()    {}
 func (*AuthUnlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{42}
+	return fileDescriptor_9084e97af2346a26, []int{47}
 }
 func (m *AuthUnlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2295,7 +2730,7 @@ func (m *AuthUnlockResponse) Reset()         { *m = AuthUnlockResponse{} }
 func (m *AuthUnlockResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUnlockResponse) ProtoMessage()    {}
 func (*AuthUnlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{43}
+	return fileDescriptor_9084e97af2346a26, []int{48}
 }
 func (m *AuthUnlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2324,6 +2759,294 @@ func (m *AuthUnlockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuthUnlockResponse proto.InternalMessageInfo
 
+type AuthProvision struct {
+	ID   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	// AAGUID of the authenticator (FIDO2).
+	AAGUID []byte `protobuf:"bytes,3,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	// PIN is true if a PIN is needed to unlock (FIDO2).
+	PIN                  bool     `protobuf:"varint,4,opt,name=pin,proto3" json:"pin,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthProvision) Reset()         { *m = AuthProvision{} }
+func (m *AuthProvision) String() string { return proto.CompactTextString(m) }
+func (*AuthProvision) ProtoMessage()    {}
+func (*AuthProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{49}
+}
+func (m *AuthProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthProvision.Merge(m, src)
+}
+func (m *AuthProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthProvision proto.InternalMessageInfo
+
+type AuthProvisionRequest struct {
+	Type AuthType `protobuf:"varint,1,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	// Device (FIDO2).
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	// PIN (FIDO2), if set on the device.
+	PIN                  string   `protobuf:"bytes,3,opt,name=pin,proto3" json:"pin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthProvisionRequest) Reset()         { *m = AuthProvisionRequest{} }
+func (m *AuthProvisionRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthProvisionRequest) ProtoMessage()    {}
+func (*AuthProvisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{50}
+}
+func (m *AuthProvisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthProvisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthProvisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthProvisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthProvisionRequest.Merge(m, src)
+}
+func (m *AuthProvisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthProvisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthProvisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthProvisionRequest proto.InternalMessageInfo
+
+type AuthProvisionResponse struct {
+	Provision            *AuthProvision `protobuf:"bytes,1,opt,name=provision,proto3" json:"provision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AuthProvisionResponse) Reset()         { *m = AuthProvisionResponse{} }
+func (m *AuthProvisionResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthProvisionResponse) ProtoMessage()    {}
+func (*AuthProvisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{51}
+}
+func (m *AuthProvisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthProvisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthProvisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthProvisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthProvisionResponse.Merge(m, src)
+}
+func (m *AuthProvisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthProvisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthProvisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthProvisionResponse proto.InternalMessageInfo
+
+type AuthDeprovisionRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthDeprovisionRequest) Reset()         { *m = AuthDeprovisionRequest{} }
+func (m *AuthDeprovisionRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthDeprovisionRequest) ProtoMessage()    {}
+func (*AuthDeprovisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{52}
+}
+func (m *AuthDeprovisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthDeprovisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthDeprovisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthDeprovisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthDeprovisionRequest.Merge(m, src)
+}
+func (m *AuthDeprovisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthDeprovisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthDeprovisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthDeprovisionRequest proto.InternalMessageInfo
+
+type AuthDeprovisionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthDeprovisionResponse) Reset()         { *m = AuthDeprovisionResponse{} }
+func (m *AuthDeprovisionResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthDeprovisionResponse) ProtoMessage()    {}
+func (*AuthDeprovisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{53}
+}
+func (m *AuthDeprovisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthDeprovisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthDeprovisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthDeprovisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthDeprovisionResponse.Merge(m, src)
+}
+func (m *AuthDeprovisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthDeprovisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthDeprovisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthDeprovisionResponse proto.InternalMessageInfo
+
+type AuthProvisionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthProvisionsRequest) Reset()         { *m = AuthProvisionsRequest{} }
+func (m *AuthProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthProvisionsRequest) ProtoMessage()    {}
+func (*AuthProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{54}
+}
+func (m *AuthProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthProvisionsRequest.Merge(m, src)
+}
+func (m *AuthProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthProvisionsRequest proto.InternalMessageInfo
+
+type AuthProvisionsResponse struct {
+	Provisions           []*AuthProvision `protobuf:"bytes,1,rep,name=provisions,proto3" json:"provisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AuthProvisionsResponse) Reset()         { *m = AuthProvisionsResponse{} }
+func (m *AuthProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthProvisionsResponse) ProtoMessage()    {}
+func (*AuthProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{55}
+}
+func (m *AuthProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthProvisionsResponse.Merge(m, src)
+}
+func (m *AuthProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthProvisionsResponse proto.InternalMessageInfo
+
 type AuthLockRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2334,7 +3057,7 @@ func (m *AuthLockRequest) Reset()         { *m = AuthLockRequest{} }
 func (m *AuthLockRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthLockRequest) ProtoMessage()    {}
 func (*AuthLockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{44}
+	return fileDescriptor_9084e97af2346a26, []int{56}
 }
 func (m *AuthLockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2373,7 +3096,7 @@ func (m *AuthLockResponse) Reset()         { *m = AuthLockResponse{} }
 func (m *AuthLockResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthLockResponse) ProtoMessage()    {}
 func (*AuthLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{45}
+	return fileDescriptor_9084e97af2346a26, []int{57}
 }
 func (m *AuthLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2403,7 +3126,10 @@ func (m *AuthLockResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_AuthLockResponse proto.InternalMessageInfo
 
 type KeyGenerateRequest struct {
-	Type                 KeyType  `protobuf:"varint,1,opt,name=type,proto3,enum=service.KeyType" json:"type,omitempty"`
+	Type KeyType `protobuf:"varint,1,opt,name=type,proto3,enum=service.KeyType" json:"type,omitempty"`
+	// Device and PIN (FIDO2 key types only).
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	PIN                  string   `protobuf:"bytes,3,opt,name=pin,proto3" json:"pin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2413,7 +3139,7 @@ func (m *KeyGenerateRequest) Reset()         { *m = KeyGenerateRequest{} }
 func (m *KeyGenerateRequest) String() string { return proto.CompactTextString(m) }
 func (*KeyGenerateRequest) ProtoMessage()    {}
 func (*KeyGenerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{46}
+	return fileDescriptor_9084e97af2346a26, []int{58}
 }
 func (m *KeyGenerateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2453,7 +3179,7 @@ func (m *KeyGenerateResponse) Reset()         { *m = KeyGenerateResponse{} }
 func (m *KeyGenerateResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyGenerateResponse) ProtoMessage()    {}
 func (*KeyGenerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{47}
+	return fileDescriptor_9084e97af2346a26, []int{59}
 }
 func (m *KeyGenerateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2496,7 +3222,7 @@ func (m *UserServiceRequest) Reset()         { *m = UserServiceRequest{} }
 func (m *UserServiceRequest) String() string { return proto.CompactTextString(m) }
 func (*UserServiceRequest) ProtoMessage()    {}
 func (*UserServiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{48}
+	return fileDescriptor_9084e97af2346a26, []int{60}
 }
 func (m *UserServiceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2537,7 +3263,7 @@ func (m *UserServiceResponse) Reset()         { *m = UserServiceResponse{} }
 func (m *UserServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*UserServiceResponse) ProtoMessage()    {}
 func (*UserServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{49}
+	return fileDescriptor_9084e97af2346a26, []int{61}
 }
 func (m *UserServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2582,7 +3308,7 @@ func (m *UserSignRequest) Reset()         { *m = UserSignRequest{} }
 func (m *UserSignRequest) String() string { return proto.CompactTextString(m) }
 func (*UserSignRequest) ProtoMessage()    {}
 func (*UserSignRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{50}
+	return fileDescriptor_9084e97af2346a26, []int{62}
 }
 func (m *UserSignRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2625,7 +3351,7 @@ func (m *UserSignResponse) Reset()         { *m = UserSignResponse{} }
 func (m *UserSignResponse) String() string { return proto.CompactTextString(m) }
 func (*UserSignResponse) ProtoMessage()    {}
 func (*UserSignResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{51}
+	return fileDescriptor_9084e97af2346a26, []int{63}
 }
 func (m *UserSignResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2674,7 +3400,7 @@ func (m *UserAddRequest) Reset()         { *m = UserAddRequest{} }
 func (m *UserAddRequest) String() string { return proto.CompactTextString(m) }
 func (*UserAddRequest) ProtoMessage()    {}
 func (*UserAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{52}
+	return fileDescriptor_9084e97af2346a26, []int{64}
 }
 func (m *UserAddRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2715,7 +3441,7 @@ func (m *UserAddResponse) Reset()         { *m = UserAddResponse{} }
 func (m *UserAddResponse) String() string { return proto.CompactTextString(m) }
 func (*UserAddResponse) ProtoMessage()    {}
 func (*UserAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{53}
+	return fileDescriptor_9084e97af2346a26, []int{65}
 }
 func (m *UserAddResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2757,7 +3483,7 @@ func (m *KeyExportRequest) Reset()         { *m = KeyExportRequest{} }
 func (m *KeyExportRequest) String() string { return proto.CompactTextString(m) }
 func (*KeyExportRequest) ProtoMessage()    {}
 func (*KeyExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{54}
+	return fileDescriptor_9084e97af2346a26, []int{66}
 }
 func (m *KeyExportRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2797,7 +3523,7 @@ func (m *KeyExportResponse) Reset()         { *m = KeyExportResponse{} }
 func (m *KeyExportResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyExportResponse) ProtoMessage()    {}
 func (*KeyExportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{55}
+	return fileDescriptor_9084e97af2346a26, []int{67}
 }
 func (m *KeyExportResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2838,7 +3564,7 @@ func (m *KeyImportRequest) Reset()         { *m = KeyImportRequest{} }
 func (m *KeyImportRequest) String() string { return proto.CompactTextString(m) }
 func (*KeyImportRequest) ProtoMessage()    {}
 func (*KeyImportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{56}
+	return fileDescriptor_9084e97af2346a26, []int{68}
 }
 func (m *KeyImportRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2878,7 +3604,7 @@ func (m *KeyImportResponse) Reset()         { *m = KeyImportResponse{} }
 func (m *KeyImportResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyImportResponse) ProtoMessage()    {}
 func (*KeyImportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{57}
+	return fileDescriptor_9084e97af2346a26, []int{69}
 }
 func (m *KeyImportResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2919,7 +3645,7 @@ func (m *KeyRemoveRequest) Reset()         { *m = KeyRemoveRequest{} }
 func (m *KeyRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*KeyRemoveRequest) ProtoMessage()    {}
 func (*KeyRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{58}
+	return fileDescriptor_9084e97af2346a26, []int{70}
 }
 func (m *KeyRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2958,7 +3684,7 @@ func (m *KeyRemoveResponse) Reset()         { *m = KeyRemoveResponse{} }
 func (m *KeyRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyRemoveResponse) ProtoMessage()    {}
 func (*KeyRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{59}
+	return fileDescriptor_9084e97af2346a26, []int{71}
 }
 func (m *KeyRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3008,7 +3734,7 @@ func (m *Key) Reset()         { *m = Key{} }
 func (m *Key) String() string { return proto.CompactTextString(m) }
 func (*Key) ProtoMessage()    {}
 func (*Key) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{60}
+	return fileDescriptor_9084e97af2346a26, []int{72}
 }
 func (m *Key) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3049,7 +3775,7 @@ func (m *KeyRequest) Reset()         { *m = KeyRequest{} }
 func (m *KeyRequest) String() string { return proto.CompactTextString(m) }
 func (*KeyRequest) ProtoMessage()    {}
 func (*KeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{61}
+	return fileDescriptor_9084e97af2346a26, []int{73}
 }
 func (m *KeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3089,7 +3815,7 @@ func (m *KeyResponse) Reset()         { *m = KeyResponse{} }
 func (m *KeyResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyResponse) ProtoMessage()    {}
 func (*KeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{62}
+	return fileDescriptor_9084e97af2346a26, []int{74}
 }
 func (m *KeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3132,7 +3858,7 @@ func (m *KeysRequest) Reset()         { *m = KeysRequest{} }
 func (m *KeysRequest) String() string { return proto.CompactTextString(m) }
 func (*KeysRequest) ProtoMessage()    {}
 func (*KeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{63}
+	return fileDescriptor_9084e97af2346a26, []int{75}
 }
 func (m *KeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3174,7 +3900,7 @@ func (m *KeysResponse) Reset()         { *m = KeysResponse{} }
 func (m *KeysResponse) String() string { return proto.CompactTextString(m) }
 func (*KeysResponse) ProtoMessage()    {}
 func (*KeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{64}
+	return fileDescriptor_9084e97af2346a26, []int{76}
 }
 func (m *KeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3222,7 +3948,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{65}
+	return fileDescriptor_9084e97af2346a26, []int{77}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3262,7 +3988,7 @@ func (m *SecretRequest) Reset()         { *m = SecretRequest{} }
 func (m *SecretRequest) String() string { return proto.CompactTextString(m) }
 func (*SecretRequest) ProtoMessage()    {}
 func (*SecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{66}
+	return fileDescriptor_9084e97af2346a26, []int{78}
 }
 func (m *SecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3302,7 +4028,7 @@ func (m *SecretResponse) Reset()         { *m = SecretResponse{} }
 func (m *SecretResponse) String() string { return proto.CompactTextString(m) }
 func (*SecretResponse) ProtoMessage()    {}
 func (*SecretResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{67}
+	return fileDescriptor_9084e97af2346a26, []int{79}
 }
 func (m *SecretResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3342,7 +4068,7 @@ func (m *SecretSaveRequest) Reset()         { *m = SecretSaveRequest{} }
 func (m *SecretSaveRequest) String() string { return proto.CompactTextString(m) }
 func (*SecretSaveRequest) ProtoMessage()    {}
 func (*SecretSaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{68}
+	return fileDescriptor_9084e97af2346a26, []int{80}
 }
 func (m *SecretSaveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3382,7 +4108,7 @@ func (m *SecretSaveResponse) Reset()         { *m = SecretSaveResponse{} }
 func (m *SecretSaveResponse) String() string { return proto.CompactTextString(m) }
 func (*SecretSaveResponse) ProtoMessage()    {}
 func (*SecretSaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{69}
+	return fileDescriptor_9084e97af2346a26, []int{81}
 }
 func (m *SecretSaveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3422,7 +4148,7 @@ func (m *SecretRemoveRequest) Reset()         { *m = SecretRemoveRequest{} }
 func (m *SecretRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*SecretRemoveRequest) ProtoMessage()    {}
 func (*SecretRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{70}
+	return fileDescriptor_9084e97af2346a26, []int{82}
 }
 func (m *SecretRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3461,7 +4187,7 @@ func (m *SecretRemoveResponse) Reset()         { *m = SecretRemoveResponse{} }
 func (m *SecretRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*SecretRemoveResponse) ProtoMessage()    {}
 func (*SecretRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{71}
+	return fileDescriptor_9084e97af2346a26, []int{83}
 }
 func (m *SecretRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3491,19 +4217,30 @@ func (m *SecretRemoveResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_SecretRemoveResponse proto.InternalMessageInfo
 
 type SecretsRequest struct {
-	Query                string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	SortField            string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection        SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	// Query, terms match (by prefix, or fuzzy) names, usernames, URLs, notes and
+	// tags (#tag in notes). Terms can be restricted to a field, for example
+	// "name:github" or "tag:work". All terms must match.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Types to include, or empty for all.
+	Types []SecretType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=service.SecretType" json:"types,omitempty"`
+	// SortField is "name", "id" or "score" (relevance, best first). Defaults to
+	// "score" with a query, otherwise "name".
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
+	// Index to start from (for pagination).
+	Index int32 `protobuf:"varint,20,opt,name=index,proto3" json:"index,omitempty"`
+	// Limit, or 0 for no limit.
+	Limit                int32    `protobuf:"varint,21,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SecretsRequest) Reset()         { *m = SecretsRequest{} }
 func (m *SecretsRequest) String() string { return proto.CompactTextString(m) }
 func (*SecretsRequest) ProtoMessage()    {}
 func (*SecretsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{72}
+	return fileDescriptor_9084e97af2346a26, []int{84}
 }
 func (m *SecretsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3533,19 +4270,21 @@ func (m *SecretsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_SecretsRequest proto.InternalMessageInfo
 
 type SecretsResponse struct {
-	Secrets              []*Secret     `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	SortField            string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection        SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Secrets       []*Secret     `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
+	// Total number of matching secrets (for pagination).
+	Total                int32    `protobuf:"varint,20,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SecretsResponse) Reset()         { *m = SecretsResponse{} }
 func (m *SecretsResponse) String() string { return proto.CompactTextString(m) }
 func (*SecretsResponse) ProtoMessage()    {}
 func (*SecretsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{73}
+	return fileDescriptor_9084e97af2346a26, []int{85}
 }
 func (m *SecretsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3574,6 +4313,643 @@ func (m *SecretsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SecretsResponse proto.InternalMessageInfo
 
+// SecretVersion is a prior version of a secret.
+type SecretVersion struct {
+	Version int64   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Secret  *Secret `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// ReplacedAt is when this version was replaced (or removed).
+	ReplacedAt           int64    `protobuf:"varint,3,opt,name=replacedAt,proto3" json:"replacedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretVersion) Reset()         { *m = SecretVersion{} }
+func (m *SecretVersion) String() string { return proto.CompactTextString(m) }
+func (*SecretVersion) ProtoMessage()    {}
+func (*SecretVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{86}
+}
+func (m *SecretVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretVersion.Merge(m, src)
+}
+func (m *SecretVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretVersion proto.InternalMessageInfo
+
+type SecretHistoryRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretHistoryRequest) Reset()         { *m = SecretHistoryRequest{} }
+func (m *SecretHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*SecretHistoryRequest) ProtoMessage()    {}
+func (*SecretHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{87}
+}
+func (m *SecretHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretHistoryRequest.Merge(m, src)
+}
+func (m *SecretHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretHistoryRequest proto.InternalMessageInfo
+
+type SecretHistoryResponse struct {
+	// Versions, newest first.
+	Versions             []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SecretHistoryResponse) Reset()         { *m = SecretHistoryResponse{} }
+func (m *SecretHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*SecretHistoryResponse) ProtoMessage()    {}
+func (*SecretHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{88}
+}
+func (m *SecretHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretHistoryResponse.Merge(m, src)
+}
+func (m *SecretHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretHistoryResponse proto.InternalMessageInfo
+
+type SecretRestoreRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretRestoreRequest) Reset()         { *m = SecretRestoreRequest{} }
+func (m *SecretRestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*SecretRestoreRequest) ProtoMessage()    {}
+func (*SecretRestoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{89}
+}
+func (m *SecretRestoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretRestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretRestoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretRestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretRestoreRequest.Merge(m, src)
+}
+func (m *SecretRestoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretRestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretRestoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretRestoreRequest proto.InternalMessageInfo
+
+type SecretRestoreResponse struct {
+	Secret               *Secret  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretRestoreResponse) Reset()         { *m = SecretRestoreResponse{} }
+func (m *SecretRestoreResponse) String() string { return proto.CompactTextString(m) }
+func (*SecretRestoreResponse) ProtoMessage()    {}
+func (*SecretRestoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{90}
+}
+func (m *SecretRestoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretRestoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretRestoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretRestoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretRestoreResponse.Merge(m, src)
+}
+func (m *SecretRestoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretRestoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretRestoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretRestoreResponse proto.InternalMessageInfo
+
+type OTPCodeRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OTPCodeRequest) Reset()         { *m = OTPCodeRequest{} }
+func (m *OTPCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*OTPCodeRequest) ProtoMessage()    {}
+func (*OTPCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{91}
+}
+func (m *OTPCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OTPCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OTPCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OTPCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OTPCodeRequest.Merge(m, src)
+}
+func (m *OTPCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OTPCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OTPCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OTPCodeRequest proto.InternalMessageInfo
+
+type OTPCodeResponse struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Type is "totp" or "hotp".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Remaining is how long (in seconds) the code is valid for, or 0 for HOTP
+	// (valid until used).
+	Remaining int64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// Period (in seconds) for TOTP.
+	Period int64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	// Counter the code was generated from for HOTP.
+	Counter              uint64   `protobuf:"varint,5,opt,name=counter,proto3" json:"counter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OTPCodeResponse) Reset()         { *m = OTPCodeResponse{} }
+func (m *OTPCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*OTPCodeResponse) ProtoMessage()    {}
+func (*OTPCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{92}
+}
+func (m *OTPCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OTPCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OTPCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OTPCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OTPCodeResponse.Merge(m, src)
+}
+func (m *OTPCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OTPCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OTPCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OTPCodeResponse proto.InternalMessageInfo
+
+type SecretsImportRequest struct {
+	// Data is the (unencrypted) export.
+	Data   []byte              `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format SecretsImportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=service.SecretsImportFormat" json:"format,omitempty"`
+	// DryRun to preview the import, without saving.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretsImportRequest) Reset()         { *m = SecretsImportRequest{} }
+func (m *SecretsImportRequest) String() string { return proto.CompactTextString(m) }
+func (*SecretsImportRequest) ProtoMessage()    {}
+func (*SecretsImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{93}
+}
+func (m *SecretsImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretsImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretsImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretsImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretsImportRequest.Merge(m, src)
+}
+func (m *SecretsImportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretsImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretsImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretsImportRequest proto.InternalMessageInfo
+
+type SecretsImportResponse struct {
+	Secrets []*SecretImport `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Imported is the number of secrets saved (or to be saved if dry run).
+	Imported int32 `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	// Duplicates is the number of secrets skipped as duplicates.
+	Duplicates           int32    `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretsImportResponse) Reset()         { *m = SecretsImportResponse{} }
+func (m *SecretsImportResponse) String() string { return proto.CompactTextString(m) }
+func (*SecretsImportResponse) ProtoMessage()    {}
+func (*SecretsImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{94}
+}
+func (m *SecretsImportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretsImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretsImportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretsImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretsImportResponse.Merge(m, src)
+}
+func (m *SecretsImportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretsImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretsImportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretsImportResponse proto.InternalMessageInfo
+
+// SecretImport is a secret from an import.
+type SecretImport struct {
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Duplicate is the ID of an existing (or earlier imported) secret with the
+	// same content, if any. Duplicates are skipped.
+	Duplicate            string   `protobuf:"bytes,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretImport) Reset()         { *m = SecretImport{} }
+func (m *SecretImport) String() string { return proto.CompactTextString(m) }
+func (*SecretImport) ProtoMessage()    {}
+func (*SecretImport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{95}
+}
+func (m *SecretImport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretImport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretImport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretImport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretImport.Merge(m, src)
+}
+func (m *SecretImport) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretImport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretImport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretImport proto.InternalMessageInfo
+
+type VaultSyncRequest struct {
+	// KID (EdX25519) to encrypt the vault to, enables syncing if not already
+	// enabled.
+	KID                  string   `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VaultSyncRequest) Reset()         { *m = VaultSyncRequest{} }
+func (m *VaultSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*VaultSyncRequest) ProtoMessage()    {}
+func (*VaultSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{96}
+}
+func (m *VaultSyncRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultSyncRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSyncRequest.Merge(m, src)
+}
+func (m *VaultSyncRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultSyncRequest proto.InternalMessageInfo
+
+type VaultSyncResponse struct {
+	KID                  string           `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Conflicts            []*VaultConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *VaultSyncResponse) Reset()         { *m = VaultSyncResponse{} }
+func (m *VaultSyncResponse) String() string { return proto.CompactTextString(m) }
+func (*VaultSyncResponse) ProtoMessage()    {}
+func (*VaultSyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{97}
+}
+func (m *VaultSyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultSyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultSyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSyncResponse.Merge(m, src)
+}
+func (m *VaultSyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultSyncResponse proto.InternalMessageInfo
+
+// VaultConflict is a remote change that replaced a local change made
+// concurrently.
+type VaultConflict struct {
+	// ID of the secret.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Secret is the remote version that was applied, or empty if removed.
+	Secret *Secret `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Local is the version that was replaced, or empty if missing.
+	Local                *Secret  `protobuf:"bytes,3,opt,name=local,proto3" json:"local,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VaultConflict) Reset()         { *m = VaultConflict{} }
+func (m *VaultConflict) String() string { return proto.CompactTextString(m) }
+func (*VaultConflict) ProtoMessage()    {}
+func (*VaultConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{98}
+}
+func (m *VaultConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultConflict.Merge(m, src)
+}
+func (m *VaultConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultConflict proto.InternalMessageInfo
+
+type SSHAgentRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHAgentRequest) Reset()         { *m = SSHAgentRequest{} }
+func (m *SSHAgentRequest) String() string { return proto.CompactTextString(m) }
+func (*SSHAgentRequest) ProtoMessage()    {}
+func (*SSHAgentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{99}
+}
+func (m *SSHAgentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHAgentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHAgentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHAgentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHAgentRequest.Merge(m, src)
+}
+func (m *SSHAgentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHAgentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHAgentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHAgentRequest proto.InternalMessageInfo
+
+type SSHAgentResponse struct {
+	// Path to the agent (unix) socket, for SSH_AUTH_SOCK.
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHAgentResponse) Reset()         { *m = SSHAgentResponse{} }
+func (m *SSHAgentResponse) String() string { return proto.CompactTextString(m) }
+func (*SSHAgentResponse) ProtoMessage()    {}
+func (*SSHAgentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{100}
+}
+func (m *SSHAgentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHAgentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHAgentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHAgentResponse.Merge(m, src)
+}
+func (m *SSHAgentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHAgentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHAgentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHAgentResponse proto.InternalMessageInfo
+
 type ItemRequest struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3585,7 +4961,7 @@ func (m *ItemRequest) Reset()         { *m = ItemRequest{} }
 func (m *ItemRequest) String() string { return proto.CompactTextString(m) }
 func (*ItemRequest) ProtoMessage()    {}
 func (*ItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{74}
+	return fileDescriptor_9084e97af2346a26, []int{101}
 }
 func (m *ItemRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3625,7 +5001,7 @@ func (m *ItemResponse) Reset()         { *m = ItemResponse{} }
 func (m *ItemResponse) String() string { return proto.CompactTextString(m) }
 func (*ItemResponse) ProtoMessage()    {}
 func (*ItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{75}
+	return fileDescriptor_9084e97af2346a26, []int{102}
 }
 func (m *ItemResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3655,6 +5031,8 @@ func (m *ItemResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ItemResponse proto.InternalMessageInfo
 
 type ItemsRequest struct {
+	// Query, secrets match using the secret search index (see SecretsRequest),
+	// other items match by ID or type.
 	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -3665,7 +5043,7 @@ func (m *ItemsRequest) Reset()         { *m = ItemsRequest{} }
 func (m *ItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*ItemsRequest) ProtoMessage()    {}
 func (*ItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{76}
+	return fileDescriptor_9084e97af2346a26, []int{103}
 }
 func (m *ItemsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3705,7 +5083,7 @@ func (m *ItemsResponse) Reset()         { *m = ItemsResponse{} }
 func (m *ItemsResponse) String() string { return proto.CompactTextString(m) }
 func (*ItemsResponse) ProtoMessage()    {}
 func (*ItemsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{77}
+	return fileDescriptor_9084e97af2346a26, []int{104}
 }
 func (m *ItemsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3746,7 +5124,7 @@ func (m *Item) Reset()         { *m = Item{} }
 func (m *Item) String() string { return proto.CompactTextString(m) }
 func (*Item) ProtoMessage()    {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{78}
+	return fileDescriptor_9084e97af2346a26, []int{105}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3787,7 +5165,7 @@ func (m *RandRequest) Reset()         { *m = RandRequest{} }
 func (m *RandRequest) String() string { return proto.CompactTextString(m) }
 func (*RandRequest) ProtoMessage()    {}
 func (*RandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{79}
+	return fileDescriptor_9084e97af2346a26, []int{106}
 }
 func (m *RandRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3827,7 +5205,7 @@ func (m *RandResponse) Reset()         { *m = RandResponse{} }
 func (m *RandResponse) String() string { return proto.CompactTextString(m) }
 func (*RandResponse) ProtoMessage()    {}
 func (*RandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{80}
+	return fileDescriptor_9084e97af2346a26, []int{107}
 }
 func (m *RandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3867,7 +5245,7 @@ func (m *RandPasswordRequest) Reset()         { *m = RandPasswordRequest{} }
 func (m *RandPasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*RandPasswordRequest) ProtoMessage()    {}
 func (*RandPasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{81}
+	return fileDescriptor_9084e97af2346a26, []int{108}
 }
 func (m *RandPasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3907,7 +5285,7 @@ func (m *RandPasswordResponse) Reset()         { *m = RandPasswordResponse{} }
 func (m *RandPasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*RandPasswordResponse) ProtoMessage()    {}
 func (*RandPasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{82}
+	return fileDescriptor_9084e97af2346a26, []int{109}
 }
 func (m *RandPasswordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3947,7 +5325,7 @@ func (m *PullRequest) Reset()         { *m = PullRequest{} }
 func (m *PullRequest) String() string { return proto.CompactTextString(m) }
 func (*PullRequest) ProtoMessage()    {}
 func (*PullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{83}
+	return fileDescriptor_9084e97af2346a26, []int{110}
 }
 func (m *PullRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +5365,7 @@ func (m *PullResponse) Reset()         { *m = PullResponse{} }
 func (m *PullResponse) String() string { return proto.CompactTextString(m) }
 func (*PullResponse) ProtoMessage()    {}
 func (*PullResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{84}
+	return fileDescriptor_9084e97af2346a26, []int{111}
 }
 func (m *PullResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4028,7 +5406,7 @@ func (m *PushRequest) Reset()         { *m = PushRequest{} }
 func (m *PushRequest) String() string { return proto.CompactTextString(m) }
 func (*PushRequest) ProtoMessage()    {}
 func (*PushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{85}
+	return fileDescriptor_9084e97af2346a26, []int{112}
 }
 func (m *PushRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4069,7 +5447,7 @@ func (m *PushResponse) Reset()         { *m = PushResponse{} }
 func (m *PushResponse) String() string { return proto.CompactTextString(m) }
 func (*PushResponse) ProtoMessage()    {}
 func (*PushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{86}
+	return fileDescriptor_9084e97af2346a26, []int{113}
 }
 func (m *PushResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4109,7 +5487,7 @@ func (m *Collection) Reset()         { *m = Collection{} }
 func (m *Collection) String() string { return proto.CompactTextString(m) }
 func (*Collection) ProtoMessage()    {}
 func (*Collection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{87}
+	return fileDescriptor_9084e97af2346a26, []int{114}
 }
 func (m *Collection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4149,7 +5527,7 @@ func (m *CollectionsRequest) Reset()         { *m = CollectionsRequest{} }
 func (m *CollectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*CollectionsRequest) ProtoMessage()    {}
 func (*CollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{88}
+	return fileDescriptor_9084e97af2346a26, []int{115}
 }
 func (m *CollectionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4189,7 +5567,7 @@ func (m *CollectionsResponse) Reset()         { *m = CollectionsResponse{} }
 func (m *CollectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*CollectionsResponse) ProtoMessage()    {}
 func (*CollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{89}
+	return fileDescriptor_9084e97af2346a26, []int{116}
 }
 func (m *CollectionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4232,7 +5610,7 @@ func (m *Document) Reset()         { *m = Document{} }
 func (m *Document) String() string { return proto.CompactTextString(m) }
 func (*Document) ProtoMessage()    {}
 func (*Document) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{90}
+	return fileDescriptor_9084e97af2346a26, []int{117}
 }
 func (m *Document) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4273,7 +5651,7 @@ func (m *DocumentsRequest) Reset()         { *m = DocumentsRequest{} }
 func (m *DocumentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DocumentsRequest) ProtoMessage()    {}
 func (*DocumentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{91}
+	return fileDescriptor_9084e97af2346a26, []int{118}
 }
 func (m *DocumentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4313,7 +5691,7 @@ func (m *DocumentsResponse) Reset()         { *m = DocumentsResponse{} }
 func (m *DocumentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DocumentsResponse) ProtoMessage()    {}
 func (*DocumentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{92}
+	return fileDescriptor_9084e97af2346a26, []int{119}
 }
 func (m *DocumentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4353,7 +5731,7 @@ func (m *DocumentDeleteRequest) Reset()         { *m = DocumentDeleteRequest{} }
 func (m *DocumentDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DocumentDeleteRequest) ProtoMessage()    {}
 func (*DocumentDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{93}
+	return fileDescriptor_9084e97af2346a26, []int{120}
 }
 func (m *DocumentDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4392,7 +5770,7 @@ func (m *DocumentDeleteResponse) Reset()         { *m = DocumentDeleteResponse{}
 func (m *DocumentDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DocumentDeleteResponse) ProtoMessage()    {}
 func (*DocumentDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{94}
+	return fileDescriptor_9084e97af2346a26, []int{121}
 }
 func (m *DocumentDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4421,6 +5799,134 @@ func (m *DocumentDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DocumentDeleteResponse proto.InternalMessageInfo
 
+type DBMigration struct {
+	// Version of the schema after the migration.
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBMigration) Reset()         { *m = DBMigration{} }
+func (m *DBMigration) String() string { return proto.CompactTextString(m) }
+func (*DBMigration) ProtoMessage()    {}
+func (*DBMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{122}
+}
+func (m *DBMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBMigration.Merge(m, src)
+}
+func (m *DBMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBMigration proto.InternalMessageInfo
+
+type DBMigrateRequest struct {
+	// DryRun, if true, only returns the pending migrations.
+	DryRun               bool     `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBMigrateRequest) Reset()         { *m = DBMigrateRequest{} }
+func (m *DBMigrateRequest) String() string { return proto.CompactTextString(m) }
+func (*DBMigrateRequest) ProtoMessage()    {}
+func (*DBMigrateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{123}
+}
+func (m *DBMigrateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBMigrateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBMigrateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBMigrateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBMigrateRequest.Merge(m, src)
+}
+func (m *DBMigrateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBMigrateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBMigrateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBMigrateRequest proto.InternalMessageInfo
+
+type DBMigrateResponse struct {
+	// Version of the schema before migrating.
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Latest is the schema version supported by the service.
+	Latest int32 `protobuf:"varint,2,opt,name=latest,proto3" json:"latest,omitempty"`
+	// Migrations pending (if dry run) or applied.
+	Migrations           []*DBMigration `protobuf:"bytes,3,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DBMigrateResponse) Reset()         { *m = DBMigrateResponse{} }
+func (m *DBMigrateResponse) String() string { return proto.CompactTextString(m) }
+func (*DBMigrateResponse) ProtoMessage()    {}
+func (*DBMigrateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{124}
+}
+func (m *DBMigrateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBMigrateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBMigrateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBMigrateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBMigrateResponse.Merge(m, src)
+}
+func (m *DBMigrateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBMigrateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBMigrateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBMigrateResponse proto.InternalMessageInfo
+
 type User struct {
 	ID                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -4441,7 +5947,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{95}
+	return fileDescriptor_9084e97af2346a26, []int{125}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4482,7 +5988,7 @@ func (m *UserRequest) Reset()         { *m = UserRequest{} }
 func (m *UserRequest) String() string { return proto.CompactTextString(m) }
 func (*UserRequest) ProtoMessage()    {}
 func (*UserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{96}
+	return fileDescriptor_9084e97af2346a26, []int{126}
 }
 func (m *UserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4522,7 +6028,7 @@ func (m *UserResponse) Reset()         { *m = UserResponse{} }
 func (m *UserResponse) String() string { return proto.CompactTextString(m) }
 func (*UserResponse) ProtoMessage()    {}
 func (*UserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{97}
+	return fileDescriptor_9084e97af2346a26, []int{127}
 }
 func (m *UserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4564,7 +6070,7 @@ func (m *UserSearchRequest) Reset()         { *m = UserSearchRequest{} }
 func (m *UserSearchRequest) String() string { return proto.CompactTextString(m) }
 func (*UserSearchRequest) ProtoMessage()    {}
 func (*UserSearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{98}
+	return fileDescriptor_9084e97af2346a26, []int{128}
 }
 func (m *UserSearchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4604,7 +6110,7 @@ func (m *UserSearchResponse) Reset()         { *m = UserSearchResponse{} }
 func (m *UserSearchResponse) String() string { return proto.CompactTextString(m) }
 func (*UserSearchResponse) ProtoMessage()    {}
 func (*UserSearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{99}
+	return fileDescriptor_9084e97af2346a26, []int{129}
 }
 func (m *UserSearchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4644,7 +6150,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{100}
+	return fileDescriptor_9084e97af2346a26, []int{130}
 }
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4684,7 +6190,7 @@ func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
 func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
 func (*SearchResponse) ProtoMessage()    {}
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{101}
+	return fileDescriptor_9084e97af2346a26, []int{131}
 }
 func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4723,7 +6229,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{102}
+	return fileDescriptor_9084e97af2346a26, []int{132}
 }
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4764,7 +6270,7 @@ func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
 func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
 func (*WatchEvent) ProtoMessage()    {}
 func (*WatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{103}
+	return fileDescriptor_9084e97af2346a26, []int{133}
 }
 func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4805,7 +6311,7 @@ func (m *Pref) Reset()         { *m = Pref{} }
 func (m *Pref) String() string { return proto.CompactTextString(m) }
 func (*Pref) ProtoMessage()    {}
 func (*Pref) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{104}
+	return fileDescriptor_9084e97af2346a26, []int{134}
 }
 func (m *Pref) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4844,7 +6350,7 @@ func (m *PreferencesRequest) Reset()         { *m = PreferencesRequest{} }
 func (m *PreferencesRequest) String() string { return proto.CompactTextString(m) }
 func (*PreferencesRequest) ProtoMessage()    {}
 func (*PreferencesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{105}
+	return fileDescriptor_9084e97af2346a26, []int{135}
 }
 func (m *PreferencesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4884,7 +6390,7 @@ func (m *PreferencesResponse) Reset()         { *m = PreferencesResponse{} }
 func (m *PreferencesResponse) String() string { return proto.CompactTextString(m) }
 func (*PreferencesResponse) ProtoMessage()    {}
 func (*PreferencesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{106}
+	return fileDescriptor_9084e97af2346a26, []int{136}
 }
 func (m *PreferencesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4924,7 +6430,7 @@ func (m *PreferenceSetRequest) Reset()         { *m = PreferenceSetRequest{} }
 func (m *PreferenceSetRequest) String() string { return proto.CompactTextString(m) }
 func (*PreferenceSetRequest) ProtoMessage()    {}
 func (*PreferenceSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{107}
+	return fileDescriptor_9084e97af2346a26, []int{137}
 }
 func (m *PreferenceSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4963,7 +6469,7 @@ func (m *PreferenceSetResponse) Reset()         { *m = PreferenceSetResponse{} }
 func (m *PreferenceSetResponse) String() string { return proto.CompactTextString(m) }
 func (*PreferenceSetResponse) ProtoMessage()    {}
 func (*PreferenceSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{108}
+	return fileDescriptor_9084e97af2346a26, []int{138}
 }
 func (m *PreferenceSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4992,6 +6498,289 @@ func (m *PreferenceSetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PreferenceSetResponse proto.InternalMessageInfo
 
+// TrustPolicy is a named set of trusted signers.
+type TrustPolicy struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Signers (KIDs or users, for example alice@github).
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// Expire (ms since epoch), if set, nothing is trusted after this time.
+	Expire               int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrustPolicy) Reset()         { *m = TrustPolicy{} }
+func (m *TrustPolicy) String() string { return proto.CompactTextString(m) }
+func (*TrustPolicy) ProtoMessage()    {}
+func (*TrustPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{139}
+}
+func (m *TrustPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPolicy.Merge(m, src)
+}
+func (m *TrustPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPolicy proto.InternalMessageInfo
+
+type TrustPoliciesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrustPoliciesRequest) Reset()         { *m = TrustPoliciesRequest{} }
+func (m *TrustPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustPoliciesRequest) ProtoMessage()    {}
+func (*TrustPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{140}
+}
+func (m *TrustPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPoliciesRequest.Merge(m, src)
+}
+func (m *TrustPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPoliciesRequest proto.InternalMessageInfo
+
+type TrustPoliciesResponse struct {
+	Policies             []*TrustPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TrustPoliciesResponse) Reset()         { *m = TrustPoliciesResponse{} }
+func (m *TrustPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustPoliciesResponse) ProtoMessage()    {}
+func (*TrustPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{141}
+}
+func (m *TrustPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPoliciesResponse.Merge(m, src)
+}
+func (m *TrustPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPoliciesResponse proto.InternalMessageInfo
+
+type TrustPolicySetRequest struct {
+	Policy               *TrustPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TrustPolicySetRequest) Reset()         { *m = TrustPolicySetRequest{} }
+func (m *TrustPolicySetRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustPolicySetRequest) ProtoMessage()    {}
+func (*TrustPolicySetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{142}
+}
+func (m *TrustPolicySetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPolicySetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPolicySetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPolicySetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPolicySetRequest.Merge(m, src)
+}
+func (m *TrustPolicySetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPolicySetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPolicySetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPolicySetRequest proto.InternalMessageInfo
+
+type TrustPolicySetResponse struct {
+	Policy               *TrustPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TrustPolicySetResponse) Reset()         { *m = TrustPolicySetResponse{} }
+func (m *TrustPolicySetResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustPolicySetResponse) ProtoMessage()    {}
+func (*TrustPolicySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{143}
+}
+func (m *TrustPolicySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPolicySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPolicySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPolicySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPolicySetResponse.Merge(m, src)
+}
+func (m *TrustPolicySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPolicySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPolicySetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPolicySetResponse proto.InternalMessageInfo
+
+type TrustPolicyRemoveRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrustPolicyRemoveRequest) Reset()         { *m = TrustPolicyRemoveRequest{} }
+func (m *TrustPolicyRemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustPolicyRemoveRequest) ProtoMessage()    {}
+func (*TrustPolicyRemoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{144}
+}
+func (m *TrustPolicyRemoveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPolicyRemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPolicyRemoveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPolicyRemoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPolicyRemoveRequest.Merge(m, src)
+}
+func (m *TrustPolicyRemoveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPolicyRemoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPolicyRemoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPolicyRemoveRequest proto.InternalMessageInfo
+
+type TrustPolicyRemoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrustPolicyRemoveResponse) Reset()         { *m = TrustPolicyRemoveResponse{} }
+func (m *TrustPolicyRemoveResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustPolicyRemoveResponse) ProtoMessage()    {}
+func (*TrustPolicyRemoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{145}
+}
+func (m *TrustPolicyRemoveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPolicyRemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPolicyRemoveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPolicyRemoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPolicyRemoveResponse.Merge(m, src)
+}
+func (m *TrustPolicyRemoveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPolicyRemoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPolicyRemoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPolicyRemoveResponse proto.InternalMessageInfo
+
 type WormholeInput struct {
 	Sender               string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Recipient            string      `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
@@ -5008,7 +6797,7 @@ func (m *WormholeInput) Reset()         { *m = WormholeInput{} }
 func (m *WormholeInput) String() string { return proto.CompactTextString(m) }
 func (*WormholeInput) ProtoMessage()    {}
 func (*WormholeInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{109}
+	return fileDescriptor_9084e97af2346a26, []int{146}
 }
 func (m *WormholeInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5049,7 +6838,7 @@ func (m *WormholeOutput) Reset()         { *m = WormholeOutput{} }
 func (m *WormholeOutput) String() string { return proto.CompactTextString(m) }
 func (*WormholeOutput) ProtoMessage()    {}
 func (*WormholeOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{110}
+	return fileDescriptor_9084e97af2346a26, []int{147}
 }
 func (m *WormholeOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5096,7 +6885,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{111}
+	return fileDescriptor_9084e97af2346a26, []int{148}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5137,7 +6926,7 @@ func (m *Content) Reset()         { *m = Content{} }
 func (m *Content) String() string { return proto.CompactTextString(m) }
 func (*Content) ProtoMessage()    {}
 func (*Content) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{112}
+	return fileDescriptor_9084e97af2346a26, []int{149}
 }
 func (m *Content) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5179,7 +6968,7 @@ func (m *MessagePrepareRequest) Reset()         { *m = MessagePrepareRequest{} }
 func (m *MessagePrepareRequest) String() string { return proto.CompactTextString(m) }
 func (*MessagePrepareRequest) ProtoMessage()    {}
 func (*MessagePrepareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{113}
+	return fileDescriptor_9084e97af2346a26, []int{150}
 }
 func (m *MessagePrepareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5219,7 +7008,7 @@ func (m *MessagePrepareResponse) Reset()         { *m = MessagePrepareResponse{}
 func (m *MessagePrepareResponse) String() string { return proto.CompactTextString(m) }
 func (*MessagePrepareResponse) ProtoMessage()    {}
 func (*MessagePrepareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{114}
+	return fileDescriptor_9084e97af2346a26, []int{151}
 }
 func (m *MessagePrepareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5261,7 +7050,7 @@ func (m *MessageCreateRequest) Reset()         { *m = MessageCreateRequest{} }
 func (m *MessageCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*MessageCreateRequest) ProtoMessage()    {}
 func (*MessageCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{115}
+	return fileDescriptor_9084e97af2346a26, []int{152}
 }
 func (m *MessageCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5301,7 +7090,7 @@ func (m *MessageCreateResponse) Reset()         { *m = MessageCreateResponse{} }
 func (m *MessageCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*MessageCreateResponse) ProtoMessage()    {}
 func (*MessageCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{116}
+	return fileDescriptor_9084e97af2346a26, []int{153}
 }
 func (m *MessageCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5342,7 +7131,7 @@ func (m *MessagesRequest) Reset()         { *m = MessagesRequest{} }
 func (m *MessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MessagesRequest) ProtoMessage()    {}
 func (*MessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{117}
+	return fileDescriptor_9084e97af2346a26, []int{154}
 }
 func (m *MessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5382,7 +7171,7 @@ func (m *MessagesResponse) Reset()         { *m = MessagesResponse{} }
 func (m *MessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MessagesResponse) ProtoMessage()    {}
 func (*MessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{118}
+	return fileDescriptor_9084e97af2346a26, []int{155}
 }
 func (m *MessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5424,7 +7213,7 @@ func (m *AdminSignURLRequest) Reset()         { *m = AdminSignURLRequest{} }
 func (m *AdminSignURLRequest) String() string { return proto.CompactTextString(m) }
 func (*AdminSignURLRequest) ProtoMessage()    {}
 func (*AdminSignURLRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{119}
+	return fileDescriptor_9084e97af2346a26, []int{156}
 }
 func (m *AdminSignURLRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5466,7 +7255,7 @@ func (m *AdminSignURLResponse) Reset()         { *m = AdminSignURLResponse{} }
 func (m *AdminSignURLResponse) String() string { return proto.CompactTextString(m) }
 func (*AdminSignURLResponse) ProtoMessage()    {}
 func (*AdminSignURLResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{120}
+	return fileDescriptor_9084e97af2346a26, []int{157}
 }
 func (m *AdminSignURLResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5507,7 +7296,7 @@ func (m *AdminCheckRequest) Reset()         { *m = AdminCheckRequest{} }
 func (m *AdminCheckRequest) String() string { return proto.CompactTextString(m) }
 func (*AdminCheckRequest) ProtoMessage()    {}
 func (*AdminCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{121}
+	return fileDescriptor_9084e97af2346a26, []int{158}
 }
 func (m *AdminCheckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5546,7 +7335,7 @@ func (m *AdminCheckResponse) Reset()         { *m = AdminCheckResponse{} }
 func (m *AdminCheckResponse) String() string { return proto.CompactTextString(m) }
 func (*AdminCheckResponse) ProtoMessage()    {}
 func (*AdminCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9084e97af2346a26, []int{122}
+	return fileDescriptor_9084e97af2346a26, []int{159}
 }
 func (m *AdminCheckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5576,11 +7365,14 @@ func (m *AdminCheckResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_AdminCheckResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("service.SignFormat", SignFormat_name, SignFormat_value)
 	proto.RegisterEnum("service.EncryptMode", EncryptMode_name, EncryptMode_value)
+	proto.RegisterEnum("service.AuthType", AuthType_name, AuthType_value)
 	proto.RegisterEnum("service.ExportType", ExportType_name, ExportType_value)
 	proto.RegisterEnum("service.KeyType", KeyType_name, KeyType_value)
 	proto.RegisterEnum("service.SortDirection", SortDirection_name, SortDirection_value)
 	proto.RegisterEnum("service.SecretType", SecretType_name, SecretType_value)
+	proto.RegisterEnum("service.SecretsImportFormat", SecretsImportFormat_name, SecretsImportFormat_value)
 	proto.RegisterEnum("service.Encoding", Encoding_name, Encoding_value)
 	proto.RegisterEnum("service.UserStatus", UserStatus_name, UserStatus_value)
 	proto.RegisterEnum("service.WatchStatus", WatchStatus_name, WatchStatus_value)
@@ -5589,10 +7381,15 @@ func init() {
 	proto.RegisterEnum("service.ContentType", ContentType_name, ContentType_value)
 	proto.RegisterEnum("service.MessageType", MessageType_name, MessageType_value)
 	proto.RegisterType((*RPCError)(nil), "service.RPCError")
+	proto.RegisterType((*SignPolicy)(nil), "service.SignPolicy")
 	proto.RegisterType((*SignRequest)(nil), "service.SignRequest")
 	proto.RegisterType((*SignResponse)(nil), "service.SignResponse")
 	proto.RegisterType((*SignFileInput)(nil), "service.SignFileInput")
 	proto.RegisterType((*SignFileOutput)(nil), "service.SignFileOutput")
+	proto.RegisterType((*SignDirRequest)(nil), "service.SignDirRequest")
+	proto.RegisterType((*SignDirResponse)(nil), "service.SignDirResponse")
+	proto.RegisterType((*VerifyDirRequest)(nil), "service.VerifyDirRequest")
+	proto.RegisterType((*VerifyDirResponse)(nil), "service.VerifyDirResponse")
 	proto.RegisterType((*VerifyRequest)(nil), "service.VerifyRequest")
 	proto.RegisterType((*VerifyResponse)(nil), "service.VerifyResponse")
 	proto.RegisterType((*VerifyDetachedRequest)(nil), "service.VerifyDetachedRequest")
@@ -5632,6 +7429,13 @@ func init() {
 	proto.RegisterType((*AuthSetupResponse)(nil), "service.AuthSetupResponse")
 	proto.RegisterType((*AuthUnlockRequest)(nil), "service.AuthUnlockRequest")
 	proto.RegisterType((*AuthUnlockResponse)(nil), "service.AuthUnlockResponse")
+	proto.RegisterType((*AuthProvision)(nil), "service.AuthProvision")
+	proto.RegisterType((*AuthProvisionRequest)(nil), "service.AuthProvisionRequest")
+	proto.RegisterType((*AuthProvisionResponse)(nil), "service.AuthProvisionResponse")
+	proto.RegisterType((*AuthDeprovisionRequest)(nil), "service.AuthDeprovisionRequest")
+	proto.RegisterType((*AuthDeprovisionResponse)(nil), "service.AuthDeprovisionResponse")
+	proto.RegisterType((*AuthProvisionsRequest)(nil), "service.AuthProvisionsRequest")
+	proto.RegisterType((*AuthProvisionsResponse)(nil), "service.AuthProvisionsResponse")
 	proto.RegisterType((*AuthLockRequest)(nil), "service.AuthLockRequest")
 	proto.RegisterType((*AuthLockResponse)(nil), "service.AuthLockResponse")
 	proto.RegisterType((*KeyGenerateRequest)(nil), "service.KeyGenerateRequest")
@@ -5662,6 +7466,21 @@ func init() {
 	proto.RegisterType((*SecretRemoveResponse)(nil), "service.SecretRemoveResponse")
 	proto.RegisterType((*SecretsRequest)(nil), "service.SecretsRequest")
 	proto.RegisterType((*SecretsResponse)(nil), "service.SecretsResponse")
+	proto.RegisterType((*SecretVersion)(nil), "service.SecretVersion")
+	proto.RegisterType((*SecretHistoryRequest)(nil), "service.SecretHistoryRequest")
+	proto.RegisterType((*SecretHistoryResponse)(nil), "service.SecretHistoryResponse")
+	proto.RegisterType((*SecretRestoreRequest)(nil), "service.SecretRestoreRequest")
+	proto.RegisterType((*SecretRestoreResponse)(nil), "service.SecretRestoreResponse")
+	proto.RegisterType((*OTPCodeRequest)(nil), "service.OTPCodeRequest")
+	proto.RegisterType((*OTPCodeResponse)(nil), "service.OTPCodeResponse")
+	proto.RegisterType((*SecretsImportRequest)(nil), "service.SecretsImportRequest")
+	proto.RegisterType((*SecretsImportResponse)(nil), "service.SecretsImportResponse")
+	proto.RegisterType((*SecretImport)(nil), "service.SecretImport")
+	proto.RegisterType((*VaultSyncRequest)(nil), "service.VaultSyncRequest")
+	proto.RegisterType((*VaultSyncResponse)(nil), "service.VaultSyncResponse")
+	proto.RegisterType((*VaultConflict)(nil), "service.VaultConflict")
+	proto.RegisterType((*SSHAgentRequest)(nil), "service.SSHAgentRequest")
+	proto.RegisterType((*SSHAgentResponse)(nil), "service.SSHAgentResponse")
 	proto.RegisterType((*ItemRequest)(nil), "service.ItemRequest")
 	proto.RegisterType((*ItemResponse)(nil), "service.ItemResponse")
 	proto.RegisterType((*ItemsRequest)(nil), "service.ItemsRequest")
@@ -5683,6 +7502,9 @@ func init() {
 	proto.RegisterType((*DocumentsResponse)(nil), "service.DocumentsResponse")
 	proto.RegisterType((*DocumentDeleteRequest)(nil), "service.DocumentDeleteRequest")
 	proto.RegisterType((*DocumentDeleteResponse)(nil), "service.DocumentDeleteResponse")
+	proto.RegisterType((*DBMigration)(nil), "service.DBMigration")
+	proto.RegisterType((*DBMigrateRequest)(nil), "service.DBMigrateRequest")
+	proto.RegisterType((*DBMigrateResponse)(nil), "service.DBMigrateResponse")
 	proto.RegisterType((*User)(nil), "service.User")
 	proto.RegisterType((*UserRequest)(nil), "service.UserRequest")
 	proto.RegisterType((*UserResponse)(nil), "service.UserResponse")
@@ -5697,6 +7519,13 @@ func init() {
 	proto.RegisterType((*PreferencesResponse)(nil), "service.PreferencesResponse")
 	proto.RegisterType((*PreferenceSetRequest)(nil), "service.PreferenceSetRequest")
 	proto.RegisterType((*PreferenceSetResponse)(nil), "service.PreferenceSetResponse")
+	proto.RegisterType((*TrustPolicy)(nil), "service.TrustPolicy")
+	proto.RegisterType((*TrustPoliciesRequest)(nil), "service.TrustPoliciesRequest")
+	proto.RegisterType((*TrustPoliciesResponse)(nil), "service.TrustPoliciesResponse")
+	proto.RegisterType((*TrustPolicySetRequest)(nil), "service.TrustPolicySetRequest")
+	proto.RegisterType((*TrustPolicySetResponse)(nil), "service.TrustPolicySetResponse")
+	proto.RegisterType((*TrustPolicyRemoveRequest)(nil), "service.TrustPolicyRemoveRequest")
+	proto.RegisterType((*TrustPolicyRemoveResponse)(nil), "service.TrustPolicyRemoveResponse")
 	proto.RegisterType((*WormholeInput)(nil), "service.WormholeInput")
 	proto.RegisterType((*WormholeOutput)(nil), "service.WormholeOutput")
 	proto.RegisterType((*Message)(nil), "service.Message")
@@ -5716,305 +7545,400 @@ func init() {
 func init() { proto.RegisterFile("keys.proto", fileDescriptor_9084e97af2346a26) }
 
 var fileDescriptor_9084e97af2346a26 = []byte{
-	// 4765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0x4f, 0x6c, 0x1b, 0xc7,
-	0x7a, 0xd7, 0x92, 0xfa, 0xfb, 0x91, 0x92, 0x56, 0x2b, 0x4a, 0xa6, 0xf7, 0x39, 0x12, 0x3d, 0x49,
-	0x9e, 0x15, 0x25, 0x76, 0x6c, 0x25, 0x4e, 0xe3, 0x97, 0x17, 0xbf, 0x50, 0x24, 0x65, 0x2b, 0x92,
-	0x49, 0x75, 0x49, 0xc6, 0x71, 0x1f, 0x0a, 0xbd, 0x0d, 0x39, 0x92, 0x16, 0xa2, 0x48, 0x66, 0x77,
-	0xa9, 0x58, 0xa7, 0x02, 0x39, 0x15, 0x44, 0x81, 0x87, 0x5e, 0x8a, 0x5e, 0x58, 0x14, 0x68, 0x81,
-	0x16, 0xe8, 0xa5, 0x40, 0x6f, 0x45, 0xcf, 0x45, 0x8e, 0x45, 0x4f, 0xef, 0x50, 0x04, 0x8d, 0xd1,
-	0x43, 0x8f, 0x05, 0x0a, 0xf4, 0x5c, 0xcc, 0xbf, 0xdd, 0x99, 0xe5, 0x92, 0x92, 0x1d, 0xe7, 0xdd,
-	0x76, 0x66, 0x7e, 0xdf, 0x37, 0xdf, 0x7c, 0xf3, 0xcd, 0x37, 0xdf, 0xcc, 0x7c, 0x24, 0xc0, 0x29,
-	0xbe, 0xf0, 0xee, 0x74, 0xdd, 0x8e, 0xdf, 0x31, 0x66, 0x3c, 0xec, 0x9e, 0x3b, 0x0d, 0x6c, 0x66,
-	0x8e, 0x3b, 0xc7, 0x1d, 0x5a, 0xf7, 0x3e, 0xf9, 0x62, 0xcd, 0xc8, 0x82, 0x59, 0xeb, 0xa0, 0x50,
-	0x72, 0xdd, 0x8e, 0x6b, 0x18, 0x30, 0xd9, 0xe8, 0x34, 0x71, 0x56, 0xcb, 0x69, 0x1b, 0x53, 0x16,
-	0xfd, 0x36, 0xb2, 0x30, 0x73, 0x86, 0x3d, 0xcf, 0x3e, 0xc6, 0xd9, 0x44, 0x4e, 0xdb, 0x98, 0xb3,
-	0x44, 0x91, 0xb4, 0x34, 0xb1, 0x6f, 0x3b, 0x2d, 0x2f, 0x9b, 0x64, 0x2d, 0xbc, 0x88, 0x3a, 0x90,
-	0xaa, 0x3a, 0xc7, 0x6d, 0x0b, 0x7f, 0xdd, 0xc3, 0x9e, 0x4f, 0xd8, 0x36, 0x6d, 0xdf, 0xa6, 0x6c,
-	0xd3, 0x16, 0xfd, 0x36, 0x56, 0x61, 0xda, 0x73, 0x8e, 0xdb, 0xd8, 0xcd, 0x4e, 0x51, 0x5a, 0x5e,
-	0x22, 0x4c, 0x6d, 0xf7, 0xac, 0xe3, 0xe2, 0x66, 0x16, 0x72, 0xda, 0xc6, 0xac, 0x25, 0x8a, 0x86,
-	0x09, 0xb3, 0x84, 0x7f, 0xe3, 0x04, 0x37, 0xb3, 0x29, 0xda, 0x14, 0x94, 0xd1, 0xa7, 0x90, 0x66,
-	0x1d, 0x7a, 0xdd, 0x4e, 0xdb, 0xc3, 0xb1, 0x3d, 0x5e, 0x87, 0xe4, 0xa9, 0xd3, 0x64, 0x83, 0xd8,
-	0x9e, 0x79, 0xf1, 0xfd, 0x7a, 0x72, 0x6f, 0xb7, 0x68, 0x91, 0x3a, 0xf4, 0x27, 0x30, 0x4f, 0xc8,
-	0x77, 0x9c, 0x16, 0xde, 0x6d, 0x77, 0x7b, 0xbe, 0xb1, 0x00, 0x09, 0xa7, 0x4d, 0xa9, 0xe7, 0xac,
-	0x84, 0xd3, 0x36, 0x74, 0x48, 0x76, 0x7a, 0x3e, 0x57, 0x00, 0xf9, 0x7c, 0xcd, 0xf2, 0x3f, 0x85,
-	0x05, 0x21, 0x40, 0xa5, 0xe7, 0x13, 0x09, 0xb8, 0xb4, 0xda, 0xb0, 0xb4, 0x46, 0x06, 0xa6, 0xbe,
-	0xba, 0xf0, 0xb1, 0x47, 0xc5, 0x99, 0xb2, 0x58, 0x81, 0xd4, 0xfa, 0x1d, 0xdf, 0x6e, 0xd1, 0xb9,
-	0x98, 0xb2, 0x58, 0x01, 0x3d, 0x83, 0xf9, 0x2f, 0xb0, 0xeb, 0x1c, 0x5d, 0x8c, 0x9b, 0x8b, 0x57,
-	0x93, 0xf9, 0x73, 0x58, 0x10, 0xac, 0xc7, 0x68, 0xfd, 0xad, 0x40, 0x4f, 0x44, 0xda, 0xd4, 0x56,
-	0xfa, 0x0e, 0x37, 0xc7, 0x3b, 0x7b, 0xf8, 0x42, 0x68, 0x0d, 0x3d, 0x85, 0x15, 0xc6, 0xab, 0xc8,
-	0xb9, 0x8f, 0x13, 0x57, 0x87, 0xa4, 0xe7, 0x1c, 0x53, 0x7e, 0x69, 0x8b, 0x7c, 0x8e, 0x1e, 0x00,
-	0x7a, 0x08, 0xab, 0x51, 0xc6, 0x5c, 0xd8, 0x50, 0x30, 0x6d, 0x8c, 0x60, 0x37, 0x21, 0xc5, 0xe8,
-	0x99, 0x5d, 0xc4, 0x88, 0x83, 0x1e, 0x43, 0x9a, 0x41, 0xf8, 0xcc, 0xbd, 0xba, 0x16, 0x9e, 0xc0,
-	0x22, 0xe3, 0xf4, 0x32, 0x86, 0x38, 0x7a, 0xec, 0x9f, 0x83, 0x1e, 0xb2, 0xe3, 0xc2, 0x5d, 0x69,
-	0xd4, 0xc3, 0xbd, 0xa0, 0x3a, 0x5c, 0x53, 0xf5, 0x38, 0x56, 0xc4, 0x2b, 0x4f, 0x4f, 0x1d, 0x96,
-	0x55, 0xb6, 0x23, 0xd5, 0xfc, 0x52, 0x6c, 0xff, 0x45, 0x83, 0xb9, 0xaa, 0x6f, 0xfb, 0xf8, 0x0c,
-	0xb7, 0x7d, 0x41, 0xa9, 0x85, 0x94, 0x82, 0x7f, 0x62, 0xd8, 0x3d, 0x24, 0x63, 0x16, 0x1c, 0x61,
-	0x80, 0xbf, 0xce, 0x4e, 0xd2, 0x85, 0x45, 0x3e, 0x09, 0x83, 0xae, 0x8b, 0xcf, 0xe9, 0xda, 0x4f,
-	0x5b, 0xf4, 0x9b, 0x78, 0x04, 0x17, 0x9f, 0x77, 0x4e, 0x71, 0x76, 0x9a, 0x02, 0x79, 0xc9, 0xb8,
-	0x01, 0x73, 0xbe, 0x73, 0x86, 0x3d, 0xdf, 0x3e, 0xeb, 0x66, 0x67, 0x72, 0xda, 0x46, 0xd2, 0x0a,
-	0x2b, 0x08, 0x27, 0xff, 0xa2, 0x8b, 0xb3, 0xb3, 0x54, 0x7f, 0xf4, 0x1b, 0xbd, 0x07, 0x8b, 0x55,
-	0xe7, 0xb8, 0x71, 0x62, 0x3b, 0x81, 0x0b, 0x1d, 0xed, 0x0e, 0xd0, 0x11, 0xe8, 0x21, 0x9a, 0x1b,
-	0xf7, 0x1a, 0x24, 0x4f, 0xf1, 0x45, 0xec, 0x1c, 0x93, 0x06, 0x63, 0x0b, 0xc0, 0x13, 0xfa, 0x21,
-	0x7e, 0x24, 0xb9, 0x91, 0xda, 0x32, 0x02, 0x58, 0xa0, 0x3a, 0x4b, 0x42, 0xa1, 0x5f, 0x81, 0x1e,
-	0x36, 0x5c, 0x2a, 0x96, 0x50, 0x5a, 0x22, 0x50, 0x1a, 0x2a, 0xc1, 0x92, 0xc4, 0x80, 0x4b, 0x7a,
-	0x17, 0xe6, 0x82, 0x3e, 0xb8, 0xbc, 0x71, 0x82, 0x84, 0x20, 0xf4, 0xc7, 0xb0, 0x1a, 0xd4, 0x17,
-	0x5c, 0x6c, 0xfb, 0x78, 0x9c, 0xb3, 0x18, 0xed, 0xf5, 0x89, 0xc7, 0x6c, 0x75, 0x1a, 0x76, 0x8b,
-	0xce, 0xe2, 0xac, 0xc5, 0x0a, 0x68, 0x0f, 0xae, 0x0d, 0xb1, 0x7f, 0x65, 0x59, 0x7f, 0x2d, 0xc9,
-	0x6a, 0x51, 0x73, 0x10, 0xb2, 0x72, 0xf5, 0x68, 0xa1, 0x4d, 0xfd, 0x28, 0x49, 0x05, 0xf3, 0x57,
-	0x96, 0xf4, 0xb7, 0x64, 0xc9, 0x38, 0xc7, 0xed, 0xd1, 0x0b, 0x90, 0xad, 0xf3, 0x44, 0xd4, 0x15,
-	0x25, 0x7f, 0xaa, 0x3d, 0xf1, 0x13, 0x00, 0x22, 0xd0, 0x18, 0xaf, 0x3a, 0x66, 0x47, 0xff, 0x6b,
-	0x0d, 0x16, 0x4a, 0xed, 0x86, 0x7b, 0xd1, 0xf5, 0x5f, 0x6d, 0xe7, 0x5b, 0x03, 0x70, 0x71, 0xc3,
-	0xe9, 0x3a, 0x74, 0x85, 0xa4, 0x72, 0xc9, 0x8d, 0x39, 0x4b, 0xaa, 0xa1, 0x63, 0xc5, 0xed, 0x26,
-	0x76, 0xb3, 0x69, 0x3e, 0x56, 0x5a, 0x32, 0x36, 0x60, 0xf2, 0x8c, 0x84, 0x50, 0xf3, 0x39, 0x6d,
-	0x63, 0x61, 0x2b, 0x13, 0x28, 0x9d, 0x0b, 0xf3, 0xa4, 0xd3, 0xc4, 0x16, 0x45, 0xa0, 0xb7, 0x61,
-	0x31, 0x90, 0x70, 0xf4, 0x06, 0x8a, 0xfe, 0x51, 0x03, 0x9d, 0xe3, 0x5e, 0xcb, 0xb6, 0xf0, 0x7b,
-	0x18, 0xd9, 0xaf, 0x60, 0x49, 0x92, 0x98, 0x4f, 0x60, 0x10, 0xb5, 0x68, 0xb1, 0x51, 0x4b, 0x42,
-	0x8e, 0x5a, 0xfe, 0x4a, 0x83, 0x34, 0xe7, 0x30, 0xda, 0x1e, 0xa5, 0x11, 0x26, 0xc6, 0x8d, 0x30,
-	0x39, 0x66, 0x84, 0x93, 0xb1, 0x23, 0x9c, 0xba, 0x74, 0x84, 0x6f, 0xc2, 0x3c, 0xaf, 0x1c, 0x6d,
-	0x9e, 0xe8, 0x04, 0x16, 0x8a, 0xf8, 0x47, 0x98, 0xe0, 0xd5, 0x15, 0xbe, 0x07, 0x8b, 0x41, 0x4f,
-	0x97, 0xc4, 0x62, 0x6c, 0xdc, 0xf1, 0x51, 0x08, 0x6d, 0x43, 0xcf, 0x41, 0xe7, 0xcc, 0x5e, 0x8f,
-	0xbd, 0x5d, 0x7d, 0x18, 0xdf, 0xc0, 0x92, 0xd4, 0xb3, 0x14, 0xb1, 0x30, 0xa1, 0xb5, 0xd1, 0x42,
-	0xc7, 0x08, 0x14, 0xd8, 0x5b, 0x32, 0xd6, 0xde, 0x26, 0x65, 0x7b, 0x43, 0x90, 0xe6, 0x1d, 0x8f,
-	0x0e, 0xf3, 0x76, 0x61, 0x9e, 0x63, 0x2e, 0x89, 0xf3, 0x2e, 0xd7, 0xf0, 0x2a, 0x64, 0xac, 0x5e,
-	0x9b, 0xc4, 0x00, 0xc4, 0x15, 0xf7, 0x3c, 0x6e, 0x1e, 0xe8, 0xef, 0x35, 0x58, 0x89, 0x34, 0xf0,
-	0xd9, 0xcc, 0xc2, 0xcc, 0x39, 0x76, 0x3d, 0xa7, 0x23, 0x26, 0x41, 0x14, 0xa9, 0xde, 0xbb, 0xdd,
-	0xb2, 0x7d, 0x16, 0x1c, 0xcf, 0x78, 0x91, 0xa8, 0x04, 0x3f, 0xc7, 0xdc, 0xc4, 0xc9, 0xa7, 0xb1,
-	0x01, 0x8b, 0x76, 0xcf, 0x3f, 0xa9, 0x62, 0xbf, 0xd7, 0x2d, 0x63, 0xdc, 0xc4, 0x4d, 0xbe, 0xa1,
-	0x44, 0xab, 0x8d, 0x75, 0x98, 0x3a, 0x72, 0x9a, 0x9d, 0x2d, 0x1a, 0xca, 0xcc, 0x6e, 0xcf, 0xbd,
-	0xf8, 0x7e, 0x7d, 0x6a, 0x67, 0xb7, 0x58, 0xd9, 0xb2, 0x58, 0x3d, 0xda, 0x01, 0x3d, 0x2f, 0x68,
-	0x84, 0x75, 0x9b, 0x30, 0xdb, 0xb5, 0x3d, 0xef, 0x9b, 0x8e, 0xcb, 0x23, 0x02, 0x2b, 0x28, 0x93,
-	0x25, 0xd7, 0x68, 0x91, 0xd5, 0x47, 0x39, 0xce, 0x59, 0xbc, 0x84, 0xee, 0xc1, 0x92, 0xc4, 0x87,
-	0x8f, 0xf6, 0x06, 0xcc, 0x11, 0x81, 0x6a, 0x9d, 0x53, 0x2c, 0xc6, 0x1b, 0x56, 0xa0, 0x47, 0x8c,
-	0xa4, 0xde, 0x6e, 0x75, 0x1a, 0xa7, 0x2f, 0xd7, 0x77, 0x42, 0xe9, 0x7b, 0x0b, 0x0c, 0x99, 0xd1,
-	0x95, 0x3a, 0x5f, 0x82, 0x45, 0x42, 0xb3, 0x1f, 0x76, 0x8d, 0x0c, 0xa6, 0x8a, 0x7d, 0x89, 0x09,
-	0xfa, 0x05, 0x18, 0x7b, 0xf8, 0xe2, 0x11, 0x6e, 0x63, 0x57, 0x8a, 0x4f, 0xde, 0xe2, 0xb1, 0x9e,
-	0x46, 0x57, 0x82, 0x2e, 0xdb, 0x46, 0xed, 0xa2, 0x8b, 0x79, 0xf4, 0x77, 0x17, 0x96, 0x15, 0x5a,
-	0x2e, 0xd7, 0x98, 0x08, 0x70, 0x17, 0x8c, 0xba, 0x87, 0xdd, 0x2a, 0x63, 0x77, 0x85, 0xd8, 0x2c,
-	0x0b, 0xe2, 0x52, 0x40, 0x18, 0x0d, 0x2f, 0xa2, 0xf7, 0x61, 0x59, 0x61, 0x15, 0xda, 0x9f, 0x20,
-	0xd0, 0x54, 0x82, 0x3f, 0x82, 0x45, 0x4a, 0x20, 0x1d, 0xf7, 0x5f, 0xa5, 0x63, 0xb2, 0x9a, 0xda,
-	0xc4, 0x88, 0x59, 0x38, 0x41, 0xbf, 0xd1, 0x67, 0xa0, 0x87, 0xbc, 0x43, 0x49, 0xc4, 0x75, 0x84,
-	0xa6, 0x5e, 0x47, 0x08, 0x0e, 0x09, 0x89, 0x43, 0x5f, 0x83, 0x05, 0xc2, 0x22, 0xdf, 0x6c, 0xbe,
-	0x6e, 0xe9, 0x08, 0xa3, 0x9e, 0xcb, 0x1c, 0x09, 0x67, 0x54, 0xb7, 0xf6, 0x2d, 0x52, 0x37, 0x22,
-	0x5e, 0x3b, 0x62, 0xaa, 0xa2, 0xb2, 0xf0, 0xd1, 0xdc, 0x84, 0xc9, 0x9e, 0x17, 0xb8, 0xb6, 0xf9,
-	0xc0, 0x22, 0x08, 0xce, 0xa2, 0x4d, 0x6a, 0x28, 0x97, 0xb8, 0x4a, 0x28, 0xe7, 0x82, 0xbe, 0x87,
-	0x2f, 0x4a, 0xcf, 0xbb, 0x1d, 0xf7, 0x2a, 0x81, 0xba, 0xbc, 0x74, 0x12, 0x91, 0xa5, 0x73, 0x8b,
-	0x5b, 0x6c, 0x92, 0x5a, 0xec, 0x72, 0xe8, 0xbb, 0x29, 0x73, 0xc9, 0x68, 0xdf, 0x85, 0x25, 0xa9,
-	0x4f, 0x3e, 0xba, 0x55, 0x98, 0xc6, 0xb4, 0x86, 0xfb, 0x48, 0x5e, 0x42, 0x0f, 0xa9, 0x80, 0xbb,
-	0x67, 0xb2, 0x80, 0xe1, 0x0e, 0x93, 0xa6, 0x3b, 0xcc, 0x18, 0xa9, 0xd0, 0x1d, 0xda, 0x99, 0xa0,
-	0xbf, 0x7c, 0x7d, 0xdc, 0xa6, 0xfd, 0x59, 0xf8, 0xac, 0x73, 0x7e, 0x85, 0xd5, 0x81, 0x96, 0x29,
-	0x7b, 0x01, 0xe7, 0x2b, 0xfa, 0xdf, 0x35, 0x48, 0xee, 0xe1, 0x0b, 0x63, 0x15, 0x12, 0x01, 0xd9,
-	0xf4, 0x8b, 0xef, 0xd7, 0x13, 0xbb, 0x45, 0x2b, 0xe1, 0x34, 0x83, 0xb5, 0x9d, 0x1c, 0xb7, 0xb6,
-	0x83, 0xf9, 0x9e, 0x1e, 0x3d, 0xdf, 0x19, 0x98, 0xf2, 0xec, 0xf3, 0x60, 0x1b, 0x65, 0x05, 0xe3,
-	0xe7, 0xb0, 0xe0, 0xf1, 0x43, 0xde, 0x3e, 0x6e, 0x1f, 0xfb, 0x27, 0xd9, 0x0d, 0xba, 0x81, 0x45,
-	0x6a, 0x8d, 0xf7, 0x60, 0x49, 0xd4, 0xd4, 0xbb, 0x4d, 0xdb, 0xc7, 0xcd, 0xbc, 0x9f, 0x7d, 0x87,
-	0x1e, 0x3a, 0x87, 0x1b, 0xd0, 0x67, 0x00, 0x74, 0xa4, 0x81, 0x0f, 0x75, 0x9a, 0xb8, 0xed, 0x3b,
-	0xfe, 0x85, 0xf0, 0xa1, 0xa2, 0x4c, 0xa6, 0xb2, 0x47, 0xc9, 0xb8, 0x49, 0xf3, 0x12, 0xba, 0x0d,
-	0x29, 0xca, 0xe1, 0x6a, 0xe7, 0x4e, 0xf4, 0x77, 0x1a, 0xc5, 0x8b, 0x1d, 0x8f, 0x0c, 0xf6, 0xeb,
-	0x1e, 0x76, 0x45, 0x7f, 0xac, 0x60, 0xfc, 0x1c, 0xa6, 0x88, 0xb6, 0xd8, 0xc1, 0x34, 0x4e, 0x99,
-	0xac, 0x99, 0xb8, 0x6a, 0xaf, 0xe3, 0xfa, 0x3b, 0x0e, 0x6e, 0x31, 0x75, 0xcd, 0x59, 0x61, 0x85,
-	0xf1, 0x4b, 0x98, 0x27, 0x85, 0xa2, 0xe3, 0xe2, 0x86, 0x4f, 0x76, 0xce, 0x14, 0x9d, 0x9a, 0xd5,
-	0x70, 0xf1, 0xc8, 0xad, 0x96, 0x0a, 0x46, 0x7f, 0xa6, 0x41, 0x9a, 0x49, 0xca, 0x87, 0x96, 0x83,
-	0xc9, 0x53, 0x7c, 0x41, 0xc2, 0xd7, 0xe4, 0xd0, 0xd8, 0x68, 0xcb, 0x4f, 0x2a, 0xce, 0xb7, 0x09,
-	0x98, 0xae, 0xe2, 0x86, 0x8b, 0xfd, 0x91, 0x16, 0x18, 0xe3, 0xff, 0x46, 0xae, 0x5f, 0xc6, 0x4a,
-	0x32, 0x4c, 0x13, 0x66, 0x89, 0xf5, 0x51, 0x06, 0x4c, 0xf4, 0xa0, 0xac, 0x2c, 0xc5, 0x54, 0xc4,
-	0x41, 0x70, 0x27, 0x98, 0x89, 0x77, 0x82, 0xed, 0x0e, 0x09, 0xc0, 0xd6, 0xd8, 0xdc, 0xd2, 0x02,
-	0x51, 0x52, 0x83, 0x9e, 0xaa, 0x89, 0x61, 0x36, 0xd9, 0x6d, 0x48, 0x50, 0x41, 0x5a, 0x7b, 0x81,
-	0xd9, 0x62, 0xd6, 0x1a, 0x54, 0xa0, 0x5b, 0x30, 0xcf, 0x04, 0x17, 0xe6, 0x33, 0x42, 0x15, 0xe8,
-	0x01, 0x2c, 0x08, 0x20, 0x9f, 0xbd, 0x5b, 0x24, 0x30, 0x23, 0x35, 0xdc, 0x36, 0x17, 0x23, 0xaa,
-	0xb0, 0x78, 0x33, 0xfa, 0x25, 0x2c, 0xb1, 0x9a, 0xaa, 0x1d, 0x3a, 0x8b, 0x2b, 0x53, 0x7f, 0x0a,
-	0x86, 0x4c, 0xfd, 0xb2, 0x9d, 0xdf, 0x86, 0x65, 0x21, 0xb7, 0xec, 0xab, 0x46, 0x0d, 0x73, 0x15,
-	0x32, 0x2a, 0x9c, 0xfb, 0xaa, 0x6f, 0x35, 0x31, 0xfe, 0x4b, 0x16, 0xda, 0x4f, 0x69, 0xb1, 0x7f,
-	0xa9, 0xc1, 0x62, 0x20, 0x04, 0x57, 0xc4, 0x3b, 0x64, 0x83, 0xa5, 0x55, 0x7c, 0x19, 0x0d, 0x69,
-	0x42, 0xb4, 0xff, 0xa4, 0xa2, 0xbd, 0x0d, 0xa9, 0x5d, 0x1f, 0x9f, 0x5d, 0xa6, 0xde, 0x7b, 0x90,
-	0x66, 0xb0, 0x70, 0xb3, 0x76, 0x7c, 0x7c, 0x36, 0xb4, 0x59, 0x53, 0x10, 0x6d, 0x42, 0x6f, 0x31,
-	0x92, 0xf1, 0x6a, 0x47, 0x1f, 0xc2, 0x3c, 0x47, 0x71, 0xce, 0x6f, 0xc2, 0x14, 0x21, 0x17, 0x5a,
-	0x89, 0xb0, 0x66, 0x6d, 0x68, 0x0b, 0x26, 0x49, 0x71, 0xdc, 0xfa, 0xa7, 0x6b, 0x3d, 0x21, 0xdd,
-	0x24, 0x7e, 0x09, 0x29, 0xcb, 0x6e, 0x37, 0x25, 0x0f, 0xdf, 0xee, 0x9d, 0x6d, 0x4b, 0xc7, 0xf0,
-	0xa0, 0x6c, 0xdc, 0x86, 0x59, 0xdc, 0x6e, 0x74, 0x9a, 0x4e, 0x9b, 0x5d, 0xb2, 0x2e, 0x6c, 0x2d,
-	0xc9, 0x47, 0x35, 0xda, 0x60, 0x05, 0x10, 0x72, 0x64, 0x62, 0x9c, 0x63, 0xce, 0x9b, 0x73, 0xfc,
-	0xc8, 0x74, 0x1b, 0x96, 0x09, 0xe6, 0x80, 0x3b, 0x8b, 0x50, 0xdf, 0xd3, 0x2d, 0xb6, 0x87, 0x31,
-	0x19, 0x78, 0x09, 0x6d, 0x41, 0x46, 0x85, 0x73, 0xd6, 0x63, 0x62, 0x7b, 0xf4, 0x0e, 0xa4, 0x0e,
-	0x7a, 0xad, 0xd6, 0x15, 0xb6, 0x30, 0xf4, 0x1e, 0xa4, 0x19, 0x34, 0x08, 0xf4, 0x27, 0x4f, 0x9d,
-	0x26, 0xd3, 0xf9, 0xdc, 0xf6, 0xec, 0x8b, 0xef, 0xd7, 0x27, 0xf7, 0x76, 0x8b, 0x9e, 0x45, 0x6b,
-	0xd1, 0x1e, 0x61, 0xec, 0x9d, 0x5c, 0x65, 0x6f, 0xcc, 0x41, 0xca, 0xc5, 0x67, 0x1d, 0x1f, 0x17,
-	0x4e, 0x70, 0xe3, 0x94, 0x5f, 0x46, 0xc8, 0x55, 0xe8, 0x11, 0xe9, 0x9a, 0x30, 0xbb, 0x34, 0x56,
-	0x21, 0x52, 0xf5, 0xdc, 0x16, 0xdb, 0xfa, 0xb8, 0x54, 0x75, 0x6b, 0xdf, 0xb3, 0x68, 0x2d, 0xca,
-	0x01, 0x14, 0x3a, 0xad, 0x16, 0xb3, 0x63, 0x7a, 0x0b, 0x6d, 0x73, 0x35, 0xce, 0x59, 0xf4, 0x1b,
-	0x6d, 0x80, 0x11, 0x22, 0x3c, 0xe9, 0xe2, 0x61, 0x08, 0xb9, 0x0f, 0xcb, 0x0a, 0x92, 0xcb, 0x76,
-	0x1f, 0x52, 0x8d, 0xb0, 0x9a, 0x5b, 0x64, 0xb8, 0x73, 0x84, 0x24, 0x96, 0x8c, 0x43, 0x5d, 0x98,
-	0x2d, 0x76, 0x1a, 0x3d, 0x7a, 0xe1, 0x1e, 0xd3, 0x1b, 0x59, 0x09, 0xe7, 0x76, 0xab, 0x27, 0xcc,
-	0x93, 0x15, 0xd4, 0xdd, 0x00, 0xc6, 0xee, 0x06, 0xa9, 0xe8, 0x6e, 0xf0, 0x10, 0x74, 0xd1, 0xe3,
-	0xb8, 0x71, 0x12, 0x73, 0xeb, 0xba, 0xf8, 0xc8, 0x79, 0x2e, 0x8e, 0x7f, 0xac, 0x84, 0x8a, 0xb0,
-	0x24, 0xd1, 0xf3, 0xd1, 0xbf, 0x0f, 0x73, 0x4d, 0x51, 0xc9, 0xc7, 0x1e, 0x2e, 0x03, 0x01, 0xb7,
-	0x42, 0x0c, 0x7a, 0x17, 0x56, 0x44, 0x75, 0x11, 0xb7, 0xb0, 0x72, 0x19, 0x3d, 0xa4, 0xf2, 0x2c,
-	0xac, 0x46, 0xc1, 0xdc, 0x65, 0xff, 0x79, 0x02, 0x26, 0x49, 0x10, 0xf8, 0x52, 0xbb, 0xfb, 0x4b,
-	0x3d, 0x59, 0x48, 0x87, 0x9b, 0x29, 0xf5, 0x70, 0xc3, 0xf7, 0xf0, 0xe9, 0x98, 0x3d, 0xfc, 0x5d,
-	0x98, 0xf6, 0xe8, 0x4d, 0x04, 0x9d, 0x1c, 0x39, 0x82, 0xa0, 0x07, 0x33, 0x76, 0x49, 0xc1, 0x21,
-	0xc6, 0x1a, 0xc0, 0x39, 0x76, 0x9d, 0x23, 0x47, 0x9a, 0x2f, 0xa9, 0x46, 0x7d, 0x08, 0x49, 0x47,
-	0x1f, 0x42, 0x74, 0x48, 0x62, 0xd7, 0x65, 0x91, 0x84, 0x45, 0x3e, 0xd1, 0x43, 0x48, 0xd1, 0xb8,
-	0xf8, 0xf2, 0x23, 0x4c, 0x70, 0xde, 0x9a, 0x94, 0xcf, 0x5b, 0xf7, 0x20, 0xcd, 0xe8, 0xaf, 0x7c,
-	0xd8, 0x42, 0x75, 0x58, 0x62, 0xc7, 0x5f, 0xdb, 0x6d, 0x9c, 0x8c, 0xdf, 0x3b, 0x49, 0x9f, 0xce,
-	0x99, 0xe3, 0x8b, 0xfb, 0x25, 0x5a, 0x18, 0x21, 0xc9, 0x03, 0x71, 0x40, 0x67, 0x6c, 0x43, 0xaf,
-	0x4f, 0x3a, 0x1d, 0xf6, 0xfa, 0x54, 0x20, 0xd6, 0x86, 0xde, 0x26, 0x31, 0xcf, 0xa5, 0xd2, 0xa0,
-	0x2d, 0xb2, 0xe3, 0x2b, 0xdc, 0x2f, 0x8d, 0x57, 0xd1, 0x02, 0xa4, 0x9f, 0xda, 0x7e, 0xc0, 0x19,
-	0x95, 0x01, 0x68, 0xb9, 0x74, 0x4e, 0x16, 0xf1, 0x7b, 0xc1, 0xd4, 0x6b, 0x91, 0x8b, 0x3b, 0x0a,
-	0x8a, 0xcc, 0xbd, 0xb0, 0xf6, 0x84, 0x64, 0xed, 0x77, 0x60, 0xf2, 0xc0, 0xc5, 0x47, 0x64, 0x66,
-	0xc5, 0xa1, 0x60, 0x8e, 0x3d, 0x3f, 0xc5, 0x3a, 0x03, 0x94, 0x01, 0x83, 0xe0, 0xb1, 0x8b, 0xdb,
-	0x0d, 0x1c, 0x5c, 0x8a, 0xfd, 0x02, 0x96, 0x95, 0xda, 0x50, 0x79, 0x64, 0x1d, 0x0f, 0x2b, 0x8f,
-	0x80, 0x2d, 0xd6, 0x86, 0x1e, 0x40, 0x26, 0xa4, 0xad, 0x86, 0x71, 0xe3, 0x4d, 0xfa, 0x7c, 0x77,
-	0x34, 0x64, 0x09, 0x94, 0x96, 0x36, 0xa1, 0x6b, 0xb0, 0x12, 0x21, 0xe5, 0x2b, 0xf5, 0x9f, 0x34,
-	0x98, 0x7f, 0xda, 0x71, 0xcf, 0x4e, 0x3a, 0xe2, 0x72, 0x74, 0x55, 0xb9, 0xa1, 0x0c, 0xaf, 0x93,
-	0x6f, 0xc0, 0x5c, 0x70, 0xe9, 0xcc, 0x47, 0x1a, 0x56, 0x10, 0x2a, 0xa7, 0x7d, 0xee, 0xf8, 0xe2,
-	0x52, 0x81, 0x97, 0xb8, 0x03, 0x80, 0x38, 0x07, 0x40, 0x37, 0xd8, 0x94, 0x74, 0xdd, 0xb8, 0xc1,
-	0xb7, 0xfc, 0x74, 0x64, 0x86, 0x0a, 0x9d, 0xb6, 0x8f, 0xdb, 0xf2, 0xf9, 0xfc, 0x0c, 0x16, 0x84,
-	0xd0, 0xfc, 0xfa, 0x72, 0x53, 0xbd, 0x48, 0x49, 0x49, 0xc7, 0xac, 0x27, 0xac, 0x3e, 0xbc, 0x5a,
-	0x79, 0x3f, 0xb0, 0x05, 0x16, 0x19, 0x5c, 0x0b, 0x6d, 0x81, 0x33, 0x55, 0xcd, 0x01, 0xfd, 0x43,
-	0x02, 0x66, 0x38, 0x97, 0x31, 0x27, 0xe6, 0x2b, 0xdc, 0x95, 0x1a, 0x9b, 0xb2, 0x12, 0x93, 0x31,
-	0x40, 0x49, 0xa5, 0x42, 0x1d, 0xd1, 0xfb, 0x7b, 0x2e, 0x89, 0x74, 0xdc, 0xd9, 0x84, 0x99, 0x06,
-	0xd3, 0x11, 0xd5, 0xb4, 0x3c, 0x78, 0xae, 0x3b, 0x4b, 0x00, 0xd4, 0x3d, 0x6a, 0x25, 0xba, 0x47,
-	0xe5, 0x20, 0x45, 0x7c, 0x58, 0xd1, 0xf1, 0xba, 0x2d, 0xfb, 0x22, 0xbb, 0x4e, 0xe7, 0x52, 0xae,
-	0x22, 0x08, 0xb2, 0x65, 0x09, 0x44, 0x8e, 0x21, 0xa4, 0x2a, 0xf4, 0x08, 0x66, 0x78, 0xaf, 0xb1,
-	0x97, 0xca, 0x1b, 0x52, 0x60, 0x37, 0x7e, 0x96, 0x6d, 0x58, 0xe1, 0x63, 0x3d, 0x70, 0x71, 0xd7,
-	0x76, 0xa5, 0x13, 0xc4, 0xab, 0x98, 0x28, 0x89, 0x28, 0xf1, 0x73, 0x9f, 0x1f, 0xfa, 0xe8, 0x37,
-	0x2a, 0xc2, 0x6a, 0xb4, 0x0b, 0xbe, 0x22, 0x5f, 0xc2, 0xa0, 0xd0, 0x6f, 0x20, 0xc3, 0xeb, 0xd4,
-	0x17, 0xdc, 0xd7, 0x27, 0x67, 0x21, 0x50, 0x45, 0xe4, 0x11, 0xf7, 0x65, 0xc4, 0x7c, 0x04, 0x8b,
-	0xbc, 0xce, 0xfb, 0x51, 0x12, 0xa2, 0xcf, 0x40, 0x0f, 0x19, 0x71, 0x41, 0xde, 0x83, 0x59, 0xde,
-	0x8f, 0x70, 0x62, 0xc3, 0x92, 0x04, 0x08, 0xf4, 0x1b, 0x58, 0xce, 0x37, 0xcf, 0x9c, 0x76, 0xd5,
-	0x39, 0x6e, 0x93, 0xed, 0x59, 0x12, 0x27, 0xcc, 0xe7, 0x08, 0x9f, 0x5c, 0x57, 0x61, 0xfa, 0x0c,
-	0xfb, 0x27, 0x1d, 0x71, 0x79, 0xc6, 0x4b, 0x62, 0xaf, 0x4f, 0x0e, 0xef, 0xf5, 0xa8, 0x01, 0x19,
-	0xb5, 0x87, 0x30, 0xb2, 0xb7, 0x7b, 0x61, 0x20, 0x43, 0xbe, 0x05, 0x9b, 0x44, 0x4c, 0xc8, 0x70,
-	0x03, 0x26, 0x1b, 0x61, 0x17, 0x34, 0x80, 0x2d, 0x90, 0x46, 0x5a, 0x8b, 0x76, 0x60, 0x89, 0x76,
-	0x42, 0xe3, 0xe2, 0xcb, 0x06, 0x31, 0xe6, 0x7d, 0x37, 0x03, 0x86, 0xcc, 0x87, 0x89, 0xba, 0xf9,
-	0x17, 0x1a, 0xa4, 0xa4, 0x67, 0x25, 0xe3, 0x2e, 0x64, 0x8a, 0xa5, 0x9d, 0x7c, 0x7d, 0xbf, 0x76,
-	0x58, 0x2a, 0x17, 0xac, 0x67, 0x07, 0xb5, 0xc3, 0x27, 0x95, 0x62, 0x49, 0x9f, 0x30, 0x57, 0xfb,
-	0x83, 0x9c, 0x51, 0xc4, 0x47, 0x76, 0xaf, 0xe5, 0xcb, 0x14, 0x6f, 0x00, 0x08, 0xe4, 0x17, 0x5b,
-	0xba, 0x66, 0xce, 0xf7, 0x07, 0xb9, 0x39, 0x0e, 0xf8, 0x62, 0xcb, 0xb8, 0x09, 0xe9, 0xea, 0xee,
-	0x23, 0x01, 0xb8, 0xa7, 0x27, 0xcc, 0xc5, 0xfe, 0x20, 0x47, 0x93, 0xdd, 0x18, 0xe4, 0x9e, 0xb9,
-	0xfc, 0xa7, 0x7f, 0xb3, 0x36, 0xf1, 0xcf, 0x7f, 0xbb, 0x26, 0x0b, 0xb2, 0xf9, 0xad, 0x06, 0x10,
-	0xde, 0x99, 0x1a, 0x77, 0x60, 0x39, 0x90, 0xeb, 0xcb, 0x83, 0x8a, 0x55, 0x3b, 0xac, 0x3d, 0x3b,
-	0x20, 0x62, 0xad, 0xf4, 0x07, 0xb9, 0x25, 0x21, 0x56, 0x88, 0xbf, 0x0b, 0x99, 0x6a, 0x7e, 0xbf,
-	0x76, 0x90, 0x2f, 0xec, 0x29, 0x04, 0x1a, 0x1b, 0x47, 0xd5, 0x6e, 0xf9, 0x5d, 0xbb, 0x71, 0x1a,
-	0x52, 0x98, 0x06, 0x97, 0x42, 0xea, 0x75, 0xf3, 0x5f, 0x35, 0x98, 0xe1, 0x37, 0x68, 0xc6, 0x06,
-	0xe8, 0xf5, 0xf2, 0x5e, 0xb9, 0xf2, 0xb4, 0x7c, 0xb8, 0x57, 0x7a, 0x26, 0xba, 0x37, 0xfa, 0x83,
-	0xdc, 0x42, 0xbd, 0x7d, 0xda, 0xee, 0x7c, 0xd3, 0x16, 0x48, 0x13, 0x66, 0x4b, 0xc5, 0x2f, 0xb7,
-	0xee, 0xdf, 0xbf, 0xf7, 0x40, 0x07, 0x33, 0xdd, 0x1f, 0xe4, 0x66, 0x4b, 0x4d, 0x56, 0x36, 0x6e,
-	0xc1, 0xa2, 0x68, 0x3b, 0x3c, 0xa8, 0x6f, 0xef, 0xef, 0x16, 0xf4, 0x14, 0x63, 0x22, 0x20, 0x07,
-	0xbd, 0xaf, 0x5a, 0x4e, 0x83, 0xcc, 0x30, 0x67, 0x91, 0x31, 0xa1, 0x3f, 0xc8, 0xf1, 0x92, 0xf1,
-	0x26, 0xcc, 0xab, 0xe4, 0x2b, 0xa6, 0xde, 0x1f, 0xe4, 0xd2, 0x32, 0xb1, 0xb9, 0xc8, 0xc7, 0x22,
-	0x84, 0xdf, 0xac, 0xc1, 0xbc, 0x72, 0xbe, 0x37, 0x32, 0x90, 0xcc, 0x57, 0x0b, 0xfa, 0x84, 0x99,
-	0xea, 0x0f, 0x72, 0x33, 0xa4, 0x2d, 0xef, 0x91, 0x4e, 0x27, 0x8b, 0xa5, 0x6a, 0x41, 0xd7, 0x98,
-	0xd4, 0x94, 0x04, 0x7b, 0x0d, 0x73, 0x85, 0xf3, 0x53, 0x99, 0x6c, 0x7e, 0xaf, 0x01, 0x84, 0xf7,
-	0x62, 0xc6, 0x26, 0x2c, 0x0b, 0x0d, 0x55, 0x4b, 0x05, 0xab, 0x14, 0xcc, 0xd1, 0x52, 0x7f, 0x90,
-	0x9b, 0xe7, 0x4a, 0xe2, 0x57, 0x72, 0xb7, 0x60, 0xf1, 0x20, 0x5f, 0xad, 0x3e, 0xad, 0x58, 0x45,
-	0x0e, 0xd6, 0x81, 0xe9, 0x41, 0x1c, 0x66, 0x39, 0xf0, 0x6d, 0x58, 0x28, 0x54, 0xca, 0xb5, 0x7c,
-	0xa1, 0x26, 0x70, 0x29, 0xc6, 0x8f, 0xf8, 0x72, 0xbb, 0xe1, 0x73, 0xd8, 0x3a, 0xa4, 0x0a, 0xf9,
-	0x90, 0x57, 0xda, 0x5c, 0xe8, 0x0f, 0x72, 0x50, 0xb0, 0x03, 0x3e, 0xeb, 0x90, 0x2a, 0x57, 0x6a,
-	0x25, 0x01, 0x98, 0x67, 0x80, 0x72, 0xc7, 0xc7, 0x0c, 0x10, 0xce, 0x7f, 0x38, 0xa2, 0xcd, 0xdf,
-	0x69, 0x30, 0x2b, 0x4e, 0xf2, 0x24, 0x28, 0x7b, 0x5c, 0xfa, 0x52, 0x9f, 0x30, 0x67, 0xfa, 0x83,
-	0x5c, 0xf2, 0x31, 0x7e, 0x4e, 0xe6, 0x68, 0x3b, 0x5f, 0x2d, 0x7d, 0x44, 0xcc, 0x9e, 0xce, 0xd1,
-	0xb6, 0xed, 0xe1, 0x8f, 0xb6, 0x44, 0xfd, 0xfd, 0x8f, 0xf5, 0x44, 0x58, 0x7f, 0xff, 0x63, 0x51,
-	0xff, 0xc1, 0x96, 0x9e, 0x0c, 0xeb, 0x3f, 0x08, 0xf0, 0xf7, 0x3e, 0xd2, 0x27, 0xc3, 0xfa, 0x7b,
-	0x1f, 0x05, 0xfc, 0x3f, 0xd4, 0xa7, 0x24, 0xfe, 0x1f, 0x12, 0x03, 0x13, 0xc6, 0xad, 0x4f, 0xf3,
-	0xa9, 0xe2, 0x06, 0x4d, 0x02, 0xc5, 0xed, 0xdd, 0x83, 0x0f, 0x1e, 0xe8, 0x33, 0xe6, 0x5c, 0x7f,
-	0x90, 0x63, 0x05, 0x53, 0xe7, 0x83, 0x0b, 0x46, 0xb3, 0xf9, 0xbf, 0x09, 0x80, 0xf0, 0x44, 0x62,
-	0xdc, 0x82, 0x74, 0xbd, 0x5a, 0xb2, 0x0e, 0xf9, 0x04, 0x8a, 0x85, 0x15, 0x22, 0xf8, 0xf4, 0x19,
-	0x6f, 0xc0, 0x0c, 0x05, 0x56, 0xf6, 0x74, 0x8d, 0x59, 0x5e, 0x88, 0xa9, 0xec, 0x19, 0x9f, 0xc0,
-	0x35, 0xda, 0x6c, 0x95, 0xaa, 0x95, 0xba, 0x55, 0x28, 0x1d, 0x96, 0x2b, 0xb5, 0xc3, 0x9d, 0x4a,
-	0xbd, 0x5c, 0xd4, 0x33, 0xe6, 0x5a, 0x7f, 0x90, 0x33, 0xa5, 0x63, 0x10, 0xf6, 0x3a, 0x3d, 0xb7,
-	0x81, 0xcb, 0x1d, 0x7f, 0xa7, 0xd3, 0x6b, 0x37, 0x8d, 0x07, 0xb0, 0x4a, 0x89, 0xc9, 0x84, 0x97,
-	0xca, 0x35, 0x89, 0x76, 0xcd, 0x7c, 0xa3, 0x3f, 0xc8, 0x5d, 0x0f, 0x69, 0xf9, 0x4e, 0x1e, 0x90,
-	0x7e, 0x04, 0x19, 0x85, 0x74, 0xb7, 0xfc, 0x45, 0x7e, 0x7f, 0xb7, 0xa8, 0xaf, 0x9b, 0x37, 0xfa,
-	0x83, 0x5c, 0x76, 0x88, 0x70, 0xb7, 0x7d, 0x6e, 0xb7, 0x9c, 0xa6, 0x71, 0x17, 0x96, 0x04, 0x5d,
-	0xf9, 0x70, 0x27, 0xbf, 0xbb, 0x5f, 0xb7, 0x4a, 0xfa, 0x86, 0x79, 0xbd, 0x3f, 0xc8, 0xad, 0x28,
-	0x44, 0xed, 0x1d, 0xdb, 0x69, 0xf5, 0x5c, 0x1c, 0x68, 0x4a, 0x80, 0xb7, 0xa2, 0x9a, 0xe2, 0xc0,
-	0xd0, 0xa0, 0xc2, 0xa6, 0xcd, 0xff, 0xd3, 0x20, 0x25, 0x1d, 0x06, 0x8c, 0x0d, 0x48, 0x3f, 0xcd,
-	0xd7, 0x0a, 0x8f, 0x0f, 0xeb, 0x42, 0xed, 0xd4, 0x3d, 0x49, 0x10, 0xa1, 0xf7, 0x5b, 0x02, 0x59,
-	0xa9, 0xd7, 0xf2, 0x8f, 0x4a, 0x7a, 0x9a, 0x75, 0x2b, 0x21, 0x2b, 0x3d, 0x9f, 0x04, 0x8f, 0xb7,
-	0x61, 0x91, 0x01, 0x8b, 0xbb, 0x55, 0xab, 0x7e, 0x50, 0x2b, 0x15, 0xf5, 0x79, 0x33, 0xdb, 0x1f,
-	0xe4, 0x32, 0x12, 0xb6, 0xe8, 0x78, 0x6e, 0xaf, 0xeb, 0xe3, 0xa6, 0xf1, 0x2e, 0x2c, 0x30, 0x78,
-	0xb5, 0x96, 0xb7, 0x6a, 0xbb, 0xe5, 0x47, 0xfa, 0x82, 0x79, 0xad, 0x3f, 0xc8, 0x2d, 0x4b, 0xe8,
-	0xaa, 0x6f, 0xbb, 0x3e, 0x59, 0x02, 0x6f, 0x02, 0x70, 0xde, 0xf9, 0x5a, 0x5e, 0xd7, 0xcd, 0xe5,
-	0xfe, 0x20, 0xb7, 0x28, 0xb3, 0xb5, 0x7d, 0x3b, 0x74, 0xe7, 0x52, 0xc3, 0xe6, 0xa7, 0x30, 0x43,
-	0x0e, 0x07, 0x7b, 0xf8, 0x82, 0xec, 0x08, 0x07, 0x56, 0x69, 0x47, 0x32, 0x35, 0xba, 0x23, 0x90,
-	0x66, 0x3e, 0xd8, 0xd0, 0x7f, 0x71, 0x9a, 0xcd, 0xff, 0x4a, 0x84, 0xd1, 0x38, 0x57, 0xdd, 0x3b,
-	0xa0, 0x3f, 0xad, 0x58, 0x4f, 0x1e, 0x57, 0xf6, 0x4b, 0x87, 0x7c, 0x6b, 0xd0, 0x27, 0xb8, 0x44,
-	0x1c, 0xc9, 0xb7, 0x05, 0xe3, 0x5d, 0x58, 0x0a, 0xa0, 0xc1, 0x30, 0xc1, 0xcc, 0xf4, 0x07, 0x39,
-	0x5d, 0xe2, 0xca, 0xc6, 0x28, 0x83, 0x2b, 0x3b, 0x3b, 0x25, 0x8b, 0x80, 0x33, 0x2a, 0xb8, 0x72,
-	0x74, 0x84, 0x5d, 0x02, 0xbe, 0x0d, 0x46, 0x00, 0xce, 0x97, 0xab, 0x4f, 0x19, 0x7a, 0x85, 0xcf,
-	0x0d, 0x47, 0xe7, 0xdb, 0xde, 0x37, 0xc3, 0xf0, 0xc7, 0xf9, 0x72, 0xb1, 0xfa, 0x38, 0xbf, 0x47,
-	0xcc, 0x4d, 0x81, 0x3f, 0xb6, 0xdb, 0x4d, 0xef, 0xc4, 0x3e, 0xc5, 0x0a, 0x9c, 0x18, 0x68, 0xa9,
-	0x40, 0x66, 0xb3, 0xa9, 0xc2, 0x89, 0x6d, 0xe2, 0x86, 0x4f, 0xd3, 0x46, 0x16, 0x43, 0xf8, 0x7e,
-	0xa5, 0x5a, 0x2a, 0xea, 0xdf, 0x69, 0xcc, 0xa9, 0x06, 0xe0, 0x56, 0xc7, 0xc3, 0x4d, 0x73, 0x95,
-	0xeb, 0x37, 0xa2, 0xd3, 0xcd, 0x16, 0xa4, 0xa4, 0x10, 0x99, 0xf8, 0xde, 0xed, 0xdd, 0x72, 0xde,
-	0x7a, 0x26, 0x96, 0x95, 0xf0, 0xe5, 0xdb, 0x4e, 0xdb, 0x76, 0x2f, 0x44, 0x04, 0x7e, 0x13, 0xd2,
-	0xf5, 0xda, 0xce, 0xc7, 0x01, 0x48, 0x63, 0x13, 0x4a, 0xea, 0x38, 0x24, 0xb4, 0x09, 0x89, 0xfd,
-	0xe6, 0x6f, 0x35, 0x48, 0x49, 0x07, 0x0d, 0xc2, 0xe7, 0x49, 0xa9, 0x5a, 0xcd, 0x3f, 0x22, 0x5e,
-	0x9a, 0x76, 0x46, 0xf9, 0x70, 0x48, 0x95, 0x74, 0x75, 0x0b, 0x16, 0x05, 0xe4, 0xa0, 0x54, 0x2e,
-	0x12, 0x65, 0xf3, 0x11, 0x8a, 0x10, 0x1b, 0xb7, 0xa9, 0xb3, 0x5e, 0x87, 0x94, 0x00, 0x12, 0x2f,
-	0x99, 0x60, 0xee, 0x9e, 0x83, 0xf2, 0x8d, 0xd3, 0x50, 0x22, 0x49, 0x82, 0xad, 0xff, 0xb8, 0x09,
-	0x93, 0x7b, 0xf8, 0xc2, 0x33, 0x3e, 0xa7, 0x8f, 0x6e, 0x22, 0xa3, 0xc0, 0xf8, 0x99, 0x7c, 0x7e,
-	0x8a, 0xe4, 0x28, 0x98, 0x37, 0xe2, 0x1b, 0xf9, 0xe1, 0x77, 0xc2, 0xb8, 0xcf, 0x79, 0x66, 0x64,
-	0x9c, 0x88, 0x8e, 0xcd, 0x95, 0x48, 0x6d, 0x40, 0xb6, 0xc5, 0x5e, 0x4f, 0x97, 0x95, 0xa3, 0x1b,
-	0x27, 0xca, 0xa8, 0x95, 0x01, 0x4d, 0x11, 0xe6, 0x82, 0x67, 0x5e, 0xe3, 0xba, 0x0c, 0x52, 0x9e,
-	0x8e, 0x4d, 0x33, 0xae, 0x29, 0xc2, 0x85, 0x85, 0x41, 0x2a, 0x17, 0xe5, 0x85, 0x5c, 0xe5, 0xa2,
-	0x3e, 0x64, 0x07, 0x5c, 0xd8, 0x3b, 0x8b, 0xca, 0x45, 0x79, 0xaa, 0x51, 0xb9, 0x44, 0x9e, 0x65,
-	0xa8, 0xf2, 0x48, 0xa8, 0x28, 0x29, 0x4f, 0xca, 0x9b, 0x90, 0x94, 0x27, 0x67, 0x3c, 0xa0, 0x09,
-	0x23, 0x0f, 0xb3, 0xe2, 0xd7, 0x01, 0xc6, 0xaa, 0x02, 0x0a, 0x32, 0xb4, 0xcc, 0x6b, 0x43, 0xf5,
-	0xec, 0x9c, 0x8f, 0x26, 0x36, 0xb4, 0xbb, 0x9a, 0xc1, 0x93, 0x29, 0xab, 0xbe, 0x8b, 0xed, 0x33,
-	0xc3, 0x50, 0xc0, 0x8c, 0xc1, 0xb2, 0x52, 0x17, 0x21, 0x9e, 0x66, 0x59, 0xda, 0x52, 0xef, 0xca,
-	0xaf, 0x0a, 0xa4, 0xde, 0xd5, 0x9f, 0x04, 0xa0, 0x09, 0xe3, 0x11, 0x40, 0x98, 0x85, 0x6e, 0x64,
-	0x23, 0xc0, 0x70, 0x00, 0xd7, 0x63, 0x5a, 0x14, 0x29, 0xf2, 0x22, 0xcf, 0x9e, 0x0f, 0x22, 0x13,
-	0x21, 0x60, 0x6c, 0x56, 0x22, 0xb5, 0x0a, 0x8b, 0xc7, 0x22, 0xdd, 0x3c, 0xcf, 0x72, 0xd3, 0x5e,
-	0x9d, 0x53, 0x55, 0xfc, 0xf8, 0x41, 0x24, 0xae, 0x1b, 0x6b, 0x11, 0x78, 0xe4, 0x97, 0x0c, 0xe6,
-	0xfa, 0xc8, 0xf6, 0x40, 0x55, 0xbf, 0x06, 0x63, 0x38, 0xc9, 0xde, 0xc8, 0x8d, 0x20, 0x0c, 0x55,
-	0x77, 0x39, 0xeb, 0x0d, 0xcd, 0x78, 0x06, 0x19, 0xb5, 0x95, 0x0f, 0xfe, 0xc6, 0x08, 0xe2, 0x97,
-	0x60, 0xfd, 0x10, 0x66, 0xf8, 0x61, 0xc7, 0xb8, 0x16, 0x4d, 0xef, 0x13, 0xc3, 0xcf, 0x0e, 0x37,
-	0x48, 0x8b, 0x4b, 0x64, 0x53, 0x72, 0x99, 0x56, 0xa2, 0x60, 0x26, 0xcc, 0x6a, 0xb4, 0x5a, 0x99,
-	0x92, 0xcf, 0x83, 0xb3, 0x1f, 0x55, 0xdb, 0xf5, 0x28, 0x38, 0xd4, 0x97, 0x19, 0xd7, 0xa4, 0xf0,
-	0x7a, 0x08, 0x33, 0x3c, 0xd9, 0x4f, 0x1a, 0x91, 0x9a, 0xcc, 0x29, 0x8d, 0x28, 0x92, 0x7b, 0x89,
-	0x26, 0x88, 0x2c, 0x52, 0x26, 0xa3, 0x24, 0x4b, 0x34, 0xb3, 0x52, 0x92, 0x65, 0x28, 0xf5, 0x91,
-	0xcb, 0x52, 0x0c, 0x12, 0x0f, 0x87, 0xb4, 0x23, 0x27, 0x2d, 0x4a, 0xda, 0x51, 0xf2, 0x14, 0x39,
-	0x97, 0x3d, 0xc8, 0xf0, 0x6a, 0xd5, 0xf6, 0x5f, 0x89, 0xd9, 0xe7, 0xb0, 0x1c, 0x1c, 0x79, 0x2b,
-	0x5d, 0xdc, 0xfe, 0x31, 0xbc, 0xfe, 0x10, 0x4c, 0x85, 0xd7, 0x6b, 0x10, 0x8f, 0xf9, 0x4b, 0x9a,
-	0xeb, 0x22, 0x39, 0x9c, 0xc8, 0x4f, 0x2a, 0x24, 0x87, 0x13, 0xfd, 0xf9, 0x04, 0xf3, 0xf7, 0xe1,
-	0x0f, 0x48, 0xae, 0xc7, 0xe4, 0x5b, 0x0d, 0xf9, 0xfb, 0xa1, 0x9f, 0x36, 0xa0, 0x09, 0xe3, 0x0b,
-	0x58, 0x8c, 0xfc, 0x96, 0xc0, 0x58, 0x1f, 0x26, 0x50, 0xae, 0xc0, 0xcc, 0xdc, 0x68, 0x40, 0x2c,
-	0x5f, 0x96, 0xf9, 0x1f, 0xc7, 0x57, 0xf9, 0xc1, 0x41, 0x1c, 0x5f, 0xf5, 0x47, 0x03, 0x6c, 0x7f,
-	0xa2, 0x8f, 0x50, 0x19, 0xf5, 0x29, 0x62, 0x68, 0x7f, 0x92, 0x9f, 0x55, 0x98, 0x8b, 0x0f, 0x9f,
-	0x37, 0x0c, 0x53, 0x7d, 0x23, 0x92, 0x1f, 0x2f, 0xcc, 0x9f, 0xc5, 0xb6, 0xc9, 0xcb, 0x46, 0xca,
-	0x3e, 0x34, 0xa2, 0x68, 0x39, 0xbd, 0x51, 0x0a, 0x54, 0x62, 0x12, 0x16, 0xd9, 0xa6, 0x29, 0x92,
-	0x07, 0x25, 0x23, 0x88, 0xe4, 0x2a, 0x4a, 0x46, 0x10, 0xcd, 0x34, 0x44, 0x13, 0xc4, 0x0b, 0xf0,
-	0x84, 0x3d, 0xc9, 0x0b, 0xa8, 0xe9, 0x84, 0x66, 0x76, 0xb8, 0x21, 0xa0, 0xff, 0x04, 0xa6, 0xb9,
-	0x4e, 0xa4, 0x5d, 0x5b, 0xd1, 0xc7, 0xb5, 0xa1, 0x7a, 0x95, 0x98, 0x25, 0xfc, 0x44, 0x93, 0x24,
-	0x62, 0x88, 0xe5, 0x64, 0x17, 0x36, 0x23, 0x61, 0x1e, 0x8a, 0x34, 0x23, 0x43, 0xa9, 0x2d, 0xd2,
-	0x8c, 0x0c, 0x27, 0xae, 0xa0, 0x09, 0xe3, 0x09, 0xa4, 0xe5, 0x14, 0x13, 0x69, 0xb7, 0x88, 0x49,
-	0x54, 0x31, 0xdf, 0x18, 0xd1, 0x2a, 0x6b, 0x94, 0xe7, 0x84, 0x18, 0x51, 0xe9, 0xbd, 0x61, 0x8d,
-	0x46, 0xd2, 0x47, 0x98, 0x81, 0xd2, 0x1c, 0x88, 0x8c, 0x9a, 0x21, 0x31, 0x64, 0xa0, 0x72, 0xde,
-	0x06, 0x9a, 0x30, 0x3e, 0x86, 0x29, 0x9a, 0x70, 0x61, 0xa8, 0x88, 0xa0, 0xcb, 0xd5, 0x68, 0xb5,
-	0xdc, 0xe1, 0x41, 0xaf, 0xd5, 0x92, 0x3a, 0x94, 0xd2, 0x0d, 0xa4, 0x0e, 0xe5, 0xcc, 0x02, 0x41,
-	0xe6, 0x9d, 0x28, 0x64, 0x41, 0x32, 0x81, 0x42, 0xe6, 0x9d, 0xa8, 0x36, 0x2b, 0xce, 0x30, 0xd2,
-	0xac, 0x2b, 0xaf, 0x4d, 0xe6, 0xf0, 0xdb, 0x4b, 0x74, 0x17, 0x94, 0x9e, 0xcb, 0xa4, 0x25, 0x34,
-	0xfc, 0xb4, 0x26, 0x2d, 0xa1, 0x98, 0x17, 0x36, 0x34, 0x61, 0x1c, 0xc0, 0xbc, 0xf2, 0x06, 0x66,
-	0xbc, 0x11, 0x43, 0x10, 0x3e, 0xab, 0x99, 0x6b, 0xa3, 0x9a, 0x65, 0xb7, 0x1a, 0xa4, 0x7b, 0x4b,
-	0x6e, 0x35, 0x9a, 0x4a, 0x2e, 0xb9, 0xd5, 0xa1, 0xec, 0x70, 0x66, 0xdd, 0x61, 0xe2, 0xb6, 0xa1,
-	0x62, 0x95, 0xb4, 0x70, 0xc9, 0xba, 0x87, 0x33, 0xbd, 0x99, 0xbe, 0x45, 0xea, 0xb6, 0xe4, 0x23,
-	0x22, 0x09, 0xde, 0xe6, 0xf5, 0x98, 0x16, 0x59, 0x47, 0x4a, 0xca, 0xbe, 0xa4, 0xa3, 0xb8, 0x1c,
-	0x7f, 0x49, 0x47, 0xb1, 0x99, 0xfe, 0xcc, 0x76, 0x2c, 0xbb, 0xdd, 0x94, 0x6c, 0x47, 0x4a, 0xe1,
-	0x91, 0x6c, 0x47, 0x4e, 0xbf, 0x61, 0x2b, 0x55, 0xce, 0x9e, 0x91, 0x56, 0x6a, 0x4c, 0x0e, 0x8e,
-	0xb4, 0x52, 0xe3, 0x52, 0x6e, 0x98, 0x2b, 0x96, 0xb2, 0x43, 0x24, 0x3b, 0x1a, 0xce, 0x2e, 0x91,
-	0xec, 0x28, 0x26, 0xa1, 0x84, 0xcd, 0x7a, 0x90, 0x69, 0x21, 0xc7, 0x42, 0x91, 0xec, 0x0d, 0x39,
-	0x16, 0x8a, 0x26, 0x66, 0xa0, 0x09, 0x12, 0x72, 0xab, 0xc9, 0x13, 0x52, 0xc8, 0x1d, 0x9b, 0x82,
-	0x21, 0x05, 0xaf, 0x23, 0xb2, 0x2e, 0xa8, 0xd6, 0xe4, 0x47, 0x0f, 0x49, 0x6b, 0x31, 0xaf, 0x2d,
-	0x92, 0xd6, 0xe2, 0x5e, 0x4a, 0xb8, 0x65, 0x06, 0xcf, 0x12, 0xb2, 0x65, 0x46, 0xdf, 0x3c, 0x64,
-	0xcb, 0x1c, 0x7a, 0xc7, 0x60, 0x83, 0x55, 0x9f, 0xd9, 0xa4, 0xc1, 0xc6, 0x3e, 0xf1, 0x49, 0x83,
-	0x8d, 0x7f, 0x9f, 0x63, 0xb6, 0xaa, 0xbc, 0x89, 0x49, 0xb6, 0x1a, 0xf7, 0x1a, 0x67, 0xae, 0x8d,
-	0x6a, 0x96, 0x17, 0x90, 0x78, 0xd7, 0x92, 0x16, 0x50, 0xe4, 0xcd, 0x4c, 0x5a, 0x40, 0xd1, 0x47,
-	0x30, 0x34, 0x61, 0xfc, 0x01, 0x4c, 0xd1, 0xab, 0x35, 0xc9, 0x37, 0xcb, 0x59, 0x09, 0xd2, 0xb9,
-	0x34, 0x4c, 0x4e, 0x40, 0x13, 0x77, 0xb5, 0xed, 0x1b, 0xdf, 0xfd, 0xb0, 0x36, 0xf1, 0xbb, 0x1f,
-	0xd6, 0xb4, 0xff, 0xf9, 0x61, 0x4d, 0xfb, 0xee, 0xc5, 0x9a, 0xf6, 0x6f, 0x2f, 0xd6, 0xb4, 0xff,
-	0x7c, 0xb1, 0xa6, 0xfd, 0xf7, 0x8b, 0x35, 0xed, 0xab, 0x69, 0xfa, 0xef, 0x06, 0x1f, 0xfc, 0x7f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xd9, 0xe5, 0x3d, 0x0a, 0x41, 0x00, 0x00,
+	// 6280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4d, 0x6c, 0x23, 0xc9,
+	0x75, 0xb0, 0x9a, 0xd4, 0xef, 0x23, 0x25, 0xb5, 0x5a, 0x94, 0x86, 0xea, 0x99, 0x91, 0xb8, 0xbd,
+	0x3f, 0xa3, 0xd5, 0xee, 0xcc, 0xce, 0x68, 0x67, 0xc6, 0xbb, 0x9f, 0xed, 0xb5, 0x29, 0x92, 0x1a,
+	0x71, 0x25, 0x91, 0xfa, 0x9a, 0xd4, 0xcc, 0x6e, 0x1c, 0x40, 0x6e, 0x93, 0x25, 0xa9, 0x31, 0xfc,
+	0x73, 0x77, 0x73, 0x76, 0x84, 0xdc, 0x8c, 0x04, 0x70, 0x84, 0x00, 0x41, 0x6e, 0xf9, 0x81, 0x80,
+	0x04, 0x09, 0x90, 0x20, 0x06, 0x72, 0xc9, 0xcd, 0x30, 0x72, 0xf6, 0x21, 0x07, 0x23, 0xc8, 0xc1,
+	0xb9, 0x2c, 0xe2, 0x71, 0x02, 0x04, 0x48, 0x0e, 0x01, 0x02, 0xe4, 0x16, 0x20, 0xa8, 0xbf, 0xae,
+	0xaa, 0x66, 0x93, 0xd2, 0xcc, 0xee, 0xc2, 0xf1, 0xad, 0xeb, 0xbd, 0x57, 0xaf, 0xde, 0x7b, 0xf5,
+	0xea, 0xd5, 0xdf, 0xab, 0x06, 0x78, 0x8a, 0xce, 0xfc, 0x3b, 0x3d, 0xaf, 0x1b, 0x74, 0x8d, 0x29,
+	0x1f, 0x79, 0xcf, 0xdc, 0x06, 0x32, 0x33, 0x27, 0xdd, 0x93, 0x2e, 0x81, 0xbd, 0x87, 0xbf, 0x28,
+	0xda, 0xb2, 0x61, 0xda, 0x3e, 0x28, 0x94, 0x3c, 0xaf, 0xeb, 0x19, 0x06, 0x8c, 0x37, 0xba, 0x4d,
+	0x94, 0xd5, 0x72, 0xda, 0xfa, 0x84, 0x4d, 0xbe, 0x8d, 0x2c, 0x4c, 0xb5, 0x91, 0xef, 0x3b, 0x27,
+	0x28, 0x9b, 0xc8, 0x69, 0xeb, 0x33, 0x36, 0x2f, 0x62, 0x4c, 0x13, 0x05, 0x8e, 0xdb, 0xf2, 0xb3,
+	0x49, 0x8a, 0x61, 0x45, 0xab, 0x08, 0x50, 0x73, 0x4f, 0x3a, 0x07, 0xdd, 0x96, 0xdb, 0x38, 0xc3,
+	0x74, 0xbe, 0x7b, 0xd2, 0x41, 0x9e, 0x9f, 0xd5, 0x72, 0x49, 0x4c, 0xc7, 0x8a, 0xc6, 0x0d, 0x98,
+	0x09, 0x4e, 0x3d, 0xe4, 0x9f, 0x76, 0x5b, 0x4d, 0xc2, 0x7d, 0xc2, 0x16, 0x00, 0xeb, 0xef, 0x35,
+	0x48, 0x61, 0x36, 0x36, 0xfa, 0x7e, 0x1f, 0xf9, 0x01, 0x96, 0xae, 0xe9, 0x04, 0x0e, 0x91, 0x2e,
+	0x6d, 0x93, 0x6f, 0x63, 0x19, 0x26, 0x29, 0xb3, 0xec, 0x04, 0x11, 0x81, 0x95, 0x70, 0x9b, 0x8e,
+	0xd7, 0xee, 0x7a, 0xa8, 0x99, 0x85, 0x9c, 0xb6, 0x3e, 0x6d, 0xf3, 0xa2, 0x61, 0xc2, 0x34, 0x16,
+	0xb3, 0x71, 0x8a, 0x9a, 0xd9, 0x14, 0x41, 0x85, 0x65, 0xe3, 0x1d, 0x98, 0x3c, 0xee, 0x7a, 0x6d,
+	0x27, 0xc8, 0xa6, 0x73, 0xda, 0xfa, 0xdc, 0xe6, 0xe2, 0x1d, 0x66, 0xbb, 0x3b, 0x58, 0x8e, 0x6d,
+	0x82, 0xb2, 0x19, 0x09, 0x16, 0xbe, 0xe3, 0xb4, 0x91, 0xdf, 0x73, 0x1a, 0x28, 0x3b, 0x4b, 0x5a,
+	0x17, 0x00, 0x43, 0x87, 0xa4, 0xef, 0x9e, 0x64, 0xe7, 0x88, 0xac, 0xf8, 0xd3, 0xfa, 0x26, 0xa4,
+	0xa9, 0x36, 0x7e, 0xaf, 0xdb, 0xf1, 0x51, 0xac, 0x3a, 0x2b, 0x90, 0x7c, 0xea, 0x52, 0x53, 0xcc,
+	0x6c, 0x4d, 0xbd, 0xf8, 0x7c, 0x2d, 0xb9, 0x5b, 0x2e, 0xda, 0x18, 0x66, 0xfd, 0x9d, 0x06, 0xb3,
+	0x44, 0x0a, 0xb7, 0x85, 0xca, 0x9d, 0x5e, 0x3f, 0x30, 0xe6, 0x20, 0xe1, 0x76, 0x48, 0xf5, 0x19,
+	0x3b, 0xe1, 0x76, 0x70, 0x93, 0xdd, 0x7e, 0xc0, 0x7a, 0x09, 0x7f, 0xfe, 0x2a, 0xad, 0x33, 0xa8,
+	0xff, 0x13, 0x98, 0xe3, 0xf2, 0x57, 0xfb, 0x01, 0x56, 0x80, 0x69, 0xab, 0x0d, 0x6a, 0x6b, 0x64,
+	0x60, 0xe2, 0x7b, 0x67, 0x01, 0xf2, 0x99, 0x57, 0xd0, 0x02, 0x86, 0x06, 0xdd, 0xc0, 0x69, 0x11,
+	0x7f, 0x9b, 0xb0, 0x69, 0xc1, 0x6a, 0x52, 0xc6, 0x45, 0xd7, 0xe3, 0x9e, 0xa2, 0x43, 0xb2, 0xe9,
+	0x7a, 0xcc, 0x34, 0xf8, 0xf3, 0x25, 0x6c, 0xb3, 0x0c, 0x93, 0xee, 0x49, 0xa7, 0xeb, 0xa1, 0xec,
+	0x24, 0x71, 0x56, 0x56, 0xb2, 0xea, 0x30, 0x1f, 0xb6, 0xc2, 0x7a, 0x70, 0x84, 0xfc, 0x83, 0xed,
+	0x65, 0x60, 0xe2, 0xd8, 0x6d, 0x21, 0x9f, 0xcb, 0x4e, 0x0a, 0xd6, 0x63, 0xd0, 0x1f, 0x23, 0xcf,
+	0x3d, 0x3e, 0x1b, 0x29, 0xbd, 0x09, 0xd3, 0x6d, 0xa7, 0xe3, 0x1e, 0x23, 0x9f, 0xb3, 0x0c, 0xcb,
+	0xc4, 0x26, 0x5e, 0xdf, 0x0f, 0xb2, 0xf3, 0x04, 0x41, 0x0b, 0xd6, 0xef, 0x68, 0xb0, 0x20, 0x31,
+	0x66, 0x02, 0xbf, 0x11, 0xea, 0x8c, 0x99, 0xa7, 0x36, 0xd3, 0x61, 0x0f, 0xee, 0xa2, 0xb3, 0xd0,
+	0x02, 0x19, 0x98, 0x70, 0x9a, 0x4d, 0x84, 0xdd, 0x10, 0x1b, 0x80, 0x16, 0xb0, 0xcf, 0x78, 0xa8,
+	0xdd, 0x7d, 0x86, 0x9a, 0xd9, 0x24, 0x1d, 0xc5, 0xac, 0x48, 0xa4, 0xeb, 0x36, 0xdd, 0x63, 0x17,
+	0x35, 0xb3, 0xe3, 0x04, 0x15, 0x96, 0xad, 0x2e, 0xcc, 0x52, 0x31, 0x46, 0x0d, 0xe2, 0x57, 0x73,
+	0xc7, 0x78, 0xc5, 0x3f, 0x86, 0x39, 0xde, 0xe0, 0x88, 0x71, 0x26, 0x0c, 0x91, 0x18, 0x6e, 0x08,
+	0xeb, 0x5f, 0x35, 0x58, 0x62, 0x46, 0x64, 0x8d, 0x8e, 0xd2, 0x82, 0x79, 0x7c, 0x22, 0xf4, 0xf8,
+	0x11, 0x7a, 0x7d, 0x89, 0x81, 0xe6, 0x1d, 0x98, 0xec, 0x91, 0x38, 0x4b, 0xc6, 0x5a, 0x2a, 0xc2,
+	0x8a, 0x86, 0x60, 0x9b, 0x91, 0x0c, 0xb1, 0xd9, 0x31, 0x2c, 0x47, 0xd5, 0x7c, 0x29, 0x87, 0x79,
+	0x4b, 0x04, 0x78, 0xec, 0x32, 0x51, 0x32, 0x8e, 0xb4, 0xbe, 0x06, 0x29, 0xda, 0x0e, 0x8d, 0x5f,
+	0x71, 0x46, 0x8c, 0x17, 0x70, 0x07, 0xd2, 0xb4, 0x22, 0x0b, 0x1c, 0xaf, 0xde, 0xa5, 0x0d, 0x98,
+	0xa7, 0x9c, 0x5e, 0x26, 0x8c, 0x0e, 0xef, 0xc7, 0x61, 0x3e, 0xa8, 0x8b, 0x46, 0x98, 0xc8, 0x57,
+	0xb3, 0xe4, 0x40, 0xdb, 0xd6, 0x2f, 0x35, 0xb8, 0xa6, 0x76, 0xce, 0x48, 0xc9, 0x7f, 0x4d, 0x3d,
+	0xf0, 0x97, 0x1a, 0x2c, 0xaa, 0x5a, 0x0e, 0x77, 0x91, 0x5f, 0x5f, 0x2d, 0x7f, 0xa2, 0xc1, 0x4c,
+	0x2d, 0x70, 0x02, 0xd4, 0x46, 0x9d, 0x70, 0x86, 0xd4, 0x84, 0x1e, 0x5c, 0xdb, 0xc4, 0xe0, 0x8a,
+	0x20, 0x19, 0x3f, 0xc7, 0xf8, 0xe8, 0xfb, 0xd9, 0x71, 0x32, 0x9f, 0xe0, 0x4f, 0xcc, 0xa0, 0xe7,
+	0xa1, 0x67, 0x64, 0x46, 0x4b, 0xdb, 0xe4, 0x1b, 0xcf, 0x67, 0x1e, 0x7a, 0xd6, 0x7d, 0x8a, 0xe7,
+	0x33, 0x4c, 0xc8, 0x4a, 0x58, 0xdb, 0xc0, 0x6d, 0x23, 0x3f, 0x70, 0xda, 0xbd, 0xec, 0x54, 0x4e,
+	0x5b, 0x4f, 0xda, 0x02, 0x80, 0x39, 0x05, 0x67, 0x3d, 0x94, 0x9d, 0x26, 0xf2, 0x93, 0x6f, 0xeb,
+	0x5d, 0x32, 0x03, 0x36, 0x4e, 0x1d, 0x37, 0x5c, 0x92, 0x0d, 0x9f, 0x01, 0xad, 0x63, 0xd0, 0x05,
+	0x35, 0x0b, 0x27, 0xab, 0x90, 0x7c, 0x8a, 0xce, 0x62, 0x47, 0x00, 0x46, 0x18, 0x9b, 0x00, 0x3e,
+	0xb7, 0x0f, 0x8f, 0x25, 0x86, 0xb0, 0x33, 0x47, 0xd9, 0x12, 0x95, 0xf5, 0x2d, 0xd0, 0x05, 0xe2,
+	0x52, 0xb1, 0xb8, 0xd1, 0x12, 0xa1, 0xd1, 0xac, 0x12, 0x2c, 0x48, 0x0c, 0x98, 0xa4, 0x77, 0x61,
+	0x26, 0x6c, 0x83, 0xc9, 0x1b, 0x27, 0x88, 0x20, 0xb2, 0xfe, 0x50, 0x83, 0xe5, 0x10, 0x51, 0xf0,
+	0x90, 0x13, 0xa0, 0x51, 0xb3, 0xc5, 0xf0, 0x95, 0x5e, 0x68, 0xfb, 0xa4, 0xb0, 0xbd, 0xf1, 0x26,
+	0x4c, 0xb5, 0xdc, 0xce, 0xd3, 0x5d, 0xb7, 0x49, 0xfa, 0x7b, 0x66, 0x2b, 0xf5, 0xe2, 0xf3, 0xb5,
+	0xa9, 0x3d, 0x0c, 0x2a, 0x17, 0x6d, 0x8e, 0xc3, 0x7e, 0xd7, 0xea, 0x36, 0x9c, 0x16, 0xf1, 0x80,
+	0x69, 0x9b, 0x16, 0xac, 0x5d, 0xb8, 0x36, 0x20, 0xd9, 0x2b, 0xeb, 0xf9, 0x1d, 0x49, 0x4d, 0x9b,
+	0xb8, 0x92, 0xb4, 0x6e, 0xc1, 0xa6, 0xd5, 0x84, 0x3f, 0x8e, 0x50, 0xf2, 0x72, 0x49, 0x39, 0xf3,
+	0x57, 0x96, 0xf4, 0x3f, 0xf0, 0x70, 0x73, 0x4f, 0x3a, 0xc3, 0x43, 0x09, 0x0d, 0xa0, 0x89, 0x68,
+	0xe8, 0x4f, 0xfe, 0x9f, 0x58, 0x41, 0xbf, 0xec, 0xfe, 0xe2, 0xeb, 0x74, 0xd3, 0x35, 0x62, 0x8a,
+	0x1c, 0xb1, 0xbb, 0xf8, 0x89, 0x06, 0x73, 0xa5, 0x4e, 0xc3, 0x3b, 0xeb, 0x05, 0xaf, 0xb6, 0x52,
+	0x5b, 0x05, 0xf0, 0x50, 0xc3, 0xed, 0xb9, 0x64, 0xe8, 0xa6, 0xc8, 0x32, 0x50, 0x82, 0x10, 0x43,
+	0xa2, 0x4e, 0x13, 0x79, 0xd9, 0x34, 0x33, 0x24, 0x29, 0x19, 0xeb, 0x30, 0xde, 0xee, 0x36, 0xa9,
+	0x82, 0x73, 0x9b, 0x99, 0xd0, 0x20, 0x4c, 0x98, 0xfd, 0x6e, 0x13, 0xd9, 0x84, 0x02, 0x1b, 0xb6,
+	0xe7, 0xf8, 0xfe, 0x67, 0x5d, 0xaf, 0x49, 0xd4, 0x9e, 0xb1, 0xc3, 0xb2, 0xf5, 0x26, 0xcc, 0x87,
+	0xd2, 0x0f, 0x5f, 0xf6, 0xe1, 0x1d, 0xa5, 0xce, 0xe8, 0xbe, 0x9c, 0xf9, 0xff, 0x57, 0xab, 0xf5,
+	0xb7, 0x60, 0x41, 0xd2, 0x86, 0x75, 0x7c, 0xb8, 0x73, 0xd2, 0x62, 0x77, 0x4e, 0x09, 0x79, 0xe7,
+	0xf4, 0x63, 0x0d, 0xd2, 0x8c, 0xc3, 0xf0, 0x41, 0x22, 0x69, 0x9f, 0x18, 0xa5, 0x7d, 0x72, 0x84,
+	0xf6, 0xe3, 0xb1, 0xda, 0x4f, 0xbc, 0x94, 0xf6, 0x93, 0x11, 0xed, 0x5f, 0x87, 0x59, 0x56, 0x61,
+	0xb8, 0xcb, 0x5b, 0x7f, 0xac, 0xc1, 0x5c, 0x11, 0x7d, 0x01, 0xbf, 0xfe, 0x52, 0x7a, 0x6a, 0xc8,
+	0x7a, 0x60, 0x17, 0xe6, 0x8b, 0xe8, 0x52, 0xaf, 0x25, 0x4b, 0x47, 0x6a, 0xc6, 0xf8, 0x95, 0x2d,
+	0xc1, 0x59, 0x7f, 0xad, 0x81, 0x5e, 0x44, 0xa1, 0x37, 0x7c, 0x71, 0xdf, 0xfe, 0x2a, 0x35, 0xff,
+	0x0c, 0x16, 0x24, 0x59, 0xa5, 0x25, 0x32, 0xd5, 0x53, 0x1b, 0xae, 0x67, 0xfc, 0xce, 0x9a, 0x7a,
+	0x7c, 0x32, 0xd6, 0xe3, 0xc7, 0x65, 0x8f, 0xaf, 0x43, 0x9a, 0x35, 0x3c, 0xdc, 0xe1, 0x65, 0x75,
+	0x12, 0x57, 0x52, 0xa7, 0x0c, 0xb3, 0x8c, 0xeb, 0x25, 0x1b, 0x94, 0xcb, 0xbb, 0x71, 0x19, 0x32,
+	0x76, 0xbf, 0x83, 0x17, 0x62, 0x78, 0x4e, 0xeb, 0xfb, 0xcc, 0x6b, 0xad, 0xbf, 0xd2, 0x60, 0x29,
+	0x82, 0x60, 0x2e, 0x93, 0x85, 0xa9, 0x67, 0xc8, 0xf3, 0xdd, 0x2e, 0xef, 0x68, 0x5e, 0x24, 0x7d,
+	0xdb, 0xeb, 0x55, 0x9c, 0x76, 0x78, 0x74, 0xc7, 0x8a, 0xd8, 0x88, 0xe8, 0x39, 0x62, 0xc3, 0x12,
+	0x7f, 0x1a, 0xeb, 0x30, 0xef, 0xf4, 0x83, 0xd3, 0x1a, 0x0a, 0xfa, 0xbd, 0x0a, 0x42, 0x78, 0xfb,
+	0x4f, 0x67, 0xe6, 0x28, 0xd8, 0x58, 0xc3, 0x07, 0x19, 0xcd, 0xee, 0x26, 0x19, 0x90, 0xd3, 0x5b,
+	0x33, 0x2f, 0x3e, 0x5f, 0x9b, 0xd8, 0x2e, 0x17, 0xab, 0x9b, 0x36, 0x85, 0x5b, 0x7f, 0xaa, 0x81,
+	0x9e, 0xe7, 0x95, 0xf8, 0xa8, 0x93, 0x8d, 0xaa, 0x45, 0x8c, 0xba, 0x0c, 0x93, 0x8d, 0x16, 0x0e,
+	0x19, 0x6c, 0x8c, 0xb3, 0x92, 0xf1, 0x26, 0x5b, 0x08, 0x25, 0x88, 0x07, 0x2e, 0x84, 0xf6, 0xc2,
+	0xcc, 0xeb, 0x67, 0x3d, 0xc4, 0xd6, 0x46, 0xcb, 0x30, 0xd9, 0x44, 0x18, 0xc1, 0x26, 0x6e, 0x56,
+	0xc2, 0xd3, 0x5d, 0xcf, 0xed, 0x64, 0xc7, 0xc5, 0x74, 0x77, 0x50, 0xae, 0xd8, 0x18, 0x66, 0xdd,
+	0x83, 0x05, 0x49, 0x42, 0x66, 0xc8, 0x1b, 0x30, 0x83, 0x75, 0xad, 0x77, 0x9f, 0x22, 0x6e, 0x4a,
+	0x01, 0xb0, 0xfe, 0x4c, 0xa3, 0x75, 0x0e, 0x3b, 0xad, 0x6e, 0xe3, 0xe9, 0xcb, 0xa9, 0x95, 0x88,
+	0x55, 0x2b, 0x79, 0x55, 0xb5, 0xc6, 0xe3, 0xd4, 0x9a, 0x88, 0x51, 0x6b, 0x13, 0x0c, 0x59, 0xc4,
+	0x2b, 0xe9, 0xf5, 0x23, 0x0d, 0x66, 0x71, 0xa5, 0x03, 0xaf, 0xfb, 0xcc, 0x25, 0x6e, 0xb3, 0x0c,
+	0x89, 0x70, 0xf1, 0x3c, 0xf9, 0xe2, 0xf3, 0xb5, 0x44, 0xb9, 0x68, 0x27, 0xdc, 0xe6, 0x55, 0xbb,
+	0xc3, 0x82, 0x49, 0xc7, 0x39, 0xe9, 0xb3, 0x4d, 0x4b, 0x7a, 0x0b, 0x5e, 0x7c, 0xbe, 0x36, 0x99,
+	0xcf, 0x3f, 0x3a, 0x2c, 0x17, 0x6d, 0x86, 0x91, 0xbb, 0x66, 0x5a, 0xd5, 0x01, 0x4b, 0xdb, 0x20,
+	0x6b, 0xd4, 0x66, 0x3e, 0x20, 0x4a, 0x26, 0x6d, 0x01, 0xb0, 0x7a, 0x90, 0x51, 0x84, 0xe5, 0xfd,
+	0xc0, 0x65, 0xd3, 0xae, 0x6a, 0xd3, 0x44, 0x9c, 0x4d, 0x93, 0x31, 0x36, 0xdd, 0x87, 0xa5, 0x48,
+	0x8b, 0xcc, 0xac, 0xf7, 0x61, 0xa6, 0xc7, 0x81, 0x2c, 0x62, 0x2d, 0x2b, 0xed, 0x8a, 0x2a, 0x82,
+	0xd0, 0xba, 0x0b, 0xcb, 0x18, 0x57, 0x44, 0xbd, 0xa8, 0x0a, 0x43, 0xcc, 0x6e, 0xad, 0xc0, 0xb5,
+	0x81, 0x1a, 0x54, 0x04, 0xeb, 0x5a, 0x44, 0xb6, 0x30, 0x5a, 0x1c, 0xc0, 0x72, 0x14, 0xc1, 0xa4,
+	0x7e, 0x08, 0x10, 0xf2, 0xa1, 0xe7, 0xf1, 0xc3, 0xc5, 0x96, 0x28, 0xad, 0x05, 0x98, 0xc7, 0xc8,
+	0x3d, 0xe1, 0xfb, 0x96, 0x01, 0xba, 0x00, 0x31, 0x89, 0xda, 0x60, 0xec, 0xa2, 0xb3, 0x47, 0xa8,
+	0x83, 0x3c, 0x69, 0x03, 0xf4, 0x86, 0xd2, 0x3b, 0xba, 0x1c, 0xf8, 0xbe, 0x58, 0xe7, 0xdc, 0x85,
+	0x45, 0xa5, 0xb9, 0x4b, 0x0f, 0x66, 0xad, 0x32, 0x18, 0x87, 0x3e, 0xf2, 0x6a, 0x54, 0x82, 0x2b,
+	0x6c, 0x18, 0xf1, 0xed, 0x05, 0xf2, 0x24, 0xb1, 0x78, 0xd1, 0x7a, 0x0f, 0x16, 0x15, 0x56, 0x22,
+	0x1e, 0xf3, 0x0a, 0x9a, 0x5a, 0xe1, 0x37, 0x60, 0x9e, 0x54, 0x90, 0xee, 0x34, 0x5e, 0xa5, 0x61,
+	0x3c, 0xbb, 0xe0, 0x8d, 0x00, 0xdf, 0x20, 0xe2, 0x6f, 0xeb, 0xdb, 0xa0, 0x0b, 0xde, 0x42, 0x12,
+	0x7e, 0x75, 0xa3, 0xa9, 0x57, 0x37, 0x9c, 0x43, 0x42, 0xe2, 0x70, 0xae, 0xc1, 0x1c, 0x66, 0x91,
+	0x6f, 0x36, 0xbf, 0x6c, 0xe9, 0x30, 0xa3, 0xbe, 0xd7, 0x92, 0x43, 0xf1, 0xa1, 0xbd, 0x67, 0x63,
+	0xd8, 0x90, 0x8d, 0xe0, 0x31, 0xcc, 0x87, 0xb2, 0x30, 0x6d, 0x5e, 0x83, 0xf1, 0xbe, 0x1f, 0x2e,
+	0x0e, 0x66, 0x43, 0x27, 0xc2, 0x74, 0x36, 0x41, 0xa9, 0x7b, 0xc4, 0xc4, 0x55, 0xf6, 0x88, 0x1e,
+	0xe8, 0xbb, 0xe8, 0xac, 0xf4, 0xbc, 0xd7, 0xf5, 0xae, 0x72, 0x7a, 0x30, 0x6a, 0x69, 0x70, 0x4b,
+	0x09, 0xeb, 0x62, 0x6b, 0x47, 0x99, 0x0b, 0x3f, 0xb7, 0xde, 0x81, 0x05, 0xa9, 0x4d, 0xa6, 0xdd,
+	0x32, 0x4c, 0x22, 0x02, 0x61, 0x6b, 0x06, 0x56, 0xb2, 0x3e, 0x22, 0x02, 0x96, 0xdb, 0xb2, 0x80,
+	0x62, 0x55, 0x97, 0x26, 0xab, 0xba, 0x11, 0x52, 0x59, 0x77, 0x60, 0x41, 0xaa, 0x7f, 0xf9, 0xf8,
+	0xb8, 0x4d, 0xda, 0xb3, 0xc9, 0xd1, 0xfe, 0x15, 0x4e, 0x79, 0x16, 0x61, 0x41, 0x22, 0x67, 0x41,
+	0xe0, 0x1f, 0x34, 0x48, 0xee, 0xa2, 0xb3, 0xa1, 0x13, 0xc9, 0x1b, 0x8a, 0xa5, 0x86, 0x85, 0x03,
+	0xde, 0xdf, 0x93, 0xc3, 0xfb, 0x3b, 0x03, 0x13, 0xbe, 0xf3, 0x2c, 0x5c, 0xba, 0xd2, 0x82, 0xf1,
+	0x16, 0xcc, 0xf9, 0xec, 0xe4, 0x69, 0x0f, 0x75, 0x4e, 0x82, 0xd3, 0xec, 0x3a, 0x59, 0x02, 0x46,
+	0xa0, 0xc6, 0xbb, 0xb0, 0xc0, 0x21, 0x87, 0xbd, 0x26, 0x9b, 0x71, 0xde, 0x26, 0x33, 0xce, 0x20,
+	0xc2, 0xfa, 0x36, 0x00, 0xd1, 0x34, 0x9c, 0xf7, 0xdd, 0x26, 0xea, 0x04, 0x6e, 0x70, 0xc6, 0xe7,
+	0x7d, 0x5e, 0xc6, 0x5d, 0xd9, 0x27, 0xd5, 0x98, 0x4b, 0xb3, 0x92, 0x75, 0x1b, 0x52, 0x84, 0xc3,
+	0xd5, 0x0e, 0xc3, 0xac, 0xbf, 0xd4, 0x08, 0x3d, 0x8f, 0xe9, 0x58, 0xd9, 0xef, 0xf7, 0x91, 0xc7,
+	0xdb, 0xa3, 0x05, 0xe3, 0x2d, 0x98, 0xc0, 0xd6, 0xa2, 0xa7, 0x65, 0x71, 0xc6, 0xa4, 0x68, 0x3c,
+	0xad, 0xfa, 0x5d, 0x2f, 0xd8, 0x76, 0x51, 0x8b, 0x9a, 0x6b, 0xc6, 0x16, 0x00, 0xe3, 0x1b, 0x30,
+	0x8b, 0x0b, 0x45, 0xd7, 0x43, 0x8d, 0x00, 0xcf, 0x67, 0x29, 0xd2, 0x35, 0x62, 0x62, 0xa8, 0xc9,
+	0x58, 0x5b, 0x25, 0xb6, 0x7e, 0x4f, 0x83, 0x34, 0x95, 0x94, 0xa9, 0x96, 0x83, 0x71, 0x7c, 0x01,
+	0xcd, 0xa6, 0x17, 0x55, 0x37, 0x82, 0xf9, 0x4a, 0xc5, 0xf9, 0x41, 0x02, 0x26, 0x6b, 0xa8, 0xe1,
+	0xa1, 0xa1, 0x73, 0x6a, 0x5c, 0xfc, 0x1b, 0x3a, 0x7e, 0x29, 0x2b, 0xc9, 0x31, 0x4d, 0x98, 0xc6,
+	0xde, 0x47, 0x18, 0x50, 0xd1, 0xc3, 0xb2, 0x32, 0x14, 0x53, 0x91, 0x00, 0xc1, 0x82, 0x60, 0x26,
+	0x3e, 0x08, 0x76, 0xba, 0x01, 0xf2, 0xb3, 0xab, 0xb4, 0x6f, 0x49, 0x41, 0x5d, 0x0a, 0x35, 0x23,
+	0x4b, 0x21, 0x8c, 0xed, 0x87, 0x6e, 0x8b, 0x28, 0x36, 0x04, 0x58, 0xb7, 0x60, 0x96, 0x0a, 0x7e,
+	0xd9, 0xf2, 0xe2, 0x43, 0x98, 0xe3, 0x84, 0xac, 0xf7, 0x6e, 0xe1, 0x8d, 0x0a, 0x86, 0x30, 0xdf,
+	0x9c, 0x8f, 0x98, 0xc2, 0x66, 0x68, 0xeb, 0x1b, 0xb0, 0x40, 0x21, 0x35, 0x47, 0x04, 0x8b, 0x2b,
+	0xd7, 0xfe, 0x26, 0x18, 0x72, 0xed, 0x97, 0x6d, 0xfc, 0x36, 0x2c, 0x32, 0x88, 0x12, 0xab, 0x86,
+	0xa9, 0xb9, 0x0c, 0x19, 0x95, 0x9c, 0xc5, 0xaa, 0x7f, 0xd2, 0xb8, 0xfe, 0x97, 0x0c, 0xb4, 0xb7,
+	0xd5, 0x81, 0x16, 0xeb, 0x1f, 0x5f, 0xfd, 0x58, 0xc3, 0xc2, 0xb9, 0x9d, 0x26, 0x7a, 0x4e, 0xdc,
+	0x68, 0xc2, 0xa6, 0x05, 0x32, 0x89, 0xba, 0x6d, 0x37, 0xc8, 0x2e, 0x51, 0x28, 0x29, 0x58, 0x7f,
+	0xa3, 0xc1, 0x7c, 0xa8, 0x1b, 0xb3, 0xef, 0xdb, 0x78, 0xde, 0x26, 0x20, 0x36, 0x3a, 0x07, 0x0c,
+	0xcc, 0xf1, 0x5f, 0xb5, 0x1a, 0x74, 0x77, 0x9e, 0x91, 0x77, 0xe7, 0x1e, 0x77, 0xda, 0xc7, 0x62,
+	0x07, 0x2b, 0xef, 0x6d, 0x93, 0x62, 0x6f, 0x2b, 0xfc, 0x24, 0x31, 0xd2, 0x4f, 0xe8, 0x21, 0x55,
+	0xaf, 0xe5, 0x34, 0xc8, 0x38, 0x49, 0x12, 0x2e, 0x12, 0xc4, 0xba, 0xc3, 0x1d, 0x63, 0xc7, 0xf5,
+	0x83, 0xae, 0x77, 0x76, 0x99, 0x23, 0xed, 0xc2, 0x52, 0x84, 0x9e, 0x59, 0x76, 0x13, 0xa6, 0x99,
+	0x70, 0x83, 0xeb, 0x6a, 0x45, 0x2b, 0x3b, 0xa4, 0xb3, 0x76, 0x84, 0x57, 0x62, 0x66, 0x97, 0x79,
+	0xb1, 0x6c, 0x8f, 0x84, 0x62, 0x0f, 0xeb, 0xdb, 0xb0, 0x14, 0xe1, 0xf4, 0xb2, 0x03, 0x6a, 0x1d,
+	0xe6, 0xaa, 0xf5, 0x83, 0x42, 0xb7, 0x79, 0x99, 0x14, 0xd6, 0xef, 0x6a, 0x30, 0x1f, 0x92, 0x8a,
+	0x83, 0xab, 0x30, 0x75, 0x68, 0x86, 0xa5, 0x0e, 0x19, 0xd2, 0x86, 0x91, 0x5f, 0x64, 0xdc, 0x80,
+	0x19, 0x0f, 0xb5, 0x1d, 0xb7, 0xe3, 0x76, 0x4e, 0x58, 0x6f, 0x08, 0x00, 0x9e, 0x3a, 0x7b, 0xc8,
+	0x73, 0xbb, 0xf4, 0x96, 0x23, 0x69, 0xb3, 0x12, 0xd6, 0xbb, 0xd1, 0xed, 0x77, 0x02, 0x76, 0x0e,
+	0x3f, 0x6e, 0xf3, 0xa2, 0xf5, 0x9c, 0x5b, 0xd0, 0x57, 0xd7, 0x48, 0x71, 0x27, 0x30, 0xf7, 0xc3,
+	0xe3, 0x77, 0xba, 0x85, 0xbd, 0x11, 0x31, 0x05, 0x63, 0x11, 0x39, 0x87, 0xc7, 0xdb, 0x12, 0xef,
+	0xcc, 0xee, 0xd3, 0x1d, 0xc8, 0xb4, 0xcd, 0x4a, 0xd6, 0x6f, 0x6b, 0xb0, 0xa4, 0xd4, 0x0b, 0x6d,
+	0xf1, 0x5e, 0x74, 0x8c, 0x2d, 0x45, 0x1a, 0x62, 0xf4, 0xe1, 0x48, 0xc3, 0xab, 0x09, 0x02, 0x42,
+	0x3c, 0x0d, 0x2a, 0x2c, 0x63, 0xff, 0x6d, 0xf6, 0x7b, 0x2d, 0xb7, 0xe1, 0x88, 0x23, 0x2e, 0x09,
+	0x62, 0x1d, 0x42, 0x5a, 0x66, 0x7a, 0xe5, 0xfe, 0xc6, 0x3d, 0x11, 0xb2, 0x61, 0x5d, 0x24, 0x00,
+	0x78, 0x1d, 0xf8, 0xd8, 0xe9, 0xb7, 0x82, 0xda, 0x59, 0xa7, 0x71, 0x85, 0x75, 0x60, 0x13, 0x16,
+	0x24, 0xf2, 0xcb, 0xf3, 0x63, 0xee, 0xc3, 0x4c, 0xa3, 0xdb, 0x39, 0x6e, 0xb9, 0x8d, 0xf0, 0xa2,
+	0x4f, 0x8c, 0x16, 0xc2, 0xa9, 0xc0, 0xd0, 0xb6, 0x20, 0xb4, 0x3e, 0x83, 0x59, 0x05, 0x37, 0x74,
+	0x9c, 0x5c, 0x39, 0x3a, 0xbc, 0xc9, 0x77, 0x1f, 0xc9, 0x78, 0x3a, 0x8a, 0xc5, 0xbb, 0xdf, 0x5a,
+	0x6d, 0x27, 0x7f, 0x22, 0xee, 0x18, 0xad, 0xb7, 0x40, 0x17, 0x20, 0x31, 0x08, 0x7a, 0x4e, 0x70,
+	0xca, 0x07, 0x01, 0xfe, 0xb6, 0xde, 0x84, 0x54, 0x39, 0x40, 0xed, 0xcb, 0xc6, 0xd4, 0x3d, 0x48,
+	0x53, 0x32, 0xb1, 0xdb, 0x71, 0x03, 0xd4, 0x1e, 0xd8, 0xed, 0x10, 0x22, 0x82, 0xb2, 0xde, 0xa0,
+	0x55, 0x46, 0xcf, 0x5b, 0xd6, 0x7d, 0x98, 0x65, 0x54, 0x8c, 0xf3, 0xeb, 0x30, 0x81, 0xab, 0x73,
+	0xdf, 0x8c, 0xb0, 0xa6, 0x38, 0x6b, 0x13, 0xc6, 0x71, 0x71, 0xd4, 0x02, 0x2a, 0x3a, 0xb4, 0xad,
+	0x4f, 0x20, 0x65, 0x3b, 0x9d, 0xa6, 0xb4, 0x44, 0xee, 0xf4, 0xdb, 0x5b, 0xd2, 0x5d, 0x44, 0x58,
+	0x36, 0x6e, 0xc3, 0x34, 0xea, 0x34, 0xba, 0x4d, 0x1c, 0x04, 0xa2, 0xc7, 0x49, 0x25, 0x86, 0xb0,
+	0x43, 0x12, 0xcb, 0x82, 0x34, 0xe5, 0x1c, 0x73, 0x4a, 0x3e, 0xc3, 0x4e, 0xfa, 0x6f, 0xc3, 0x22,
+	0xa6, 0x39, 0x60, 0xab, 0x2d, 0x61, 0xef, 0xc9, 0x16, 0xdd, 0x04, 0x50, 0x19, 0x58, 0xc9, 0xda,
+	0x84, 0x8c, 0x4a, 0xce, 0x58, 0x8f, 0x38, 0xd0, 0xb3, 0xde, 0x86, 0xd4, 0x41, 0xbf, 0xd5, 0xba,
+	0xc2, 0x1e, 0xc0, 0x7a, 0x17, 0xd2, 0x94, 0x34, 0x3c, 0x83, 0x1b, 0x7f, 0xea, 0x36, 0x59, 0x02,
+	0xe4, 0xd6, 0xf4, 0x8b, 0xcf, 0xd7, 0xc6, 0x77, 0xcb, 0x45, 0xdf, 0x26, 0x50, 0x6b, 0x17, 0x33,
+	0xf6, 0x4f, 0xaf, 0xc0, 0xd8, 0xc8, 0x41, 0xca, 0x43, 0xed, 0x6e, 0x80, 0x0a, 0xa7, 0xa8, 0xf1,
+	0x94, 0xdd, 0xc8, 0xc8, 0x20, 0xeb, 0x11, 0xa4, 0x29, 0xb3, 0xcb, 0x47, 0xe1, 0x0d, 0x18, 0xef,
+	0x7b, 0x2d, 0x3a, 0x00, 0x99, 0x54, 0x87, 0xf6, 0x9e, 0x6f, 0x13, 0xa8, 0x95, 0x03, 0x28, 0x74,
+	0x5b, 0x2d, 0x36, 0x63, 0xc7, 0xf9, 0xf6, 0x3a, 0x18, 0x82, 0xc2, 0x97, 0x42, 0xef, 0x00, 0xe5,
+	0x1e, 0x2c, 0x2a, 0x94, 0x4c, 0xb6, 0x07, 0x90, 0x6a, 0x08, 0x30, 0xf3, 0x48, 0xb1, 0xb4, 0x12,
+	0x55, 0x6c, 0x99, 0xce, 0xea, 0xc1, 0x74, 0xb1, 0xdb, 0xe8, 0x93, 0x34, 0x8a, 0x98, 0xd6, 0xf0,
+	0x48, 0x78, 0xe6, 0xb4, 0xfa, 0xdc, 0x3d, 0x69, 0x41, 0x5d, 0x4e, 0xc3, 0xc8, 0xe5, 0x74, 0x2a,
+	0xba, 0x9c, 0xfe, 0x08, 0x74, 0xde, 0xe2, 0x28, 0x3d, 0xc9, 0x04, 0xe6, 0xa1, 0x63, 0xf7, 0x39,
+	0x3f, 0xc3, 0xa2, 0x25, 0xab, 0x08, 0x0b, 0x52, 0xfd, 0x70, 0x9e, 0x98, 0x69, 0x72, 0x20, 0xd3,
+	0x5d, 0x0c, 0x03, 0x4e, 0x6e, 0x0b, 0x1a, 0xeb, 0x1d, 0x58, 0xe2, 0xe0, 0x22, 0x6a, 0x21, 0x25,
+	0xc3, 0x60, 0xc0, 0xe4, 0x59, 0x58, 0x8e, 0x12, 0xb3, 0x35, 0x6f, 0x19, 0x52, 0xc5, 0xad, 0x7d,
+	0xf7, 0xc4, 0x73, 0x82, 0x98, 0x45, 0xd6, 0x84, 0x58, 0x64, 0xe5, 0x20, 0xd5, 0x44, 0x7e, 0xc3,
+	0x73, 0x7b, 0x01, 0x5f, 0x72, 0xcc, 0xd8, 0x32, 0xc8, 0xda, 0x00, 0x9d, 0xb3, 0x92, 0x96, 0x0d,
+	0x7c, 0xc2, 0xd4, 0x94, 0x09, 0xf3, 0xb7, 0x60, 0x41, 0xa2, 0x8d, 0xbf, 0xbd, 0x90, 0x1a, 0xc7,
+	0x23, 0xd7, 0x09, 0x78, 0xca, 0xe3, 0x84, 0xcd, 0x4a, 0xc6, 0x7d, 0x80, 0x36, 0x97, 0x9d, 0xde,
+	0x3a, 0xa6, 0xa4, 0xdb, 0x29, 0x49, 0x31, 0x5b, 0xa2, 0xb3, 0xfe, 0x20, 0x01, 0xe3, 0xf8, 0xe4,
+	0xe0, 0xa5, 0xb6, 0x84, 0x2f, 0x95, 0x7c, 0x23, 0x9d, 0x88, 0x4d, 0xa8, 0x27, 0x62, 0x6c, 0xe3,
+	0x37, 0x19, 0xb3, 0xf1, 0x7b, 0x07, 0x26, 0x7d, 0x72, 0x9d, 0x43, 0x1c, 0x52, 0xde, 0x56, 0x90,
+	0xd3, 0x3c, 0x82, 0xb2, 0x19, 0x09, 0x5e, 0x0a, 0x3c, 0xc3, 0x49, 0x52, 0xae, 0xe4, 0xa3, 0x12,
+	0x44, 0x4d, 0xe9, 0x49, 0x47, 0x53, 0x7a, 0xf0, 0x9d, 0x8f, 0xe7, 0xd1, 0xed, 0xa7, 0x8d, 0x3f,
+	0xad, 0x8f, 0x20, 0x85, 0x5b, 0xb9, 0xc2, 0xb9, 0x57, 0x78, 0x48, 0x37, 0x2e, 0x1f, 0xd2, 0xdd,
+	0x83, 0x34, 0xad, 0x7f, 0xe5, 0x13, 0x3a, 0xeb, 0x10, 0x16, 0x88, 0x62, 0xc8, 0xf1, 0x1a, 0xa7,
+	0xa3, 0x37, 0x5c, 0xe1, 0x9e, 0x26, 0x29, 0xed, 0x69, 0x86, 0x48, 0xf2, 0x21, 0x18, 0x32, 0x5b,
+	0x31, 0xd3, 0xe1, 0x46, 0x07, 0x67, 0x3a, 0x22, 0x10, 0xc5, 0x59, 0x6f, 0xc2, 0x2c, 0xaf, 0x36,
+	0x6a, 0x1a, 0xdd, 0x84, 0x39, 0x4e, 0x76, 0xd5, 0x43, 0x0e, 0x6b, 0x0e, 0xd2, 0x4f, 0x9c, 0x20,
+	0xe4, 0x6c, 0x55, 0x00, 0x48, 0xb9, 0xf4, 0x0c, 0x07, 0xae, 0x77, 0xc3, 0xae, 0xd7, 0x22, 0x37,
+	0xac, 0x84, 0x28, 0xd2, 0xf7, 0x7c, 0x84, 0x27, 0xa4, 0x11, 0x7e, 0x07, 0xc6, 0x0f, 0x3c, 0x74,
+	0x6c, 0xe8, 0xe2, 0x24, 0x69, 0x86, 0x26, 0x52, 0xc5, 0x06, 0x40, 0x2b, 0x03, 0x06, 0xa6, 0x47,
+	0x1e, 0xea, 0x34, 0x50, 0x78, 0x57, 0xf0, 0xff, 0x60, 0x51, 0x81, 0x0a, 0xe3, 0xe1, 0xd8, 0x35,
+	0x68, 0x3c, 0x4c, 0x6c, 0x53, 0x9c, 0xf5, 0x21, 0x64, 0x44, 0xdd, 0x9a, 0x38, 0x6c, 0x78, 0x8d,
+	0x24, 0xa2, 0x1d, 0x0f, 0x78, 0x02, 0xa9, 0x4b, 0x50, 0xf8, 0xee, 0x22, 0x52, 0x95, 0x45, 0xa7,
+	0x1a, 0xa4, 0xea, 0xf8, 0x56, 0x95, 0xbd, 0x1e, 0xe0, 0xe3, 0x52, 0x93, 0xc6, 0x65, 0x56, 0x4d,
+	0x38, 0x95, 0x5e, 0x14, 0xd0, 0x63, 0x54, 0xd7, 0x43, 0x6c, 0x6f, 0xc1, 0x4a, 0x78, 0xfb, 0x2f,
+	0x98, 0xba, 0x42, 0xf9, 0x32, 0x2c, 0x45, 0xe0, 0x61, 0xba, 0xd1, 0x74, 0x8f, 0xc1, 0x98, 0x05,
+	0x44, 0xff, 0x48, 0xe2, 0xd9, 0x21, 0x95, 0x55, 0x92, 0x59, 0x9d, 0x49, 0xc6, 0x78, 0x37, 0xcc,
+	0x1c, 0xa4, 0xe6, 0x88, 0x67, 0xc4, 0x68, 0xac, 0x6d, 0x58, 0x8e, 0xb2, 0x61, 0x22, 0xbd, 0x1c,
+	0x9f, 0x3b, 0x90, 0x95, 0xc1, 0xca, 0x21, 0x49, 0x8c, 0x4d, 0xad, 0xeb, 0xb0, 0x12, 0x43, 0xcf,
+	0xfa, 0xe4, 0x6f, 0x35, 0x98, 0x7d, 0xd2, 0xf5, 0xda, 0xa7, 0x5d, 0x9e, 0x59, 0xb0, 0xac, 0x5c,
+	0xd6, 0x8b, 0xdc, 0x0e, 0xb2, 0xbf, 0x63, 0x19, 0x20, 0x7c, 0x57, 0x11, 0x02, 0x70, 0x2d, 0xb7,
+	0xf3, 0xcc, 0x0d, 0xc2, 0xab, 0x5a, 0x5a, 0x62, 0x41, 0x19, 0xe2, 0x82, 0x32, 0x59, 0xe8, 0xa5,
+	0xa4, 0x5d, 0xdc, 0x3a, 0x5b, 0x7a, 0xa6, 0x23, 0xa3, 0xa6, 0xd0, 0xed, 0x04, 0xa8, 0x23, 0x1f,
+	0xb4, 0xb7, 0x61, 0x8e, 0x0b, 0xcd, 0xee, 0xe5, 0x37, 0xd4, 0x1b, 0x91, 0x94, 0x74, 0x5e, 0xba,
+	0x4f, 0xe1, 0xe2, 0x8e, 0xe4, 0xbd, 0x70, 0x7c, 0xd2, 0x15, 0xea, 0x35, 0x31, 0x3e, 0x19, 0x53,
+	0x75, 0x88, 0x5a, 0x3f, 0x4a, 0xc0, 0x14, 0xe3, 0x32, 0xe2, 0xe8, 0xfb, 0x0a, 0x49, 0x00, 0xc6,
+	0x86, 0x6c, 0xc4, 0x64, 0x0c, 0xa1, 0x40, 0x87, 0xe6, 0x88, 0x26, 0xd3, 0x30, 0x49, 0x84, 0x39,
+	0xb0, 0xf2, 0x0d, 0x6a, 0xa3, 0x2c, 0x44, 0x94, 0x67, 0xb6, 0xb3, 0x39, 0x81, 0xba, 0x56, 0x5a,
+	0x8a, 0xae, 0x95, 0x72, 0x90, 0xc2, 0xf3, 0x4a, 0xd1, 0xf5, 0x7b, 0x2d, 0xe7, 0x2c, 0xbb, 0x46,
+	0xd7, 0x05, 0x12, 0x08, 0x53, 0xe0, 0xa5, 0x13, 0xa7, 0xc8, 0x51, 0x0a, 0x09, 0x64, 0x3d, 0x82,
+	0x29, 0xd6, 0x6a, 0xec, 0x5e, 0x7d, 0x5d, 0xb9, 0x6c, 0x1e, 0xd5, 0xcb, 0x0e, 0x2c, 0x31, 0x5d,
+	0x0f, 0x3c, 0xd4, 0x73, 0xe4, 0x43, 0x94, 0x57, 0x71, 0x51, 0xbc, 0xb3, 0x41, 0xcf, 0x03, 0x76,
+	0x7a, 0x4b, 0xbe, 0xad, 0x22, 0x2c, 0x47, 0x9b, 0x60, 0x63, 0xf2, 0x25, 0x1c, 0xca, 0xfa, 0x2e,
+	0x64, 0x18, 0x4c, 0x4d, 0x0f, 0xfd, 0xf2, 0xe4, 0x2c, 0xc0, 0x52, 0xa4, 0x85, 0x57, 0x10, 0xf3,
+	0x11, 0xcc, 0x33, 0x98, 0xff, 0x85, 0x24, 0xc4, 0x57, 0x92, 0x82, 0x51, 0x18, 0xc3, 0xa6, 0x59,
+	0x3b, 0x3c, 0xac, 0x0e, 0x4a, 0x12, 0x52, 0x58, 0xdf, 0x85, 0xc5, 0x7c, 0xb3, 0xed, 0x76, 0xf0,
+	0xad, 0x26, 0x5e, 0x32, 0x49, 0xe2, 0x88, 0x5c, 0x7a, 0xe5, 0xe9, 0x4e, 0x1b, 0x05, 0xa7, 0x5d,
+	0x7e, 0x0b, 0xc6, 0x4a, 0x7c, 0xfd, 0x95, 0x1c, 0x5c, 0x7f, 0x59, 0x0d, 0xc8, 0xa8, 0x2d, 0x88,
+	0x1d, 0x26, 0x4e, 0x91, 0xe0, 0x11, 0x12, 0x7f, 0x73, 0x36, 0x89, 0x41, 0x36, 0x78, 0x23, 0xd5,
+	0x10, 0x4d, 0x90, 0x8d, 0x54, 0x01, 0x23, 0x09, 0xd4, 0xda, 0x86, 0x05, 0xd2, 0x08, 0xd9, 0x9f,
+	0x5d, 0xa6, 0xc4, 0x88, 0x24, 0xcd, 0x0c, 0x18, 0x32, 0x1f, 0x2a, 0xea, 0xc6, 0xcf, 0x34, 0x9a,
+	0xf8, 0x49, 0x8f, 0xad, 0x8c, 0x3b, 0xb0, 0x58, 0x2c, 0x6d, 0xe7, 0x0f, 0xf7, 0xea, 0x47, 0xb5,
+	0xf2, 0xa3, 0xca, 0xd1, 0x76, 0xd5, 0xde, 0xcf, 0xd7, 0xf5, 0x31, 0x73, 0xe9, 0xfc, 0x22, 0xb7,
+	0x50, 0x44, 0xc7, 0xe4, 0x98, 0x46, 0xd0, 0xbf, 0x45, 0x8e, 0x36, 0x14, 0x5a, 0xcd, 0x5c, 0x38,
+	0xbf, 0xc8, 0xcd, 0xd6, 0x6a, 0x3b, 0x12, 0xdd, 0x06, 0x2c, 0xec, 0x1f, 0xee, 0xd5, 0xcb, 0x0a,
+	0x65, 0xc2, 0x5c, 0x3c, 0xbf, 0xc8, 0xcd, 0xef, 0xf7, 0x5b, 0x81, 0xab, 0xd2, 0x92, 0x8c, 0x20,
+	0x85, 0x36, 0x49, 0x69, 0x09, 0x42, 0xd0, 0x9a, 0xc6, 0x0f, 0xff, 0x7c, 0x75, 0xec, 0xc7, 0x7f,
+	0xb1, 0x2a, 0xe9, 0xb0, 0xf1, 0x8f, 0x1a, 0xa4, 0xa4, 0x34, 0x33, 0xe3, 0x2e, 0x64, 0xb8, 0x4e,
+	0xa5, 0x4a, 0xc1, 0xfe, 0xf4, 0xa0, 0x7e, 0xb4, 0x5f, 0x2d, 0x96, 0xf4, 0x31, 0x73, 0xf9, 0xfc,
+	0x22, 0x67, 0x30, 0xa5, 0xe4, 0x1a, 0x37, 0x01, 0x38, 0xe5, 0xe3, 0x4d, 0x5d, 0x33, 0x67, 0xcf,
+	0x2f, 0x72, 0x33, 0x8c, 0xe0, 0xf1, 0xa6, 0xf1, 0x1a, 0xa4, 0xb1, 0x68, 0x8c, 0xe0, 0x9e, 0x9e,
+	0x30, 0xe7, 0xcf, 0x2f, 0x72, 0xe4, 0xb5, 0x21, 0x25, 0xb9, 0x67, 0xac, 0x41, 0xea, 0x20, 0x5f,
+	0xab, 0x3d, 0xa9, 0xda, 0x45, 0x4c, 0x91, 0x34, 0xe7, 0xce, 0x2f, 0x72, 0xc0, 0xcf, 0x0b, 0x1e,
+	0xdf, 0x33, 0xae, 0x43, 0x32, 0xff, 0xa8, 0xa4, 0x8f, 0x9b, 0xc6, 0xf9, 0x45, 0x6e, 0x2e, 0x7f,
+	0x82, 0xa4, 0xf6, 0xcd, 0x45, 0xa6, 0x95, 0xac, 0xc6, 0xc6, 0x1f, 0x69, 0x30, 0xcd, 0x13, 0x52,
+	0xb0, 0x08, 0x87, 0x95, 0xdd, 0x4a, 0xf5, 0x49, 0xe5, 0x28, 0x7f, 0x58, 0xdf, 0xd1, 0xc7, 0xa8,
+	0x08, 0x87, 0x9d, 0xa7, 0x9d, 0xee, 0x67, 0x1d, 0x4c, 0x66, 0xbc, 0x0e, 0xb3, 0xa1, 0x08, 0x84,
+	0x06, 0x4c, 0xfd, 0xfc, 0x22, 0x97, 0xe6, 0x42, 0x10, 0xa2, 0xf7, 0x61, 0x99, 0xda, 0x7a, 0x67,
+	0x3f, 0x5f, 0x38, 0xaa, 0x95, 0x0a, 0x76, 0xa9, 0x4e, 0xa9, 0x33, 0xe6, 0xb5, 0xf3, 0x8b, 0xdc,
+	0x22, 0xc1, 0x62, 0x24, 0x3d, 0xd2, 0xc2, 0x95, 0x4c, 0x9d, 0x89, 0x17, 0x8a, 0xb3, 0xf1, 0x03,
+	0x0d, 0x40, 0xdc, 0x54, 0xcb, 0x5e, 0x54, 0xfa, 0xe4, 0xa0, 0x6a, 0xd7, 0x8f, 0xea, 0x9f, 0x1e,
+	0x94, 0x22, 0x5e, 0x24, 0xd1, 0xdf, 0x85, 0x4c, 0x2d, 0xbf, 0x57, 0x3f, 0xc8, 0x17, 0x76, 0x95,
+	0x0a, 0x1a, 0xed, 0xa1, 0x9a, 0xd3, 0x0a, 0x7a, 0x4e, 0xe3, 0xa9, 0xa8, 0x21, 0xfa, 0x5d, 0xc0,
+	0x36, 0x7e, 0x98, 0x80, 0x29, 0x76, 0x6f, 0x69, 0xac, 0x83, 0xce, 0xed, 0xb3, 0x5b, 0xfa, 0x94,
+	0x37, 0x4f, 0x6c, 0xcd, 0x6c, 0xc4, 0x29, 0x4d, 0x98, 0x2e, 0x15, 0x3f, 0xd9, 0x7c, 0xf0, 0xe0,
+	0xde, 0x87, 0x3a, 0x98, 0xe9, 0xf3, 0x8b, 0xdc, 0x74, 0xa9, 0x49, 0xcb, 0xc6, 0x2d, 0x98, 0xe7,
+	0xb8, 0xa3, 0x83, 0xc3, 0xad, 0xbd, 0x72, 0x41, 0x4f, 0x51, 0x26, 0x9c, 0xe4, 0xa0, 0xff, 0xbd,
+	0x96, 0xdb, 0xc0, 0xc3, 0x91, 0xb1, 0xc8, 0x98, 0x70, 0x7e, 0x91, 0x63, 0x25, 0xdc, 0x07, 0x6a,
+	0xf5, 0x25, 0xda, 0x07, 0x4a, 0xe5, 0x35, 0x48, 0xd1, 0x3e, 0x28, 0xd5, 0x36, 0x1f, 0x3c, 0xd4,
+	0x57, 0xa9, 0xaf, 0x10, 0x10, 0x81, 0x48, 0x04, 0xc5, 0x62, 0x2d, 0xaf, 0xaf, 0xc9, 0x04, 0xcd,
+	0x62, 0x2d, 0x6f, 0xce, 0x33, 0x6b, 0x70, 0xf5, 0x37, 0xea, 0x30, 0x5b, 0x8b, 0xdc, 0x98, 0x24,
+	0xf3, 0xb5, 0x82, 0x3e, 0x66, 0xa6, 0xce, 0x2f, 0x72, 0x53, 0x18, 0x97, 0xf7, 0xb1, 0xd8, 0xe3,
+	0xc5, 0x52, 0xad, 0xa0, 0x6b, 0x54, 0x6f, 0x52, 0x05, 0xf9, 0x0d, 0x73, 0x89, 0xf1, 0x53, 0x99,
+	0x6c, 0xfc, 0x0f, 0x8e, 0x15, 0xe1, 0x7d, 0x95, 0xb1, 0x01, 0x8b, 0xdc, 0xc6, 0xcc, 0x71, 0x98,
+	0x99, 0xc9, 0xf8, 0x67, 0x66, 0xa6, 0xf4, 0xd8, 0x92, 0xa1, 0x33, 0x52, 0x62, 0x1d, 0xa8, 0x25,
+	0xb9, 0x3b, 0xd6, 0xf8, 0x91, 0xea, 0x5c, 0xa1, 0x5a, 0xa9, 0xe7, 0x0b, 0x75, 0x4e, 0x97, 0xa2,
+	0xfc, 0xf0, 0xd4, 0xed, 0x34, 0x02, 0x46, 0xb6, 0x06, 0xa9, 0x42, 0x5e, 0xf0, 0x4a, 0x53, 0x93,
+	0x14, 0x9c, 0x90, 0xcf, 0x1a, 0xa4, 0x2a, 0xd5, 0x7a, 0x89, 0x13, 0xcc, 0x52, 0x82, 0x4a, 0x37,
+	0x40, 0x8c, 0xe0, 0x26, 0x40, 0xb5, 0x7e, 0xc0, 0xf1, 0x73, 0x74, 0x8c, 0x57, 0xeb, 0x07, 0x14,
+	0x2d, 0x05, 0x96, 0x50, 0xe1, 0x8d, 0x3f, 0x49, 0xc0, 0xa2, 0x72, 0x66, 0xcf, 0x02, 0xd6, 0x26,
+	0x2c, 0x71, 0x43, 0x94, 0xf7, 0x89, 0xf7, 0x86, 0x61, 0x93, 0x8c, 0x21, 0x66, 0x0a, 0xa5, 0xce,
+	0x2d, 0x98, 0xaf, 0x56, 0x4a, 0xa1, 0x4d, 0x0a, 0xb5, 0xc7, 0xdc, 0x20, 0xd5, 0x0e, 0xe2, 0x36,
+	0x29, 0xd4, 0x1e, 0x63, 0x83, 0x6c, 0x95, 0xeb, 0x4f, 0xf2, 0x76, 0xb1, 0x54, 0x39, 0xfa, 0xb8,
+	0x56, 0xad, 0xe8, 0x19, 0x6a, 0x90, 0x2d, 0x37, 0xf8, 0xcc, 0xf1, 0x9a, 0xa8, 0x83, 0x81, 0xd8,
+	0xd3, 0x04, 0x19, 0xe6, 0xc6, 0x3c, 0x2d, 0xa4, 0xc2, 0xbc, 0xd6, 0x20, 0xb5, 0x5b, 0x22, 0x8d,
+	0x1e, 0x7d, 0xb2, 0xbf, 0xc7, 0x3d, 0x6d, 0x17, 0x91, 0x06, 0x3f, 0xd9, 0xdf, 0xc3, 0x46, 0x29,
+	0xec, 0xd8, 0xd5, 0xfd, 0x12, 0x61, 0xb1, 0x4e, 0x8d, 0x52, 0x38, 0xf5, 0xba, 0x6d, 0x54, 0xa8,
+	0x3d, 0x36, 0xaf, 0x33, 0xa3, 0xc4, 0x59, 0x61, 0xe3, 0xe7, 0x1a, 0x4c, 0xf3, 0xd3, 0x57, 0xbc,
+	0xa9, 0xdc, 0x29, 0x7d, 0xa2, 0x8f, 0x99, 0x53, 0xe7, 0x17, 0xb9, 0xe4, 0x0e, 0x7a, 0x8e, 0x87,
+	0xc8, 0x56, 0xbe, 0x56, 0x7a, 0x88, 0xe3, 0x29, 0x19, 0x22, 0x5b, 0x8e, 0x8f, 0x1e, 0x6e, 0x72,
+	0xf8, 0x83, 0x0f, 0xf4, 0x84, 0x80, 0x3f, 0xf8, 0x80, 0xc3, 0xdf, 0xdf, 0xd4, 0x93, 0x02, 0xfe,
+	0x7e, 0x48, 0x7f, 0xef, 0xa1, 0x3e, 0x2e, 0xe0, 0xf7, 0x1e, 0x86, 0xfc, 0xef, 0xeb, 0x13, 0x12,
+	0xff, 0xfb, 0x78, 0x7c, 0xf3, 0xd8, 0xa2, 0x4f, 0x32, 0x3f, 0x67, 0xf1, 0x04, 0x6f, 0x74, 0xb7,
+	0xca, 0x07, 0xef, 0x7f, 0xa8, 0x4f, 0x99, 0x33, 0xe7, 0x17, 0x39, 0x5a, 0x10, 0xe1, 0x8d, 0x6b,
+	0xb3, 0xf1, 0x5f, 0x09, 0x00, 0x71, 0xa2, 0x62, 0xdc, 0x82, 0xf4, 0x61, 0xad, 0x64, 0x1f, 0xb1,
+	0x4e, 0xe7, 0x71, 0x4d, 0x50, 0xb0, 0x0e, 0x37, 0x6e, 0xc2, 0x14, 0x21, 0xac, 0xee, 0xea, 0x1a,
+	0xed, 0x0e, 0x41, 0x53, 0xdd, 0x35, 0xbe, 0x0e, 0xd7, 0x08, 0xda, 0x2e, 0xd5, 0xaa, 0x87, 0x76,
+	0xa1, 0x74, 0x54, 0xa9, 0x62, 0xd7, 0x39, 0xac, 0x14, 0xf5, 0x8c, 0xb9, 0x7a, 0x7e, 0x91, 0x33,
+	0x05, 0xb9, 0x8d, 0xfc, 0x6e, 0xdf, 0x6b, 0xa0, 0x4a, 0x37, 0xd8, 0xee, 0xf6, 0x3b, 0x4d, 0xe3,
+	0x43, 0x58, 0x26, 0x95, 0xf1, 0x68, 0x29, 0x55, 0xea, 0x52, 0xdd, 0x55, 0xf3, 0xe6, 0xf9, 0x45,
+	0x6e, 0x45, 0xd4, 0x65, 0xab, 0xde, 0xb0, 0xea, 0x43, 0xc8, 0x28, 0x55, 0xcb, 0x95, 0xc7, 0xf9,
+	0xbd, 0x72, 0x51, 0x5f, 0x33, 0x6f, 0x9c, 0x5f, 0xe4, 0xb2, 0x03, 0x15, 0xcb, 0x9d, 0x67, 0x4e,
+	0xcb, 0x6d, 0x1a, 0x77, 0x61, 0x81, 0xd7, 0xab, 0x1c, 0x6d, 0xe7, 0xcb, 0x7b, 0x87, 0x76, 0x49,
+	0x5f, 0x37, 0x57, 0xce, 0x2f, 0x72, 0x4b, 0x4a, 0xa5, 0xce, 0xb6, 0xe3, 0xb6, 0xfa, 0x1e, 0x0a,
+	0x2d, 0xc5, 0x89, 0x37, 0xa3, 0x96, 0x62, 0x84, 0x62, 0xb8, 0x09, 0xd4, 0xc6, 0x7f, 0x6b, 0x90,
+	0x92, 0x0e, 0x33, 0x8c, 0x75, 0x48, 0x3f, 0xc9, 0xd7, 0x0b, 0x3b, 0x47, 0x87, 0xdc, 0xec, 0x64,
+	0x76, 0x90, 0x48, 0xb8, 0xdd, 0x6f, 0x71, 0xca, 0xea, 0x61, 0x1d, 0xcf, 0xb2, 0x69, 0xda, 0xac,
+	0x44, 0x59, 0xed, 0x07, 0x78, 0xa3, 0x75, 0x1b, 0xe6, 0x29, 0x61, 0xb1, 0x5c, 0xb3, 0x0f, 0x0f,
+	0xea, 0xa5, 0xa2, 0x3e, 0x6b, 0x66, 0xcf, 0x2f, 0x72, 0x19, 0x89, 0xb6, 0xe8, 0xfa, 0x5e, 0xbf,
+	0x17, 0x90, 0x17, 0x38, 0x73, 0x94, 0xbc, 0x56, 0xcf, 0xdb, 0xf5, 0x72, 0xe5, 0x91, 0x3e, 0x47,
+	0x47, 0xb8, 0x44, 0x5d, 0x0b, 0x1c, 0x2f, 0xc0, 0x43, 0xe0, 0x75, 0x00, 0xc6, 0x3b, 0x5f, 0xcf,
+	0xeb, 0x3a, 0x5d, 0xbf, 0xc8, 0x6c, 0x9d, 0xc0, 0x11, 0x33, 0xbd, 0x84, 0xd8, 0xf8, 0x26, 0x4c,
+	0xe1, 0xc3, 0x0d, 0x9c, 0x04, 0xf5, 0x1a, 0xa4, 0x0f, 0xec, 0xd2, 0xb6, 0xe4, 0x6a, 0x64, 0x9e,
+	0xc7, 0x68, 0xa6, 0xac, 0x08, 0xfe, 0xac, 0xce, 0xc6, 0xbf, 0x24, 0xc4, 0xce, 0x95, 0x99, 0xee,
+	0x6d, 0xd0, 0x9f, 0x54, 0xed, 0xfd, 0x9d, 0xea, 0x5e, 0xe9, 0x88, 0xcd, 0xcc, 0xfa, 0x18, 0x93,
+	0x88, 0x51, 0xb2, 0x59, 0xd9, 0x78, 0x07, 0x16, 0x42, 0xd2, 0x50, 0x4d, 0x30, 0x33, 0xe7, 0x17,
+	0x39, 0x5d, 0xe2, 0x4a, 0x75, 0x94, 0x89, 0xab, 0xdb, 0xdb, 0x25, 0x1b, 0x13, 0x67, 0x54, 0xe2,
+	0xea, 0xf1, 0x31, 0xf2, 0x30, 0xf1, 0x6d, 0x30, 0x42, 0xe2, 0x7c, 0xa5, 0xf6, 0x84, 0x52, 0x2f,
+	0xb1, 0xbe, 0x61, 0xd4, 0xf9, 0x8e, 0xff, 0xd9, 0x20, 0xf9, 0x4e, 0xbe, 0x52, 0xac, 0xed, 0xe4,
+	0x77, 0xb1, 0xbb, 0x29, 0xe4, 0x3b, 0x4e, 0xa7, 0xe9, 0x9f, 0x3a, 0x4f, 0x91, 0x42, 0x8e, 0x1d,
+	0xb4, 0x54, 0xc0, 0xbd, 0xd9, 0x54, 0xc9, 0xb1, 0x6f, 0xa2, 0x46, 0x40, 0xde, 0x27, 0xcc, 0x0b,
+	0xf2, 0xbd, 0x6a, 0xad, 0x54, 0xd4, 0x7f, 0xaa, 0xd1, 0x00, 0x1c, 0x12, 0xb7, 0xba, 0x3e, 0x6a,
+	0x9a, 0xcb, 0xcc, 0xbe, 0x11, 0x9b, 0x6e, 0xb4, 0x20, 0x25, 0x6d, 0x27, 0x69, 0x9c, 0xae, 0xe4,
+	0xed, 0x4f, 0xf9, 0xb0, 0xe2, 0x13, 0xe1, 0x96, 0xdb, 0x71, 0xbc, 0x33, 0x46, 0x4a, 0x16, 0x6e,
+	0xf5, 0xed, 0x0f, 0x42, 0x22, 0x8d, 0x2d, 0xdc, 0xea, 0xdb, 0x1f, 0x30, 0x12, 0xe1, 0x13, 0x12,
+	0xfb, 0x8d, 0xdf, 0xd7, 0x20, 0x25, 0x6d, 0xca, 0x31, 0x9f, 0xfd, 0x52, 0xad, 0x96, 0x7f, 0x84,
+	0xa7, 0x38, 0xd2, 0x18, 0xe1, 0xc3, 0x48, 0x6a, 0xb8, 0xa9, 0x5b, 0x30, 0xcf, 0x49, 0x0e, 0x4a,
+	0x95, 0x22, 0x36, 0x36, 0xd3, 0x90, 0x6f, 0x47, 0x51, 0x87, 0x04, 0xeb, 0x35, 0x48, 0x71, 0x42,
+	0x1c, 0x25, 0x13, 0x74, 0x5a, 0x60, 0x44, 0xf9, 0xc6, 0x53, 0x21, 0x91, 0x24, 0xc1, 0xe6, 0xbf,
+	0x6f, 0xc0, 0x38, 0xce, 0xdb, 0x32, 0x3e, 0x86, 0x94, 0x94, 0x46, 0x6b, 0x5c, 0x97, 0xcf, 0x1a,
+	0x22, 0xb9, 0xbc, 0xe6, 0x8d, 0x78, 0x24, 0x3b, 0x28, 0x1a, 0x33, 0x1e, 0x30, 0x9e, 0x19, 0x99,
+	0x8e, 0xef, 0x24, 0xcd, 0xa5, 0x08, 0x34, 0xac, 0xb6, 0x49, 0x53, 0x06, 0x17, 0x65, 0x3c, 0xaf,
+	0x94, 0x51, 0x81, 0x61, 0x9d, 0x22, 0xcc, 0x84, 0xb9, 0x8d, 0xc6, 0x8a, 0x4c, 0xa4, 0xe4, 0x02,
+	0x98, 0x66, 0x1c, 0x2a, 0xc2, 0xa5, 0xf4, 0x7c, 0x90, 0x4b, 0xe9, 0xf9, 0x50, 0x2e, 0xa5, 0xe7,
+	0xb1, 0x5c, 0xe8, 0xb1, 0x99, 0xca, 0x45, 0x39, 0x7a, 0x33, 0xcd, 0x38, 0x94, 0x6c, 0x3c, 0xbc,
+	0x07, 0x91, 0x8c, 0x27, 0x25, 0x0b, 0x9b, 0x4b, 0x11, 0x68, 0x58, 0x2d, 0x0f, 0xd3, 0xfc, 0xd7,
+	0x1a, 0xc6, 0xb2, 0x42, 0x14, 0x3e, 0x05, 0x32, 0xaf, 0x0d, 0xc0, 0xe9, 0x99, 0x98, 0x35, 0xb6,
+	0xae, 0xdd, 0xd5, 0x0c, 0xf6, 0x7a, 0xb0, 0x16, 0x78, 0xc8, 0x69, 0x1b, 0x86, 0x42, 0x4c, 0x19,
+	0xa8, 0x8f, 0x15, 0x95, 0xca, 0x1f, 0xc1, 0x14, 0xfb, 0x37, 0x86, 0xa1, 0x36, 0x23, 0xfe, 0x6a,
+	0x61, 0x66, 0x07, 0x11, 0xa1, 0xfc, 0x5f, 0x87, 0x49, 0xfa, 0xfa, 0x5b, 0x92, 0x5e, 0xf9, 0x6d,
+	0x84, 0x79, 0x6d, 0x00, 0x1e, 0x56, 0x7e, 0x04, 0x20, 0x5e, 0xdb, 0x1b, 0xd9, 0x08, 0xa1, 0x30,
+	0xc0, 0x4a, 0x0c, 0x46, 0xd1, 0x22, 0xcf, 0xff, 0x32, 0xc0, 0x8c, 0x90, 0x89, 0x54, 0xa0, 0x6c,
+	0x96, 0x22, 0x50, 0x85, 0xc5, 0x0e, 0x7f, 0xc6, 0x9e, 0xa7, 0x8f, 0xa8, 0x5e, 0x9d, 0x53, 0x8d,
+	0xff, 0xc7, 0x82, 0x3f, 0x88, 0x37, 0x56, 0x23, 0xe4, 0x91, 0x7f, 0x52, 0x98, 0x6b, 0x43, 0xf1,
+	0xa1, 0xa9, 0xbe, 0x03, 0xc6, 0xe0, 0xbf, 0x04, 0x8c, 0xdc, 0x90, 0x8a, 0xc2, 0x74, 0x97, 0xb3,
+	0x5e, 0xd7, 0x8c, 0x4f, 0x21, 0xa3, 0x62, 0x99, 0xf2, 0x37, 0x86, 0x54, 0x7e, 0x09, 0xd6, 0x45,
+	0x98, 0x61, 0x58, 0xd7, 0x33, 0xa2, 0xfd, 0x28, 0xf9, 0x98, 0x19, 0x87, 0x0a, 0xb5, 0xff, 0x08,
+	0xa6, 0xd8, 0x6e, 0x5c, 0xf2, 0x52, 0xf5, 0xd1, 0xab, 0x99, 0x1d, 0x44, 0x48, 0x43, 0x9c, 0x3f,
+	0x38, 0x64, 0x9a, 0x2d, 0x45, 0x89, 0xa9, 0x4a, 0xcb, 0x51, 0xb0, 0xd2, 0xb1, 0x1f, 0x87, 0x47,
+	0x1b, 0xc4, 0xf8, 0x2b, 0x51, 0x62, 0x61, 0x75, 0x33, 0x0e, 0x15, 0x1d, 0x77, 0x45, 0x14, 0xd5,
+	0xa8, 0x88, 0x86, 0x68, 0x14, 0x79, 0x6b, 0x68, 0x8d, 0x61, 0x59, 0x8a, 0x28, 0x4e, 0x96, 0x22,
+	0x1a, 0x2a, 0x4b, 0x11, 0xc5, 0xcb, 0x52, 0x0c, 0xdf, 0xc0, 0x0d, 0x58, 0xa7, 0x88, 0x62, 0xad,
+	0xa3, 0x3c, 0x99, 0x63, 0x5c, 0x76, 0x21, 0xc3, 0xc0, 0xea, 0x08, 0x7a, 0x25, 0x66, 0x1f, 0xc3,
+	0x62, 0x78, 0xa2, 0x53, 0xed, 0xa1, 0xce, 0x17, 0xe1, 0xf5, 0xff, 0xc1, 0x54, 0x78, 0x7d, 0x09,
+	0xe2, 0xd1, 0xa8, 0x4d, 0xd2, 0xcc, 0x0d, 0x25, 0x3a, 0xca, 0xbf, 0x58, 0x30, 0x57, 0x62, 0x30,
+	0xf2, 0xac, 0x23, 0x7e, 0x28, 0xb1, 0x12, 0xf3, 0xd4, 0x61, 0x60, 0x60, 0x0c, 0xfc, 0xea, 0xc0,
+	0x1a, 0x33, 0x1e, 0xc3, 0x7c, 0xe4, 0xff, 0x00, 0xc6, 0xda, 0x60, 0x05, 0xe5, 0xd0, 0xda, 0xcc,
+	0x0d, 0x27, 0x88, 0xe5, 0x4b, 0x5f, 0xf3, 0xc7, 0xf1, 0x55, 0x7e, 0x22, 0x60, 0xe6, 0x86, 0x13,
+	0xc8, 0xb3, 0x24, 0xb9, 0xca, 0xcf, 0xa8, 0x17, 0xba, 0x03, 0xb3, 0xa4, 0x7c, 0x39, 0x4d, 0x27,
+	0x0a, 0x71, 0x49, 0x6c, 0x98, 0x0a, 0x99, 0x72, 0x05, 0x6c, 0x5e, 0x8f, 0xc5, 0xc9, 0xc3, 0x46,
+	0x7a, 0xf8, 0x63, 0x44, 0xa9, 0xe5, 0x97, 0x45, 0xe6, 0x8d, 0x78, 0xa4, 0x3c, 0x75, 0xf3, 0x77,
+	0x3b, 0x92, 0x13, 0x44, 0x9e, 0x09, 0x99, 0x2b, 0x31, 0x18, 0x39, 0xae, 0xb1, 0xb7, 0x32, 0x52,
+	0x14, 0x50, 0x5f, 0xf2, 0x98, 0xd9, 0x41, 0x84, 0x3c, 0xfb, 0x32, 0x9b, 0xc8, 0x09, 0xab, 0xb2,
+	0x3d, 0xae, 0x0d, 0xc0, 0xd5, 0xca, 0x34, 0xd7, 0x3e, 0x9a, 0x3b, 0x17, 0x53, 0x59, 0xce, 0x33,
+	0xa7, 0x3d, 0x22, 0x52, 0xc0, 0xa5, 0x1e, 0x19, 0xc8, 0x2a, 0x37, 0xaf, 0xc7, 0xe2, 0x42, 0x46,
+	0xfb, 0x3c, 0x09, 0x92, 0x2d, 0xc0, 0x6e, 0x0c, 0xb4, 0x29, 0xaf, 0xc1, 0x6e, 0x0e, 0xc1, 0xca,
+	0x16, 0xa5, 0x18, 0xdf, 0x88, 0x4a, 0xef, 0xc7, 0xac, 0x67, 0xd4, 0x14, 0x6b, 0x6b, 0xcc, 0x38,
+	0x80, 0x59, 0x25, 0x47, 0xd8, 0x88, 0xb6, 0xa8, 0xe6, 0x1a, 0x9b, 0xab, 0xc3, 0xd0, 0x83, 0x1c,
+	0x59, 0x7a, 0xaf, 0x31, 0xa8, 0x83, 0x9c, 0x40, 0x6c, 0xae, 0x0e, 0x43, 0xcb, 0x3a, 0xb2, 0x1c,
+	0x5e, 0x49, 0x47, 0x35, 0x01, 0xd8, 0xcc, 0x0e, 0x22, 0x06, 0x25, 0x62, 0x67, 0x48, 0x03, 0x12,
+	0xa9, 0x09, 0xb9, 0xe6, 0xea, 0x30, 0xb4, 0x1c, 0xcc, 0xc2, 0x1c, 0x52, 0x79, 0x96, 0x8f, 0xa4,
+	0xa1, 0x9a, 0x66, 0x1c, 0x4a, 0x0e, 0x0e, 0x24, 0x73, 0x31, 0xa3, 0xe6, 0x35, 0x0e, 0x04, 0x07,
+	0x39, 0xdb, 0xd2, 0x1a, 0x33, 0x3e, 0x80, 0x09, 0x0c, 0xf1, 0x0d, 0x95, 0x22, 0xec, 0xee, 0xe5,
+	0x28, 0x58, 0x6e, 0x10, 0xa7, 0xfa, 0x49, 0x0d, 0x4a, 0x49, 0x82, 0xe6, 0x52, 0x04, 0xaa, 0x56,
+	0xf3, 0x4f, 0x95, 0x6a, 0xfe, 0x69, 0x5c, 0x35, 0xff, 0x54, 0x8d, 0x17, 0x7c, 0x17, 0x2b, 0x8d,
+	0x38, 0xe5, 0x6e, 0xde, 0x1c, 0xbc, 0xa9, 0x1e, 0x98, 0x77, 0x58, 0xe6, 0xaa, 0x3c, 0xef, 0xa8,
+	0xf9, 0xad, 0xe6, 0x4a, 0x0c, 0x46, 0x8e, 0x80, 0x52, 0xce, 0x88, 0x14, 0x01, 0x07, 0xf3, 0x4b,
+	0xcc, 0x1b, 0xf1, 0x48, 0xd9, 0x91, 0x94, 0x44, 0x10, 0xc9, 0x91, 0xe2, 0x72, 0x4b, 0xcc, 0xd5,
+	0x61, 0x68, 0x99, 0xa3, 0x92, 0xd4, 0x21, 0x71, 0x8c, 0x4b, 0x02, 0x31, 0x57, 0x87, 0xa1, 0x43,
+	0x8e, 0x35, 0x98, 0x53, 0x93, 0x32, 0x8c, 0xb8, 0x3a, 0x52, 0xd2, 0x87, 0xb9, 0x36, 0x14, 0x1f,
+	0x32, 0xfd, 0x4d, 0x58, 0x18, 0xc8, 0xb8, 0x30, 0x5e, 0x8b, 0xab, 0xa7, 0x86, 0x2f, 0x6b, 0x14,
+	0x89, 0x6c, 0x04, 0xf5, 0x59, 0xf7, 0xcd, 0x21, 0xaf, 0x7c, 0x07, 0x8c, 0x10, 0xfb, 0xdc, 0x99,
+	0x4e, 0xe7, 0x91, 0x87, 0xc8, 0xd2, 0x74, 0x1e, 0xff, 0xa8, 0xd9, 0xcc, 0x0d, 0x27, 0x90, 0x8d,
+	0xab, 0x34, 0xe9, 0x1b, 0x43, 0x64, 0xf1, 0x07, 0x8d, 0x1b, 0xff, 0xca, 0x99, 0x06, 0x93, 0xf0,
+	0x85, 0xbf, 0x14, 0x4c, 0xa2, 0xff, 0x25, 0x30, 0xcd, 0x38, 0x94, 0x3c, 0x41, 0x89, 0x07, 0xf5,
+	0x86, 0x4a, 0xab, 0xfc, 0x08, 0xc0, 0xbc, 0x1e, 0x8b, 0x93, 0x87, 0x2d, 0x7f, 0x2b, 0x2d, 0x8d,
+	0xb9, 0xc8, 0x8b, 0x6a, 0x73, 0x25, 0x06, 0x23, 0x77, 0xa8, 0xf2, 0x03, 0x08, 0xa9, 0x43, 0xe3,
+	0xfe, 0x18, 0x61, 0xae, 0x0e, 0x43, 0xcb, 0x21, 0x08, 0xe7, 0x40, 0x4b, 0x21, 0x48, 0xca, 0xdf,
+	0x36, 0x97, 0x22, 0x50, 0x79, 0xb2, 0x95, 0x53, 0xa7, 0xa5, 0xc9, 0x36, 0x26, 0x01, 0xdb, 0xbc,
+	0x39, 0x04, 0x2b, 0xc7, 0x12, 0x29, 0x35, 0x58, 0x8a, 0x25, 0x83, 0xa9, 0xc5, 0xe6, 0x8d, 0x78,
+	0xa4, 0xdc, 0xeb, 0x61, 0x9a, 0xad, 0xbc, 0x9d, 0x89, 0xa4, 0xee, 0x9a, 0x66, 0x1c, 0x4a, 0x76,
+	0x48, 0x35, 0x73, 0x56, 0x72, 0xc8, 0xd8, 0xfc, 0x5b, 0x73, 0x6d, 0x28, 0x5e, 0x11, 0x8d, 0x67,
+	0xbf, 0xca, 0xa2, 0x45, 0xb2, 0x67, 0x4d, 0x33, 0x0e, 0x25, 0xdb, 0x5e, 0xce, 0x57, 0x90, 0x6c,
+	0x1f, 0x93, 0x28, 0x61, 0xde, 0x1c, 0x82, 0x55, 0xfc, 0x3b, 0xcc, 0x28, 0x90, 0xfd, 0x3b, 0x9a,
+	0xae, 0x60, 0x5e, 0x8f, 0xc5, 0xc9, 0x26, 0x53, 0x33, 0x64, 0x24, 0x93, 0xc5, 0x66, 0xe7, 0x98,
+	0x6b, 0x43, 0xf1, 0xb2, 0xc7, 0x2b, 0xe9, 0x2c, 0x92, 0xc7, 0xc7, 0x25, 0xd2, 0x98, 0xab, 0xc3,
+	0xd0, 0xf2, 0x30, 0x64, 0x28, 0x5f, 0x1a, 0x86, 0x91, 0x74, 0x17, 0x73, 0x25, 0x06, 0x13, 0xb2,
+	0xf8, 0x1a, 0x4c, 0x90, 0x93, 0x7e, 0x69, 0xa1, 0x20, 0x27, 0x79, 0x9a, 0x8b, 0x2a, 0x98, 0xe4,
+	0x7a, 0x5a, 0x63, 0x77, 0xb5, 0xad, 0x1b, 0x3f, 0xfd, 0xc5, 0xea, 0xd8, 0xcf, 0x7f, 0xb1, 0xaa,
+	0xfd, 0xe7, 0x2f, 0x56, 0xb5, 0x9f, 0xbe, 0x58, 0xd5, 0x7e, 0xf6, 0x62, 0x55, 0xfb, 0xe7, 0x17,
+	0xab, 0xda, 0xbf, 0xbd, 0x58, 0xd5, 0xbe, 0x37, 0x49, 0xfe, 0xc6, 0xfc, 0xfe, 0xff, 0x0e, 0x00,
+	0x4a, 0x52, 0x0e, 0xbe, 0xba, 0x59, 0x00, 0x00,
 }
 
 func (this *RPCError) GoString() string {
@@ -6032,16 +7956,33 @@ func (this *RPCError) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *SignPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&service.SignPolicy{")
+	s = append(s, "Signers: "+fmt.Sprintf("%#v", this.Signers)+",\n")
+	s = append(s, "Threshold: "+fmt.Sprintf("%#v", this.Threshold)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *SignRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 11)
 	s = append(s, "&service.SignRequest{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Signer: "+fmt.Sprintf("%#v", this.Signer)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Detached: "+fmt.Sprintf("%#v", this.Detached)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Sig: "+fmt.Sprintf("%#v", this.Sig)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6066,13 +8007,15 @@ func (this *SignFileInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 11)
 	s = append(s, "&service.SignFileInput{")
 	s = append(s, "In: "+fmt.Sprintf("%#v", this.In)+",\n")
 	s = append(s, "Out: "+fmt.Sprintf("%#v", this.Out)+",\n")
 	s = append(s, "Signer: "+fmt.Sprintf("%#v", this.Signer)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Detached: "+fmt.Sprintf("%#v", this.Detached)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "Sig: "+fmt.Sprintf("%#v", this.Sig)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6094,15 +8037,80 @@ func (this *SignFileOutput) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *VerifyRequest) GoString() string {
+func (this *SignDirRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&service.SignDirRequest{")
+	s = append(s, "Dir: "+fmt.Sprintf("%#v", this.Dir)+",\n")
+	s = append(s, "Out: "+fmt.Sprintf("%#v", this.Out)+",\n")
+	s = append(s, "Signer: "+fmt.Sprintf("%#v", this.Signer)+",\n")
+	s = append(s, "Ignore: "+fmt.Sprintf("%#v", this.Ignore)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SignDirResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.SignDirResponse{")
+	s = append(s, "KID: "+fmt.Sprintf("%#v", this.KID)+",\n")
+	s = append(s, "Out: "+fmt.Sprintf("%#v", this.Out)+",\n")
+	s = append(s, "Files: "+fmt.Sprintf("%#v", this.Files)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyDirRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 7)
+	s = append(s, "&service.VerifyDirRequest{")
+	s = append(s, "Dir: "+fmt.Sprintf("%#v", this.Dir)+",\n")
+	s = append(s, "Manifest: "+fmt.Sprintf("%#v", this.Manifest)+",\n")
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyDirResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&service.VerifyDirResponse{")
+	if this.Signer != nil {
+		s = append(s, "Signer: "+fmt.Sprintf("%#v", this.Signer)+",\n")
+	}
+	s = append(s, "Added: "+fmt.Sprintf("%#v", this.Added)+",\n")
+	s = append(s, "Removed: "+fmt.Sprintf("%#v", this.Removed)+",\n")
+	s = append(s, "Modified: "+fmt.Sprintf("%#v", this.Modified)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
 	s = append(s, "&service.VerifyRequest{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Detached: "+fmt.Sprintf("%#v", this.Detached)+",\n")
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6129,11 +8137,17 @@ func (this *VerifyDetachedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 11)
 	s = append(s, "&service.VerifyDetachedRequest{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Sig: "+fmt.Sprintf("%#v", this.Sig)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
+	}
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6144,11 +8158,14 @@ func (this *VerifyDetachedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&service.VerifyDetachedResponse{")
 	if this.Signer != nil {
 		s = append(s, "Signer: "+fmt.Sprintf("%#v", this.Signer)+",\n")
 	}
+	if this.Signers != nil {
+		s = append(s, "Signers: "+fmt.Sprintf("%#v", this.Signers)+",\n")
+	}
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6159,9 +8176,10 @@ func (this *VerifyInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&service.VerifyInput{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6188,11 +8206,12 @@ func (this *VerifyFileInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&service.VerifyFileInput{")
 	s = append(s, "In: "+fmt.Sprintf("%#v", this.In)+",\n")
 	s = append(s, "Out: "+fmt.Sprintf("%#v", this.Out)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6219,11 +8238,17 @@ func (this *VerifyDetachedFileInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 11)
 	s = append(s, "&service.VerifyDetachedFileInput{")
 	s = append(s, "In: "+fmt.Sprintf("%#v", this.In)+",\n")
 	s = append(s, "Sig: "+fmt.Sprintf("%#v", this.Sig)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
+	}
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6234,11 +8259,17 @@ func (this *VerifyDetachedInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 11)
 	s = append(s, "&service.VerifyDetachedInput{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Sig: "+fmt.Sprintf("%#v", this.Sig)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
+	}
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6329,10 +8360,12 @@ func (this *StatementCreateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&service.StatementCreateRequest{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "KID: "+fmt.Sprintf("%#v", this.KID)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "LinkKID: "+fmt.Sprintf("%#v", this.LinkKID)+",\n")
 	s = append(s, "Local: "+fmt.Sprintf("%#v", this.Local)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
@@ -6389,7 +8422,7 @@ func (this *SignInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 13)
 	s = append(s, "&service.SignInput{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "In: "+fmt.Sprintf("%#v", this.In)+",\n")
@@ -6397,6 +8430,9 @@ func (this *SignInput) GoString() string {
 	s = append(s, "Signer: "+fmt.Sprintf("%#v", this.Signer)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Detached: "+fmt.Sprintf("%#v", this.Detached)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Sig: "+fmt.Sprintf("%#v", this.Sig)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6421,13 +8457,14 @@ func (this *EncryptRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&service.EncryptRequest{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Recipients: "+fmt.Sprintf("%#v", this.Recipients)+",\n")
 	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
 	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6451,7 +8488,7 @@ func (this *EncryptFileInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&service.EncryptFileInput{")
 	s = append(s, "In: "+fmt.Sprintf("%#v", this.In)+",\n")
 	s = append(s, "Out: "+fmt.Sprintf("%#v", this.Out)+",\n")
@@ -6459,6 +8496,7 @@ func (this *EncryptFileInput) GoString() string {
 	s = append(s, "Recipients: "+fmt.Sprintf("%#v", this.Recipients)+",\n")
 	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
 	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6483,13 +8521,14 @@ func (this *EncryptInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&service.EncryptInput{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Recipients: "+fmt.Sprintf("%#v", this.Recipients)+",\n")
 	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
 	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6513,11 +8552,13 @@ func (this *DecryptRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&service.DecryptRequest{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6544,12 +8585,14 @@ func (this *DecryptFileInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 10)
 	s = append(s, "&service.DecryptFileInput{")
 	s = append(s, "In: "+fmt.Sprintf("%#v", this.In)+",\n")
 	s = append(s, "Out: "+fmt.Sprintf("%#v", this.Out)+",\n")
 	s = append(s, "Armored: "+fmt.Sprintf("%#v", this.Armored)+",\n")
 	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6578,9 +8621,11 @@ func (this *DecryptInput) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 7)
 	s = append(s, "&service.DecryptInput{")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
+	s = append(s, "Trust: "+fmt.Sprintf("%#v", this.Trust)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6636,10 +8681,13 @@ func (this *AuthSetupRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&service.AuthSetupRequest{")
 	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
 	s = append(s, "Client: "+fmt.Sprintf("%#v", this.Client)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Device: "+fmt.Sprintf("%#v", this.Device)+",\n")
+	s = append(s, "PIN: "+fmt.Sprintf("%#v", this.PIN)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6663,10 +8711,13 @@ func (this *AuthUnlockRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&service.AuthUnlockRequest{")
 	s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
 	s = append(s, "Client: "+fmt.Sprintf("%#v", this.Client)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Device: "+fmt.Sprintf("%#v", this.Device)+",\n")
+	s = append(s, "PIN: "+fmt.Sprintf("%#v", this.PIN)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -6686,6 +8737,105 @@ func (this *AuthUnlockResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *AuthProvision) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&service.AuthProvision{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "AAGUID: "+fmt.Sprintf("%#v", this.AAGUID)+",\n")
+	s = append(s, "PIN: "+fmt.Sprintf("%#v", this.PIN)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthProvisionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.AuthProvisionRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Device: "+fmt.Sprintf("%#v", this.Device)+",\n")
+	s = append(s, "PIN: "+fmt.Sprintf("%#v", this.PIN)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthProvisionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.AuthProvisionResponse{")
+	if this.Provision != nil {
+		s = append(s, "Provision: "+fmt.Sprintf("%#v", this.Provision)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthDeprovisionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.AuthDeprovisionRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthDeprovisionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&service.AuthDeprovisionResponse{")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthProvisionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&service.AuthProvisionsRequest{")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthProvisionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.AuthProvisionsResponse{")
+	if this.Provisions != nil {
+		s = append(s, "Provisions: "+fmt.Sprintf("%#v", this.Provisions)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *AuthLockRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -6714,9 +8864,11 @@ func (this *KeyGenerateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 7)
 	s = append(s, "&service.KeyGenerateRequest{")
 	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Device: "+fmt.Sprintf("%#v", this.Device)+",\n")
+	s = append(s, "PIN: "+fmt.Sprintf("%#v", this.PIN)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -7097,11 +9249,14 @@ func (this *SecretsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 10)
 	s = append(s, "&service.SecretsRequest{")
 	s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	s = append(s, "Types: "+fmt.Sprintf("%#v", this.Types)+",\n")
 	s = append(s, "SortField: "+fmt.Sprintf("%#v", this.SortField)+",\n")
 	s = append(s, "SortDirection: "+fmt.Sprintf("%#v", this.SortDirection)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -7112,13 +9267,239 @@ func (this *SecretsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&service.SecretsResponse{")
 	if this.Secrets != nil {
 		s = append(s, "Secrets: "+fmt.Sprintf("%#v", this.Secrets)+",\n")
 	}
 	s = append(s, "SortField: "+fmt.Sprintf("%#v", this.SortField)+",\n")
 	s = append(s, "SortDirection: "+fmt.Sprintf("%#v", this.SortDirection)+",\n")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretVersion) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.SecretVersion{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Secret != nil {
+		s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	}
+	s = append(s, "ReplacedAt: "+fmt.Sprintf("%#v", this.ReplacedAt)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.SecretHistoryRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretHistoryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.SecretHistoryResponse{")
+	if this.Versions != nil {
+		s = append(s, "Versions: "+fmt.Sprintf("%#v", this.Versions)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretRestoreRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&service.SecretRestoreRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretRestoreResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.SecretRestoreResponse{")
+	if this.Secret != nil {
+		s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OTPCodeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.OTPCodeRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OTPCodeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&service.OTPCodeResponse{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Remaining: "+fmt.Sprintf("%#v", this.Remaining)+",\n")
+	s = append(s, "Period: "+fmt.Sprintf("%#v", this.Period)+",\n")
+	s = append(s, "Counter: "+fmt.Sprintf("%#v", this.Counter)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretsImportRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.SecretsImportRequest{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretsImportResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.SecretsImportResponse{")
+	if this.Secrets != nil {
+		s = append(s, "Secrets: "+fmt.Sprintf("%#v", this.Secrets)+",\n")
+	}
+	s = append(s, "Imported: "+fmt.Sprintf("%#v", this.Imported)+",\n")
+	s = append(s, "Duplicates: "+fmt.Sprintf("%#v", this.Duplicates)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretImport) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&service.SecretImport{")
+	if this.Secret != nil {
+		s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	}
+	s = append(s, "Duplicate: "+fmt.Sprintf("%#v", this.Duplicate)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VaultSyncRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.VaultSyncRequest{")
+	s = append(s, "KID: "+fmt.Sprintf("%#v", this.KID)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VaultSyncResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&service.VaultSyncResponse{")
+	s = append(s, "KID: "+fmt.Sprintf("%#v", this.KID)+",\n")
+	if this.Conflicts != nil {
+		s = append(s, "Conflicts: "+fmt.Sprintf("%#v", this.Conflicts)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VaultConflict) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.VaultConflict{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	if this.Secret != nil {
+		s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	}
+	if this.Local != nil {
+		s = append(s, "Local: "+fmt.Sprintf("%#v", this.Local)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SSHAgentRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&service.SSHAgentRequest{")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SSHAgentResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.SSHAgentResponse{")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -7413,6 +9794,50 @@ func (this *DocumentDeleteResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DBMigration) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&service.DBMigration{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Description: "+fmt.Sprintf("%#v", this.Description)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DBMigrateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.DBMigrateRequest{")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DBMigrateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.DBMigrateResponse{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Latest: "+fmt.Sprintf("%#v", this.Latest)+",\n")
+	if this.Migrations != nil {
+		s = append(s, "Migrations: "+fmt.Sprintf("%#v", this.Migrations)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *User) GoString() string {
 	if this == nil {
 		return "nil"
@@ -7616,6 +10041,103 @@ func (this *PreferenceSetResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TrustPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&service.TrustPolicy{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Signers: "+fmt.Sprintf("%#v", this.Signers)+",\n")
+	s = append(s, "Expire: "+fmt.Sprintf("%#v", this.Expire)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TrustPoliciesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&service.TrustPoliciesRequest{")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TrustPoliciesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.TrustPoliciesResponse{")
+	if this.Policies != nil {
+		s = append(s, "Policies: "+fmt.Sprintf("%#v", this.Policies)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TrustPolicySetRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.TrustPolicySetRequest{")
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TrustPolicySetResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.TrustPolicySetResponse{")
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TrustPolicyRemoveRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&service.TrustPolicyRemoveRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TrustPolicyRemoveResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&service.TrustPolicyRemoveResponse{")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *WormholeInput) GoString() string {
 	if this == nil {
 		return "nil"
@@ -7865,6 +10387,7 @@ type KeysClient interface {
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
 	SignFile(ctx context.Context, opts ...grpc.CallOption) (Keys_SignFileClient, error)
 	SignStream(ctx context.Context, opts ...grpc.CallOption) (Keys_SignStreamClient, error)
+	SignDir(ctx context.Context, in *SignDirRequest, opts ...grpc.CallOption) (*SignDirResponse, error)
 	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
 	VerifyFile(ctx context.Context, opts ...grpc.CallOption) (Keys_VerifyFileClient, error)
 	VerifyStream(ctx context.Context, opts ...grpc.CallOption) (Keys_VerifyStreamClient, error)
@@ -7872,6 +10395,7 @@ type KeysClient interface {
 	VerifyDetached(ctx context.Context, in *VerifyDetachedRequest, opts ...grpc.CallOption) (*VerifyDetachedResponse, error)
 	VerifyDetachedFile(ctx context.Context, opts ...grpc.CallOption) (Keys_VerifyDetachedFileClient, error)
 	VerifyDetachedStream(ctx context.Context, opts ...grpc.CallOption) (Keys_VerifyDetachedStreamClient, error)
+	VerifyDir(ctx context.Context, in *VerifyDirRequest, opts ...grpc.CallOption) (*VerifyDirResponse, error)
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error)
 	EncryptStream(ctx context.Context, opts ...grpc.CallOption) (Keys_EncryptStreamClient, error)
 	EncryptFile(ctx context.Context, opts ...grpc.CallOption) (Keys_EncryptFileClient, error)
@@ -7895,13 +10419,25 @@ type KeysClient interface {
 	SecretSave(ctx context.Context, in *SecretSaveRequest, opts ...grpc.CallOption) (*SecretSaveResponse, error)
 	SecretRemove(ctx context.Context, in *SecretRemoveRequest, opts ...grpc.CallOption) (*SecretRemoveResponse, error)
 	Secrets(ctx context.Context, in *SecretsRequest, opts ...grpc.CallOption) (*SecretsResponse, error)
+	SecretHistory(ctx context.Context, in *SecretHistoryRequest, opts ...grpc.CallOption) (*SecretHistoryResponse, error)
+	SecretRestore(ctx context.Context, in *SecretRestoreRequest, opts ...grpc.CallOption) (*SecretRestoreResponse, error)
+	OTPCode(ctx context.Context, in *OTPCodeRequest, opts ...grpc.CallOption) (*OTPCodeResponse, error)
+	SecretsImport(ctx context.Context, in *SecretsImportRequest, opts ...grpc.CallOption) (*SecretsImportResponse, error)
+	VaultSync(ctx context.Context, in *VaultSyncRequest, opts ...grpc.CallOption) (*VaultSyncResponse, error)
 	Item(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*ItemResponse, error)
 	Items(ctx context.Context, in *ItemsRequest, opts ...grpc.CallOption) (*ItemsResponse, error)
 	Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (*PullResponse, error)
 	Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
 	Wormhole(ctx context.Context, opts ...grpc.CallOption) (Keys_WormholeClient, error)
+	SSHAgent(ctx context.Context, in *SSHAgentRequest, opts ...grpc.CallOption) (*SSHAgentResponse, error)
 	Preferences(ctx context.Context, in *PreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
 	PreferenceSet(ctx context.Context, in *PreferenceSetRequest, opts ...grpc.CallOption) (*PreferenceSetResponse, error)
+	TrustPolicies(ctx context.Context, in *TrustPoliciesRequest, opts ...grpc.CallOption) (*TrustPoliciesResponse, error)
+	TrustPolicySet(ctx context.Context, in *TrustPolicySetRequest, opts ...grpc.CallOption) (*TrustPolicySetResponse, error)
+	TrustPolicyRemove(ctx context.Context, in *TrustPolicyRemoveRequest, opts ...grpc.CallOption) (*TrustPolicyRemoveResponse, error)
+	AuthProvision(ctx context.Context, in *AuthProvisionRequest, opts ...grpc.CallOption) (*AuthProvisionResponse, error)
+	AuthDeprovision(ctx context.Context, in *AuthDeprovisionRequest, opts ...grpc.CallOption) (*AuthDeprovisionResponse, error)
+	AuthProvisions(ctx context.Context, in *AuthProvisionsRequest, opts ...grpc.CallOption) (*AuthProvisionsResponse, error)
 	// These requests do not need auth, since they are used to set or check auth.
 	// BEGIN NO AUTH
 	AuthSetup(ctx context.Context, in *AuthSetupRequest, opts ...grpc.CallOption) (*AuthSetupResponse, error)
@@ -7913,6 +10449,7 @@ type KeysClient interface {
 	Collections(ctx context.Context, in *CollectionsRequest, opts ...grpc.CallOption) (*CollectionsResponse, error)
 	Documents(ctx context.Context, in *DocumentsRequest, opts ...grpc.CallOption) (*DocumentsResponse, error)
 	DocumentDelete(ctx context.Context, in *DocumentDeleteRequest, opts ...grpc.CallOption) (*DocumentDeleteResponse, error)
+	DBMigrate(ctx context.Context, in *DBMigrateRequest, opts ...grpc.CallOption) (*DBMigrateResponse, error)
 	// Admin
 	AdminSignURL(ctx context.Context, in *AdminSignURLRequest, opts ...grpc.CallOption) (*AdminSignURLResponse, error)
 	AdminCheck(ctx context.Context, in *AdminCheckRequest, opts ...grpc.CallOption) (*AdminCheckResponse, error)
@@ -8056,6 +10593,15 @@ func (x *keysSignStreamClient) Recv() (*SignOutput, error) {
 	return m, nil
 }
 
+func (c *keysClient) SignDir(ctx context.Context, in *SignDirRequest, opts ...grpc.CallOption) (*SignDirResponse, error) {
+	out := new(SignDirResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/SignDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
 	out := new(VerifyResponse)
 	err := c.cc.Invoke(ctx, "/service.Keys/Verify", in, out, opts...)
@@ -8235,6 +10781,15 @@ func (x *keysVerifyDetachedStreamClient) CloseAndRecv() (*VerifyDetachedResponse
 	return m, nil
 }
 
+func (c *keysClient) VerifyDir(ctx context.Context, in *VerifyDirRequest, opts ...grpc.CallOption) (*VerifyDirResponse, error) {
+	out := new(VerifyDirResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/VerifyDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error) {
 	out := new(EncryptResponse)
 	err := c.cc.Invoke(ctx, "/service.Keys/Encrypt", in, out, opts...)
@@ -8596,6 +11151,51 @@ func (c *keysClient) Secrets(ctx context.Context, in *SecretsRequest, opts ...gr
 	return out, nil
 }
 
+func (c *keysClient) SecretHistory(ctx context.Context, in *SecretHistoryRequest, opts ...grpc.CallOption) (*SecretHistoryResponse, error) {
+	out := new(SecretHistoryResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/SecretHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretRestore(ctx context.Context, in *SecretRestoreRequest, opts ...grpc.CallOption) (*SecretRestoreResponse, error) {
+	out := new(SecretRestoreResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/SecretRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) OTPCode(ctx context.Context, in *OTPCodeRequest, opts ...grpc.CallOption) (*OTPCodeResponse, error) {
+	out := new(OTPCodeResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/OTPCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretsImport(ctx context.Context, in *SecretsImportRequest, opts ...grpc.CallOption) (*SecretsImportResponse, error) {
+	out := new(SecretsImportResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/SecretsImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) VaultSync(ctx context.Context, in *VaultSyncRequest, opts ...grpc.CallOption) (*VaultSyncResponse, error) {
+	out := new(VaultSyncResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/VaultSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) Item(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*ItemResponse, error) {
 	out := new(ItemResponse)
 	err := c.cc.Invoke(ctx, "/service.Keys/Item", in, out, opts...)
@@ -8663,6 +11263,15 @@ func (x *keysWormholeClient) Recv() (*WormholeOutput, error) {
 	return m, nil
 }
 
+func (c *keysClient) SSHAgent(ctx context.Context, in *SSHAgentRequest, opts ...grpc.CallOption) (*SSHAgentResponse, error) {
+	out := new(SSHAgentResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/SSHAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) Preferences(ctx context.Context, in *PreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
 	out := new(PreferencesResponse)
 	err := c.cc.Invoke(ctx, "/service.Keys/Preferences", in, out, opts...)
@@ -8681,6 +11290,60 @@ func (c *keysClient) PreferenceSet(ctx context.Context, in *PreferenceSetRequest
 	return out, nil
 }
 
+func (c *keysClient) TrustPolicies(ctx context.Context, in *TrustPoliciesRequest, opts ...grpc.CallOption) (*TrustPoliciesResponse, error) {
+	out := new(TrustPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/TrustPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) TrustPolicySet(ctx context.Context, in *TrustPolicySetRequest, opts ...grpc.CallOption) (*TrustPolicySetResponse, error) {
+	out := new(TrustPolicySetResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/TrustPolicySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) TrustPolicyRemove(ctx context.Context, in *TrustPolicyRemoveRequest, opts ...grpc.CallOption) (*TrustPolicyRemoveResponse, error) {
+	out := new(TrustPolicyRemoveResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/TrustPolicyRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AuthProvision(ctx context.Context, in *AuthProvisionRequest, opts ...grpc.CallOption) (*AuthProvisionResponse, error) {
+	out := new(AuthProvisionResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/AuthProvision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AuthDeprovision(ctx context.Context, in *AuthDeprovisionRequest, opts ...grpc.CallOption) (*AuthDeprovisionResponse, error) {
+	out := new(AuthDeprovisionResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/AuthDeprovision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AuthProvisions(ctx context.Context, in *AuthProvisionsRequest, opts ...grpc.CallOption) (*AuthProvisionsResponse, error) {
+	out := new(AuthProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/AuthProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) AuthSetup(ctx context.Context, in *AuthSetupRequest, opts ...grpc.CallOption) (*AuthSetupResponse, error) {
 	out := new(AuthSetupResponse)
 	err := c.cc.Invoke(ctx, "/service.Keys/AuthSetup", in, out, opts...)
@@ -8762,6 +11425,15 @@ func (c *keysClient) DocumentDelete(ctx context.Context, in *DocumentDeleteReque
 	return out, nil
 }
 
+func (c *keysClient) DBMigrate(ctx context.Context, in *DBMigrateRequest, opts ...grpc.CallOption) (*DBMigrateResponse, error) {
+	out := new(DBMigrateResponse)
+	err := c.cc.Invoke(ctx, "/service.Keys/DBMigrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) AdminSignURL(ctx context.Context, in *AdminSignURLRequest, opts ...grpc.CallOption) (*AdminSignURLResponse, error) {
 	out := new(AdminSignURLResponse)
 	err := c.cc.Invoke(ctx, "/service.Keys/AdminSignURL", in, out, opts...)
@@ -8850,6 +11522,7 @@ type KeysServer interface {
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
 	SignFile(Keys_SignFileServer) error
 	SignStream(Keys_SignStreamServer) error
+	SignDir(context.Context, *SignDirRequest) (*SignDirResponse, error)
 	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
 	VerifyFile(Keys_VerifyFileServer) error
 	VerifyStream(Keys_VerifyStreamServer) error
@@ -8857,6 +11530,7 @@ type KeysServer interface {
 	VerifyDetached(context.Context, *VerifyDetachedRequest) (*VerifyDetachedResponse, error)
 	VerifyDetachedFile(Keys_VerifyDetachedFileServer) error
 	VerifyDetachedStream(Keys_VerifyDetachedStreamServer) error
+	VerifyDir(context.Context, *VerifyDirRequest) (*VerifyDirResponse, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error)
 	EncryptStream(Keys_EncryptStreamServer) error
 	EncryptFile(Keys_EncryptFileServer) error
@@ -8880,13 +11554,25 @@ type KeysServer interface {
 	SecretSave(context.Context, *SecretSaveRequest) (*SecretSaveResponse, error)
 	SecretRemove(context.Context, *SecretRemoveRequest) (*SecretRemoveResponse, error)
 	Secrets(context.Context, *SecretsRequest) (*SecretsResponse, error)
+	SecretHistory(context.Context, *SecretHistoryRequest) (*SecretHistoryResponse, error)
+	SecretRestore(context.Context, *SecretRestoreRequest) (*SecretRestoreResponse, error)
+	OTPCode(context.Context, *OTPCodeRequest) (*OTPCodeResponse, error)
+	SecretsImport(context.Context, *SecretsImportRequest) (*SecretsImportResponse, error)
+	VaultSync(context.Context, *VaultSyncRequest) (*VaultSyncResponse, error)
 	Item(context.Context, *ItemRequest) (*ItemResponse, error)
 	Items(context.Context, *ItemsRequest) (*ItemsResponse, error)
 	Pull(context.Context, *PullRequest) (*PullResponse, error)
 	Push(context.Context, *PushRequest) (*PushResponse, error)
 	Wormhole(Keys_WormholeServer) error
+	SSHAgent(context.Context, *SSHAgentRequest) (*SSHAgentResponse, error)
 	Preferences(context.Context, *PreferencesRequest) (*PreferencesResponse, error)
 	PreferenceSet(context.Context, *PreferenceSetRequest) (*PreferenceSetResponse, error)
+	TrustPolicies(context.Context, *TrustPoliciesRequest) (*TrustPoliciesResponse, error)
+	TrustPolicySet(context.Context, *TrustPolicySetRequest) (*TrustPolicySetResponse, error)
+	TrustPolicyRemove(context.Context, *TrustPolicyRemoveRequest) (*TrustPolicyRemoveResponse, error)
+	AuthProvision(context.Context, *AuthProvisionRequest) (*AuthProvisionResponse, error)
+	AuthDeprovision(context.Context, *AuthDeprovisionRequest) (*AuthDeprovisionResponse, error)
+	AuthProvisions(context.Context, *AuthProvisionsRequest) (*AuthProvisionsResponse, error)
 	// These requests do not need auth, since they are used to set or check auth.
 	// BEGIN NO AUTH
 	AuthSetup(context.Context, *AuthSetupRequest) (*AuthSetupResponse, error)
@@ -8898,6 +11584,7 @@ type KeysServer interface {
 	Collections(context.Context, *CollectionsRequest) (*CollectionsResponse, error)
 	Documents(context.Context, *DocumentsRequest) (*DocumentsResponse, error)
 	DocumentDelete(context.Context, *DocumentDeleteRequest) (*DocumentDeleteResponse, error)
+	DBMigrate(context.Context, *DBMigrateRequest) (*DBMigrateResponse, error)
 	// Admin
 	AdminSignURL(context.Context, *AdminSignURLRequest) (*AdminSignURLResponse, error)
 	AdminCheck(context.Context, *AdminCheckRequest) (*AdminCheckResponse, error)
@@ -8939,6 +11626,9 @@ func (*UnimplementedKeysServer) SignFile(srv Keys_SignFileServer) error {
 func (*UnimplementedKeysServer) SignStream(srv Keys_SignStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method SignStream not implemented")
 }
+func (*UnimplementedKeysServer) SignDir(ctx context.Context, req *SignDirRequest) (*SignDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignDir not implemented")
+}
 func (*UnimplementedKeysServer) Verify(ctx context.Context, req *VerifyRequest) (*VerifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
 }
@@ -8960,6 +11650,9 @@ func (*UnimplementedKeysServer) VerifyDetachedFile(srv Keys_VerifyDetachedFileSe
 func (*UnimplementedKeysServer) VerifyDetachedStream(srv Keys_VerifyDetachedStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method VerifyDetachedStream not implemented")
 }
+func (*UnimplementedKeysServer) VerifyDir(ctx context.Context, req *VerifyDirRequest) (*VerifyDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDir not implemented")
+}
 func (*UnimplementedKeysServer) Encrypt(ctx context.Context, req *EncryptRequest) (*EncryptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
 }
@@ -9029,6 +11722,21 @@ func (*UnimplementedKeysServer) SecretRemove(ctx context.Context, req *SecretRem
 func (*UnimplementedKeysServer) Secrets(ctx context.Context, req *SecretsRequest) (*SecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Secrets not implemented")
 }
+func (*UnimplementedKeysServer) SecretHistory(ctx context.Context, req *SecretHistoryRequest) (*SecretHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretHistory not implemented")
+}
+func (*UnimplementedKeysServer) SecretRestore(ctx context.Context, req *SecretRestoreRequest) (*SecretRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretRestore not implemented")
+}
+func (*UnimplementedKeysServer) OTPCode(ctx context.Context, req *OTPCodeRequest) (*OTPCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OTPCode not implemented")
+}
+func (*UnimplementedKeysServer) SecretsImport(ctx context.Context, req *SecretsImportRequest) (*SecretsImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretsImport not implemented")
+}
+func (*UnimplementedKeysServer) VaultSync(ctx context.Context, req *VaultSyncRequest) (*VaultSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultSync not implemented")
+}
 func (*UnimplementedKeysServer) Item(ctx context.Context, req *ItemRequest) (*ItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Item not implemented")
 }
@@ -9044,12 +11752,33 @@ func (*UnimplementedKeysServer) Push(ctx context.Context, req *PushRequest) (*Pu
 func (*UnimplementedKeysServer) Wormhole(srv Keys_WormholeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Wormhole not implemented")
 }
+func (*UnimplementedKeysServer) SSHAgent(ctx context.Context, req *SSHAgentRequest) (*SSHAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHAgent not implemented")
+}
 func (*UnimplementedKeysServer) Preferences(ctx context.Context, req *PreferencesRequest) (*PreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Preferences not implemented")
 }
 func (*UnimplementedKeysServer) PreferenceSet(ctx context.Context, req *PreferenceSetRequest) (*PreferenceSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreferenceSet not implemented")
 }
+func (*UnimplementedKeysServer) TrustPolicies(ctx context.Context, req *TrustPoliciesRequest) (*TrustPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustPolicies not implemented")
+}
+func (*UnimplementedKeysServer) TrustPolicySet(ctx context.Context, req *TrustPolicySetRequest) (*TrustPolicySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustPolicySet not implemented")
+}
+func (*UnimplementedKeysServer) TrustPolicyRemove(ctx context.Context, req *TrustPolicyRemoveRequest) (*TrustPolicyRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustPolicyRemove not implemented")
+}
+func (*UnimplementedKeysServer) AuthProvision(ctx context.Context, req *AuthProvisionRequest) (*AuthProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthProvision not implemented")
+}
+func (*UnimplementedKeysServer) AuthDeprovision(ctx context.Context, req *AuthDeprovisionRequest) (*AuthDeprovisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthDeprovision not implemented")
+}
+func (*UnimplementedKeysServer) AuthProvisions(ctx context.Context, req *AuthProvisionsRequest) (*AuthProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthProvisions not implemented")
+}
 func (*UnimplementedKeysServer) AuthSetup(ctx context.Context, req *AuthSetupRequest) (*AuthSetupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthSetup not implemented")
 }
@@ -9077,6 +11806,9 @@ func (*UnimplementedKeysServer) Documents(ctx context.Context, req *DocumentsReq
 func (*UnimplementedKeysServer) DocumentDelete(ctx context.Context, req *DocumentDeleteRequest) (*DocumentDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DocumentDelete not implemented")
 }
+func (*UnimplementedKeysServer) DBMigrate(ctx context.Context, req *DBMigrateRequest) (*DBMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DBMigrate not implemented")
+}
 func (*UnimplementedKeysServer) AdminSignURL(ctx context.Context, req *AdminSignURLRequest) (*AdminSignURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminSignURL not implemented")
 }
@@ -9278,6 +12010,24 @@ func (x *keysSignStreamServer) Recv() (*SignInput, error) {
 	return m, nil
 }
 
+func _Keys_SignDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SignDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/SignDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SignDir(ctx, req.(*SignDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyRequest)
 	if err := dec(in); err != nil {
@@ -9444,6 +12194,24 @@ func (x *keysVerifyDetachedStreamServer) Recv() (*VerifyDetachedInput, error) {
 	return m, nil
 }
 
+func _Keys_VerifyDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).VerifyDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/VerifyDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).VerifyDir(ctx, req.(*VerifyDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EncryptRequest)
 	if err := dec(in); err != nil {
@@ -9914,6 +12682,96 @@ func _Keys_Secrets_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keys_SecretHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/SecretHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretHistory(ctx, req.(*SecretHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/SecretRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretRestore(ctx, req.(*SecretRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_OTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).OTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/OTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).OTPCode(ctx, req.(*OTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretsImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretsImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretsImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/SecretsImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretsImport(ctx, req.(*SecretsImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_VaultSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).VaultSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/VaultSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).VaultSync(ctx, req.(*VaultSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_Item_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ItemRequest)
 	if err := dec(in); err != nil {
@@ -10012,6 +12870,24 @@ func (x *keysWormholeServer) Recv() (*WormholeInput, error) {
 	return m, nil
 }
 
+func _Keys_SSHAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SSHAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/SSHAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SSHAgent(ctx, req.(*SSHAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_Preferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PreferencesRequest)
 	if err := dec(in); err != nil {
@@ -10048,6 +12924,114 @@ func _Keys_PreferenceSet_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keys_TrustPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).TrustPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/TrustPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).TrustPolicies(ctx, req.(*TrustPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_TrustPolicySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustPolicySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).TrustPolicySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/TrustPolicySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).TrustPolicySet(ctx, req.(*TrustPolicySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_TrustPolicyRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustPolicyRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).TrustPolicyRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/TrustPolicyRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).TrustPolicyRemove(ctx, req.(*TrustPolicyRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/AuthProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthProvision(ctx, req.(*AuthProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthDeprovision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthDeprovisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthDeprovision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/AuthDeprovision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthDeprovision(ctx, req.(*AuthDeprovisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/AuthProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthProvisions(ctx, req.(*AuthProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_AuthSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthSetupRequest)
 	if err := dec(in); err != nil {
@@ -10210,6 +13194,24 @@ func _Keys_DocumentDelete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keys_DBMigrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).DBMigrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Keys/DBMigrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).DBMigrate(ctx, req.(*DBMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_AdminSignURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminSignURLRequest)
 	if err := dec(in); err != nil {
@@ -10353,6 +13355,10 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sign",
 			Handler:    _Keys_Sign_Handler,
 		},
+		{
+			MethodName: "SignDir",
+			Handler:    _Keys_SignDir_Handler,
+		},
 		{
 			MethodName: "Verify",
 			Handler:    _Keys_Verify_Handler,
@@ -10361,6 +13367,10 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyDetached",
 			Handler:    _Keys_VerifyDetached_Handler,
 		},
+		{
+			MethodName: "VerifyDir",
+			Handler:    _Keys_VerifyDir_Handler,
+		},
 		{
 			MethodName: "Encrypt",
 			Handler:    _Keys_Encrypt_Handler,
@@ -10425,6 +13435,26 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Secrets",
 			Handler:    _Keys_Secrets_Handler,
 		},
+		{
+			MethodName: "SecretHistory",
+			Handler:    _Keys_SecretHistory_Handler,
+		},
+		{
+			MethodName: "SecretRestore",
+			Handler:    _Keys_SecretRestore_Handler,
+		},
+		{
+			MethodName: "OTPCode",
+			Handler:    _Keys_OTPCode_Handler,
+		},
+		{
+			MethodName: "SecretsImport",
+			Handler:    _Keys_SecretsImport_Handler,
+		},
+		{
+			MethodName: "VaultSync",
+			Handler:    _Keys_VaultSync_Handler,
+		},
 		{
 			MethodName: "Item",
 			Handler:    _Keys_Item_Handler,
@@ -10441,6 +13471,10 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Push",
 			Handler:    _Keys_Push_Handler,
 		},
+		{
+			MethodName: "SSHAgent",
+			Handler:    _Keys_SSHAgent_Handler,
+		},
 		{
 			MethodName: "Preferences",
 			Handler:    _Keys_Preferences_Handler,
@@ -10449,6 +13483,30 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PreferenceSet",
 			Handler:    _Keys_PreferenceSet_Handler,
 		},
+		{
+			MethodName: "TrustPolicies",
+			Handler:    _Keys_TrustPolicies_Handler,
+		},
+		{
+			MethodName: "TrustPolicySet",
+			Handler:    _Keys_TrustPolicySet_Handler,
+		},
+		{
+			MethodName: "TrustPolicyRemove",
+			Handler:    _Keys_TrustPolicyRemove_Handler,
+		},
+		{
+			MethodName: "AuthProvision",
+			Handler:    _Keys_AuthProvision_Handler,
+		},
+		{
+			MethodName: "AuthDeprovision",
+			Handler:    _Keys_AuthDeprovision_Handler,
+		},
+		{
+			MethodName: "AuthProvisions",
+			Handler:    _Keys_AuthProvisions_Handler,
+		},
 		{
 			MethodName: "AuthSetup",
 			Handler:    _Keys_AuthSetup_Handler,
@@ -10485,6 +13543,10 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DocumentDelete",
 			Handler:    _Keys_DocumentDelete_Handler,
 		},
+		{
+			MethodName: "DBMigrate",
+			Handler:    _Keys_DBMigrate_Handler,
+		},
 		{
 			MethodName: "AdminSignURL",
 			Handler:    _Keys_AdminSignURL_Handler,
@@ -10650,6 +13712,47 @@ func (m *RPCError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SignRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10674,6 +13777,25 @@ func (m *SignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Format != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Detached {
 		i--
 		if m.Detached {
@@ -10776,6 +13898,18 @@ func (m *SignFileInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Format != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Detached {
 		i--
 		if m.Detached {
@@ -10864,7 +13998,7 @@ func (m *SignFileOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifyRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignDirRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10874,12 +14008,12 @@ func (m *VerifyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignDirRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10888,37 +14022,40 @@ func (m *VerifyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Detached {
-		i--
-		if m.Detached {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Ignore) > 0 {
+		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ignore[iNdEx])
+			copy(dAtA[i:], m.Ignore[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.Ignore[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
-		i--
-		dAtA[i] = 0x58
 	}
-	if m.Armored {
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Signer)))
 		i--
-		if m.Armored {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x2a
+	}
+	if len(m.Out) > 0 {
+		i -= len(m.Out)
+		copy(dAtA[i:], m.Out)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Out)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x12
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Dir)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifyResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignDirResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10928,12 +14065,12 @@ func (m *VerifyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignDirResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10942,29 +14079,29 @@ func (m *VerifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Signer != nil {
-		{
-			size, err := m.Signer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
-		}
+	if m.Files != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Files))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Out) > 0 {
+		i -= len(m.Out)
+		copy(dAtA[i:], m.Out)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Out)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+	if len(m.KID) > 0 {
+		i -= len(m.KID)
+		copy(dAtA[i:], m.KID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifyDetachedRequest) Marshal() (dAtA []byte, err error) {
+func (m *VerifyDirRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10974,12 +14111,12 @@ func (m *VerifyDetachedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifyDetachedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerifyDirRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyDetachedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerifyDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10988,34 +14125,31 @@ func (m *VerifyDetachedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Armored {
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
 		i--
-		if m.Armored {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x7a
 	}
-	if len(m.Sig) > 0 {
-		i -= len(m.Sig)
-		copy(dAtA[i:], m.Sig)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sig)))
+	if len(m.Manifest) > 0 {
+		i -= len(m.Manifest)
+		copy(dAtA[i:], m.Manifest)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Manifest)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Dir)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifyDetachedResponse) Marshal() (dAtA []byte, err error) {
+func (m *VerifyDirResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11025,12 +14159,12 @@ func (m *VerifyDetachedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifyDetachedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerifyDirResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyDetachedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerifyDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11039,6 +14173,33 @@ func (m *VerifyDetachedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Modified) > 0 {
+		for iNdEx := len(m.Modified) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Modified[iNdEx])
+			copy(dAtA[i:], m.Modified[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.Modified[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Signer != nil {
 		{
 			size, err := m.Signer.MarshalToSizedBuffer(dAtA[:i])
@@ -11054,7 +14215,7 @@ func (m *VerifyDetachedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifyInput) Marshal() (dAtA []byte, err error) {
+func (m *VerifyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11064,12 +14225,12 @@ func (m *VerifyInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifyInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerifyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerifyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11078,6 +14239,33 @@ func (m *VerifyInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Detached {
+		i--
+		if m.Detached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Armored {
+		i--
+		if m.Armored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -11088,7 +14276,7 @@ func (m *VerifyInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifyOutput) Marshal() (dAtA []byte, err error) {
+func (m *VerifyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11098,12 +14286,234 @@ func (m *VerifyOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifyOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerifyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Signer != nil {
+		{
+			size, err := m.Signer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDetachedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDetachedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDetachedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Format != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Armored {
+		i--
+		if m.Armored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDetachedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDetachedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDetachedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Signer != nil {
+		{
+			size, err := m.Signer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11158,6 +14568,13 @@ func (m *VerifyFileInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.Armored {
 		i--
 		if m.Armored {
@@ -11255,6 +14672,37 @@ func (m *VerifyDetachedFileInput) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Format != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Armored {
 		i--
 		if m.Armored {
@@ -11306,6 +14754,37 @@ func (m *VerifyDetachedInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Format != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Armored {
 		i--
 		if m.Armored {
@@ -11609,6 +15088,20 @@ func (m *StatementCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x28
 	}
+	if len(m.LinkKID) > 0 {
+		i -= len(m.LinkKID)
+		copy(dAtA[i:], m.LinkKID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.LinkKID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.KID) > 0 {
 		i -= len(m.KID)
 		copy(dAtA[i:], m.KID)
@@ -11777,6 +15270,25 @@ func (m *SignInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Format != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Detached {
 		i--
 		if m.Detached {
@@ -11893,6 +15405,13 @@ func (m *EncryptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Mode != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.Mode))
 		i--
@@ -11992,6 +15511,13 @@ func (m *EncryptFileInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Mode != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.Mode))
 		i--
@@ -12101,6 +15627,13 @@ func (m *EncryptInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Mode != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.Mode))
 		i--
@@ -12200,6 +15733,20 @@ func (m *DecryptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Mode != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.Mode))
 		i--
@@ -12295,6 +15842,20 @@ func (m *DecryptFileInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Mode != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.Mode))
 		i--
@@ -12407,6 +15968,20 @@ func (m *DecryptInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trust) > 0 {
+		i -= len(m.Trust)
+		copy(dAtA[i:], m.Trust)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Trust)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -12589,6 +16164,25 @@ func (m *AuthSetupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
+	if len(m.PIN) > 0 {
+		i -= len(m.PIN)
+		copy(dAtA[i:], m.PIN)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.PIN)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Password) > 0 {
 		i -= len(m.Password)
 		copy(dAtA[i:], m.Password)
@@ -12657,6 +16251,25 @@ func (m *AuthUnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PIN) > 0 {
+		i -= len(m.PIN)
+		copy(dAtA[i:], m.PIN)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.PIN)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Client) > 0 {
 		i -= len(m.Client)
 		copy(dAtA[i:], m.Client)
@@ -12708,6 +16321,281 @@ func (m *AuthUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuthProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PIN {
+		i--
+		if m.PIN {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AAGUID) > 0 {
+		i -= len(m.AAGUID)
+		copy(dAtA[i:], m.AAGUID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.AAGUID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthProvisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthProvisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PIN) > 0 {
+		i -= len(m.PIN)
+		copy(dAtA[i:], m.PIN)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.PIN)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Provision != nil {
+		{
+			size, err := m.Provision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthDeprovisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthDeprovisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthDeprovisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthDeprovisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthDeprovisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthDeprovisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Provisions) > 0 {
+		for iNdEx := len(m.Provisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AuthLockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12786,6 +16674,20 @@ func (m *KeyGenerateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PIN) > 0 {
+		i -= len(m.PIN)
+		copy(dAtA[i:], m.PIN)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.PIN)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Type != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
 		i--
@@ -13519,20 +17421,20 @@ func (m *KeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if len(m.Types) > 0 {
-		dAtA17 := make([]byte, len(m.Types)*10)
-		var j16 int
+		dAtA22 := make([]byte, len(m.Types)*10)
+		var j21 int
 		for _, num := range m.Types {
 			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j16++
+				j21++
 			}
-			dAtA17[j16] = uint8(num)
-			j16++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintKeys(dAtA, i, uint64(j16))
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintKeys(dAtA, i, uint64(j21))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -13927,6 +17829,20 @@ func (m *SecretsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Limit != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Index != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.SortDirection != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.SortDirection))
 		i--
@@ -13939,6 +17855,24 @@ func (m *SecretsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x52
 	}
+	if len(m.Types) > 0 {
+		dAtA27 := make([]byte, len(m.Types)*10)
+		var j26 int
+		for _, num := range m.Types {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintKeys(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Query) > 0 {
 		i -= len(m.Query)
 		copy(dAtA[i:], m.Query)
@@ -13973,6 +17907,13 @@ func (m *SecretsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Total != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.SortDirection != 0 {
 		i = encodeVarintKeys(dAtA, i, uint64(m.SortDirection))
 		i--
@@ -14002,7 +17943,7 @@ func (m *SecretsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ItemRequest) Marshal() (dAtA []byte, err error) {
+func (m *SecretVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14012,12 +17953,61 @@ func (m *ItemRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ItemRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReplacedAt != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.ReplacedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14036,7 +18026,7 @@ func (m *ItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ItemResponse) Marshal() (dAtA []byte, err error) {
+func (m *SecretHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14046,12 +18036,12 @@ func (m *ItemResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ItemResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14060,22 +18050,24 @@ func (m *ItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ItemsRequest) Marshal() (dAtA []byte, err error) {
+func (m *SecretRestoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14085,12 +18077,12 @@ func (m *ItemsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ItemsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretRestoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretRestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14099,17 +18091,22 @@ func (m *ItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Query)))
+	if m.Version != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ItemsResponse) Marshal() (dAtA []byte, err error) {
+func (m *SecretRestoreResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14119,12 +18116,12 @@ func (m *ItemsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretRestoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretRestoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14133,24 +18130,22 @@ func (m *ItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeys(dAtA, i, uint64(size))
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Item) Marshal() (dAtA []byte, err error) {
+func (m *OTPCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14160,12 +18155,12 @@ func (m *Item) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Item) MarshalTo(dAtA []byte) (int, error) {
+func (m *OTPCodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OTPCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14174,13 +18169,6 @@ func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.ID) > 0 {
 		i -= len(m.ID)
 		copy(dAtA[i:], m.ID)
@@ -14191,7 +18179,7 @@ func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RandRequest) Marshal() (dAtA []byte, err error) {
+func (m *OTPCodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14201,12 +18189,12 @@ func (m *RandRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RandRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OTPCodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OTPCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14215,20 +18203,39 @@ func (m *RandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Encoding != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Encoding))
+	if m.Counter != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Counter))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x28
 	}
-	if m.NumBytes != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.NumBytes))
+	if m.Period != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Period))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x20
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RandResponse) Marshal() (dAtA []byte, err error) {
+func (m *SecretsImportRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14238,12 +18245,12 @@ func (m *RandResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RandResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretsImportRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretsImportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14252,6 +18259,21 @@ func (m *RandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Format != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -14262,7 +18284,7 @@ func (m *RandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RandPasswordRequest) Marshal() (dAtA []byte, err error) {
+func (m *SecretsImportResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14272,12 +18294,12 @@ func (m *RandPasswordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RandPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretsImportResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RandPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretsImportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14286,15 +18308,34 @@ func (m *RandPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Length != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Length))
+	if m.Duplicates != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Duplicates))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if m.Imported != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RandPasswordResponse) Marshal() (dAtA []byte, err error) {
+func (m *SecretImport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14304,12 +18345,12 @@ func (m *RandPasswordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RandPasswordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretImport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RandPasswordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretImport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14318,17 +18359,29 @@ func (m *RandPasswordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+	if len(m.Duplicate) > 0 {
+		i -= len(m.Duplicate)
+		copy(dAtA[i:], m.Duplicate)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Duplicate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PullRequest) Marshal() (dAtA []byte, err error) {
+func (m *VaultSyncRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14338,12 +18391,12 @@ func (m *PullRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PullRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VaultSyncRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VaultSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14352,17 +18405,17 @@ func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Identity)))
+	if len(m.KID) > 0 {
+		i -= len(m.KID)
+		copy(dAtA[i:], m.KID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PullResponse) Marshal() (dAtA []byte, err error) {
+func (m *VaultSyncResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14372,12 +18425,12 @@ func (m *PullResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PullResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *VaultSyncResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VaultSyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14386,19 +18439,31 @@ func (m *PullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.KIDs) > 0 {
-		for iNdEx := len(m.KIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KIDs[iNdEx])
-			copy(dAtA[i:], m.KIDs[iNdEx])
-			i = encodeVarintKeys(dAtA, i, uint64(len(m.KIDs[iNdEx])))
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.KID) > 0 {
+		i -= len(m.KID)
+		copy(dAtA[i:], m.KID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PushRequest) Marshal() (dAtA []byte, err error) {
+func (m *VaultConflict) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14408,12 +18473,12 @@ func (m *PushRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PushRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VaultConflict) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VaultConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14422,27 +18487,41 @@ func (m *PushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemoteCheck {
+	if m.Local != nil {
+		{
+			size, err := m.Local.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
 		i--
-		if m.RemoteCheck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x1a
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Identity)))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PushResponse) Marshal() (dAtA []byte, err error) {
+func (m *SSHAgentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14452,12 +18531,12 @@ func (m *PushResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PushResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SSHAgentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SSHAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14466,26 +18545,10 @@ func (m *PushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.URLs) > 0 {
-		for iNdEx := len(m.URLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.URLs[iNdEx])
-			copy(dAtA[i:], m.URLs[iNdEx])
-			i = encodeVarintKeys(dAtA, i, uint64(len(m.URLs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.KID) > 0 {
-		i -= len(m.KID)
-		copy(dAtA[i:], m.KID)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Collection) Marshal() (dAtA []byte, err error) {
+func (m *SSHAgentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14495,12 +18558,12 @@ func (m *Collection) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Collection) MarshalTo(dAtA []byte) (int, error) {
+func (m *SSHAgentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Collection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SSHAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14519,7 +18582,7 @@ func (m *Collection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CollectionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ItemRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14529,12 +18592,12 @@ func (m *CollectionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CollectionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ItemRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CollectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14543,17 +18606,17 @@ func (m *CollectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CollectionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ItemResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14563,12 +18626,12 @@ func (m *CollectionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CollectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ItemResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CollectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14577,24 +18640,22 @@ func (m *CollectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Collections) > 0 {
-		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeys(dAtA, i, uint64(size))
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Document) Marshal() (dAtA []byte, err error) {
+func (m *ItemsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14604,12 +18665,12 @@ func (m *Document) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Document) MarshalTo(dAtA []byte) (int, error) {
+func (m *ItemsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Document) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14618,34 +18679,58 @@ func (m *Document) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.UpdatedAt))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Query)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0xa
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x50
+	return len(dAtA) - i, nil
+}
+
+func (m *ItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	return dAtA[:n], nil
+}
+
+func (m *ItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocumentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *Item) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14655,12 +18740,12 @@ func (m *DocumentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Item) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14669,24 +18754,24 @@ func (m *DocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Prefix) > 0 {
-		i -= len(m.Prefix)
-		copy(dAtA[i:], m.Prefix)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Prefix)))
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Type)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocumentsResponse) Marshal() (dAtA []byte, err error) {
+func (m *RandRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14696,12 +18781,12 @@ func (m *DocumentsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RandRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14710,24 +18795,20 @@ func (m *DocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Documents) > 0 {
-		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeys(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Encoding != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Encoding))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumBytes != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.NumBytes))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocumentDeleteRequest) Marshal() (dAtA []byte, err error) {
+func (m *RandResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14737,12 +18818,12 @@ func (m *DocumentDeleteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocumentDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RandResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocumentDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14751,17 +18832,17 @@ func (m *DocumentDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocumentDeleteResponse) Marshal() (dAtA []byte, err error) {
+func (m *RandPasswordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14771,12 +18852,12 @@ func (m *DocumentDeleteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocumentDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RandPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocumentDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RandPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14785,10 +18866,15 @@ func (m *DocumentDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Length != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *User) Marshal() (dAtA []byte, err error) {
+func (m *RandPasswordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14798,12 +18884,12 @@ func (m *User) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
+func (m *RandPasswordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RandPasswordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14812,74 +18898,17 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.VerifiedAt != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.VerifiedAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Status != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Seq != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.KID) > 0 {
-		i -= len(m.KID)
-		copy(dAtA[i:], m.KID)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserRequest) Marshal() (dAtA []byte, err error) {
+func (m *PullRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14889,12 +18918,12 @@ func (m *UserRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PullRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14903,27 +18932,17 @@ func (m *UserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Local {
-		i--
-		if m.Local {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.KID) > 0 {
-		i -= len(m.KID)
-		copy(dAtA[i:], m.KID)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Identity)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserResponse) Marshal() (dAtA []byte, err error) {
+func (m *PullResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14933,12 +18952,12 @@ func (m *UserResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PullResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14947,22 +18966,19 @@ func (m *UserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
+	if len(m.KIDs) > 0 {
+		for iNdEx := len(m.KIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KIDs[iNdEx])
+			copy(dAtA[i:], m.KIDs[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.KIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserSearchRequest) Marshal() (dAtA []byte, err error) {
+func (m *PushRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14972,12 +18988,12 @@ func (m *UserSearchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PushRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14986,32 +19002,27 @@ func (m *UserSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Local {
+	if m.RemoteCheck {
 		i--
-		if m.Local {
+		if m.RemoteCheck {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.Limit != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Query)))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Identity)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserSearchResponse) Marshal() (dAtA []byte, err error) {
+func (m *PushResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15021,12 +19032,12 @@ func (m *UserSearchResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PushResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15035,24 +19046,26 @@ func (m *UserSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeys(dAtA, i, uint64(size))
-			}
+	if len(m.URLs) > 0 {
+		for iNdEx := len(m.URLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.URLs[iNdEx])
+			copy(dAtA[i:], m.URLs[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.URLs[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.KID) > 0 {
+		i -= len(m.KID)
+		copy(dAtA[i:], m.KID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
+func (m *Collection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15062,12 +19075,12 @@ func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Collection) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Collection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15076,17 +19089,17 @@ func (m *SearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Query)))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
+func (m *CollectionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15096,12 +19109,12 @@ func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CollectionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CollectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15110,24 +19123,17 @@ func (m *SearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeys(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
+func (m *CollectionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15137,12 +19143,12 @@ func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CollectionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CollectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15151,10 +19157,24 @@ func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Collections) > 0 {
+		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WatchEvent) Marshal() (dAtA []byte, err error) {
+func (m *Document) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15164,12 +19184,12 @@ func (m *WatchEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WatchEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *Document) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Document) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15178,22 +19198,34 @@ func (m *WatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Path) > 0 {
 		i -= len(m.Path)
 		copy(dAtA[i:], m.Path)
 		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pref) Marshal() (dAtA []byte, err error) {
+func (m *DocumentsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15203,12 +19235,12 @@ func (m *Pref) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pref) MarshalTo(dAtA []byte) (int, error) {
+func (m *DocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15217,24 +19249,24 @@ func (m *Pref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Value)))
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Prefix)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Key)))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PreferencesRequest) Marshal() (dAtA []byte, err error) {
+func (m *DocumentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15244,12 +19276,12 @@ func (m *PreferencesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PreferencesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15258,10 +19290,24 @@ func (m *PreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PreferencesResponse) Marshal() (dAtA []byte, err error) {
+func (m *DocumentDeleteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15271,12 +19317,12 @@ func (m *PreferencesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DocumentDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DocumentDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15285,24 +19331,17 @@ func (m *PreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Prefs) > 0 {
-		for iNdEx := len(m.Prefs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeys(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PreferenceSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *DocumentDeleteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15312,12 +19351,12 @@ func (m *PreferenceSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PreferenceSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DocumentDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PreferenceSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DocumentDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15326,22 +19365,10 @@ func (m *PreferenceSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Pref != nil {
-		{
-			size, err := m.Pref.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PreferenceSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *DBMigration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15351,12 +19378,12 @@ func (m *PreferenceSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PreferenceSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DBMigration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PreferenceSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DBMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15365,10 +19392,22 @@ func (m *PreferenceSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WormholeInput) Marshal() (dAtA []byte, err error) {
+func (m *DBMigrateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15378,12 +19417,12 @@ func (m *WormholeInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WormholeInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *DBMigrateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WormholeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DBMigrateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15392,50 +19431,20 @@ func (m *WormholeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Type != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Invite) > 0 {
-		i -= len(m.Invite)
-		copy(dAtA[i:], m.Invite)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Invite)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
+	if m.DryRun {
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WormholeOutput) Marshal() (dAtA []byte, err error) {
+func (m *DBMigrateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15445,12 +19454,12 @@ func (m *WormholeOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WormholeOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *DBMigrateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WormholeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DBMigrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15459,27 +19468,34 @@ func (m *WormholeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Status != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Status))
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Latest != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Latest))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
-		}
+	if m.Version != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *User) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15489,12 +19505,12 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15503,69 +19519,60 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DateDisplay) > 0 {
-		i -= len(m.DateDisplay)
-		copy(dAtA[i:], m.DateDisplay)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.DateDisplay)))
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Err)))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0xa2
 	}
-	if len(m.TimeDisplay) > 0 {
-		i -= len(m.TimeDisplay)
-		copy(dAtA[i:], m.TimeDisplay)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.TimeDisplay)))
+	if m.Timestamp != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x60
+	}
+	if m.VerifiedAt != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.VerifiedAt))
 		i--
-		dAtA[i] = 0xfa
+		dAtA[i] = 0x58
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.CreatedAt))
+	if m.Status != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x50
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.URL)))
 		i--
-		dAtA[i] = 0xa8
+		dAtA[i] = 0x32
 	}
-	if m.Content != nil {
-		{
-			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
-		}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Service)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x2a
 	}
-	if m.Type != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+	if m.Seq != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Seq))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
-	if m.Recipient != nil {
-		{
-			size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
-		}
+	if len(m.KID) > 0 {
+		i -= len(m.KID)
+		copy(dAtA[i:], m.KID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Sender != nil {
-		{
-			size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15579,7 +19586,7 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Content) Marshal() (dAtA []byte, err error) {
+func (m *UserRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15589,51 +19596,12 @@ func (m *Content) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Content) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Content) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Type != 0 {
-		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MessagePrepareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MessagePrepareRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessagePrepareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15642,31 +19610,27 @@ func (m *MessagePrepareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Text)))
+	if m.Local {
 		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
+		if m.Local {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+	if len(m.KID) > 0 {
+		i -= len(m.KID)
+		copy(dAtA[i:], m.KID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessagePrepareResponse) Marshal() (dAtA []byte, err error) {
+func (m *UserResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15676,12 +19640,12 @@ func (m *MessagePrepareResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessagePrepareResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessagePrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15690,9 +19654,9 @@ func (m *MessagePrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Message != nil {
+	if m.User != nil {
 		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -15705,7 +19669,7 @@ func (m *MessagePrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageCreateRequest) Marshal() (dAtA []byte, err error) {
+func (m *UserSearchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15715,12 +19679,12 @@ func (m *MessageCreateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserSearchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15729,31 +19693,32 @@ func (m *MessageCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Text)))
+	if m.Local {
 		i--
-		dAtA[i] = 0x5a
+		if m.Local {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
+	if m.Limit != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Query)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageCreateResponse) Marshal() (dAtA []byte, err error) {
+func (m *UserSearchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15763,12 +19728,12 @@ func (m *MessageCreateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserSearchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15777,22 +19742,24 @@ func (m *MessageCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintKeys(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15802,12 +19769,12 @@ func (m *MessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15816,24 +19783,17 @@ func (m *MessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Query)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15843,12 +19803,12 @@ func (m *MessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15857,10 +19817,10 @@ func (m *MessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -15874,7 +19834,7 @@ func (m *MessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminSignURLRequest) Marshal() (dAtA []byte, err error) {
+func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15884,12 +19844,12 @@ func (m *AdminSignURLRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminSignURLRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminSignURLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15898,31 +19858,10 @@ func (m *AdminSignURLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Method) > 0 {
-		i -= len(m.Method)
-		copy(dAtA[i:], m.Method)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Method)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminSignURLResponse) Marshal() (dAtA []byte, err error) {
+func (m *WatchEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15932,12 +19871,12 @@ func (m *AdminSignURLResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminSignURLResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminSignURLResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15946,31 +19885,22 @@ func (m *AdminSignURLResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.CURL) > 0 {
-		i -= len(m.CURL)
-		copy(dAtA[i:], m.CURL)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.CURL)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.URL)))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Auth) > 0 {
-		i -= len(m.Auth)
-		copy(dAtA[i:], m.Auth)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Auth)))
+	if m.Status != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminCheckRequest) Marshal() (dAtA []byte, err error) {
+func (m *Pref) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15980,12 +19910,12 @@ func (m *AdminCheckRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminCheckRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Pref) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Pref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15994,24 +19924,24 @@ func (m *AdminCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.KID) > 0 {
-		i -= len(m.KID)
-		copy(dAtA[i:], m.KID)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Signer)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminCheckResponse) Marshal() (dAtA []byte, err error) {
+func (m *PreferencesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -16021,12 +19951,12 @@ func (m *AdminCheckResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminCheckResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PreferencesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -16038,512 +19968,1065 @@ func (m *AdminCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
-	offset -= sovKeys(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PreferencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RPCError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovKeys(uint64(m.Code))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Prefs) > 0 {
+		for iNdEx := len(m.Prefs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PreferenceSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PreferenceSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreferenceSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Armored {
-		n += 2
-	}
-	if m.Detached {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Pref != nil {
+		{
+			size, err := m.Pref.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PreferenceSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PreferenceSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreferenceSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignFileInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TrustPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.In)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Out)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Armored {
-		n += 2
+	if m.Expire != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Expire))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Detached {
-		n += 2
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintKeys(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignFileOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TrustPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Bytes != 0 {
-		n += 1 + sovKeys(uint64(m.Bytes))
-	}
-	if m.Total != 0 {
-		n += 1 + sovKeys(uint64(m.Total))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TrustPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Armored {
-		n += 2
-	}
-	if m.Detached {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Signer != nil {
-		l = m.Signer.Size()
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TrustPolicySetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VerifyDetachedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Sig)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Armored {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *TrustPolicySetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyDetachedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TrustPolicySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Signer != nil {
-		l = m.Signer.Size()
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TrustPolicySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustPolicySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustPolicySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TrustPolicyRemoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustPolicyRemoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustPolicyRemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Signer != nil {
-		l = m.Signer.Size()
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyFileInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TrustPolicyRemoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustPolicyRemoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustPolicyRemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.In)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Out)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Armored {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyFileOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WormholeInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WormholeInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WormholeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Signer != nil {
-		l = m.Signer.Size()
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Out)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *VerifyDetachedFileInput) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Type != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x60
 	}
-	var l int
-	_ = l
-	l = len(m.In)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Sig)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Armored {
-		n += 2
+	if len(m.Invite) > 0 {
+		i -= len(m.Invite)
+		copy(dAtA[i:], m.Invite)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Invite)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyDetachedInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WormholeOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WormholeOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WormholeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Sig)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Armored {
-		n += 2
+	if m.Status != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Statement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sig)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if len(m.DateDisplay) > 0 {
+		i -= len(m.DateDisplay)
+		copy(dAtA[i:], m.DateDisplay)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.DateDisplay)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if len(m.TimeDisplay) > 0 {
+		i -= len(m.TimeDisplay)
+		copy(dAtA[i:], m.TimeDisplay)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.TimeDisplay)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	if m.Seq != 0 {
-		n += 1 + sovKeys(uint64(m.Seq))
+	if m.CreatedAt != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	l = len(m.Prev)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Revoke != 0 {
-		n += 1 + sovKeys(uint64(m.Revoke))
+	if m.Type != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovKeys(uint64(m.Timestamp))
+	if m.Recipient != nil {
+		{
+			size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.Sender != nil {
+		{
+			size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SigchainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Content) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SigchainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != nil {
-		l = m.Key.Size()
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if len(m.Statements) > 0 {
-		for _, e := range m.Statements {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Content) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Content) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Seq != 0 {
-		n += 1 + sovKeys(uint64(m.Seq))
+	if m.Type != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StatementResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessagePrepareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagePrepareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagePrepareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Statement != nil {
-		l = m.Statement.Size()
-		n += 1 + l + sovKeys(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x5a
 	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessagePrepareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagePrepareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagePrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StatementCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.Local {
-		n += 2
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StatementCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Statement != nil {
-		l = m.Statement.Size()
-		n += 1 + l + sovKeys(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StatementRevokeRequest) Size() (n int) {
+func (m *AdminSignURLRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSignURLRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSignURLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminSignURLResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSignURLResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSignURLResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CURL) > 0 {
+		i -= len(m.CURL)
+		copy(dAtA[i:], m.CURL)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.CURL)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auth) > 0 {
+		i -= len(m.Auth)
+		copy(dAtA[i:], m.Auth)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Auth)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminCheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminCheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.KID) > 0 {
+		i -= len(m.KID)
+		copy(dAtA[i:], m.KID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminCheckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminCheckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeys(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RPCError) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovKeys(uint64(m.Seq))
+	if m.Code != 0 {
+		n += 1 + sovKeys(uint64(m.Code))
 	}
-	l = len(m.KID)
+	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Local {
-		n += 2
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -16551,15 +21034,20 @@ func (m *StatementRevokeRequest) Size() (n int) {
 	return n
 }
 
-func (m *StatementRevokeResponse) Size() (n int) {
+func (m *SignPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Statement != nil {
-		l = m.Statement.Size()
-		n += 1 + l + sovKeys(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovKeys(uint64(m.Threshold))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -16567,7 +21055,7 @@ func (m *StatementRevokeResponse) Size() (n int) {
 	return n
 }
 
-func (m *SignInput) Size() (n int) {
+func (m *SignRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -16577,14 +21065,6 @@ func (m *SignInput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.In)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Out)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
@@ -16595,13 +21075,24 @@ func (m *SignInput) Size() (n int) {
 	if m.Detached {
 		n += 2
 	}
+	if m.Format != 0 {
+		n += 1 + sovKeys(uint64(m.Format))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SignOutput) Size() (n int) {
+func (m *SignResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -16621,61 +21112,72 @@ func (m *SignOutput) Size() (n int) {
 	return n
 }
 
-func (m *EncryptRequest) Size() (n int) {
+func (m *SignFileInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.In)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Out)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.Armored {
 		n += 2
 	}
-	if len(m.Recipients) > 0 {
-		for _, s := range m.Recipients {
-			l = len(s)
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	if m.Detached {
+		n += 2
 	}
-	l = len(m.Sender)
+	if m.Format != 0 {
+		n += 1 + sovKeys(uint64(m.Format))
+	}
+	l = len(m.Sig)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Mode != 0 {
-		n += 1 + sovKeys(uint64(m.Mode))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *EncryptResponse) Size() (n int) {
+func (m *SignFileOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if m.Bytes != 0 {
+		n += 1 + sovKeys(uint64(m.Bytes))
+	}
+	if m.Total != 0 {
+		n += 1 + sovKeys(uint64(m.Total))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *EncryptFileInput) Size() (n int) {
+func (m *SignDirRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.In)
+	l = len(m.Dir)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -16683,39 +21185,38 @@ func (m *EncryptFileInput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Armored {
-		n += 2
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	if len(m.Recipients) > 0 {
-		for _, s := range m.Recipients {
+	if len(m.Ignore) > 0 {
+		for _, s := range m.Ignore {
 			l = len(s)
 			n += 1 + l + sovKeys(uint64(l))
 		}
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Mode != 0 {
-		n += 1 + sovKeys(uint64(m.Mode))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *EncryptFileOutput) Size() (n int) {
+func (m *SignDirResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Bytes != 0 {
-		n += 1 + sovKeys(uint64(m.Bytes))
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Total != 0 {
-		n += 1 + sovKeys(uint64(m.Total))
+	l = len(m.Out)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Files != 0 {
+		n += 1 + sovKeys(uint64(m.Files))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -16723,31 +21224,23 @@ func (m *EncryptFileOutput) Size() (n int) {
 	return n
 }
 
-func (m *EncryptInput) Size() (n int) {
+func (m *VerifyDirRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.Dir)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Armored {
-		n += 2
-	}
-	if len(m.Recipients) > 0 {
-		for _, s := range m.Recipients {
-			l = len(s)
-			n += 1 + l + sovKeys(uint64(l))
-		}
-	}
-	l = len(m.Sender)
+	l = len(m.Manifest)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Mode != 0 {
-		n += 1 + sovKeys(uint64(m.Mode))
+	l = len(m.Trust)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -16755,23 +21248,41 @@ func (m *EncryptInput) Size() (n int) {
 	return n
 }
 
-func (m *EncryptOutput) Size() (n int) {
+func (m *VerifyDirResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
+	if m.Signer != nil {
+		l = m.Signer.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if len(m.Modified) > 0 {
+		for _, s := range m.Modified {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DecryptRequest) Size() (n int) {
+func (m *VerifyRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -16784,8 +21295,12 @@ func (m *DecryptRequest) Size() (n int) {
 	if m.Armored {
 		n += 2
 	}
-	if m.Mode != 0 {
-		n += 1 + sovKeys(uint64(m.Mode))
+	if m.Detached {
+		n += 2
+	}
+	l = len(m.Trust)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -16793,7 +21308,7 @@ func (m *DecryptRequest) Size() (n int) {
 	return n
 }
 
-func (m *DecryptResponse) Size() (n int) {
+func (m *VerifyResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -16803,8 +21318,8 @@ func (m *DecryptResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Sender != nil {
-		l = m.Sender.Size()
+	if m.Signer != nil {
+		l = m.Signer.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -16813,75 +21328,67 @@ func (m *DecryptResponse) Size() (n int) {
 	return n
 }
 
-func (m *DecryptFileInput) Size() (n int) {
+func (m *VerifyDetachedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.In)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Out)
+	l = len(m.Sig)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.Armored {
 		n += 2
 	}
-	if m.Mode != 0 {
-		n += 1 + sovKeys(uint64(m.Mode))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Format != 0 {
+		n += 1 + sovKeys(uint64(m.Format))
 	}
-	return n
-}
-
-func (m *DecryptFileOutput) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Sender != nil {
-		l = m.Sender.Size()
+	if m.Policy != nil {
+		l = m.Policy.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Out)
+	l = len(m.Trust)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Bytes != 0 {
-		n += 1 + sovKeys(uint64(m.Bytes))
-	}
-	if m.Total != 0 {
-		n += 1 + sovKeys(uint64(m.Total))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DecryptInput) Size() (n int) {
+func (m *VerifyDetachedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
+	if m.Signer != nil {
+		l = m.Signer.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DecryptOutput) Size() (n int) {
+func (m *VerifyInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -16891,8 +21398,8 @@ func (m *DecryptOutput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Sender != nil {
-		l = m.Sender.Size()
+	l = len(m.Trust)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -16901,41 +21408,46 @@ func (m *DecryptOutput) Size() (n int) {
 	return n
 }
 
-func (m *RuntimeStatusRequest) Size() (n int) {
+func (m *VerifyOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Signer != nil {
+		l = m.Signer.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RuntimeStatusResponse) Size() (n int) {
+func (m *VerifyFileInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.AppName)
+	l = len(m.In)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Exe)
+	l = len(m.Out)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.AuthSetupNeeded {
+	if m.Armored {
 		n += 2
 	}
-	if m.FIDO2 {
-		n += 2
+	l = len(m.Trust)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -16943,17 +21455,17 @@ func (m *RuntimeStatusResponse) Size() (n int) {
 	return n
 }
 
-func (m *AuthSetupRequest) Size() (n int) {
+func (m *VerifyFileOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Password)
-	if l > 0 {
+	if m.Signer != nil {
+		l = m.Signer.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Client)
+	l = len(m.Out)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -16963,13 +21475,35 @@ func (m *AuthSetupRequest) Size() (n int) {
 	return n
 }
 
-func (m *AuthSetupResponse) Size() (n int) {
+func (m *VerifyDetachedFileInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AuthToken)
+	l = len(m.In)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Armored {
+		n += 2
+	}
+	if m.Format != 0 {
+		n += 1 + sovKeys(uint64(m.Format))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Trust)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -16979,17 +21513,35 @@ func (m *AuthSetupResponse) Size() (n int) {
 	return n
 }
 
-func (m *AuthUnlockRequest) Size() (n int) {
+func (m *VerifyDetachedInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Password)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Client)
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Armored {
+		n += 2
+	}
+	if m.Format != 0 {
+		n += 1 + sovKeys(uint64(m.Format))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Trust)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -16999,13 +21551,38 @@ func (m *AuthUnlockRequest) Size() (n int) {
 	return n
 }
 
-func (m *AuthUnlockResponse) Size() (n int) {
+func (m *Statement) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AuthToken)
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovKeys(uint64(m.Seq))
+	}
+	l = len(m.Prev)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Revoke != 0 {
+		n += 1 + sovKeys(uint64(m.Revoke))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovKeys(uint64(m.Timestamp))
+	}
+	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17015,38 +21592,56 @@ func (m *AuthUnlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *AuthLockRequest) Size() (n int) {
+func (m *SigchainRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AuthLockResponse) Size() (n int) {
+func (m *SigchainResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if len(m.Statements) > 0 {
+		for _, e := range m.Statements {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *KeyGenerateRequest) Size() (n int) {
+func (m *StatementRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovKeys(uint64(m.Type))
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovKeys(uint64(m.Seq))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17054,14 +21649,14 @@ func (m *KeyGenerateRequest) Size() (n int) {
 	return n
 }
 
-func (m *KeyGenerateResponse) Size() (n int) {
+func (m *StatementResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.KID)
-	if l > 0 {
+	if m.Statement != nil {
+		l = m.Statement.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -17070,34 +21665,45 @@ func (m *KeyGenerateResponse) Size() (n int) {
 	return n
 }
 
-func (m *UserServiceRequest) Size() (n int) {
+func (m *StatementCreateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Service)
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.LinkKID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if m.Local {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *UserServiceResponse) Size() (n int) {
+func (m *StatementCreateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Service)
-	if l > 0 {
+	if m.Statement != nil {
+		l = m.Statement.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -17106,23 +21712,21 @@ func (m *UserServiceResponse) Size() (n int) {
 	return n
 }
 
-func (m *UserSignRequest) Size() (n int) {
+func (m *StatementRevokeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.Seq != 0 {
+		n += 1 + sovKeys(uint64(m.Seq))
 	}
-	l = len(m.Service)
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.Local {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17130,18 +21734,14 @@ func (m *UserSignRequest) Size() (n int) {
 	return n
 }
 
-func (m *UserSignResponse) Size() (n int) {
+func (m *StatementRevokeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
+	if m.Statement != nil {
+		l = m.Statement.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -17150,49 +21750,63 @@ func (m *UserSignResponse) Size() (n int) {
 	return n
 }
 
-func (m *UserAddRequest) Size() (n int) {
+func (m *SignInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.KID)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Service)
+	l = len(m.In)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.Out)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.URL)
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Local {
+	if m.Armored {
+		n += 2
+	}
+	if m.Detached {
 		n += 2
 	}
+	if m.Format != 0 {
+		n += 1 + sovKeys(uint64(m.Format))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *UserAddResponse) Size() (n int) {
+func (m *SignOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.User != nil {
-		l = m.User.Size()
+	l = len(m.Data)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Statement != nil {
-		l = m.Statement.Size()
+	l = len(m.KID)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -17201,22 +21815,35 @@ func (m *UserAddResponse) Size() (n int) {
 	return n
 }
 
-func (m *KeyExportRequest) Size() (n int) {
+func (m *EncryptRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.KID)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Password)
+	if m.Armored {
+		n += 2
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovKeys(uint64(m.Type))
+	if m.Mode != 0 {
+		n += 1 + sovKeys(uint64(m.Mode))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17224,13 +21851,13 @@ func (m *KeyExportRequest) Size() (n int) {
 	return n
 }
 
-func (m *KeyExportResponse) Size() (n int) {
+func (m *EncryptResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Export)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17240,7 +21867,7 @@ func (m *KeyExportResponse) Size() (n int) {
 	return n
 }
 
-func (m *KeyImportRequest) Size() (n int) {
+func (m *EncryptFileInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -17250,6 +21877,26 @@ func (m *KeyImportRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	l = len(m.Out)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Armored {
+		n += 2
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovKeys(uint64(m.Mode))
+	}
 	l = len(m.Password)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
@@ -17260,15 +21907,17 @@ func (m *KeyImportRequest) Size() (n int) {
 	return n
 }
 
-func (m *KeyImportResponse) Size() (n int) {
+func (m *EncryptFileOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.Bytes != 0 {
+		n += 1 + sovKeys(uint64(m.Bytes))
+	}
+	if m.Total != 0 {
+		n += 1 + sovKeys(uint64(m.Total))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17276,13 +21925,33 @@ func (m *KeyImportResponse) Size() (n int) {
 	return n
 }
 
-func (m *KeyRemoveRequest) Size() (n int) {
+func (m *EncryptInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.KID)
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Armored {
+		n += 2
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovKeys(uint64(m.Mode))
+	}
+	l = len(m.Password)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17292,43 +21961,45 @@ func (m *KeyRemoveRequest) Size() (n int) {
 	return n
 }
 
-func (m *KeyRemoveResponse) Size() (n int) {
+func (m *EncryptOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Key) Size() (n int) {
+func (m *DecryptRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovKeys(uint64(m.Type))
-	}
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Saved {
+	if m.Armored {
 		n += 2
 	}
-	if m.SigchainLength != 0 {
-		n += 2 + sovKeys(uint64(m.SigchainLength))
+	if m.Mode != 0 {
+		n += 1 + sovKeys(uint64(m.Mode))
 	}
-	if m.SigchainUpdatedAt != 0 {
-		n += 2 + sovKeys(uint64(m.SigchainUpdatedAt))
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Trust)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17336,18 +22007,19 @@ func (m *Key) Size() (n int) {
 	return n
 }
 
-func (m *KeyRequest) Size() (n int) {
+func (m *DecryptResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Identity)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Update {
-		n += 2
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17355,45 +22027,33 @@ func (m *KeyRequest) Size() (n int) {
 	return n
 }
 
-func (m *KeyResponse) Size() (n int) {
+func (m *DecryptFileInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Key != nil {
-		l = m.Key.Size()
+	l = len(m.In)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *KeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Query)
+	l = len(m.Out)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if len(m.Types) > 0 {
-		l = 0
-		for _, e := range m.Types {
-			l += sovKeys(uint64(e))
-		}
-		n += 1 + sovKeys(uint64(l)) + l
+	if m.Armored {
+		n += 2
 	}
-	l = len(m.SortField)
+	if m.Mode != 0 {
+		n += 1 + sovKeys(uint64(m.Mode))
+	}
+	l = len(m.Password)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.SortDirection != 0 {
-		n += 1 + sovKeys(uint64(m.SortDirection))
+	l = len(m.Trust)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17401,24 +22061,25 @@ func (m *KeysRequest) Size() (n int) {
 	return n
 }
 
-func (m *KeysResponse) Size() (n int) {
+func (m *DecryptFileOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.SortField)
+	l = len(m.Out)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.SortDirection != 0 {
-		n += 1 + sovKeys(uint64(m.SortDirection))
+	if m.Bytes != 0 {
+		n += 1 + sovKeys(uint64(m.Bytes))
+	}
+	if m.Total != 0 {
+		n += 1 + sovKeys(uint64(m.Total))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17426,24 +22087,13 @@ func (m *KeysResponse) Size() (n int) {
 	return n
 }
 
-func (m *Secret) Size() (n int) {
+func (m *DecryptInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovKeys(uint64(m.Type))
-	}
-	l = len(m.Username)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17451,19 +22101,9 @@ func (m *Secret) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 2 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Notes)
+	l = len(m.Trust)
 	if l > 0 {
-		n += 2 + l + sovKeys(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 2 + sovKeys(uint64(m.CreatedAt))
-	}
-	if m.UpdatedAt != 0 {
-		n += 2 + sovKeys(uint64(m.UpdatedAt))
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17471,62 +22111,91 @@ func (m *Secret) Size() (n int) {
 	return n
 }
 
-func (m *SecretRequest) Size() (n int) {
+func (m *DecryptOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SecretResponse) Size() (n int) {
+func (m *RuntimeStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SecretSaveRequest) Size() (n int) {
+func (m *RuntimeStatusResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.AppName)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Exe)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if m.AuthSetupNeeded {
+		n += 2
+	}
+	if m.FIDO2 {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SecretSaveResponse) Size() (n int) {
+func (m *AuthSetupRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.PIN)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -17535,13 +22204,13 @@ func (m *SecretSaveResponse) Size() (n int) {
 	return n
 }
 
-func (m *SecretRemoveRequest) Size() (n int) {
+func (m *AuthSetupResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
+	l = len(m.AuthToken)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17551,59 +22220,75 @@ func (m *SecretRemoveRequest) Size() (n int) {
 	return n
 }
 
-func (m *SecretRemoveResponse) Size() (n int) {
+func (m *AuthUnlockRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.PIN)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SecretsRequest) Size() (n int) {
+func (m *AuthUnlockResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.SortField)
+	l = len(m.AuthToken)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.SortDirection != 0 {
-		n += 1 + sovKeys(uint64(m.SortDirection))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SecretsResponse) Size() (n int) {
+func (m *AuthProvision) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Secrets) > 0 {
-		for _, e := range m.Secrets {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.SortField)
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	l = len(m.AAGUID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.SortDirection != 0 {
-		n += 1 + sovKeys(uint64(m.SortDirection))
+	if m.PIN {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovKeys(uint64(m.CreatedAt))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17611,13 +22296,20 @@ func (m *SecretsResponse) Size() (n int) {
 	return n
 }
 
-func (m *ItemRequest) Size() (n int) {
+func (m *AuthProvisionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.PIN)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17627,14 +22319,14 @@ func (m *ItemRequest) Size() (n int) {
 	return n
 }
 
-func (m *ItemResponse) Size() (n int) {
+func (m *AuthProvisionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
+	if m.Provision != nil {
+		l = m.Provision.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -17643,13 +22335,13 @@ func (m *ItemResponse) Size() (n int) {
 	return n
 }
 
-func (m *ItemsRequest) Size() (n int) {
+func (m *AuthDeprovisionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Query)
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17659,55 +22351,41 @@ func (m *ItemsRequest) Size() (n int) {
 	return n
 }
 
-func (m *ItemsResponse) Size() (n int) {
+func (m *AuthDeprovisionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Item) Size() (n int) {
+func (m *AuthProvisionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RandRequest) Size() (n int) {
+func (m *AuthProvisionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NumBytes != 0 {
-		n += 1 + sovKeys(uint64(m.NumBytes))
-	}
-	if m.Encoding != 0 {
-		n += 1 + sovKeys(uint64(m.Encoding))
+	if len(m.Provisions) > 0 {
+		for _, e := range m.Provisions {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17715,44 +22393,44 @@ func (m *RandRequest) Size() (n int) {
 	return n
 }
 
-func (m *RandResponse) Size() (n int) {
+func (m *AuthLockRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RandPasswordRequest) Size() (n int) {
+func (m *AuthLockResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Length != 0 {
-		n += 1 + sovKeys(uint64(m.Length))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RandPasswordResponse) Size() (n int) {
+func (m *KeyGenerateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Password)
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.PIN)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17762,13 +22440,13 @@ func (m *RandPasswordResponse) Size() (n int) {
 	return n
 }
 
-func (m *PullRequest) Size() (n int) {
+func (m *KeyGenerateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Identity)
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17778,17 +22456,19 @@ func (m *PullRequest) Size() (n int) {
 	return n
 }
 
-func (m *PullResponse) Size() (n int) {
+func (m *UserServiceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.KIDs) > 0 {
-		for _, s := range m.KIDs {
-			l = len(s)
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17796,26 +22476,23 @@ func (m *PullResponse) Size() (n int) {
 	return n
 }
 
-func (m *PushRequest) Size() (n int) {
+func (m *UserServiceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Identity)
+	l = len(m.Service)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.RemoteCheck {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *PushResponse) Size() (n int) {
+func (m *UserSignRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -17825,11 +22502,13 @@ func (m *PushResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if len(m.URLs) > 0 {
-		for _, s := range m.URLs {
-			l = len(s)
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17837,13 +22516,17 @@ func (m *PushResponse) Size() (n int) {
 	return n
 }
 
-func (m *Collection) Size() (n int) {
+func (m *UserSignResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17853,33 +22536,50 @@ func (m *Collection) Size() (n int) {
 	return n
 }
 
-func (m *CollectionsRequest) Size() (n int) {
+func (m *UserAddRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.URL)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if m.Local {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *CollectionsResponse) Size() (n int) {
+func (m *UserAddResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Collections) > 0 {
-		for _, e := range m.Collections {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Statement != nil {
+		l = m.Statement.Size()
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17887,25 +22587,38 @@ func (m *CollectionsResponse) Size() (n int) {
 	return n
 }
 
-func (m *Document) Size() (n int) {
+func (m *KeyExportRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Value)
+	l = len(m.Password)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovKeys(uint64(m.CreatedAt))
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
 	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovKeys(uint64(m.UpdatedAt))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeyExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Export)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17913,17 +22626,17 @@ func (m *Document) Size() (n int) {
 	return n
 }
 
-func (m *DocumentsRequest) Size() (n int) {
+func (m *KeyImportRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.In)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Prefix)
+	l = len(m.Password)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17933,17 +22646,15 @@ func (m *DocumentsRequest) Size() (n int) {
 	return n
 }
 
-func (m *DocumentsResponse) Size() (n int) {
+func (m *KeyImportResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Documents) > 0 {
-		for _, e := range m.Documents {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -17951,13 +22662,13 @@ func (m *DocumentsResponse) Size() (n int) {
 	return n
 }
 
-func (m *DocumentDeleteRequest) Size() (n int) {
+func (m *KeyRemoveRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -17967,7 +22678,7 @@ func (m *DocumentDeleteRequest) Size() (n int) {
 	return n
 }
 
-func (m *DocumentDeleteResponse) Size() (n int) {
+func (m *KeyRemoveResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -17979,7 +22690,7 @@ func (m *DocumentDeleteResponse) Size() (n int) {
 	return n
 }
 
-func (m *User) Size() (n int) {
+func (m *Key) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -17989,37 +22700,21 @@ func (m *User) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.KID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.Seq != 0 {
-		n += 1 + sovKeys(uint64(m.Seq))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
 	}
-	l = len(m.URL)
-	if l > 0 {
+	if m.User != nil {
+		l = m.User.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovKeys(uint64(m.Status))
-	}
-	if m.VerifiedAt != 0 {
-		n += 1 + sovKeys(uint64(m.VerifiedAt))
+	if m.Saved {
+		n += 2
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovKeys(uint64(m.Timestamp))
+	if m.SigchainLength != 0 {
+		n += 2 + sovKeys(uint64(m.SigchainLength))
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 2 + l + sovKeys(uint64(l))
+	if m.SigchainUpdatedAt != 0 {
+		n += 2 + sovKeys(uint64(m.SigchainUpdatedAt))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -18027,17 +22722,17 @@ func (m *User) Size() (n int) {
 	return n
 }
 
-func (m *UserRequest) Size() (n int) {
+func (m *KeyRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.KID)
+	l = len(m.Identity)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Local {
+	if m.Update {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -18046,14 +22741,14 @@ func (m *UserRequest) Size() (n int) {
 	return n
 }
 
-func (m *UserResponse) Size() (n int) {
+func (m *KeyResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.User != nil {
-		l = m.User.Size()
+	if m.Key != nil {
+		l = m.Key.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -18062,7 +22757,7 @@ func (m *UserResponse) Size() (n int) {
 	return n
 }
 
-func (m *UserSearchRequest) Size() (n int) {
+func (m *KeysRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -18072,11 +22767,19 @@ func (m *UserSearchRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Limit != 0 {
-		n += 1 + sovKeys(uint64(m.Limit))
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovKeys(uint64(e))
+		}
+		n += 1 + sovKeys(uint64(l)) + l
 	}
-	if m.Local {
-		n += 2
+	l = len(m.SortField)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.SortDirection != 0 {
+		n += 1 + sovKeys(uint64(m.SortDirection))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -18084,80 +22787,83 @@ func (m *UserSearchRequest) Size() (n int) {
 	return n
 }
 
-func (m *UserSearchResponse) Size() (n int) {
+func (m *KeysResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Users) > 0 {
-		for _, e := range m.Users {
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
 			l = e.Size()
 			n += 1 + l + sovKeys(uint64(l))
 		}
 	}
+	l = len(m.SortField)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.SortDirection != 0 {
+		n += 1 + sovKeys(uint64(m.SortDirection))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SearchRequest) Size() (n int) {
+func (m *Secret) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Query)
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	return n
-}
-
-func (m *SearchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
 	}
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	return n
-}
-
-func (m *WatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.URL)
+	if l > 0 {
+		n += 2 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 2 + l + sovKeys(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 2 + sovKeys(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 2 + sovKeys(uint64(m.UpdatedAt))
 	}
-	var l int
-	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *WatchEvent) Size() (n int) {
+func (m *SecretRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovKeys(uint64(m.Status))
-	}
-	l = len(m.Path)
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -18167,18 +22873,14 @@ func (m *WatchEvent) Size() (n int) {
 	return n
 }
 
-func (m *Pref) Size() (n int) {
+func (m *SecretResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
+	if m.Secret != nil {
+		l = m.Secret.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -18187,29 +22889,31 @@ func (m *Pref) Size() (n int) {
 	return n
 }
 
-func (m *PreferencesRequest) Size() (n int) {
+func (m *SecretSaveRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *PreferencesResponse) Size() (n int) {
+func (m *SecretSaveResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Prefs) > 0 {
-		for _, e := range m.Prefs {
-			l = e.Size()
-			n += 1 + l + sovKeys(uint64(l))
-		}
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -18217,14 +22921,14 @@ func (m *PreferencesResponse) Size() (n int) {
 	return n
 }
 
-func (m *PreferenceSetRequest) Size() (n int) {
+func (m *SecretRemoveRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pref != nil {
-		l = m.Pref.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -18233,7 +22937,7 @@ func (m *PreferenceSetRequest) Size() (n int) {
 	return n
 }
 
-func (m *PreferenceSetResponse) Size() (n int) {
+func (m *SecretRemoveResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -18245,34 +22949,63 @@ func (m *PreferenceSetResponse) Size() (n int) {
 	return n
 }
 
-func (m *WormholeInput) Size() (n int) {
+func (m *SecretsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
+	l = len(m.Query)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovKeys(uint64(e))
+		}
+		n += 1 + sovKeys(uint64(l)) + l
 	}
-	l = len(m.Invite)
+	l = len(m.SortField)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
+	if m.SortDirection != 0 {
+		n += 1 + sovKeys(uint64(m.SortDirection))
 	}
-	l = len(m.Data)
+	if m.Index != 0 {
+		n += 2 + sovKeys(uint64(m.Index))
+	}
+	if m.Limit != 0 {
+		n += 2 + sovKeys(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	l = len(m.SortField)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovKeys(uint64(m.Type))
+	if m.SortDirection != 0 {
+		n += 1 + sovKeys(uint64(m.SortDirection))
+	}
+	if m.Total != 0 {
+		n += 2 + sovKeys(uint64(m.Total))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -18280,18 +23013,21 @@ func (m *WormholeInput) Size() (n int) {
 	return n
 }
 
-func (m *WormholeOutput) Size() (n int) {
+func (m *SecretVersion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Message != nil {
-		l = m.Message.Size()
+	if m.Version != 0 {
+		n += 1 + sovKeys(uint64(m.Version))
+	}
+	if m.Secret != nil {
+		l = m.Secret.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovKeys(uint64(m.Status))
+	if m.ReplacedAt != 0 {
+		n += 1 + sovKeys(uint64(m.ReplacedAt))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -18299,7 +23035,7 @@ func (m *WormholeOutput) Size() (n int) {
 	return n
 }
 
-func (m *Message) Size() (n int) {
+func (m *SecretHistoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -18309,31 +23045,103 @@ func (m *Message) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Sender != nil {
-		l = m.Sender.Size()
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretRestoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Recipient != nil {
-		l = m.Recipient.Size()
+	if m.Version != 0 {
+		n += 1 + sovKeys(uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretRestoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovKeys(uint64(m.Type))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.Content != nil {
-		l = m.Content.Size()
+	return n
+}
+
+func (m *OTPCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.CreatedAt != 0 {
-		n += 2 + sovKeys(uint64(m.CreatedAt))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	l = len(m.TimeDisplay)
+	return n
+}
+
+func (m *OTPCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
 	if l > 0 {
-		n += 2 + l + sovKeys(uint64(l))
+		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.DateDisplay)
+	l = len(m.Type)
 	if l > 0 {
-		n += 2 + l + sovKeys(uint64(l))
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovKeys(uint64(m.Remaining))
+	}
+	if m.Period != 0 {
+		n += 1 + sovKeys(uint64(m.Period))
+	}
+	if m.Counter != 0 {
+		n += 1 + sovKeys(uint64(m.Counter))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -18341,7 +23149,7 @@ func (m *Message) Size() (n int) {
 	return n
 }
 
-func (m *Content) Size() (n int) {
+func (m *SecretsImportRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -18351,8 +23159,11 @@ func (m *Content) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovKeys(uint64(m.Type))
+	if m.Format != 0 {
+		n += 1 + sovKeys(uint64(m.Format))
+	}
+	if m.DryRun {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -18360,38 +23171,104 @@ func (m *Content) Size() (n int) {
 	return n
 }
 
-func (m *MessagePrepareRequest) Size() (n int) {
+func (m *SecretsImportResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.Imported != 0 {
+		n += 1 + sovKeys(uint64(m.Imported))
+	}
+	if m.Duplicates != 0 {
+		n += 1 + sovKeys(uint64(m.Duplicates))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretImport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Duplicate)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Recipient)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VaultSyncRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Text)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VaultSyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MessagePrepareResponse) Size() (n int) {
+func (m *VaultConflict) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Message != nil {
-		l = m.Message.Size()
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Local != nil {
+		l = m.Local.Size()
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -18400,21 +23277,73 @@ func (m *MessagePrepareResponse) Size() (n int) {
 	return n
 }
 
-func (m *MessageCreateRequest) Size() (n int) {
+func (m *SSHAgentRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Recipient)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ItemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Text)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ItemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ItemsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -18424,14 +23353,36 @@ func (m *MessageCreateRequest) Size() (n int) {
 	return n
 }
 
-func (m *MessageCreateResponse) Size() (n int) {
+func (m *ItemsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Message != nil {
-		l = m.Message.Size()
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Item) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -18440,17 +23391,62 @@ func (m *MessageCreateResponse) Size() (n int) {
 	return n
 }
 
-func (m *MessagesRequest) Size() (n int) {
+func (m *RandRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
+	if m.NumBytes != 0 {
+		n += 1 + sovKeys(uint64(m.NumBytes))
+	}
+	if m.Encoding != 0 {
+		n += 1 + sovKeys(uint64(m.Encoding))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Recipient)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RandPasswordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovKeys(uint64(m.Length))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RandPasswordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Password)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -18460,15 +23456,31 @@ func (m *MessagesRequest) Size() (n int) {
 	return n
 }
 
-func (m *MessagesResponse) Size() (n int) {
+func (m *PullRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KIDs) > 0 {
+		for _, s := range m.KIDs {
+			l = len(s)
 			n += 1 + l + sovKeys(uint64(l))
 		}
 	}
@@ -18478,21 +23490,54 @@ func (m *MessagesResponse) Size() (n int) {
 	return n
 }
 
-func (m *AdminSignURLRequest) Size() (n int) {
+func (m *PushRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Signer)
+	l = len(m.Identity)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.Method)
+	if m.RemoteCheck {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KID)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.URL)
+	if len(m.URLs) > 0 {
+		for _, s := range m.URLs {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Collection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
@@ -18502,69 +23547,5745 @@ func (m *AdminSignURLRequest) Size() (n int) {
 	return n
 }
 
-func (m *AdminSignURLResponse) Size() (n int) {
+func (m *CollectionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Auth)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.URL)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CollectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collections) > 0 {
+		for _, e := range m.Collections {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Document) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.CURL)
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovKeys(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovKeys(uint64(m.UpdatedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AdminCheckRequest) Size() (n int) {
+func (m *DocumentsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Signer)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
-	l = len(m.KID)
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovKeys(uint64(m.Version))
+	}
+	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *DBMigrateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBMigrateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovKeys(uint64(m.Version))
+	}
+	if m.Latest != 0 {
+		n += 1 + sovKeys(uint64(m.Latest))
+	}
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovKeys(uint64(m.Seq))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovKeys(uint64(m.Status))
+	}
+	if m.VerifiedAt != 0 {
+		n += 1 + sovKeys(uint64(m.VerifiedAt))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovKeys(uint64(m.Timestamp))
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 2 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Local {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovKeys(uint64(m.Limit))
+	}
+	if m.Local {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovKeys(uint64(m.Status))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Pref) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PreferencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PreferencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prefs) > 0 {
+		for _, e := range m.Prefs {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PreferenceSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pref != nil {
+		l = m.Pref.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PreferenceSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.Expire != 0 {
+		n += 1 + sovKeys(uint64(m.Expire))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustPolicySetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustPolicySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustPolicyRemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustPolicyRemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WormholeInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Invite)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WormholeOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovKeys(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Recipient != nil {
+		l = m.Recipient.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 2 + sovKeys(uint64(m.CreatedAt))
+	}
+	l = len(m.TimeDisplay)
+	if l > 0 {
+		n += 2 + l + sovKeys(uint64(l))
+	}
+	l = len(m.DateDisplay)
+	if l > 0 {
+		n += 2 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Content) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeys(uint64(m.Type))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessagePrepareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessagePrepareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessageCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessageCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminSignURLRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminSignURLResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auth)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.CURL)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminCheckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.KID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminCheckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovKeys(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeys(x uint64) (n int) {
+	return sovKeys(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RPCError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RPCError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RPCError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Detached = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= SignFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignFileInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignFileInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.In = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Detached = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= SignFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignFileOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignFileOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignDirRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignDirRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ignore = append(m.Ignore, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignDirResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignDirResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			m.Files = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Files |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDirRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDirRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDirResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDirResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signer == nil {
+				m.Signer = &Key{}
+			}
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modified = append(m.Modified, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Detached = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signer == nil {
+				m.Signer = &Key{}
+			}
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDetachedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDetachedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDetachedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= SignFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &SignPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDetachedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDetachedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDetachedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signer == nil {
+				m.Signer = &Key{}
+			}
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &Key{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signer == nil {
+				m.Signer = &Key{}
+			}
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyFileInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyFileInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.In = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyFileOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyFileOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signer == nil {
+				m.Signer = &Key{}
+			}
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDetachedFileInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDetachedFileInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDetachedFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.In = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= SignFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &SignPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDetachedInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDetachedInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDetachedInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= SignFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &SignPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Statement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Statement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Statement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prev", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prev = append(m.Prev[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prev == nil {
+				m.Prev = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+			}
+			m.Revoke = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revoke |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigchainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigchainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigchainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigchainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigchainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigchainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &Key{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statements = append(m.Statements, &Statement{})
+			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Statement == nil {
+				m.Statement = &Statement{}
+			}
+			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkKID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkKID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Local = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Statement == nil {
+				m.Statement = &Statement{}
+			}
+			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementRevokeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementRevokeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Local = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementRevokeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementRevokeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Statement == nil {
+				m.Statement = &Statement{}
+			}
+			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.In = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Detached = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= SignFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *AdminCheckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovKeys(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozKeys(x uint64) (n int) {
-	return sovKeys(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RPCError) Unmarshal(dAtA []byte) error {
+func (m *SignOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18587,36 +29308,17 @@ func (m *RPCError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RPCError: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RPCError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -18626,27 +29328,29 @@ func (m *RPCError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18674,7 +29378,7 @@ func (m *RPCError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18701,7 +29405,7 @@ func (m *RPCError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignRequest) Unmarshal(dAtA []byte) error {
+func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18724,10 +29428,10 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18764,9 +29468,29 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18794,13 +29518,13 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -18810,17 +29534,29 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Armored = bool(v != 0)
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var v int
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -18830,12 +29566,43 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Mode |= EncryptMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Detached = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -18861,7 +29628,7 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignResponse) Unmarshal(dAtA []byte) error {
+func (m *EncryptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18884,10 +29651,10 @@ func (m *SignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18924,9 +29691,230 @@ func (m *SignResponse) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptFileInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.In = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= EncryptMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18954,8 +29942,100 @@ func (m *SignResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptFileOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptFileOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -18981,7 +30061,7 @@ func (m *SignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignFileInput) Unmarshal(dAtA []byte) error {
+func (m *EncryptInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19004,17 +30084,17 @@ func (m *SignFileInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignFileInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19024,27 +30104,49 @@ func (m *SignFileInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.In = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Armored = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19072,11 +30174,11 @@ func (m *SignFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Out = string(dAtA[iNdEx:postIndex])
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19104,13 +30206,13 @@ func (m *SignFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var v int
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19120,17 +30222,16 @@ func (m *SignFileInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Mode |= EncryptMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Armored = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19140,12 +30241,24 @@ func (m *SignFileInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Detached = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -19171,7 +30284,7 @@ func (m *SignFileInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignFileOutput) Unmarshal(dAtA []byte) error {
+func (m *EncryptOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19194,17 +30307,17 @@ func (m *SignFileOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignFileOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19214,62 +30327,26 @@ func (m *SignFileOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
-			}
-			m.Bytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Bytes |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
-			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -19295,7 +30372,7 @@ func (m *SignFileOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyRequest) Unmarshal(dAtA []byte) error {
+func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19318,10 +30395,10 @@ func (m *VerifyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19378,11 +30455,11 @@ func (m *VerifyRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Armored = bool(v != 0)
-		case 11:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var v int
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19392,12 +30469,75 @@ func (m *VerifyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Mode |= EncryptMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Detached = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -19423,7 +30563,7 @@ func (m *VerifyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyResponse) Unmarshal(dAtA []byte) error {
+func (m *DecryptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19446,10 +30586,10 @@ func (m *VerifyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19488,7 +30628,7 @@ func (m *VerifyResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19515,10 +30655,10 @@ func (m *VerifyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signer == nil {
-				m.Signer = &Key{}
+			if m.Sender == nil {
+				m.Sender = &Key{}
 			}
-			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19547,7 +30687,7 @@ func (m *VerifyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyDetachedRequest) Unmarshal(dAtA []byte) error {
+func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19570,17 +30710,17 @@ func (m *VerifyDetachedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyDetachedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptFileInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyDetachedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19590,31 +30730,29 @@ func (m *VerifyDetachedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.In = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19624,25 +30762,23 @@ func (m *VerifyDetachedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sig == nil {
-				m.Sig = []byte{}
-			}
+			m.Out = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 0 {
@@ -19664,6 +30800,89 @@ func (m *VerifyDetachedRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Armored = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= EncryptMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -19689,7 +30908,7 @@ func (m *VerifyDetachedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyDetachedResponse) Unmarshal(dAtA []byte) error {
+func (m *DecryptFileOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19712,15 +30931,15 @@ func (m *VerifyDetachedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyDetachedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptFileOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyDetachedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19747,13 +30966,83 @@ func (m *VerifyDetachedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signer == nil {
-				m.Signer = &Key{}
+			if m.Sender == nil {
+				m.Sender = &Key{}
 			}
-			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -19779,7 +31068,7 @@ func (m *VerifyDetachedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyInput) Unmarshal(dAtA []byte) error {
+func (m *DecryptInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19798,21 +31087,87 @@ func (m *VerifyInput) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecryptInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecryptInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -19822,25 +31177,23 @@ func (m *VerifyInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19867,7 +31220,7 @@ func (m *VerifyInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyOutput) Unmarshal(dAtA []byte) error {
+func (m *DecryptOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19890,10 +31243,10 @@ func (m *VerifyOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19932,7 +31285,7 @@ func (m *VerifyOutput) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19959,10 +31312,10 @@ func (m *VerifyOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signer == nil {
-				m.Signer = &Key{}
+			if m.Sender == nil {
+				m.Sender = &Key{}
 			}
-			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19991,7 +31344,7 @@ func (m *VerifyOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyFileInput) Unmarshal(dAtA []byte) error {
+func (m *RuntimeStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20014,15 +31367,69 @@ func (m *VerifyFileInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyFileInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuntimeStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuntimeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20050,11 +31457,11 @@ func (m *VerifyFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.In = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20082,11 +31489,43 @@ func (m *VerifyFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Out = string(dAtA[iNdEx:postIndex])
+			m.AppName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exe", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exe = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSetupNeeded", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20103,7 +31542,27 @@ func (m *VerifyFileInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Armored = bool(v != 0)
+			m.AuthSetupNeeded = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIDO2", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FIDO2 = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -20129,7 +31588,7 @@ func (m *VerifyFileInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyFileOutput) Unmarshal(dAtA []byte) error {
+func (m *AuthSetupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20152,17 +31611,17 @@ func (m *VerifyFileOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyFileOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthSetupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthSetupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20172,31 +31631,46 @@ func (m *VerifyFileOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signer == nil {
-				m.Signer = &Key{}
-			}
-			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AuthType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20224,65 +31698,11 @@ func (m *VerifyFileOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Out = string(dAtA[iNdEx:postIndex])
+			m.Device = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VerifyDetachedFileInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyDetachedFileInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyDetachedFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20310,13 +31730,13 @@ func (m *VerifyDetachedFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.In = string(dAtA[iNdEx:postIndex])
+			m.PIN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20326,46 +31746,24 @@ func (m *VerifyDetachedFileInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sig == nil {
-				m.Sig = []byte{}
-			}
+			m.Client = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Armored = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -20391,7 +31789,7 @@ func (m *VerifyDetachedFileInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyDetachedInput) Unmarshal(dAtA []byte) error {
+func (m *AuthSetupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20414,51 +31812,17 @@ func (m *VerifyDetachedInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyDetachedInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthSetupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyDetachedInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthSetupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20468,46 +31832,24 @@ func (m *VerifyDetachedInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sig == nil {
-				m.Sig = []byte{}
-			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Armored = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -20533,7 +31875,7 @@ func (m *VerifyDetachedInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Statement) Unmarshal(dAtA []byte) error {
+func (m *AuthUnlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20556,17 +31898,17 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Statement: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthUnlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Statement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthUnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20576,63 +31918,27 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sig == nil {
-				m.Sig = []byte{}
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20660,13 +31966,13 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
+			m.Client = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Seq = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20676,16 +31982,16 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= int32(b&0x7F) << shift
+				m.Type |= AuthType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20695,67 +32001,27 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prev = append(m.Prev[:0], dAtA[iNdEx:postIndex]...)
-			if m.Prev == nil {
-				m.Prev = []byte{}
-			}
+			m.Device = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
-			}
-			m.Revoke = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Revoke |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20783,7 +32049,7 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.PIN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20810,7 +32076,7 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigchainRequest) Unmarshal(dAtA []byte) error {
+func (m *AuthUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20833,15 +32099,15 @@ func (m *SigchainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigchainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigchainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20869,7 +32135,7 @@ func (m *SigchainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20896,7 +32162,7 @@ func (m *SigchainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigchainResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthProvision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20919,17 +32185,17 @@ func (m *SigchainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigchainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthProvision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigchainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthProvision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20939,33 +32205,48 @@ func (m *SigchainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Key == nil {
-				m.Key = &Key{}
-			}
-			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AuthType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AAGUID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -20975,26 +32256,65 @@ func (m *SigchainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statements = append(m.Statements, &Statement{})
-			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AAGUID = append(m.AAGUID[:0], dAtA[iNdEx:postIndex]...)
+			if m.AAGUID == nil {
+				m.AAGUID = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PIN = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -21020,7 +32340,7 @@ func (m *SigchainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatementRequest) Unmarshal(dAtA []byte) error {
+func (m *AuthProvisionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21043,15 +32363,34 @@ func (m *StatementRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatementRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthProvisionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AuthType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21079,13 +32418,13 @@ func (m *StatementRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
+			m.Device = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
 			}
-			m.Seq = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -21095,11 +32434,24 @@ func (m *StatementRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PIN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -21125,7 +32477,7 @@ func (m *StatementRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatementResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthProvisionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21148,15 +32500,15 @@ func (m *StatementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthProvisionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provision", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21183,10 +32535,10 @@ func (m *StatementResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Statement == nil {
-				m.Statement = &Statement{}
+			if m.Provision == nil {
+				m.Provision = &AuthProvision{}
 			}
-			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Provision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21215,7 +32567,7 @@ func (m *StatementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatementCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *AuthDeprovisionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21238,49 +32590,15 @@ func (m *StatementCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatementCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthDeprovisionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatementCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthDeprovisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21308,28 +32626,8 @@ func (m *StatementCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Local = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -21355,7 +32653,7 @@ func (m *StatementCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatementCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthDeprovisionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21378,48 +32676,66 @@ func (m *StatementCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatementCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthDeprovisionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatementCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthDeprovisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Statement == nil {
-				m.Statement = &Statement{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
 			}
-			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -21445,7 +32761,7 @@ func (m *StatementCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatementRevokeRequest) Unmarshal(dAtA []byte) error {
+func (m *AuthProvisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21468,36 +32784,17 @@ func (m *StatementRevokeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatementRevokeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthProvisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatementRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
-			}
-			m.Seq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seq |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -21507,44 +32804,26 @@ func (m *StatementRevokeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Provisions = append(m.Provisions, &AuthProvision{})
+			if err := m.Provisions[len(m.Provisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Local = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -21570,7 +32849,7 @@ func (m *StatementRevokeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatementRevokeResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthLockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21593,48 +32872,66 @@ func (m *StatementRevokeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatementRevokeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthLockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatementRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Statement == nil {
-				m.Statement = &Statement{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
 			}
-			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -21660,7 +32957,7 @@ func (m *StatementRevokeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignInput) Unmarshal(dAtA []byte) error {
+func (m *KeyGenerateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21683,17 +32980,17 @@ func (m *SignInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyGenerateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyGenerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -21703,29 +33000,14 @@ func (m *SignInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Type |= KeyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21753,11 +33035,11 @@ func (m *SignInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.In = string(dAtA[iNdEx:postIndex])
+			m.Device = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PIN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21785,11 +33067,65 @@ func (m *SignInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Out = string(dAtA[iNdEx:postIndex])
+			m.PIN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyGenerateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyGenerateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyGenerateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21817,48 +33153,8 @@ func (m *SignInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Armored = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detached", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Detached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -21884,7 +33180,7 @@ func (m *SignInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignOutput) Unmarshal(dAtA []byte) error {
+func (m *UserServiceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21907,17 +33203,17 @@ func (m *SignOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserServiceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -21927,29 +33223,27 @@ func (m *SignOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21977,7 +33271,7 @@ func (m *SignOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22004,7 +33298,7 @@ func (m *SignOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
+func (m *UserServiceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22027,17 +33321,17 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserServiceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22047,49 +33341,81 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
 			}
-			m.Armored = bool(v != 0)
-		case 11:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserSignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserSignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22117,11 +33443,11 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22149,13 +33475,13 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Mode = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22165,11 +33491,24 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= EncryptMode(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -22195,7 +33534,7 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptResponse) Unmarshal(dAtA []byte) error {
+func (m *UserSignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22218,17 +33557,17 @@ func (m *EncryptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22238,25 +33577,55 @@ func (m *EncryptResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22283,7 +33652,7 @@ func (m *EncryptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
+func (m *UserAddRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22306,15 +33675,15 @@ func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptFileInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserAddRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22342,11 +33711,11 @@ func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.In = string(dAtA[iNdEx:postIndex])
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22374,31 +33743,11 @@ func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Out = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Armored = bool(v != 0)
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22426,11 +33775,11 @@ func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22458,13 +33807,13 @@ func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
 			}
-			m.Mode = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22474,11 +33823,12 @@ func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= EncryptMode(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Local = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -22504,7 +33854,7 @@ func (m *EncryptFileInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptFileOutput) Unmarshal(dAtA []byte) error {
+func (m *UserAddResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22527,17 +33877,17 @@ func (m *EncryptFileOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptFileOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserAddResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.Bytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22547,16 +33897,33 @@ func (m *EncryptFileOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Bytes |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
 			}
-			m.Total = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22566,11 +33933,28 @@ func (m *EncryptFileOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Statement == nil {
+				m.Statement = &Statement{}
+			}
+			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -22596,7 +33980,7 @@ func (m *EncryptFileOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptInput) Unmarshal(dAtA []byte) error {
+func (m *KeyExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22619,69 +34003,15 @@ func (m *EncryptInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Armored = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22709,11 +34039,11 @@ func (m *EncryptInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22741,13 +34071,13 @@ func (m *EncryptInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Mode = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22757,7 +34087,7 @@ func (m *EncryptInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= EncryptMode(b&0x7F) << shift
+				m.Type |= ExportType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22787,7 +34117,7 @@ func (m *EncryptInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptOutput) Unmarshal(dAtA []byte) error {
+func (m *KeyExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22810,15 +34140,15 @@ func (m *EncryptOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -22845,9 +34175,9 @@ func (m *EncryptOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Export = append(m.Export[:0], dAtA[iNdEx:postIndex]...)
+			if m.Export == nil {
+				m.Export = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -22875,7 +34205,7 @@ func (m *EncryptOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
+func (m *KeyImportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22898,15 +34228,15 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyImportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -22933,16 +34263,16 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.In = append(m.In[:0], dAtA[iNdEx:postIndex]...)
+			if m.In == nil {
+				m.In = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22952,17 +34282,83 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Armored = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyImportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyImportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
-			m.Mode = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -22972,11 +34368,24 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= EncryptMode(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -23002,7 +34411,7 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptResponse) Unmarshal(dAtA []byte) error {
+func (m *KeyRemoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23025,17 +34434,17 @@ func (m *DecryptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRemoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23045,62 +34454,78 @@ func (m *DecryptResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sender == nil {
-				m.Sender = &Key{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
 			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -23126,7 +34551,7 @@ func (m *DecryptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
+func (m *Key) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23149,15 +34574,15 @@ func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptFileInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Key: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptFileInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Key: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23185,13 +34610,32 @@ func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.In = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23201,27 +34645,31 @@ func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Out = string(dAtA[iNdEx:postIndex])
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armored", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Saved", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -23238,12 +34686,12 @@ func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Armored = bool(v != 0)
-		case 13:
+			m.Saved = bool(v != 0)
+		case 40:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigchainLength", wireType)
 			}
-			m.Mode = 0
+			m.SigchainLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23253,7 +34701,26 @@ func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= EncryptMode(b&0x7F) << shift
+				m.SigchainLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigchainUpdatedAt", wireType)
+			}
+			m.SigchainUpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigchainUpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23283,7 +34750,7 @@ func (m *DecryptFileInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptFileOutput) Unmarshal(dAtA []byte) error {
+func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23306,51 +34773,15 @@ func (m *DecryptFileOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptFileOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptFileOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Sender == nil {
-				m.Sender = &Key{}
-			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23378,32 +34809,13 @@ func (m *DecryptFileOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Out = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
-			}
-			m.Bytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Bytes |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			m.Total = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23413,11 +34825,12 @@ func (m *DecryptFileOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Update = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -23443,7 +34856,7 @@ func (m *DecryptFileOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptInput) Unmarshal(dAtA []byte) error {
+func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23466,17 +34879,17 @@ func (m *DecryptInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23486,24 +34899,26 @@ func (m *DecryptInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Key == nil {
+				m.Key = &Key{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -23531,7 +34946,7 @@ func (m *DecryptInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptOutput) Unmarshal(dAtA []byte) error {
+func (m *KeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23554,17 +34969,17 @@ func (m *DecryptOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23574,31 +34989,98 @@ func (m *DecryptOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v KeyType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= KeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Types = append(m.Types, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthKeys
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthKeys
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Types) == 0 {
+					m.Types = make([]KeyType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v KeyType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeys
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= KeyType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Types = append(m.Types, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23608,28 +35090,43 @@ func (m *DecryptOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sender == nil {
-				m.Sender = &Key{}
+			m.SortField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
 			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SortDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortDirection |= SortDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -23655,7 +35152,7 @@ func (m *DecryptOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuntimeStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *KeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23678,12 +35175,97 @@ func (m *RuntimeStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &Key{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
+			}
+			m.SortDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortDirection |= SortDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -23709,7 +35291,7 @@ func (m *RuntimeStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *Secret) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23732,15 +35314,15 @@ func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23768,11 +35350,11 @@ func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23800,11 +35382,30 @@ func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SecretType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23832,13 +35433,109 @@ func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exe = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthSetupNeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23848,17 +35545,16 @@ func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AuthSetupNeeded = bool(v != 0)
-		case 6:
+		case 101:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FIDO2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var v int
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23868,12 +35564,11 @@ func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FIDO2 = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -23899,7 +35594,7 @@ func (m *RuntimeStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthSetupRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23922,15 +35617,15 @@ func (m *AuthSetupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthSetupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthSetupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23958,13 +35653,67 @@ func (m *AuthSetupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -23974,23 +35723,27 @@ func (m *AuthSetupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
+			if m.Secret == nil {
+				m.Secret = &Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24017,7 +35770,7 @@ func (m *AuthSetupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthSetupResponse) Unmarshal(dAtA []byte) error {
+func (m *SecretSaveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24040,17 +35793,17 @@ func (m *AuthSetupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthSetupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretSaveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthSetupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretSaveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -24060,23 +35813,27 @@ func (m *AuthSetupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			if m.Secret == nil {
+				m.Secret = &Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24103,7 +35860,7 @@ func (m *AuthSetupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthUnlockRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretSaveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24126,17 +35883,17 @@ func (m *AuthUnlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthUnlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretSaveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthUnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretSaveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -24146,55 +35903,27 @@ func (m *AuthUnlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
+			if m.Secret == nil {
+				m.Secret = &Secret{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24221,7 +35950,7 @@ func (m *AuthUnlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthUnlockResponse) Unmarshal(dAtA []byte) error {
+func (m *SecretRemoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24244,15 +35973,15 @@ func (m *AuthUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRemoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24280,7 +36009,7 @@ func (m *AuthUnlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24307,7 +36036,7 @@ func (m *AuthUnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthLockRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretRemoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24330,10 +36059,10 @@ func (m *AuthLockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthLockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRemoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -24361,7 +36090,7 @@ func (m *AuthLockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthLockResponse) Unmarshal(dAtA []byte) error {
+func (m *SecretsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24384,12 +36113,202 @@ func (m *AuthLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v SecretType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= SecretType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Types = append(m.Types, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthKeys
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthKeys
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Types) == 0 {
+					m.Types = make([]SecretType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v SecretType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeys
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= SecretType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Types = append(m.Types, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
+			}
+			m.SortDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortDirection |= SortDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -24415,7 +36334,7 @@ func (m *AuthLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyGenerateRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24438,17 +36357,17 @@ func (m *KeyGenerateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyGenerateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyGenerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -24458,68 +36377,29 @@ func (m *KeyGenerateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= KeyType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyGenerateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Secrets = append(m.Secrets, &Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyGenerateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyGenerateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24547,8 +36427,46 @@ func (m *KeyGenerateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
+			m.SortField = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
+			}
+			m.SortDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortDirection |= SortDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -24574,7 +36492,7 @@ func (m *KeyGenerateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24597,17 +36515,17 @@ func (m *UserServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -24617,29 +36535,16 @@ func (m *UserServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -24649,24 +36554,47 @@ func (m *UserServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			if m.Secret == nil {
+				m.Secret = &Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedAt", wireType)
+			}
+			m.ReplacedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplacedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -24692,7 +36620,7 @@ func (m *UserServiceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *SecretHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24715,15 +36643,15 @@ func (m *UserServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24751,7 +36679,7 @@ func (m *UserServiceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24778,7 +36706,7 @@ func (m *UserServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSignRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24801,49 +36729,17 @@ func (m *UserSignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -24853,55 +36749,25 @@ func (m *UserSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Versions = append(m.Versions, &SecretVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24928,7 +36794,7 @@ func (m *UserSignRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSignResponse) Unmarshal(dAtA []byte) error {
+func (m *SecretRestoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24951,15 +36817,15 @@ func (m *UserSignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRestoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24987,13 +36853,13 @@ func (m *UserSignResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25003,24 +36869,11 @@ func (m *UserSignResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -25046,7 +36899,7 @@ func (m *UserSignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserAddRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretRestoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25069,17 +36922,17 @@ func (m *UserAddRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserAddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRestoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRestoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25089,91 +36942,85 @@ func (m *UserAddRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			if m.Secret == nil {
+				m.Secret = &Secret{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OTPCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OTPCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OTPCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25201,28 +37048,8 @@ func (m *UserAddRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Local = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -25248,7 +37075,7 @@ func (m *UserAddRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserAddResponse) Unmarshal(dAtA []byte) error {
+func (m *OTPCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25271,17 +37098,17 @@ func (m *UserAddResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserAddResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OTPCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OTPCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25291,33 +37118,29 @@ func (m *UserAddResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &User{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25327,28 +37150,81 @@ func (m *UserAddResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Statement == nil {
-				m.Statement = &Statement{}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -25374,7 +37250,7 @@ func (m *UserAddResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyExportRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretsImportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25397,17 +37273,17 @@ func (m *KeyExportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyExportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretsImportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretsImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25417,29 +37293,31 @@ func (m *KeyExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var stringLen uint64
+			m.Format = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25449,29 +37327,16 @@ func (m *KeyExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Format |= SecretsImportFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25481,11 +37346,12 @@ func (m *KeyExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ExportType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -25511,7 +37377,7 @@ func (m *KeyExportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyExportResponse) Unmarshal(dAtA []byte) error {
+func (m *SecretsImportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25534,17 +37400,17 @@ func (m *KeyExportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyExportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretsImportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretsImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25554,26 +37420,64 @@ func (m *KeyExportResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Export = append(m.Export[:0], dAtA[iNdEx:postIndex]...)
-			if m.Export == nil {
-				m.Export = []byte{}
+			m.Secrets = append(m.Secrets, &SecretImport{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
+			}
+			m.Imported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Imported |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duplicates", wireType)
+			}
+			m.Duplicates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duplicates |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -25599,7 +37503,7 @@ func (m *KeyExportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyImportRequest) Unmarshal(dAtA []byte) error {
+func (m *SecretImport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25622,17 +37526,17 @@ func (m *KeyImportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyImportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretImport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretImport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -25642,29 +37546,31 @@ func (m *KeyImportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.In = append(m.In[:0], dAtA[iNdEx:postIndex]...)
-			if m.In == nil {
-				m.In = []byte{}
+			if m.Secret == nil {
+				m.Secret = &Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duplicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25692,7 +37598,7 @@ func (m *KeyImportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Duplicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25719,7 +37625,7 @@ func (m *KeyImportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyImportResponse) Unmarshal(dAtA []byte) error {
+func (m *VaultSyncRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25742,10 +37648,10 @@ func (m *KeyImportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyImportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultSyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25805,7 +37711,7 @@ func (m *KeyImportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyRemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *VaultSyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25828,10 +37734,10 @@ func (m *KeyRemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyRemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultSyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25866,60 +37772,40 @@ func (m *KeyRemoveRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthKeys
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyRemoveResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Conflicts = append(m.Conflicts, &VaultConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyRemoveResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -25945,7 +37831,7 @@ func (m *KeyRemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Key) Unmarshal(dAtA []byte) error {
+func (m *VaultConflict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25968,10 +37854,10 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Key: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultConflict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Key: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultConflict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26006,11 +37892,11 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 			}
 			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -26020,14 +37906,31 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= KeyType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Secret == nil {
+				m.Secret = &Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26054,57 +37957,126 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &User{}
+			if m.Local == nil {
+				m.Local = &Secret{}
 			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Local.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Saved", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
 			}
-			m.Saved = bool(v != 0)
-		case 40:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigchainLength", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
 			}
-			m.SigchainLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigchainLength |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 41:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigchainUpdatedAt", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHAgentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHAgentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.SigchainUpdatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -26114,11 +38086,24 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigchainUpdatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -26144,7 +38129,7 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyRequest) Unmarshal(dAtA []byte) error {
+func (m *ItemRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26167,15 +38152,15 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26203,28 +38188,8 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -26250,7 +38215,7 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyResponse) Unmarshal(dAtA []byte) error {
+func (m *ItemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26273,15 +38238,15 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26308,10 +38273,10 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Key == nil {
-				m.Key = &Key{}
+			if m.Item == nil {
+				m.Item = &Item{}
 			}
-			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26340,7 +38305,7 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeysRequest) Unmarshal(dAtA []byte) error {
+func (m *ItemsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26363,10 +38328,10 @@ func (m *KeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26401,126 +38366,6 @@ func (m *KeysRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v KeyType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowKeys
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= KeyType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Types = append(m.Types, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowKeys
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthKeys
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthKeys
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Types) == 0 {
-					m.Types = make([]KeyType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v KeyType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowKeys
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= KeyType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Types = append(m.Types, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
-			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SortField = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
-			}
-			m.SortDirection = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SortDirection |= SortDirection(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -26546,7 +38391,7 @@ func (m *KeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeysResponse) Unmarshal(dAtA []byte) error {
+func (m *ItemsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26569,15 +38414,15 @@ func (m *KeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26604,62 +38449,11 @@ func (m *KeysResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &Key{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SortField = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
-			}
-			m.SortDirection = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SortDirection |= SortDirection(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -26685,7 +38479,7 @@ func (m *KeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Secret) Unmarshal(dAtA []byte) error {
+func (m *Item) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26708,10 +38502,10 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26748,155 +38542,8 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= SecretType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
-			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -26923,46 +38570,8 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Notes = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 100:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 101:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
-			}
-			m.UpdatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -26988,7 +38597,7 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretRequest) Unmarshal(dAtA []byte) error {
+func (m *RandRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27011,17 +38620,17 @@ func (m *SecretRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RandRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBytes", wireType)
 			}
-			var stringLen uint64
+			m.NumBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -27031,24 +38640,30 @@ func (m *SecretRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumBytes |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Encoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoding |= Encoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -27074,7 +38689,7 @@ func (m *SecretRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretResponse) Unmarshal(dAtA []byte) error {
+func (m *RandResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27097,17 +38712,17 @@ func (m *SecretResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RandResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -27117,27 +38732,23 @@ func (m *SecretResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
-			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27164,7 +38775,7 @@ func (m *SecretResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretSaveRequest) Unmarshal(dAtA []byte) error {
+func (m *RandPasswordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27187,17 +38798,17 @@ func (m *SecretSaveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretSaveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RandPasswordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretSaveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RandPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var msglen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -27207,28 +38818,11 @@ func (m *SecretSaveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Length |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
-			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -27254,7 +38848,7 @@ func (m *SecretSaveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretSaveResponse) Unmarshal(dAtA []byte) error {
+func (m *RandPasswordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27277,17 +38871,17 @@ func (m *SecretSaveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretSaveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RandPasswordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretSaveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RandPasswordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -27297,27 +38891,23 @@ func (m *SecretSaveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
-			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27344,7 +38934,7 @@ func (m *SecretSaveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretRemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *PullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27367,15 +38957,15 @@ func (m *SecretRemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27403,7 +38993,7 @@ func (m *SecretRemoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27430,7 +39020,7 @@ func (m *SecretRemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretRemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *PullResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27453,12 +39043,44 @@ func (m *SecretRemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KIDs = append(m.KIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -27484,7 +39106,7 @@ func (m *SecretRemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretsRequest) Unmarshal(dAtA []byte) error {
+func (m *PushRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27507,15 +39129,15 @@ func (m *SecretsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27543,11 +39165,85 @@ func (m *SecretsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoteCheck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27575,13 +39271,13 @@ func (m *SecretsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SortField = string(dAtA[iNdEx:postIndex])
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLs", wireType)
 			}
-			m.SortDirection = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -27591,11 +39287,24 @@ func (m *SecretsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SortDirection |= SortDirection(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLs = append(m.URLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -27621,7 +39330,7 @@ func (m *SecretsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretsResponse) Unmarshal(dAtA []byte) error {
+func (m *Collection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27644,49 +39353,15 @@ func (m *SecretsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Secrets = append(m.Secrets, &Secret{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27714,27 +39389,8 @@ func (m *SecretsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SortField = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortDirection", wireType)
-			}
-			m.SortDirection = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SortDirection |= SortDirection(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -27760,7 +39416,7 @@ func (m *SecretsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ItemRequest) Unmarshal(dAtA []byte) error {
+func (m *CollectionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27783,15 +39439,15 @@ func (m *ItemRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ItemRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27819,7 +39475,7 @@ func (m *ItemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27846,7 +39502,7 @@ func (m *ItemRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ItemResponse) Unmarshal(dAtA []byte) error {
+func (m *CollectionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27869,15 +39525,15 @@ func (m *ItemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ItemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27904,10 +39560,8 @@ func (m *ItemResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &Item{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collections = append(m.Collections, &Collection{})
+			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27936,7 +39590,7 @@ func (m *ItemResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ItemsRequest) Unmarshal(dAtA []byte) error {
+func (m *Document) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27959,15 +39613,15 @@ func (m *ItemsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ItemsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Document: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Document: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27995,67 +39649,13 @@ func (m *ItemsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ItemsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ItemsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -28065,26 +39665,62 @@ func (m *ItemsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Item{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -28110,7 +39746,7 @@ func (m *ItemsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *DocumentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28133,15 +39769,15 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28169,11 +39805,11 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28201,7 +39837,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28228,7 +39864,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RandRequest) Unmarshal(dAtA []byte) error {
+func (m *DocumentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28251,17 +39887,17 @@ func (m *RandRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RandRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
 			}
-			m.NumBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -28271,30 +39907,26 @@ func (m *RandRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumBytes |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
 			}
-			m.Encoding = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Encoding |= Encoding(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Documents = append(m.Documents, &Document{})
+			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -28320,7 +39952,7 @@ func (m *RandRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RandResponse) Unmarshal(dAtA []byte) error {
+func (m *DocumentDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28343,15 +39975,15 @@ func (m *RandResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RandResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28379,7 +40011,7 @@ func (m *RandResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28406,7 +40038,7 @@ func (m *RandResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RandPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *DocumentDeleteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28429,31 +40061,12 @@ func (m *RandPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RandPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentDeleteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RandPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
-			}
-			m.Length = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Length |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -28479,7 +40092,7 @@ func (m *RandPasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RandPasswordResponse) Unmarshal(dAtA []byte) error {
+func (m *DBMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28502,15 +40115,34 @@ func (m *RandPasswordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RandPasswordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RandPasswordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28538,7 +40170,7 @@ func (m *RandPasswordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28565,7 +40197,7 @@ func (m *RandPasswordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequest) Unmarshal(dAtA []byte) error {
+func (m *DBMigrateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28588,17 +40220,17 @@ func (m *PullRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBMigrateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBMigrateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -28608,24 +40240,12 @@ func (m *PullRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -28651,7 +40271,7 @@ func (m *PullRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullResponse) Unmarshal(dAtA []byte) error {
+func (m *DBMigrateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28674,17 +40294,55 @@ func (m *PullResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBMigrateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBMigrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
+			}
+			m.Latest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latest |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -28694,23 +40352,25 @@ func (m *PullResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KIDs = append(m.KIDs, string(dAtA[iNdEx:postIndex]))
+			m.Migrations = append(m.Migrations, &DBMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28737,7 +40397,7 @@ func (m *PullResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushRequest) Unmarshal(dAtA []byte) error {
+func (m *User) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28760,15 +40420,15 @@ func (m *PushRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28796,13 +40456,13 @@ func (m *PushRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCheck", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -28812,67 +40472,25 @@ func (m *PushRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RemoteCheck = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PushResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PushResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
@@ -28904,9 +40522,28 @@ func (m *PushResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28934,65 +40571,100 @@ func (m *PushResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URLs = append(m.URLs, string(dAtA[iNdEx:postIndex]))
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Collection) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UserStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.VerifiedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29020,7 +40692,7 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29047,7 +40719,7 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollectionsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29070,15 +40742,15 @@ func (m *CollectionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29106,8 +40778,28 @@ func (m *CollectionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.KID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Local = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -29133,7 +40825,7 @@ func (m *CollectionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollectionsResponse) Unmarshal(dAtA []byte) error {
+func (m *UserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29156,15 +40848,15 @@ func (m *CollectionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29191,8 +40883,10 @@ func (m *CollectionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collections = append(m.Collections, &Collection{})
-			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29221,7 +40915,7 @@ func (m *CollectionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Document) Unmarshal(dAtA []byte) error {
+func (m *UserSearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29244,47 +40938,15 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Document: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSearchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Document: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29312,13 +40974,13 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.CreatedAt = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -29328,16 +40990,16 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
 			}
-			m.UpdatedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -29347,11 +41009,12 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Local = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -29377,7 +41040,7 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserSearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29400,17 +41063,17 @@ func (m *DocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -29420,55 +41083,25 @@ func (m *DocumentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29495,7 +41128,7 @@ func (m *DocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29518,17 +41151,17 @@ func (m *DocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -29538,25 +41171,23 @@ func (m *DocumentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Documents = append(m.Documents, &Document{})
-			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29583,7 +41214,7 @@ func (m *DocumentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocumentDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29606,17 +41237,17 @@ func (m *DocumentDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocumentDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocumentDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -29626,23 +41257,25 @@ func (m *DocumentDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, &Key{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29669,7 +41302,7 @@ func (m *DocumentDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocumentDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29692,10 +41325,10 @@ func (m *DocumentDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocumentDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocumentDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -29723,7 +41356,7 @@ func (m *DocumentDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *WatchEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29746,17 +41379,17 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -29766,27 +41399,14 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= WatchStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29814,94 +41434,65 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthKeys
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
-			}
-			m.Seq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seq |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pref) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pref: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pref: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29929,68 +41520,11 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= UserStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
-			}
-			m.VerifiedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerifiedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30018,7 +41552,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30045,7 +41579,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserRequest) Unmarshal(dAtA []byte) error {
+func (m *PreferencesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30068,64 +41602,12 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferencesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Local = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -30151,7 +41633,7 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserResponse) Unmarshal(dAtA []byte) error {
+func (m *PreferencesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30174,15 +41656,15 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferencesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30209,10 +41691,8 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &User{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prefs = append(m.Prefs, &Pref{})
+			if err := m.Prefs[len(m.Prefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30241,7 +41721,7 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSearchRequest) Unmarshal(dAtA []byte) error {
+func (m *PreferenceSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30264,17 +41744,17 @@ func (m *UserSearchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSearchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferenceSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferenceSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pref", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -30284,63 +41764,28 @@ func (m *UserSearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+			if m.Pref == nil {
+				m.Pref = &Pref{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Local = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -30366,7 +41811,7 @@ func (m *UserSearchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSearchResponse) Unmarshal(dAtA []byte) error {
+func (m *PreferenceSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30389,46 +41834,12 @@ func (m *UserSearchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSearchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferenceSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferenceSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -30454,7 +41865,7 @@ func (m *UserSearchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+func (m *TrustPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30477,15 +41888,15 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30513,67 +41924,13 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SearchResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -30583,26 +41940,43 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &Key{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+			}
+			m.Expire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expire |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -30628,7 +42002,7 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchRequest) Unmarshal(dAtA []byte) error {
+func (m *TrustPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30651,10 +42025,10 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -30682,7 +42056,7 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchEvent) Unmarshal(dAtA []byte) error {
+func (m *TrustPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30705,36 +42079,17 @@ func (m *WatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= WatchStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -30744,23 +42099,25 @@ func (m *WatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Policies = append(m.Policies, &TrustPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30787,7 +42144,7 @@ func (m *WatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pref) Unmarshal(dAtA []byte) error {
+func (m *TrustPolicySetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30810,17 +42167,17 @@ func (m *Pref) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pref: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustPolicySetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pref: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustPolicySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -30830,110 +42187,28 @@ func (m *Pref) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Policy == nil {
+				m.Policy = &TrustPolicy{}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PreferencesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PreferencesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -30959,7 +42234,7 @@ func (m *PreferencesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreferencesResponse) Unmarshal(dAtA []byte) error {
+func (m *TrustPolicySetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30982,15 +42257,15 @@ func (m *PreferencesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreferencesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustPolicySetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustPolicySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31017,8 +42292,10 @@ func (m *PreferencesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefs = append(m.Prefs, &Pref{})
-			if err := m.Prefs[len(m.Prefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &TrustPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31047,7 +42324,7 @@ func (m *PreferencesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreferenceSetRequest) Unmarshal(dAtA []byte) error {
+func (m *TrustPolicyRemoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31070,17 +42347,17 @@ func (m *PreferenceSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreferenceSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustPolicyRemoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferenceSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustPolicyRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -31090,27 +42367,23 @@ func (m *PreferenceSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeys
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pref == nil {
-				m.Pref = &Pref{}
-			}
-			if err := m.Pref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31137,7 +42410,7 @@ func (m *PreferenceSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreferenceSetResponse) Unmarshal(dAtA []byte) error {
+func (m *TrustPolicyRemoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31160,10 +42433,10 @@ func (m *PreferenceSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreferenceSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustPolicyRemoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferenceSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustPolicyRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Directory manifests list the files in a directory tree, with their size and
+// SHA-256 digest, and are signed (armored saltpack) by SignDir:
+//
+//   keys.pub/manifest/v1
+//   ignore <TAB> *.tmp
+//   file <TAB> sha256 <TAB> size <TAB> path
+//
+// Paths are relative (slash separated) and sorted. Ignore patterns (from the
+// request and .keysignore) are included, so verifying uses the same patterns
+// as signing. Only regular files are included, symlinks are skipped.
+
+const (
+	manifestHeader   = "keys.pub/manifest/v1"
+	manifestFilename = ".keys-manifest"
+	ignoreFilename   = ".keysignore"
+)
+
+type manifestFile struct {
+	Path   string
+	Size   int64
+	Digest string
+}
+
+type manifest struct {
+	Ignore []string
+	Files  []*manifestFile
+}
+
+func (m *manifest) encode() []byte {
+	var buf bytes.Buffer
+	buf.WriteString(manifestHeader + "\n")
+	for _, pattern := range m.Ignore {
+		fmt.Fprintf(&buf, "ignore\t%s\n", pattern)
+	}
+	for _, f := range m.Files {
+		fmt.Fprintf(&buf, "file\t%s\t%d\t%s\n", f.Digest, f.Size, f.Path)
+	}
+	return buf.Bytes()
+}
+
+func parseManifest(b []byte) (*manifest, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	if !scanner.Scan() || scanner.Text() != manifestHeader {
+		return nil, errors.Errorf("invalid manifest header")
+	}
+	m := &manifest{Ignore: []string{}, Files: []*manifestFile{}}
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Split(line, "\t")
+		switch fields[0] {
+		case "ignore":
+			if len(fields) != 2 {
+				return nil, errors.Errorf("invalid manifest line %q", line)
+			}
+			m.Ignore = append(m.Ignore, fields[1])
+		case "file":
+			if len(fields) != 4 {
+				return nil, errors.Errorf("invalid manifest line %q", line)
+			}
+			size, err := strconv.ParseInt(fields[2], 10, 64)
+			if err != nil {
+				return nil, errors.Errorf("invalid manifest line %q", line)
+			}
+			m.Files = append(m.Files, &manifestFile{Path: fields[3], Digest: fields[1], Size: size})
+		default:
+			return nil, errors.Errorf("invalid manifest line %q", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// readIgnoreFile returns patterns from .keysignore in dir, if it exists.
+func readIgnoreFile(dir string) ([]string, error) {
+	f, err := os.Open(filepath.Join(dir, ignoreFilename)) // #nosec
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	patterns := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+func validateIgnorePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if strings.ContainsAny(pattern, "\t\n") {
+			return errors.Errorf("invalid ignore pattern %q", pattern)
+		}
+		if _, err := path.Match(strings.Trim(pattern, "/"), ""); err != nil {
+			return errors.Errorf("invalid ignore pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// isIgnored returns true if the (relative, slash separated) path matches an
+// ignore pattern. Patterns ending with / only match directories. Patterns
+// without a / match the name at any level, otherwise the pattern matches the
+// path from the root.
+func isIgnored(patterns []string, rel string, isDir bool) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "/") {
+			if !isDir {
+				continue
+			}
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		name := rel
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimPrefix(pattern, "/")
+		} else {
+			name = path.Base(rel)
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// scanDir returns the files in dir (sorted by path), skipping ignored files
+// and the manifest itself.
+func scanDir(ctx context.Context, dir string, ignore []string, manifestPath string) ([]*manifestFile, error) {
+	files := []*manifestFile{}
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if p == dir {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if isIgnored(ignore, rel, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() || !info.Mode().IsRegular() || p == manifestPath {
+			return nil
+		}
+		if strings.ContainsAny(rel, "\t\n") {
+			return errors.Errorf("unsupported file name %q", rel)
+		}
+		digest, err := sha256File(p)
+		if err != nil {
+			return err
+		}
+		files = append(files, &manifestFile{Path: rel, Size: info.Size(), Digest: digest})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+func sha256File(p string) (string, error) {
+	f, err := os.Open(p) // #nosec
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	h := sha256.New()
+	if _, err := io.Copy(h, bufio.NewReader(f)); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// diffManifest returns the files added, removed or modified compared to the
+// manifest.
+func diffManifest(m *manifest, files []*manifestFile) (added []string, removed []string, modified []string) {
+	added, removed, modified = []string{}, []string{}, []string{}
+	current := make(map[string]*manifestFile, len(files))
+	for _, f := range files {
+		current[f.Path] = f
+	}
+	for _, mf := range m.Files {
+		f, ok := current[mf.Path]
+		if !ok {
+			removed = append(removed, mf.Path)
+			continue
+		}
+		delete(current, mf.Path)
+		if f.Size != mf.Size || f.Digest != mf.Digest {
+			modified = append(modified, mf.Path)
+		}
+	}
+	for _, f := range files {
+		if _, ok := current[f.Path]; ok {
+			added = append(added, f.Path)
+		}
+	}
+	return added, removed, modified
+}
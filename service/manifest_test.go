@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsIgnored(t *testing.T) {
+	patterns := []string{"*.tmp", "build/", "/docs/*.md", ".git"}
+	require.True(t, isIgnored(patterns, "a.tmp", false))
+	require.True(t, isIgnored(patterns, "sub/a.tmp", false))
+	require.True(t, isIgnored(patterns, "build", true))
+	require.False(t, isIgnored(patterns, "build", false))
+	require.True(t, isIgnored(patterns, "docs/README.md", false))
+	require.False(t, isIgnored(patterns, "sub/docs/README.md", false))
+	require.True(t, isIgnored(patterns, "sub/.git", true))
+	require.False(t, isIgnored(patterns, "main.go", false))
+}
+
+func TestManifestEncodeParse(t *testing.T) {
+	m := &manifest{
+		Ignore: []string{"*.tmp"},
+		Files: []*manifestFile{
+			&manifestFile{Path: "a.txt", Size: 3, Digest: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+			&manifestFile{Path: "sub dir/b.txt", Size: 0, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		},
+	}
+	out, err := parseManifest(m.encode())
+	require.NoError(t, err)
+	require.Equal(t, m, out)
+
+	_, err = parseManifest([]byte("keys.pub/manifest/v2\n"))
+	require.EqualError(t, err, "invalid manifest header")
+
+	_, err = parseManifest([]byte(manifestHeader + "\nfile\tabc\tsize\ta.txt\n"))
+	require.EqualError(t, err, "invalid manifest line \"file\\tabc\\tsize\\ta.txt\"")
+}
+
+func TestSignVerifyDir(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	dir, err := ioutil.TempDir("", "keys-manifest-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	write := func(name string, s string) {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0700))
+		require.NoError(t, ioutil.WriteFile(p, []byte(s), 0600))
+	}
+	write("a.txt", "a")
+	write("b.txt", "b")
+	write("sub/c.txt", "c")
+	write("sub/c.tmp", "tmp")
+	write("build/out", "out")
+	write(".keysignore", "# Build output\nbuild/\n")
+
+	signResp, err := service.SignDir(context.TODO(), &SignDirRequest{
+		Dir:    dir,
+		Signer: alice.ID().String(),
+		Ignore: []string{"*.tmp"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), signResp.KID)
+	require.Equal(t, filepath.Join(dir, manifestFilename), signResp.Out)
+	require.Equal(t, int32(4), signResp.Files)
+
+	verifyResp, err := service.VerifyDir(context.TODO(), &VerifyDirRequest{Dir: dir})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+	require.Equal(t, []string{}, verifyResp.Added)
+	require.Equal(t, []string{}, verifyResp.Removed)
+	require.Equal(t, []string{}, verifyResp.Modified)
+
+	// Changes (ignored files don't count)
+	write("a.txt", "a2")
+	write("d.txt", "d")
+	write("build/out2", "out2")
+	write("sub/d.tmp", "tmp")
+	require.NoError(t, os.Remove(filepath.Join(dir, "sub", "c.txt")))
+
+	verifyResp, err = service.VerifyDir(context.TODO(), &VerifyDirRequest{Dir: dir})
+	require.NoError(t, err)
+	require.Equal(t, []string{"d.txt"}, verifyResp.Added)
+	require.Equal(t, []string{"sub/c.txt"}, verifyResp.Removed)
+	require.Equal(t, []string{"a.txt"}, verifyResp.Modified)
+
+	// Trust
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{Policy: &TrustPolicy{Name: "release", Signers: []string{bob.ID().String()}}})
+	require.NoError(t, err)
+	_, err = service.VerifyDir(context.TODO(), &VerifyDirRequest{Dir: dir, Trust: "release"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted signer "+alice.ID().String()+" (trust policy release)")
+
+	// Tampered manifest
+	manifestPath := filepath.Join(dir, manifestFilename)
+	b, err := ioutil.ReadFile(manifestPath)
+	require.NoError(t, err)
+	b[len(b)/2]++
+	require.NoError(t, ioutil.WriteFile(manifestPath, b, 0600))
+	_, err = service.VerifyDir(context.TODO(), &VerifyDirRequest{Dir: dir})
+	require.Error(t, err)
+
+	_, err = service.SignDir(context.TODO(), &SignDirRequest{Signer: alice.ID().String()})
+	require.EqualError(t, err, "dir not specified")
+}
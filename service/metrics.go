@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+)
+
+// metrics for the service, exposed in the Prometheus text format on a
+// localhost-only port if Config.MetricsPort is set.
+//
+// If metrics are disabled, metrics is nil (its methods are no-ops).
+type metrics struct {
+	registry     *prometheus.Registry
+	grpc         *grpc_prometheus.ServerMetrics
+	authFailures *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	grpcMetrics := grpc_prometheus.NewServerMetrics()
+	grpcMetrics.EnableHandlingTimeHistogram()
+
+	authFailures := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "keys_auth_failures_total",
+		Help: "Number of failed RPC authorizations by reason.",
+	}, []string{"reason"})
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(grpcMetrics, authFailures)
+
+	return &metrics{
+		registry:     registry,
+		grpc:         grpcMetrics,
+		authFailures: authFailures,
+	}
+}
+
+func (m *metrics) authFailure(reason string) {
+	if m == nil {
+		return
+	}
+	m.authFailures.WithLabelValues(reason).Inc()
+}
+
+func (m *metrics) unaryInterceptor() grpc.UnaryServerInterceptor {
+	if m == nil {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(ctx, req)
+		}
+	}
+	return m.grpc.UnaryServerInterceptor()
+}
+
+func (m *metrics) streamInterceptor() grpc.StreamServerInterceptor {
+	if m == nil {
+		return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, ss)
+		}
+	}
+	return m.grpc.StreamServerInterceptor()
+}
+
+// initialize metrics for the registered services.
+func (m *metrics) initialize(server *grpc.Server) {
+	if m == nil {
+		return
+	}
+	m.grpc.InitializeMetrics(server)
+}
+
+func (m *metrics) handler() http.Handler {
+	if m == nil {
+		return http.NotFoundHandler()
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	return mux
+}
+
+// listen for metrics requests on localhost.
+func (m *metrics) listen(port int) (net.Listener, error) {
+	logger.Infof("Listening for metrics on port %d", port)
+	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen for metrics")
+	}
+	return lis, nil
+}
+
+func (m *metrics) serve(lis net.Listener) {
+	if err := http.Serve(lis, m.handler()); err != nil {
+		logger.Infof("Metrics server stopped: %v", err)
+	}
+}
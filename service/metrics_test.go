@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMetrics(t *testing.T) {
+	cfg, closeFn := testConfig(t, "KeysTest", "", "mem")
+	defer closeFn()
+	st, err := newKeyringStore(cfg)
+	require.NoError(t, err)
+	auth, err := newAuth(cfg, st)
+	require.NoError(t, err)
+	defer func() { _ = auth.keyring.Reset() }()
+	auth.metrics = newMetrics()
+
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.MD{})
+	err = auth.authorize(ctx, "/service.Keys/SomeMethod")
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = authorization missing")
+
+	ctx2 := metadata.NewIncomingContext(context.TODO(), metadata.MD{
+		"authorization": []string{"badtoken"},
+	})
+	err = auth.authorize(ctx2, "/service.Keys/SomeMethod")
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid token")
+	err = auth.authorize(ctx2, "/service.Keys/SomeMethod")
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid token")
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	auth.metrics.handler().ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	require.Contains(t, body, `keys_auth_failures_total{reason="missing"} 1`)
+	require.Contains(t, body, `keys_auth_failures_total{reason="invalid"} 2`)
+}
+
+func TestMetricsDisabled(t *testing.T) {
+	var m *metrics
+	m.authFailure("missing")
+	m.initialize(nil)
+
+	resp, err := m.unaryInterceptor()(context.TODO(), "req", nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "resp", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "resp", resp)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	m.handler().ServeHTTP(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keys-pub/keysd/db"
+	"github.com/pkg/errors"
+)
+
+// migration upgrades the local db (or keyring items) to a schema version.
+//
+// Migrations must be idempotent. A migration may be interrupted and run
+// again, and all db migrations run again if the db is removed. The keyring
+// outlives the db, so keyring items are versioned separately (see
+// keyringMigrations).
+type migration struct {
+	// Version of the schema after the migration.
+	version     int
+	description string
+	run         func(ctx context.Context, s *service) error
+}
+
+// migrations in order. Add new migrations to the end, with the next version.
+var migrations = []*migration{
+	&migration{
+		version:     1,
+		description: "Index linked device and encryption keys for saved sigchains",
+		run:         migrateKeyDirectory,
+	},
+	&migration{
+		version:     2,
+		description: "Index secrets for search",
+		run:         migrateSecretIndex,
+	},
+}
+
+// keyringMigrations upgrade keyring items, in order. Add new migrations to the
+// end, with the next version.
+var keyringMigrations = []*migration{}
+
+// keyringSchemaID is the reserved keyring item with the keyring schema. It
+// isn't encrypted (or listed with the keyring items).
+const keyringSchemaID = "#schema"
+
+func latestSchemaVersion(migrations []*migration) int {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].version
+}
+
+// pendingMigrations returns the current schema version and the migrations
+// that haven't run. If the db was written by a newer version, returns an
+// error.
+func (s *service) pendingMigrations(ctx context.Context, migrations []*migration) (int, []*migration, error) {
+	schema, err := s.db.Schema(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return pendingForSchema("db", schema, migrations)
+}
+
+func pendingForSchema(name string, schema *db.Schema, migrations []*migration) (int, []*migration, error) {
+	version := 0
+	if schema != nil {
+		version = schema.Version
+	}
+	latest := latestSchemaVersion(migrations)
+	if version > latest {
+		return version, nil, errors.Errorf("%s schema version %d was written by a newer version of the app (%s), this version supports up to %d; upgrade the app to continue", name, version, schema.Build, latest)
+	}
+	pending := []*migration{}
+	for _, m := range migrations {
+		if m.version > version {
+			pending = append(pending, m)
+		}
+	}
+	return version, pending, nil
+}
+
+// migrate runs pending migrations, saving the schema version after each step.
+func (s *service) migrate(ctx context.Context, migrations []*migration) ([]*migration, error) {
+	_, pending, err := s.pendingMigrations(ctx, migrations)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range pending {
+		logger.Infof("Migrating db to schema %d: %s", m.version, m.description)
+		if err := m.run(ctx, s); err != nil {
+			return nil, errors.Wrapf(err, "db migration %d failed", m.version)
+		}
+		if err := s.db.SetSchema(ctx, &db.Schema{Version: m.version, Build: s.build.Version}); err != nil {
+			return nil, err
+		}
+	}
+	return pending, nil
+}
+
+// keyringSchema returns the keyring schema, or nil if not set (a new keyring,
+// or one written before keyring schema versioning).
+func (s *service) keyringSchema() (*db.Schema, error) {
+	b, err := s.auth.st.Get(keyringService(s.cfg), keyringSchemaID)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, nil
+	}
+	var schema db.Schema
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return nil, errors.Wrapf(err, "invalid keyring schema")
+	}
+	return &schema, nil
+}
+
+func (s *service) setKeyringSchema(schema *db.Schema) error {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	return s.auth.st.Set(keyringService(s.cfg), keyringSchemaID, b, "")
+}
+
+// migrateKeyringItems runs pending keyring migrations, saving the keyring
+// schema version after each step. The keyring must be unlocked.
+func (s *service) migrateKeyringItems(ctx context.Context, migrations []*migration) ([]*migration, error) {
+	schema, err := s.keyringSchema()
+	if err != nil {
+		return nil, err
+	}
+	_, pending, err := pendingForSchema("keyring", schema, migrations)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range pending {
+		logger.Infof("Migrating keyring to schema %d: %s", m.version, m.description)
+		if err := m.run(ctx, s); err != nil {
+			return nil, errors.Wrapf(err, "keyring migration %d failed", m.version)
+		}
+		if err := s.setKeyringSchema(&db.Schema{Version: m.version, Build: s.build.Version}); err != nil {
+			return nil, err
+		}
+	}
+	return pending, nil
+}
+
+// DBMigrate (RPC) runs (or with dry run, lists) pending db migrations.
+func (s *service) DBMigrate(ctx context.Context, req *DBMigrateRequest) (*DBMigrateResponse, error) {
+	s.openMtx.Lock()
+	defer s.openMtx.Unlock()
+	if !s.open {
+		return nil, errors.Errorf("db is not open")
+	}
+
+	version, pending, err := s.pendingMigrations(ctx, migrations)
+	if err != nil {
+		return nil, err
+	}
+	if !req.DryRun {
+		pending, err = s.migrate(ctx, migrations)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &DBMigrateResponse{
+		Version:    int32(version),
+		Latest:     int32(latestSchemaVersion(migrations)),
+		Migrations: migrationsToRPC(pending),
+	}, nil
+}
+
+func migrationsToRPC(migrations []*migration) []*DBMigration {
+	out := make([]*DBMigration, 0, len(migrations))
+	for _, m := range migrations {
+		out = append(out, &DBMigration{
+			Version:     int32(m.version),
+			Description: m.description,
+		})
+	}
+	return out
+}
+
+// migrateKeyDirectory indexes the key directory for sigchains saved before
+// the key directory existed.
+func migrateKeyDirectory(ctx context.Context, s *service) error {
+	kids, err := s.scs.KIDs()
+	if err != nil {
+		return err
+	}
+	for _, kid := range kids {
+		sc, err := s.scs.Sigchain(kid)
+		if err != nil {
+			return err
+		}
+		if err := s.indexKeyDirectory(ctx, sc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keysd/db"
+	"github.com/keys-pub/keysd/http/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsOrder(t *testing.T) {
+	for _, ms := range [][]*migration{migrations, keyringMigrations} {
+		for i, m := range ms {
+			require.Equal(t, i+1, m.version)
+			require.NotEmpty(t, m.description)
+			require.NotNil(t, m.run)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	env := newTestEnv(t)
+	svc, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, svc)
+	ctx := context.TODO()
+
+	// Open migrated to latest
+	schema, err := svc.db.Schema(ctx)
+	require.NoError(t, err)
+	require.Equal(t, latestSchemaVersion(migrations), schema.Version)
+	require.Equal(t, "1.2.3", schema.Build)
+
+	resp, err := svc.DBMigrate(ctx, &DBMigrateRequest{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(latestSchemaVersion(migrations)), resp.Version)
+	require.Equal(t, int32(latestSchemaVersion(migrations)), resp.Latest)
+	require.Equal(t, 0, len(resp.Migrations))
+
+	// Test migrations
+	runs := []int{}
+	testMigrations := []*migration{
+		&migration{version: 1, description: "one", run: func(ctx context.Context, s *service) error {
+			runs = append(runs, 1)
+			return nil
+		}},
+		&migration{version: 2, description: "two", run: func(ctx context.Context, s *service) error {
+			runs = append(runs, 2)
+			return nil
+		}},
+	}
+	err = svc.db.SetSchema(ctx, &db.Schema{Version: 1})
+	require.NoError(t, err)
+
+	version, pending, err := svc.pendingMigrations(ctx, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	require.Equal(t, 1, len(pending))
+	require.Equal(t, "two", pending[0].description)
+
+	applied, err := svc.migrate(ctx, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(applied))
+	require.Equal(t, []int{2}, runs)
+
+	// Again (nothing pending)
+	applied, err = svc.migrate(ctx, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(applied))
+	require.Equal(t, []int{2}, runs)
+
+	// Newer
+	err = svc.db.SetSchema(ctx, &db.Schema{Version: 3, Build: "2.0.0"})
+	require.NoError(t, err)
+	_, err = svc.migrate(ctx, testMigrations)
+	require.EqualError(t, err, "db schema version 3 was written by a newer version of the app (2.0.0), this version supports up to 2; upgrade the app to continue")
+
+	// Newer (Open)
+	err = svc.db.SetSchema(ctx, &db.Schema{Version: latestSchemaVersion(migrations) + 1, Build: "2.0.0"})
+	require.NoError(t, err)
+	svc.Close()
+	_, err = svc.AuthUnlock(ctx, &AuthUnlockRequest{Password: "testpassword"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "was written by a newer version of the app (2.0.0)")
+	require.False(t, svc.open)
+}
+
+func TestMigrateKeyringItems(t *testing.T) {
+	env := newTestEnv(t)
+	svc, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, svc)
+	ctx := context.TODO()
+
+	schema, err := svc.keyringSchema()
+	require.NoError(t, err)
+	require.Nil(t, schema)
+
+	runs := []int{}
+	testMigrations := []*migration{
+		&migration{version: 1, description: "one", run: func(ctx context.Context, s *service) error {
+			runs = append(runs, 1)
+			return nil
+		}},
+		&migration{version: 2, description: "two", run: func(ctx context.Context, s *service) error {
+			runs = append(runs, 2)
+			return nil
+		}},
+	}
+	applied, err := svc.migrateKeyringItems(ctx, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(applied))
+	require.Equal(t, []int{1, 2}, runs)
+	schema, err = svc.keyringSchema()
+	require.NoError(t, err)
+	require.Equal(t, &db.Schema{Version: 2, Build: "1.2.3"}, schema)
+
+	// Again (nothing pending)
+	applied, err = svc.migrateKeyringItems(ctx, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(applied))
+
+	// Not a keyring item
+	items, err := svc.auth.keyring.List(nil)
+	require.NoError(t, err)
+	for _, item := range items {
+		require.NotEqual(t, keyringSchemaID, item.ID)
+	}
+
+	// Newer
+	err = svc.setKeyringSchema(&db.Schema{Version: 3, Build: "2.0.0"})
+	require.NoError(t, err)
+	_, err = svc.migrateKeyringItems(ctx, testMigrations)
+	require.EqualError(t, err, "keyring schema version 3 was written by a newer version of the app (2.0.0), this version supports up to 2; upgrade the app to continue")
+
+	// Newer (Open)
+	svc.Close()
+	_, err = svc.AuthUnlock(ctx, &AuthUnlockRequest{Password: "testpassword"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "keyring schema version 3 was written by a newer version of the app (2.0.0)")
+	require.False(t, svc.open)
+}
+
+func TestMigrateKeyDirectory(t *testing.T) {
+	env := newTestEnv(t)
+	device := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x10}, 32)))
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	ctx := context.TODO()
+
+	// Save a device statement without indexing (as before the key directory)
+	sc := keys.NewSigchain(alice.ID())
+	st, err := api.NewDeviceStatement(sc, device, alice, env.clock.Now())
+	require.NoError(t, err)
+	err = sc.Add(st)
+	require.NoError(t, err)
+	err = service.scs.SaveSigchain(sc)
+	require.NoError(t, err)
+
+	owner, err := service.keyOwner(ctx, device.ID())
+	require.NoError(t, err)
+	require.Equal(t, keys.ID(""), owner)
+
+	err = migrateKeyDirectory(ctx, service)
+	require.NoError(t, err)
+	owner, err = service.keyOwner(ctx, device.ID())
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), owner)
+
+	// Idempotent
+	err = migrateKeyDirectory(ctx, service)
+	require.NoError(t, err)
+	owner, err = service.keyOwner(ctx, device.ID())
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), owner)
+}
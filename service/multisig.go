@@ -0,0 +1,291 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+)
+
+// Multi-signatures (MultiSignFormat) are detached signatures that several keys
+// can sign (add to) over time, for example for release approvals.
+//
+// Each signer signs (EdX25519) the SHA-512 digest of the data, prefixed with
+// multiSigContext. The signature is text, with a line for each signer:
+//
+//   -----BEGIN KEYS MULTI SIGNATURE-----
+//   kex1... (base64 signature)
+//   -----END KEYS MULTI SIGNATURE-----
+
+const (
+	multiSigContext = "keys.pub/multisig/v1\x00"
+	multiSigBegin   = "-----BEGIN KEYS MULTI SIGNATURE-----"
+	multiSigEnd     = "-----END KEYS MULTI SIGNATURE-----"
+)
+
+type multiSig struct {
+	KID keys.ID
+	Sig []byte
+}
+
+// isMultiSig returns true if the signature is a multi-signature.
+func isMultiSig(b []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(b, " \t\r\n"), []byte(multiSigBegin))
+}
+
+func multiSigMessage(h []byte) []byte {
+	return append([]byte(multiSigContext), h...)
+}
+
+func parseMultiSig(b []byte) ([]*multiSig, error) {
+	if !isMultiSig(b) {
+		return nil, errors.Errorf("invalid multi-signature")
+	}
+	sigs := []*multiSig{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	begin, end := false, false
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case !begin:
+			begin = line == multiSigBegin
+			continue
+		case end:
+			return nil, errors.Errorf("invalid multi-signature (data after end)")
+		case line == multiSigEnd:
+			end = true
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, errors.Errorf("invalid multi-signature line")
+		}
+		kid, err := keys.ParseID(fields[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid multi-signature kid")
+		}
+		sig, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid multi-signature for %s", kid)
+		}
+		for _, s := range sigs {
+			if s.KID == kid {
+				return nil, errors.Errorf("invalid multi-signature (duplicate signer %s)", kid)
+			}
+		}
+		sigs = append(sigs, &multiSig{KID: kid, Sig: sig})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !end {
+		return nil, errors.Errorf("invalid multi-signature (missing end)")
+	}
+	return sigs, nil
+}
+
+func encodeMultiSig(sigs []*multiSig) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(multiSigBegin + "\n")
+	for _, s := range sigs {
+		buf.WriteString(s.KID.String() + " " + base64.StdEncoding.EncodeToString(s.Sig) + "\n")
+	}
+	buf.WriteString(multiSigEnd + "\n")
+	return buf.Bytes()
+}
+
+// multiSignHash signs a (SHA-512) hash, adding to an existing multi-signature
+// (if specified).
+func multiSignHash(key *keys.EdX25519Key, h []byte, existing []byte) ([]byte, error) {
+	sigs := []*multiSig{}
+	if len(existing) > 0 {
+		s, err := parseMultiSig(existing)
+		if err != nil {
+			return nil, err
+		}
+		sigs = s
+	}
+	for _, s := range sigs {
+		if s.KID == key.ID() {
+			return nil, errors.Errorf("already signed by %s", key.ID())
+		}
+	}
+	sig := key.SignDetached(multiSigMessage(h))
+	sigs = append(sigs, &multiSig{KID: key.ID(), Sig: sig})
+	return encodeMultiSig(sigs), nil
+}
+
+type multiSignWriter struct {
+	w        io.Writer
+	key      *keys.EdX25519Key
+	existing []byte
+	hash     hash.Hash
+	closed   bool
+}
+
+// newMultiSignWriter returns a writer that outputs the multi-signature on
+// Close.
+func newMultiSignWriter(w io.Writer, key *keys.EdX25519Key, existing []byte) (io.WriteCloser, error) {
+	if len(existing) > 0 {
+		// Check existing signature before reading the data.
+		if _, err := parseMultiSig(existing); err != nil {
+			return nil, err
+		}
+	}
+	return &multiSignWriter{
+		w:        w,
+		key:      key,
+		existing: existing,
+		hash:     sha512.New(),
+	}, nil
+}
+
+func (m *multiSignWriter) Write(p []byte) (int, error) {
+	if m.closed {
+		return 0, errors.Errorf("write after close")
+	}
+	return m.hash.Write(p)
+}
+
+func (m *multiSignWriter) Close() error {
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	out, err := multiSignHash(m.key, m.hash.Sum(nil), m.existing)
+	if err != nil {
+		return err
+	}
+	_, err = m.w.Write(out)
+	return err
+}
+
+// multiVerify verifies all the signatures in a multi-signature, returning
+// the signers.
+func multiVerify(sig []byte, reader io.Reader) ([]keys.ID, error) {
+	sigs, err := parseMultiSig(sig)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) == 0 {
+		return nil, errors.Errorf("no signatures")
+	}
+	h := sha512.New()
+	if _, err := io.Copy(h, reader); err != nil {
+		return nil, err
+	}
+	msg := multiSigMessage(h.Sum(nil))
+	kids := make([]keys.ID, 0, len(sigs))
+	for _, s := range sigs {
+		spk, err := keys.NewEdX25519PublicKeyFromID(s.KID)
+		if err != nil {
+			return nil, err
+		}
+		if err := spk.VerifyDetached(s.Sig, msg); err != nil {
+			return nil, errors.Errorf("invalid signature from %s", s.KID)
+		}
+		kids = append(kids, s.KID)
+	}
+	return kids, nil
+}
+
+// verifySigners returns the (verified) signers and checks the policy (if
+// specified).
+func (s *service) verifySigners(ctx context.Context, kids []keys.ID, policy *SignPolicy) ([]*Key, error) {
+	signers := make([]*Key, 0, len(kids))
+	for _, kid := range kids {
+		signer, err := s.verifyKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	if err := checkSignPolicy(signers, policy); err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+func checkSignPolicy(signers []*Key, policy *SignPolicy) error {
+	if policy == nil || (len(policy.Signers) == 0 && policy.Threshold == 0) {
+		return nil
+	}
+	if policy.Threshold < 0 {
+		return errors.Errorf("invalid policy threshold")
+	}
+	signers = distinctSigners(signers)
+	if len(policy.Signers) == 0 {
+		if len(signers) < int(policy.Threshold) {
+			return errors.Errorf("signature policy not satisfied, %d of %d required signers", len(signers), policy.Threshold)
+		}
+		return nil
+	}
+	threshold := int(policy.Threshold)
+	if threshold == 0 {
+		threshold = len(policy.Signers)
+	}
+	if threshold > len(policy.Signers) {
+		return errors.Errorf("invalid policy threshold, %d is more than the number of signers", threshold)
+	}
+	// Each signer counts for (at most) one of the expected signers.
+	count := 0
+	used := make([]bool, len(signers))
+	for _, expected := range policy.Signers {
+		for i, signer := range signers {
+			if !used[i] && signerMatches(signer, expected) {
+				used[i] = true
+				count++
+				break
+			}
+		}
+	}
+	if count < threshold {
+		if len(policy.Signers) == 1 && len(signers) == 1 {
+			return errors.Errorf("invalid signer, expected %s, was %s", policy.Signers[0], signers[0].ID)
+		}
+		return errors.Errorf("signature policy not satisfied, %d of %d required signers", count, threshold)
+	}
+	return nil
+}
+
+// signerMatches returns true if the signer is the expected KID or (verified)
+// user.
+func signerMatches(signer *Key, expected string) bool {
+	if strings.Contains(expected, "@") {
+		return signer.User != nil && signer.User.Status == UserStatusOK && signer.User.ID == expected
+	}
+	return signer.ID == expected
+}
+
+// signerIdentity returns the (verified) user for a signer, or the KID.
+func signerIdentity(signer *Key) string {
+	if signer.User != nil && signer.User.Status == UserStatusOK {
+		return signer.User.ID
+	}
+	return signer.ID
+}
+
+// distinctSigners removes signers for the same KID or user, so a user counts
+// once towards a threshold.
+func distinctSigners(signers []*Key) []*Key {
+	out := make([]*Key, 0, len(signers))
+	seen := map[string]bool{}
+	for _, signer := range signers {
+		if seen[signer.ID] || seen[signerIdentity(signer)] {
+			continue
+		}
+		seen[signer.ID] = true
+		seen[signerIdentity(signer)] = true
+		out = append(out, signer)
+	}
+	return out
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiSign(t *testing.T) {
+	b := []byte("release v1.0.0")
+	h := sha512.Sum512(b)
+
+	sig, err := multiSignHash(alice, h[:], nil)
+	require.NoError(t, err)
+	require.True(t, isMultiSig(sig))
+	require.True(t, strings.HasPrefix(string(sig), multiSigBegin+"\n"+alice.ID().String()+" "))
+
+	sig, err = multiSignHash(bob, h[:], sig)
+	require.NoError(t, err)
+
+	_, err = multiSignHash(bob, h[:], sig)
+	require.EqualError(t, err, "already signed by "+bob.ID().String())
+
+	kids, err := multiVerify(sig, bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, []keys.ID{alice.ID(), bob.ID()}, kids)
+
+	_, err = multiVerify(sig, bytes.NewReader([]byte("release v1.0.1")))
+	require.EqualError(t, err, "invalid signature from "+alice.ID().String())
+
+	// Stream
+	var buf bytes.Buffer
+	w, err := newMultiSignWriter(&buf, charlie, sig)
+	require.NoError(t, err)
+	_, err = w.Write(b[:4])
+	require.NoError(t, err)
+	_, err = w.Write(b[4:])
+	require.NoError(t, err)
+	err = w.Close()
+	require.NoError(t, err)
+	kids, err = multiVerify(buf.Bytes(), bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, []keys.ID{alice.ID(), bob.ID(), charlie.ID()}, kids)
+}
+
+func TestMultiSigInvalid(t *testing.T) {
+	b := []byte("release v1.0.0")
+	h := sha512.Sum512(b)
+	sig, err := multiSignHash(alice, h[:], nil)
+	require.NoError(t, err)
+
+	// Signature for a different signer
+	modified := strings.Replace(string(sig), alice.ID().String(), bob.ID().String(), 1)
+	_, err = multiVerify([]byte(modified), bytes.NewReader(b))
+	require.EqualError(t, err, "invalid signature from "+bob.ID().String())
+
+	// Duplicate signer
+	lines := strings.Split(string(sig), "\n")
+	duplicate := strings.Join([]string{lines[0], lines[1], lines[1], lines[2]}, "\n")
+	_, err = multiVerify([]byte(duplicate), bytes.NewReader(b))
+	require.EqualError(t, err, "invalid multi-signature (duplicate signer "+alice.ID().String()+")")
+
+	_, err = multiVerify([]byte(multiSigBegin+"\n"+multiSigEnd+"\n"), bytes.NewReader(b))
+	require.EqualError(t, err, "no signatures")
+
+	_, err = multiVerify([]byte(strings.TrimSuffix(string(sig), multiSigEnd+"\n")), bytes.NewReader(b))
+	require.EqualError(t, err, "invalid multi-signature (missing end)")
+
+	_, err = multiVerify([]byte("BEGIN SALTPACK DETACHED SIGNATURE."), bytes.NewReader(b))
+	require.EqualError(t, err, "invalid multi-signature")
+}
+
+func TestCheckSignPolicy(t *testing.T) {
+	signers := []*Key{
+		&Key{ID: alice.ID().String(), User: &User{ID: "alice@github", Status: UserStatusOK}},
+		&Key{ID: bob.ID().String()},
+	}
+
+	err := checkSignPolicy(signers, nil)
+	require.NoError(t, err)
+
+	err = checkSignPolicy(signers, &SignPolicy{Signers: []string{"alice@github", bob.ID().String()}})
+	require.NoError(t, err)
+
+	err = checkSignPolicy(signers, &SignPolicy{Signers: []string{"alice@github", charlie.ID().String()}})
+	require.EqualError(t, err, "signature policy not satisfied, 1 of 2 required signers")
+
+	err = checkSignPolicy(signers, &SignPolicy{Signers: []string{"alice@github", bob.ID().String(), charlie.ID().String()}, Threshold: 2})
+	require.NoError(t, err)
+
+	err = checkSignPolicy(signers, &SignPolicy{Signers: []string{"bob@github", charlie.ID().String()}, Threshold: 1})
+	require.EqualError(t, err, "signature policy not satisfied, 0 of 1 required signers")
+
+	err = checkSignPolicy(signers, &SignPolicy{Signers: []string{alice.ID().String()}, Threshold: 2})
+	require.EqualError(t, err, "invalid policy threshold, 2 is more than the number of signers")
+
+	err = checkSignPolicy(signers, &SignPolicy{Threshold: 2})
+	require.NoError(t, err)
+	err = checkSignPolicy(signers, &SignPolicy{Threshold: 3})
+	require.EqualError(t, err, "signature policy not satisfied, 2 of 3 required signers")
+
+	err = checkSignPolicy(signers[1:], &SignPolicy{Signers: []string{alice.ID().String()}})
+	require.EqualError(t, err, "invalid signer, expected "+alice.ID().String()+", was "+bob.ID().String())
+
+	// A signer counts once
+	err = checkSignPolicy(signers[:1], &SignPolicy{Signers: []string{"alice@github", alice.ID().String()}})
+	require.EqualError(t, err, "signature policy not satisfied, 1 of 2 required signers")
+	err = checkSignPolicy([]*Key{signers[1], signers[1]}, &SignPolicy{Threshold: 2})
+	require.EqualError(t, err, "signature policy not satisfied, 1 of 2 required signers")
+
+	// A user counts once (for different keys)
+	aliceOther := &Key{ID: charlie.ID().String(), User: &User{ID: "alice@github", Status: UserStatusOK}}
+	err = checkSignPolicy([]*Key{signers[0], aliceOther}, &SignPolicy{Threshold: 2})
+	require.EqualError(t, err, "signature policy not satisfied, 1 of 2 required signers")
+
+	// User not verified
+	failed := []*Key{&Key{ID: alice.ID().String(), User: &User{ID: "alice@github", Status: UserStatusConnFailure}}}
+	err = checkSignPolicy(failed, &SignPolicy{Signers: []string{"alice@github"}})
+	require.EqualError(t, err, "invalid signer, expected alice@github, was "+alice.ID().String())
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keys/secret"
+	"github.com/pkg/errors"
+)
+
+// OTP secrets (TOTP, RFC 6238 and HOTP, RFC 4226) are stored as secrets with
+// the otpauth:// URI (as used by authenticator QR codes) as the password, see
+// https://github.com/google/google-authenticator/wiki/Key-Uri-Format.
+//
+// For HOTP, the counter in the URI is incremented (and the secret saved and
+// pushed to the vault) each time a code is generated. The last counter used is
+// also kept in the db, raised for HOTP counters in vault changes from other
+// devices, so if a (vault) change or restore has an older counter, we don't go
+// backwards and reuse codes.
+
+// otpSecretType is the secret type for OTP secrets.
+const otpSecretType secret.Type = "otp"
+
+// otpKey is a parsed otpauth:// URI.
+type otpKey struct {
+	Type      string // "totp" or "hotp"
+	Issuer    string
+	Account   string
+	Secret    []byte
+	Algorithm string // "SHA1", "SHA256" or "SHA512"
+	Digits    int
+	Period    int // For TOTP
+	Counter   uint64
+}
+
+// parseOTP parses a QR payload, either an otpauth:// URI or a (base32) secret,
+// which defaults to TOTP.
+func parseOTP(payload string) (*otpKey, error) {
+	payload = strings.TrimSpace(payload)
+	if payload == "" {
+		return nil, errors.Errorf("no otp secret")
+	}
+	if !strings.Contains(payload, "://") {
+		b, err := otpDecodeSecret(payload)
+		if err != nil {
+			return nil, err
+		}
+		return &otpKey{Type: "totp", Secret: b, Algorithm: "SHA1", Digits: 6, Period: 30}, nil
+	}
+
+	u, err := url.Parse(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid otpauth uri")
+	}
+	if u.Scheme == "otpauth-migration" {
+		return nil, errors.Errorf("otpauth-migration uri isn't supported, export accounts individually")
+	}
+	if u.Scheme != "otpauth" {
+		return nil, errors.Errorf("invalid otpauth uri scheme %q", u.Scheme)
+	}
+	key := &otpKey{
+		Type:      strings.ToLower(u.Host),
+		Algorithm: "SHA1",
+		Digits:    6,
+		Period:    30,
+	}
+	switch key.Type {
+	case "totp", "hotp":
+	default:
+		return nil, errors.Errorf("invalid otp type %q", u.Host)
+	}
+
+	label := strings.TrimPrefix(u.Path, "/")
+	if i := strings.Index(label, ":"); i >= 0 {
+		key.Issuer = strings.TrimSpace(label[:i])
+		label = label[i+1:]
+	}
+	key.Account = strings.TrimSpace(label)
+
+	q := u.Query()
+	if q.Get("secret") == "" {
+		return nil, errors.Errorf("otpauth uri has no secret")
+	}
+	if key.Secret, err = otpDecodeSecret(q.Get("secret")); err != nil {
+		return nil, err
+	}
+	if issuer := q.Get("issuer"); issuer != "" {
+		key.Issuer = issuer
+	}
+	if alg := q.Get("algorithm"); alg != "" {
+		key.Algorithm = strings.ToUpper(alg)
+		if otpHash(key.Algorithm) == nil {
+			return nil, errors.Errorf("invalid otp algorithm %q", alg)
+		}
+	}
+	if digits := q.Get("digits"); digits != "" {
+		n, err := strconv.Atoi(digits)
+		if err != nil || n < 6 || n > 8 {
+			return nil, errors.Errorf("invalid otp digits %q", digits)
+		}
+		key.Digits = n
+	}
+	if period := q.Get("period"); period != "" {
+		n, err := strconv.Atoi(period)
+		if err != nil || n <= 0 {
+			return nil, errors.Errorf("invalid otp period %q", period)
+		}
+		key.Period = n
+	}
+	if key.Type == "hotp" {
+		if q.Get("counter") == "" {
+			return nil, errors.Errorf("hotp uri has no counter")
+		}
+		n, err := strconv.ParseUint(q.Get("counter"), 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid otp counter %q", q.Get("counter"))
+		}
+		key.Counter = n
+	}
+	return key, nil
+}
+
+func otpDecodeSecret(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.Replace(strings.TrimSpace(s), " ", "", -1))
+	s = strings.TrimRight(s, "=")
+	b, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+	if err != nil {
+		return nil, errors.Errorf("invalid otp secret (base32)")
+	}
+	if len(b) == 0 {
+		return nil, errors.Errorf("no otp secret")
+	}
+	return b, nil
+}
+
+// String returns the otpauth:// URI.
+func (k *otpKey) String() string {
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+	q := []string{"secret=" + base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(k.Secret)}
+	if k.Issuer != "" {
+		q = append(q, "issuer="+url.QueryEscape(k.Issuer))
+	}
+	q = append(q, "algorithm="+k.Algorithm, "digits="+strconv.Itoa(k.Digits))
+	switch k.Type {
+	case "hotp":
+		q = append(q, "counter="+strconv.FormatUint(k.Counter, 10))
+	default:
+		q = append(q, "period="+strconv.Itoa(k.Period))
+	}
+	return fmt.Sprintf("otpauth://%s/%s?%s", k.Type, url.PathEscape(label), strings.Join(q, "&"))
+}
+
+func otpHash(alg string) func() hash.Hash {
+	switch alg {
+	case "SHA1":
+		return sha1.New
+	case "SHA256":
+		return sha256.New
+	case "SHA512":
+		return sha512.New
+	default:
+		return nil
+	}
+}
+
+// hotp returns the HOTP code (RFC 4226) for a counter.
+func hotp(key []byte, counter uint64, digits int, alg string) string {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, counter)
+	h := hmac.New(otpHash(alg), key)
+	_, _ = h.Write(b)
+	sum := h.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, bin%mod)
+}
+
+// totp returns the TOTP code (RFC 6238) at a time, and the number of seconds
+// remaining in its period.
+func totp(key []byte, t time.Time, period int, digits int, alg string) (string, int64) {
+	secs := t.Unix()
+	counter := uint64(secs / int64(period))
+	remaining := int64(period) - secs%int64(period)
+	return hotp(key, counter, digits, alg), remaining
+}
+
+// otpSecret normalizes an OTP secret, the password (QR payload) is parsed and
+// replaced with the otpauth:// URI, and the name and username default to the
+// issuer and account.
+func otpSecret(sec *secret.Secret) error {
+	key, err := parseOTP(sec.Password)
+	if err != nil {
+		return err
+	}
+	sec.Password = key.String()
+	if strings.TrimSpace(sec.Name) == "" {
+		sec.Name = key.Issuer
+		if sec.Name == "" {
+			sec.Name = key.Account
+		}
+	}
+	if sec.Username == "" {
+		sec.Username = key.Account
+	}
+	return nil
+}
+
+// OTPCode (RPC) returns the current code for an OTP secret.
+func (s *service) OTPCode(ctx context.Context, req *OTPCodeRequest) (*OTPCodeResponse, error) {
+	if req.ID == "" {
+		return nil, errors.Errorf("id not specified")
+	}
+	sec, key, counter, err := s.otpNext(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.Type {
+	case "hotp":
+		// Outside of the secret lock, since vault changes (pull) set secrets.
+		if err := s.vaultChanged(ctx, sec.ID, sec); err != nil {
+			return nil, err
+		}
+		return &OTPCodeResponse{
+			Code:    hotp(key.Secret, counter, key.Digits, key.Algorithm),
+			Type:    key.Type,
+			Counter: counter,
+		}, nil
+	default:
+		code, remaining := totp(key.Secret, s.nowFn(), key.Period, key.Digits, key.Algorithm)
+		return &OTPCodeResponse{
+			Code:      code,
+			Type:      key.Type,
+			Remaining: remaining,
+			Period:    int64(key.Period),
+		}, nil
+	}
+}
+
+// otpNext returns the OTP secret and key, and for HOTP, the counter to use,
+// saving the secret with the next counter.
+func (s *service) otpNext(ctx context.Context, id string) (*secret.Secret, *otpKey, uint64, error) {
+	// Locked, so concurrent requests don't get the same HOTP counter.
+	s.secretMtx.Lock()
+	defer s.secretMtx.Unlock()
+
+	sec, err := s.ss.Get(id)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if sec == nil {
+		return nil, nil, 0, keys.NewErrNotFound(id)
+	}
+	if sec.Type != otpSecretType {
+		return nil, nil, 0, errors.Errorf("secret %s isn't an otp secret", id)
+	}
+	key, err := parseOTP(sec.Password)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if key.Type != "hotp" {
+		return sec, key, 0, nil
+	}
+
+	counter, err := s.otpCounter(ctx, id)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if key.Counter > counter {
+		counter = key.Counter
+	}
+	key.Counter = counter + 1
+	if err := s.setOTPCounter(ctx, id, key.Counter); err != nil {
+		return nil, nil, 0, err
+	}
+	sec.Password = key.String()
+	// The counter changes with every code, so it isn't added to the history
+	// (as setSecret would).
+	out, _, err := s.ss.Set(sec)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if err := s.indexSecret(ctx, out); err != nil {
+		return nil, nil, 0, err
+	}
+	return out, key, counter, nil
+}
+
+// otpCounterSeen raises the HOTP counter for a secret (if it's a HOTP secret),
+// for changes from the vault, so the counter doesn't go backwards if a later
+// change has an older counter.
+func (s *service) otpCounterSeen(ctx context.Context, sec *secret.Secret) error {
+	if sec.Type != otpSecretType {
+		return nil
+	}
+	key, err := parseOTP(sec.Password)
+	if err != nil || key.Type != "hotp" {
+		return nil
+	}
+
+	s.secretMtx.Lock()
+	defer s.secretMtx.Unlock()
+	counter, err := s.otpCounter(ctx, sec.ID)
+	if err != nil {
+		return err
+	}
+	if key.Counter <= counter {
+		return nil
+	}
+	return s.setOTPCounter(ctx, sec.ID, key.Counter)
+}
+
+func otpCounterPath(id string) string {
+	return ds.Path("otp-counter", id)
+}
+
+// otpCounter returns the next HOTP counter for a secret, from the db, or 0.
+func (s *service) otpCounter(ctx context.Context, id string) (uint64, error) {
+	doc, err := s.db.Get(ctx, otpCounterPath(id))
+	if err != nil {
+		return 0, err
+	}
+	if doc == nil {
+		return 0, nil
+	}
+	if len(doc.Data) != 8 {
+		return 0, errors.Errorf("invalid otp counter")
+	}
+	return binary.BigEndian.Uint64(doc.Data), nil
+}
+
+func (s *service) setOTPCounter(ctx context.Context, id string, counter uint64) error {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, counter)
+	if err := s.db.Set(ctx, otpCounterPath(id), b); err != nil {
+		return errors.Wrapf(err, "failed to save otp counter")
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHOTP(t *testing.T) {
+	// RFC 4226, Appendix D
+	key := []byte("12345678901234567890")
+	expected := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for i, code := range expected {
+		require.Equal(t, code, hotp(key, uint64(i), 6, "SHA1"))
+	}
+}
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238, Appendix B
+	keys := map[string][]byte{
+		"SHA1":   []byte("12345678901234567890"),
+		"SHA256": []byte("12345678901234567890123456789012"),
+		"SHA512": []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+	vectors := []struct {
+		secs int64
+		alg  string
+		code string
+	}{
+		{59, "SHA1", "94287082"},
+		{59, "SHA256", "46119246"},
+		{59, "SHA512", "90693936"},
+		{1111111109, "SHA1", "07081804"},
+		{1111111109, "SHA256", "68084774"},
+		{1111111109, "SHA512", "25091201"},
+		{1111111111, "SHA1", "14050471"},
+		{1111111111, "SHA256", "67062674"},
+		{1111111111, "SHA512", "99943326"},
+		{1234567890, "SHA1", "89005924"},
+		{1234567890, "SHA256", "91819424"},
+		{1234567890, "SHA512", "93441116"},
+		{2000000000, "SHA1", "69279037"},
+		{2000000000, "SHA256", "90698825"},
+		{2000000000, "SHA512", "38618901"},
+		{20000000000, "SHA1", "65353130"},
+		{20000000000, "SHA256", "77737706"},
+		{20000000000, "SHA512", "47863826"},
+	}
+	for _, v := range vectors {
+		code, _ := totp(keys[v.alg], time.Unix(v.secs, 0), 30, 8, v.alg)
+		require.Equal(t, v.code, code, "%d %s", v.secs, v.alg)
+	}
+
+	_, remaining := totp(keys["SHA1"], time.Unix(59, 0), 30, 8, "SHA1")
+	require.Equal(t, int64(1), remaining)
+	_, remaining = totp(keys["SHA1"], time.Unix(60, 0), 30, 8, "SHA1")
+	require.Equal(t, int64(30), remaining)
+}
+
+func TestParseOTP(t *testing.T) {
+	key, err := parseOTP("otpauth://totp/ACME%20Co:john.doe@email.com?secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ&issuer=ACME%20Co&algorithm=SHA256&digits=8&period=60")
+	require.NoError(t, err)
+	require.Equal(t, "totp", key.Type)
+	require.Equal(t, "ACME Co", key.Issuer)
+	require.Equal(t, "john.doe@email.com", key.Account)
+	require.Equal(t, "SHA256", key.Algorithm)
+	require.Equal(t, 8, key.Digits)
+	require.Equal(t, 60, key.Period)
+	require.Equal(t, "otpauth://totp/ACME%20Co:john.doe@email.com?secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ&issuer=ACME+Co&algorithm=SHA256&digits=8&period=60", key.String())
+
+	// Round trip
+	key2, err := parseOTP(key.String())
+	require.NoError(t, err)
+	require.Equal(t, key, key2)
+
+	// Defaults
+	key, err = parseOTP("otpauth://totp/alice?secret=gezdgnbvgy3tqojq")
+	require.NoError(t, err)
+	require.Equal(t, "", key.Issuer)
+	require.Equal(t, "alice", key.Account)
+	require.Equal(t, "SHA1", key.Algorithm)
+	require.Equal(t, 6, key.Digits)
+	require.Equal(t, 30, key.Period)
+	require.Equal(t, []byte("1234567890"), key.Secret)
+
+	// Secret only
+	key, err = parseOTP(" GEZD GNBV GY3T QOJQ ")
+	require.NoError(t, err)
+	require.Equal(t, "totp", key.Type)
+	require.Equal(t, []byte("1234567890"), key.Secret)
+
+	key, err = parseOTP("otpauth://hotp/Example:alice?secret=GEZDGNBVGY3TQOJQ&counter=5")
+	require.NoError(t, err)
+	require.Equal(t, "hotp", key.Type)
+	require.Equal(t, uint64(5), key.Counter)
+	require.Equal(t, "otpauth://hotp/Example:alice?secret=GEZDGNBVGY3TQOJQ&issuer=Example&algorithm=SHA1&digits=6&counter=5", key.String())
+
+	_, err = parseOTP("otpauth://hotp/alice?secret=GEZDGNBVGY3TQOJQ")
+	require.EqualError(t, err, "hotp uri has no counter")
+	_, err = parseOTP("otpauth://totp/alice")
+	require.EqualError(t, err, "otpauth uri has no secret")
+	_, err = parseOTP("otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQ&algorithm=MD5")
+	require.EqualError(t, err, "invalid otp algorithm \"MD5\"")
+	_, err = parseOTP("otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQ&digits=4")
+	require.EqualError(t, err, "invalid otp digits \"4\"")
+	_, err = parseOTP("otpauth://motp/alice?secret=GEZDGNBVGY3TQOJQ")
+	require.EqualError(t, err, "invalid otp type \"motp\"")
+	_, err = parseOTP("https://example.com")
+	require.EqualError(t, err, "invalid otpauth uri scheme \"https\"")
+	_, err = parseOTP("not!base32")
+	require.EqualError(t, err, "invalid otp secret (base32)")
+}
+
+func TestOTPCode(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	service.nowFn = func() time.Time { return time.Unix(1111111109, 0) }
+
+	// RFC 6238 key (base32)
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Type: OTPSecret, Password: "otpauth://totp/Example:alice@example.com?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&issuer=Example&digits=8"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Example", saveResp.Secret.Name)
+	require.Equal(t, "alice@example.com", saveResp.Secret.Username)
+	require.Equal(t, OTPSecret, saveResp.Secret.Type)
+	totpID := saveResp.Secret.ID
+
+	resp, err := service.OTPCode(ctx, &OTPCodeRequest{ID: totpID})
+	require.NoError(t, err)
+	require.Equal(t, "07081804", resp.Code)
+	require.Equal(t, "totp", resp.Type)
+	require.Equal(t, int64(1), resp.Remaining)
+	require.Equal(t, int64(30), resp.Period)
+
+	// HOTP, counter is incremented
+	saveResp, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "Bank", Type: OTPSecret, Password: "otpauth://hotp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&counter=0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Bank", saveResp.Secret.Name)
+	hotpID := saveResp.Secret.ID
+	for i, code := range []string{"755224", "287082", "359152"} {
+		resp, err := service.OTPCode(ctx, &OTPCodeRequest{ID: hotpID})
+		require.NoError(t, err)
+		require.Equal(t, code, resp.Code)
+		require.Equal(t, uint64(i), resp.Counter)
+		require.Equal(t, int64(0), resp.Remaining)
+	}
+	secretResp, err := service.Secret(ctx, &SecretRequest{ID: hotpID})
+	require.NoError(t, err)
+	require.Contains(t, secretResp.Secret.Password, "counter=3")
+	historyResp, err := service.SecretHistory(ctx, &SecretHistoryRequest{ID: hotpID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(historyResp.Versions))
+
+	// Search by type
+	require.Equal(t, []string{"Bank", "Example"}, testSecretNames(t, service, &SecretsRequest{Types: []SecretType{OTPSecret}}))
+
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "Invalid", Type: OTPSecret, Password: "otpauth://totp/alice"},
+	})
+	require.EqualError(t, err, "otpauth uri has no secret")
+
+	passwordID := testSaveSecret(t, service, &Secret{Name: "Password", Type: PasswordSecret, Password: "password"})
+	_, err = service.OTPCode(ctx, &OTPCodeRequest{ID: passwordID})
+	require.EqualError(t, err, "secret "+passwordID+" isn't an otp secret")
+	_, err = service.OTPCode(ctx, &OTPCodeRequest{ID: "notfound"})
+	require.EqualError(t, err, "not found notfound")
+}
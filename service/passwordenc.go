@@ -0,0 +1,270 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Password encryption (PasswordV1) is for sharing with someone who doesn't
+// have keys (yet).
+//
+// The key is derived from the password with scrypt, using a random salt and
+// the scrypt parameters in the header:
+//
+//   magic (8) | logN (1) | r (1) | p (1) | salt (16)
+//
+// followed by the data in secretbox chunks of (up to) 64KiB, with the nonce
+// the chunk counter and a flag for the last chunk, so chunks can't be
+// reordered or truncated. Since the key is unique for each message (salt), the
+// nonce doesn't need to be random.
+//
+// Armored, the message is base64 between BEGIN and END lines.
+
+var pwMagic = []byte("KEYSPW\x00\x01")
+
+const (
+	pwLogN = 15
+	pwR    = 8
+	pwP    = 1
+	// pwMaxMem and pwMaxP limit memory and time when decrypting.
+	pwMaxMem = 256 * 1024 * 1024
+	pwMaxP   = 16
+
+	pwHeaderSize   = 8 + 3 + 16
+	pwChunkSize    = 64 * 1024
+	pwEncChunkSize = pwChunkSize + secretbox.Overhead
+
+	pwArmorBegin = "-----BEGIN KEYS PASSWORD ENCRYPTED MESSAGE-----"
+	pwArmorEnd   = "-----END KEYS PASSWORD ENCRYPTED MESSAGE-----"
+)
+
+// isPasswordEncrypted returns true if the (start of the) data is password
+// encrypted, armored or not.
+func isPasswordEncrypted(b []byte) bool {
+	if bytes.HasPrefix(b, pwMagic) {
+		return true
+	}
+	return bytes.HasPrefix(bytes.TrimLeft(b, " \t\r\n"), []byte(pwArmorBegin))
+}
+
+func pwKey(password string, salt []byte, logN, r, p byte) (*[32]byte, error) {
+	if password == "" {
+		return nil, errors.Errorf("no password specified")
+	}
+	if logN == 0 || logN > 30 || r == 0 || p == 0 || p > pwMaxP || (128*int(r))<<logN > pwMaxMem {
+		return nil, errors.Errorf("unsupported password encryption parameters")
+	}
+	b, err := scrypt.Key([]byte(password), salt, 1<<logN, int(r), int(p), 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to derive key from password")
+	}
+	return keys.Bytes32(b), nil
+}
+
+func pwNonce(counter uint64, last bool) *[24]byte {
+	var nonce [24]byte
+	if last {
+		nonce[15] = 1
+	}
+	binary.BigEndian.PutUint64(nonce[16:], counter)
+	return &nonce
+}
+
+type pwEncryptWriter struct {
+	w       io.Writer
+	armor   io.WriteCloser
+	key     *[32]byte
+	buf     []byte
+	counter uint64
+	closed  bool
+}
+
+// newPasswordEncryptWriter returns a writer that encrypts with a password.
+// The writer must be closed to finish the message.
+func newPasswordEncryptWriter(w io.Writer, password string, armored bool) (io.WriteCloser, error) {
+	salt := keys.RandBytes(16)
+	key, err := pwKey(password, salt, pwLogN, pwR, pwP)
+	if err != nil {
+		return nil, err
+	}
+	var armor io.WriteCloser
+	if armored {
+		armor, err = newArmorWriter(w, pwArmorBegin, pwArmorEnd)
+		if err != nil {
+			return nil, err
+		}
+		w = armor
+	}
+	header := make([]byte, 0, pwHeaderSize)
+	header = append(header, pwMagic...)
+	header = append(header, pwLogN, pwR, pwP)
+	header = append(header, salt...)
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &pwEncryptWriter{
+		w:     w,
+		armor: armor,
+		key:   key,
+		buf:   make([]byte, 0, pwChunkSize),
+	}, nil
+}
+
+func (e *pwEncryptWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.Errorf("write after close")
+	}
+	n := len(p)
+	e.buf = append(e.buf, p...)
+	// Keep the last chunk in the buffer, so it's marked as last on Close.
+	for len(e.buf) > pwChunkSize {
+		if err := e.seal(e.buf[:pwChunkSize], false); err != nil {
+			return 0, err
+		}
+		e.buf = e.buf[pwChunkSize:]
+	}
+	return n, nil
+}
+
+func (e *pwEncryptWriter) seal(b []byte, last bool) error {
+	out := secretbox.Seal(nil, b, pwNonce(e.counter, last), e.key)
+	e.counter++
+	_, err := e.w.Write(out)
+	return err
+}
+
+func (e *pwEncryptWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	if err := e.seal(e.buf, true); err != nil {
+		return err
+	}
+	e.buf = nil
+	if e.armor != nil {
+		return e.armor.Close()
+	}
+	return nil
+}
+
+type pwDecryptReader struct {
+	r       *bufio.Reader
+	key     *[32]byte
+	out     []byte
+	counter uint64
+	done    bool
+}
+
+// newPasswordDecryptReader returns a reader that decrypts a password
+// encrypted message, armored or not.
+func newPasswordDecryptReader(r io.Reader, password string) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	peek, _ := br.Peek(len(pwArmorBegin))
+	if !bytes.HasPrefix(peek, pwMagic) {
+		dr, err := newArmorReader(br, pwArmorBegin, pwArmorEnd)
+		if err != nil {
+			return nil, err
+		}
+		r = dr
+	} else {
+		r = br
+	}
+
+	header := make([]byte, pwHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Errorf("invalid data")
+	}
+	if !bytes.Equal(header[:8], pwMagic) {
+		return nil, errors.Errorf("invalid data")
+	}
+	key, err := pwKey(password, header[11:], header[8], header[9], header[10])
+	if err != nil {
+		return nil, err
+	}
+	return &pwDecryptReader{
+		r:   bufio.NewReaderSize(r, pwEncChunkSize+1),
+		key: key,
+	}, nil
+}
+
+func (d *pwDecryptReader) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+func (d *pwDecryptReader) open() error {
+	// If there is more than a chunk, this isn't the last one.
+	b, err := d.r.Peek(pwEncChunkSize + 1)
+	last := false
+	if err == io.EOF {
+		last = true
+	} else if err != nil {
+		return err
+	} else {
+		b = b[:pwEncChunkSize]
+	}
+	if len(b) < secretbox.Overhead {
+		return errors.Errorf("invalid data (truncated)")
+	}
+	out, ok := secretbox.Open(nil, b, pwNonce(d.counter, last), d.key)
+	if !ok {
+		if d.counter == 0 {
+			return errors.Errorf("failed to decrypt, invalid password or data")
+		}
+		return errors.Errorf("failed to decrypt, invalid data")
+	}
+	if last && len(out) == 0 && d.counter > 0 {
+		return errors.Errorf("invalid data (empty last chunk)")
+	}
+	if _, err := d.r.Discard(len(b)); err != nil {
+		return err
+	}
+	d.counter++
+	d.out = out
+	d.done = last
+	return nil
+}
+
+func passwordEncrypt(b []byte, password string, armored bool) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := newPasswordEncryptWriter(&buf, password, armored)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func passwordDecrypt(b []byte, password string) ([]byte, error) {
+	r, err := newPasswordDecryptReader(bytes.NewReader(b), password)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
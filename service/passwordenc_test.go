@@ -0,0 +1,111 @@
+package service
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordEncrypt(t *testing.T) {
+	sizes := []int{0, 1, pwChunkSize - 1, pwChunkSize, pwChunkSize + 1, 2 * pwChunkSize, (2 * pwChunkSize) + 5}
+	for _, size := range sizes {
+		for _, armored := range []bool{true, false} {
+			b := bytes.Repeat([]byte{0x01}, size)
+			encrypted, err := passwordEncrypt(b, "testpassword", armored)
+			require.NoError(t, err)
+			require.True(t, isPasswordEncrypted(encrypted))
+			if armored {
+				require.True(t, strings.HasPrefix(string(encrypted), pwArmorBegin+"\n"))
+				require.True(t, strings.HasSuffix(string(encrypted), "\n"+pwArmorEnd+"\n"))
+			}
+
+			out, err := passwordDecrypt(encrypted, "testpassword")
+			require.NoError(t, err, "size %d", size)
+			require.Equal(t, b, out)
+
+			_, err = passwordDecrypt(encrypted, "invalidpassword")
+			require.EqualError(t, err, "failed to decrypt, invalid password or data")
+		}
+	}
+}
+
+func TestPasswordEncryptStream(t *testing.T) {
+	b := bytes.Repeat([]byte{0x01}, (2*pwChunkSize)+5)
+	var buf bytes.Buffer
+	w, err := newPasswordEncryptWriter(&buf, "testpassword", false)
+	require.NoError(t, err)
+	for i := 0; i < len(b); i += 1000 {
+		e := i + 1000
+		if e > len(b) {
+			e = len(b)
+		}
+		_, err = w.Write(b[i:e])
+		require.NoError(t, err)
+	}
+	err = w.Close()
+	require.NoError(t, err)
+
+	r, err := newPasswordDecryptReader(bytes.NewReader(buf.Bytes()), "testpassword")
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, b, out)
+}
+
+func TestPasswordDecryptInvalid(t *testing.T) {
+	b := bytes.Repeat([]byte{0x01}, (2*pwChunkSize)+5)
+	encrypted, err := passwordEncrypt(b, "testpassword", false)
+	require.NoError(t, err)
+
+	// Truncated (at chunk boundary)
+	truncated := encrypted[:pwHeaderSize+(2*pwEncChunkSize)]
+	_, err = passwordDecrypt(truncated, "testpassword")
+	require.EqualError(t, err, "failed to decrypt, invalid data")
+
+	truncated = encrypted[:pwHeaderSize+pwEncChunkSize+10]
+	_, err = passwordDecrypt(truncated, "testpassword")
+	require.EqualError(t, err, "invalid data (truncated)")
+
+	// Modified
+	modified := append([]byte{}, encrypted...)
+	modified[len(modified)-1] ^= 0x01
+	_, err = passwordDecrypt(modified, "testpassword")
+	require.EqualError(t, err, "failed to decrypt, invalid data")
+
+	// Parameters
+	params := append([]byte{}, encrypted...)
+	params[8] = 19
+	_, err = passwordDecrypt(params, "testpassword")
+	require.EqualError(t, err, "unsupported password encryption parameters")
+	params[8], params[9] = pwLogN, 255
+	_, err = passwordDecrypt(params, "testpassword")
+	require.EqualError(t, err, "unsupported password encryption parameters")
+
+	_, err = passwordDecrypt([]byte("KEYSPW"), "testpassword")
+	require.EqualError(t, err, "invalid data")
+
+	// Armored without end
+	armored, err := passwordEncrypt([]byte("hi"), "testpassword", true)
+	require.NoError(t, err)
+	noEnd := strings.TrimSuffix(string(armored), pwArmorEnd+"\n")
+	_, err = passwordDecrypt([]byte(noEnd), "testpassword")
+	require.EqualError(t, err, "invalid data (missing end of armored message)")
+	_, err = passwordDecrypt([]byte(strings.TrimSuffix(noEnd, "\n")), "testpassword")
+	require.EqualError(t, err, "invalid data (missing end of armored message)")
+	_, err = passwordDecrypt([]byte(noEnd+"\n  \n"), "testpassword")
+	require.EqualError(t, err, "invalid data (missing end of armored message)")
+
+	// Armored with another end
+	_, err = passwordDecrypt([]byte(noEnd+"-----END AGE ENCRYPTED FILE-----\n"), "testpassword")
+	require.EqualError(t, err, "invalid data (missing end of armored message)")
+
+	// Armored with data after end
+	_, err = passwordDecrypt(append(armored, []byte("more\n")...), "testpassword")
+	require.EqualError(t, err, "invalid data (after end of armored message)")
+	out, err := passwordDecrypt(append(armored, []byte("\n\n")...), "testpassword")
+	require.NoError(t, err)
+	require.Equal(t, []byte("hi"), out)
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// peerCred is the process on the other end of a unix socket connection.
+type peerCred struct {
+	UID int
+	PID int
+	// Exe is the executable path, if available.
+	Exe string
+}
+
+func (p peerCred) String() string {
+	return fmt.Sprintf("uid=%d, pid=%d, exe=%s", p.UID, p.PID, p.Exe)
+}
+
+// peerCredAuthInfo is the AuthInfo for connections on the unix socket.
+type peerCredAuthInfo struct {
+	credentials.AuthInfo
+	cred *peerCred
+}
+
+// peerCredTransport records the peer credentials for connections on the unix
+// socket, after the TLS handshake. Connections from processes of other users
+// are refused.
+type peerCredTransport struct {
+	credentials.TransportCredentials
+	uid int
+}
+
+func newPeerCredTransport(creds credentials.TransportCredentials) credentials.TransportCredentials {
+	return peerCredTransport{TransportCredentials: creds, uid: os.Getuid()}
+}
+
+func (t peerCredTransport) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, info, err := t.TransportCredentials.ServerHandshake(rawConn)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := rawConn.(*net.UnixConn); !ok {
+		return conn, info, nil
+	}
+	cred, err := readPeerCred(rawConn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, errors.Wrapf(err, "failed to read peer credentials")
+	}
+	if cred.UID != t.uid {
+		logger.Warningf("Refusing connection from %s", cred)
+		_ = conn.Close()
+		return nil, nil, errors.Errorf("peer uid %d doesn't match %d", cred.UID, t.uid)
+	}
+	return conn, peerCredAuthInfo{AuthInfo: info, cred: cred}, nil
+}
+
+func (t peerCredTransport) Clone() credentials.TransportCredentials {
+	return peerCredTransport{TransportCredentials: t.TransportCredentials.Clone(), uid: t.uid}
+}
+
+// peerCredFromContext returns the peer credentials for a request, or nil if
+// the request isn't from the unix socket.
+func peerCredFromContext(ctx context.Context) *peerCred {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(peerCredAuthInfo)
+	if !ok {
+		return nil
+	}
+	return info.cred
+}
+
+// listenSocket listens on the unix socket, removing a stale socket left from
+// a previous run.
+func listenSocket(path string) (net.Listener, error) {
+	logger.Infof("Listening for connections on %s", path)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to remove socket")
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen on socket")
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		_ = lis.Close()
+		return nil, errors.Wrapf(err, "failed to set socket permissions")
+	}
+	return lis, nil
+}
+
+// socketAvailable returns true if the service is listening on the unix socket.
+func socketAvailable(path string) bool {
+	if !peerCredSupported {
+		return false
+	}
+	if _, err := os.Stat(path); err != nil {
+		return false
+	}
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
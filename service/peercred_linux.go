@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+const peerCredSupported = true
+
+// readPeerCred reads SO_PEERCRED from a unix socket connection.
+func readPeerCred(conn net.Conn) (*peerCred, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, errors.Errorf("not a unix socket connection")
+	}
+	raw, err := uc.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+	var ucred *syscall.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return nil, err
+	}
+	if credErr != nil {
+		return nil, credErr
+	}
+	cred := &peerCred{UID: int(ucred.Uid), PID: int(ucred.Pid)}
+	exe, err := os.Readlink(fmt.Sprintf("/proc/%d/exe", ucred.Pid))
+	if err != nil {
+		logger.Warningf("Failed to read executable for pid %d: %v", ucred.Pid, err)
+	} else {
+		cred.Exe = exe
+	}
+	return cred, nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// testCertificate generates a localhost certificate with a SAN, since newer
+// versions of Go don't accept CN only certificates.
+func testCertificate(t *testing.T) (string, tls.Certificate) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyDER, err := x509.MarshalECPrivateKey(priv)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	tlsCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return string(certPEM), tlsCert
+}
+
+func TestSocketPeerCred(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	defer closeFn()
+	cfg := service.cfg
+
+	certPEM, tlsCert := testCertificate(t)
+	err := saveCertificate(cfg, certPEM)
+	require.NoError(t, err)
+	defer func() { _ = deleteCertificate(cfg) }()
+
+	var cred *peerCred
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		cred = peerCredFromContext(ctx)
+		return handler(ctx, req)
+	}
+	server := grpc.NewServer(
+		grpc.Creds(newPeerCredTransport(credentials.NewServerTLSFromCert(&tlsCert))),
+		grpc.UnaryInterceptor(interceptor),
+	)
+	RegisterKeysServer(server, service)
+	defer server.Stop()
+
+	path, err := cfg.socketPath(true)
+	require.NoError(t, err)
+	require.False(t, socketAvailable(path))
+
+	lis, err := listenSocket(path)
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	defer os.Remove(path)
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	require.True(t, socketAvailable(path))
+
+	conn, err := connectLocal(cfg, "")
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Equal(t, path, conn.Target())
+
+	_, err = NewKeysClient(conn).RuntimeStatus(context.TODO(), &RuntimeStatusRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, cred)
+	require.Equal(t, os.Getuid(), cred.UID)
+	require.Equal(t, os.Getpid(), cred.PID)
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	require.Equal(t, exe, cred.Exe)
+}
+
+func TestSocketPeerCredOtherUser(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	defer closeFn()
+	cfg := service.cfg
+
+	certPEM, tlsCert := testCertificate(t)
+	err := saveCertificate(cfg, certPEM)
+	require.NoError(t, err)
+	defer func() { _ = deleteCertificate(cfg) }()
+
+	// Only allow connections from another uid
+	creds := peerCredTransport{
+		TransportCredentials: credentials.NewServerTLSFromCert(&tlsCert),
+		uid:                  os.Getuid() + 1,
+	}
+	server := grpc.NewServer(grpc.Creds(creds))
+	RegisterKeysServer(server, service)
+	defer server.Stop()
+
+	path, err := cfg.socketPath(true)
+	require.NoError(t, err)
+	lis, err := listenSocket(path)
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	defer os.Remove(path)
+
+	conn, err := connectLocal(cfg, "")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	_, err = NewKeysClient(conn).RuntimeStatus(ctx, &RuntimeStatusRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
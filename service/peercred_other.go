@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package service
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+const peerCredSupported = false
+
+func readPeerCred(conn net.Conn) (*peerCred, error) {
+	return nil, errors.Errorf("peer credentials not supported")
+}
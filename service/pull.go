@@ -83,6 +83,14 @@ func (s *service) update(ctx context.Context, kid keys.ID) (bool, *user.Result,
 		}
 	}
 
+	sc, err := s.scs.Sigchain(kid)
+	if err != nil {
+		return false, nil, err
+	}
+	if err := s.indexKeyDirectory(ctx, sc); err != nil {
+		return false, nil, err
+	}
+
 	res, err := s.users.Update(ctx, kid)
 	if err != nil {
 		return false, nil, err
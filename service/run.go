@@ -23,6 +23,7 @@ import (
 	"github.com/keys-pub/keys/util"
 	"github.com/keys-pub/keysd/db"
 	"github.com/keys-pub/keysd/fido2"
+	"github.com/keys-pub/keysd/fido2/virtual"
 	"github.com/keys-pub/keysd/http/client"
 	"github.com/keys-pub/keysd/wormhole"
 	"github.com/keys-pub/keysd/wormhole/sctp"
@@ -151,8 +152,6 @@ type ServeFn func() error
 // CloseFn closes the service.
 type CloseFn func()
 
-// TODO: Protect against incompatible downgrades
-
 func runService(cfg *Config, build Build, lgi LogInterceptor) error {
 	serveFn, closeFn, serveErr := NewServiceFn(cfg, build, lgi)
 	if serveErr != nil {
@@ -184,7 +183,7 @@ func NewServiceFn(cfg *Config, build Build, lgi LogInterceptor) (ServeFn, CloseF
 		return nil, nil, errNoCertFound{}
 	}
 	tlsCert := cert.TLSCertificate()
-	creds := credentials.NewServerTLSFromCert(&tlsCert)
+	creds := newPeerCredTransport(credentials.NewServerTLSFromCert(&tlsCert))
 
 	opts = []grpc.ServerOption{
 		grpc.Creds(creds),
@@ -195,17 +194,25 @@ func NewServiceFn(cfg *Config, build Build, lgi LogInterceptor) (ServeFn, CloseF
 		return nil, nil, err
 	}
 
+	var metrics *metrics
+	if cfg.MetricsPort() != 0 {
+		metrics = newMetrics()
+		auth.metrics = metrics
+	}
+
 	lgi.Replace()
 
 	opts = append(opts, middleware.WithUnaryServerChain(
 		ctxtags.UnaryServerInterceptor(ctxtags.WithFieldExtractor(ctxtags.CodeGenRequestFieldExtractor)),
 		lgi.Unary(),
+		metrics.unaryInterceptor(),
 		auth.unaryInterceptor,
 		panichandler.UnaryServerInterceptor,
 	),
 		middleware.WithStreamServerChain(
 			ctxtags.StreamServerInterceptor(ctxtags.WithFieldExtractor(ctxtags.CodeGenRequestFieldExtractor)),
 			lgi.Stream(),
+			metrics.streamInterceptor(),
 			auth.streamInterceptor,
 			panichandler.StreamServerInterceptor,
 		),
@@ -222,13 +229,60 @@ func NewServiceFn(cfg *Config, build Build, lgi LogInterceptor) (ServeFn, CloseF
 	RegisterKeysServer(grpcServer, service)
 
 	// FIDO2 service
-	server, err := fido2.OpenPlugin(filepath.Join(exeDir(), "fido2.so"))
+	server, err := fido2Server(cfg)
 	if err != nil {
-		logger.Errorf("fido2 plugin is not available: %v", err)
+		logger.Errorf("fido2 is not available: %v", err)
 	} else {
-		logger.Infof("Registering FIDO2 plugin...")
+		logger.Infof("Registering FIDO2 (%s)...", cfg.FIDO2())
 		fido2.RegisterAuthenticatorsServer(grpcServer, server)
-		service.fido2 = true
+		service.fido2 = server
+	}
+
+	metrics.initialize(grpcServer)
+
+	// Close listeners if we fail before serving
+	listeners := []net.Listener{}
+	ok := false
+	defer func() {
+		if !ok {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+		}
+	}()
+
+	var metricsLis net.Listener
+	if port := cfg.MetricsPort(); port != 0 {
+		metricsLis, err = metrics.listen(port)
+		if err != nil {
+			return nil, nil, err
+		}
+		listeners = append(listeners, metricsLis)
+	}
+
+	var gw *gateway
+	var gatewayLis net.Listener
+	if port := cfg.GatewayPort(); port != 0 {
+		gw = newGateway(service, auth)
+		gatewayLis, err = gw.listen(port)
+		if err != nil {
+			return nil, nil, err
+		}
+		listeners = append(listeners, gatewayLis)
+	}
+
+	var sshAgentLis net.Listener
+	if cfg.SSHAgent() {
+		path, err := sshAgentPath(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		sshAgentLis, err = listenSSHAgent(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		listeners = append(listeners, sshAgentLis)
+		service.sshAgentPath = path
 	}
 
 	logger.Infof("Listening for connections on port %d", cfg.Port())
@@ -236,20 +290,86 @@ func NewServiceFn(cfg *Config, build Build, lgi LogInterceptor) (ServeFn, CloseF
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to tcp listen")
 	}
+	listeners = append(listeners, lis)
+
+	var socketLis net.Listener
+	var socketPath string
+	if peerCredSupported {
+		socketPath, err = cfg.socketPath(true)
+		if err != nil {
+			return nil, nil, err
+		}
+		socketLis, err = listenSocket(socketPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		listeners = append(listeners, socketLis)
+	}
 
 	serveFn := func() error {
 		if err := writePID(cfg); err != nil {
 			return err
 		}
+		if metricsLis != nil {
+			go metrics.serve(metricsLis)
+		}
+		if gatewayLis != nil {
+			go gw.serve(gatewayLis)
+		}
+		if sshAgentLis != nil {
+			go newSSHAgent(service.ks, auth).serve(sshAgentLis)
+		}
+		if socketLis != nil {
+			go func() {
+				if err := grpcServer.Serve(socketLis); err != nil {
+					logger.Errorf("Failed to serve on socket: %v", err)
+				}
+			}()
+		}
 		return grpcServer.Serve(lis)
 	}
 	closeFn := func() {
 		grpcServer.Stop()
+		if metricsLis != nil {
+			_ = metricsLis.Close()
+		}
+		if gatewayLis != nil {
+			_ = gatewayLis.Close()
+		}
+		if sshAgentLis != nil {
+			_ = sshAgentLis.Close()
+		}
+		if socketPath != "" {
+			_ = os.Remove(socketPath)
+		}
 		service.Close()
 	}
+	ok = true
 	return serveFn, closeFn, nil
 }
 
+// fido2Server returns the FIDO2 authenticators server, from the fido2.so
+// plugin, or the virtual authenticator (with state in the app dir).
+// The virtual authenticator keeps its secrets in a file, so it's only allowed
+// if fido2Insecure is set.
+func fido2Server(cfg *Config) (fido2.AuthenticatorsServer, error) {
+	switch cfg.FIDO2() {
+	case "plugin":
+		return fido2.OpenPlugin(filepath.Join(exeDir(), "fido2.so"))
+	case "virtual":
+		if !cfg.FIDO2Insecure() {
+			return nil, errors.Errorf("virtual fido2 is insecure (for testing only), run `keys config set %s true` to allow it", fido2InsecureKey)
+		}
+		path, err := cfg.AppPath("fido2-virtual.json", true)
+		if err != nil {
+			return nil, err
+		}
+		return virtual.NewAuthenticatorsServer(path)
+	default:
+		return nil, errors.Errorf("unknown fido2 config %q, should be plugin or virtual", cfg.FIDO2())
+	}
+}
+
 // IsPortInUse returns true if port is currently in use.
 func IsPortInUse(port int) bool {
 	lis, lisErr := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keys/secret"
+	"github.com/keys-pub/keys/util"
+	"github.com/pkg/errors"
+)
+
+// Secret history keeps prior versions of a secret when it's changed or
+// removed, in the local (encrypted) db at /secret-history-{id}/{version}.
+// The number of versions kept for each secret is set by the secretHistory
+// config (see Config.SecretHistory).
+//
+// Restoring a version saves it as the current secret, so the current secret is
+// kept in the history too, and a restore can itself be undone.
+
+// secretVersion is a prior version of a secret.
+type secretVersion struct {
+	Version    int64          `json:"version"`
+	Secret     *secret.Secret `json:"secret"`
+	ReplacedAt time.Time      `json:"replacedAt"`
+}
+
+func secretHistoryCollection(id string) string {
+	return "secret-history-" + id
+}
+
+func secretHistoryPath(id string, version int64) string {
+	// Padded so the versions are ordered
+	return ds.Path(secretHistoryCollection(id), fmt.Sprintf("%015d", version))
+}
+
+// SecretHistory (RPC) lists prior versions of a secret.
+func (s *service) SecretHistory(ctx context.Context, req *SecretHistoryRequest) (*SecretHistoryResponse, error) {
+	if req.ID == "" {
+		return nil, errors.Errorf("id not specified")
+	}
+	versions, err := s.secretVersions(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*SecretVersion, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		out = append(out, secretVersionToRPC(versions[i]))
+	}
+	return &SecretHistoryResponse{
+		Versions: out,
+	}, nil
+}
+
+// SecretRestore (RPC) restores a prior version of a secret.
+func (s *service) SecretRestore(ctx context.Context, req *SecretRestoreRequest) (*SecretRestoreResponse, error) {
+	if req.ID == "" {
+		return nil, errors.Errorf("id not specified")
+	}
+	doc, err := s.db.Get(ctx, secretHistoryPath(req.ID, req.Version))
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, errors.Errorf("secret %s version %d not found", req.ID, req.Version)
+	}
+	var version secretVersion
+	if err := json.Unmarshal(doc.Data, &version); err != nil {
+		return nil, errors.Wrapf(err, "invalid secret history")
+	}
+	sec := version.Secret
+	sec.ID = req.ID
+
+	out, err := s.setSecret(ctx, sec)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.vaultChanged(ctx, out.ID, out); err != nil {
+		return nil, err
+	}
+	return &SecretRestoreResponse{
+		Secret: secretToRPC(out),
+	}, nil
+}
+
+// setSecret saves a secret, adding the existing version (if changed) to the
+// history.
+func (s *service) setSecret(ctx context.Context, sec *secret.Secret) (*secret.Secret, error) {
+	s.secretMtx.Lock()
+	defer s.secretMtx.Unlock()
+
+	existing, err := s.ss.Get(sec.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && !secretEqual(existing, sec) {
+		if err := s.addSecretVersion(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+	out, _, err := s.ss.Set(sec)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.indexSecret(ctx, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// removeSecret removes a secret, adding it to the history.
+func (s *service) removeSecret(ctx context.Context, id string) (bool, error) {
+	s.secretMtx.Lock()
+	defer s.secretMtx.Unlock()
+
+	existing, err := s.ss.Get(id)
+	if err != nil {
+		return false, err
+	}
+	if existing == nil {
+		return false, nil
+	}
+	if err := s.addSecretVersion(ctx, existing); err != nil {
+		return false, err
+	}
+	ok, err := s.ss.Delete(id)
+	if err != nil {
+		return false, err
+	}
+	if err := s.unindexSecret(ctx, id); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// addSecretVersion adds a version to the history and removes versions past
+// the retention limit.
+func (s *service) addSecretVersion(ctx context.Context, sec *secret.Secret) error {
+	keep := s.cfg.SecretHistory()
+	versions, err := s.secretVersions(ctx, sec.ID)
+	if err != nil {
+		return err
+	}
+	if keep > 0 {
+		next := int64(1)
+		if len(versions) > 0 {
+			next = versions[len(versions)-1].Version + 1
+		}
+		version := &secretVersion{
+			Version:    next,
+			Secret:     sec,
+			ReplacedAt: s.nowFn(),
+		}
+		b, err := json.Marshal(version)
+		if err != nil {
+			return err
+		}
+		if err := s.db.Set(ctx, secretHistoryPath(sec.ID, next), b); err != nil {
+			return errors.Wrapf(err, "failed to save secret history")
+		}
+		versions = append(versions, version)
+	}
+	for len(versions) > keep {
+		if _, err := s.db.Delete(ctx, secretHistoryPath(sec.ID, versions[0].Version)); err != nil {
+			return errors.Wrapf(err, "failed to remove secret history")
+		}
+		versions = versions[1:]
+	}
+	return nil
+}
+
+// secretVersions returns the history for a secret, oldest first.
+func (s *service) secretVersions(ctx context.Context, id string) ([]*secretVersion, error) {
+	iter, err := s.db.Documents(ctx, secretHistoryCollection(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	versions := []*secretVersion{}
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			break
+		}
+		var version secretVersion
+		if err := json.Unmarshal(doc.Data, &version); err != nil {
+			return nil, errors.Wrapf(err, "invalid secret history")
+		}
+		versions = append(versions, &version)
+	}
+	return versions, nil
+}
+
+// secretEqual returns true if the secrets have the same content (ignoring
+// timestamps).
+func secretEqual(a *secret.Secret, b *secret.Secret) bool {
+	marshal := func(sec *secret.Secret) []byte {
+		cp := *sec
+		cp.CreatedAt = time.Time{}
+		cp.UpdatedAt = time.Time{}
+		b, _ := json.Marshal(cp)
+		return b
+	}
+	return bytes.Equal(marshal(a), marshal(b))
+}
+
+func secretVersionToRPC(v *secretVersion) *SecretVersion {
+	return &SecretVersion{
+		Version:    v.Version,
+		Secret:     secretToRPC(v.Secret),
+		ReplacedAt: int64(util.TimeToMillis(v.ReplacedAt)),
+	}
+}
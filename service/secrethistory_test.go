@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretHistory(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	service.cfg.Set(secretHistoryKey, "3")
+
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "testing", Type: PasswordSecret, Password: "password1"},
+	})
+	require.NoError(t, err)
+	id := saveResp.Secret.ID
+
+	// New secret, no history
+	historyResp, err := service.SecretHistory(ctx, &SecretHistoryRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(historyResp.Versions))
+
+	// Unchanged, no history
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{ID: id, Name: "testing", Type: PasswordSecret, Password: "password1"},
+	})
+	require.NoError(t, err)
+	historyResp, err = service.SecretHistory(ctx, &SecretHistoryRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(historyResp.Versions))
+
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{ID: id, Name: "testing", Type: PasswordSecret, Password: "password2"},
+	})
+	require.NoError(t, err)
+	historyResp, err = service.SecretHistory(ctx, &SecretHistoryRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(historyResp.Versions))
+	require.Equal(t, int64(1), historyResp.Versions[0].Version)
+	require.Equal(t, "password1", historyResp.Versions[0].Secret.Password)
+	require.NotEmpty(t, historyResp.Versions[0].ReplacedAt)
+
+	// Restore
+	restoreResp, err := service.SecretRestore(ctx, &SecretRestoreRequest{ID: id, Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, "password1", restoreResp.Secret.Password)
+	secretResp, err := service.Secret(ctx, &SecretRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "password1", secretResp.Secret.Password)
+	historyResp, err = service.SecretHistory(ctx, &SecretHistoryRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(historyResp.Versions))
+	require.Equal(t, int64(2), historyResp.Versions[0].Version)
+	require.Equal(t, "password2", historyResp.Versions[0].Secret.Password)
+
+	_, err = service.SecretRestore(ctx, &SecretRestoreRequest{ID: id, Version: 10})
+	require.EqualError(t, err, "secret "+id+" version 10 not found")
+
+	// Retention
+	for _, password := range []string{"password3", "password4", "password5"} {
+		_, err = service.SecretSave(ctx, &SecretSaveRequest{
+			Secret: &Secret{ID: id, Name: "testing", Type: PasswordSecret, Password: password},
+		})
+		require.NoError(t, err)
+	}
+	historyResp, err = service.SecretHistory(ctx, &SecretHistoryRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(historyResp.Versions))
+	require.Equal(t, int64(5), historyResp.Versions[0].Version)
+	require.Equal(t, "password4", historyResp.Versions[0].Secret.Password)
+	require.Equal(t, int64(3), historyResp.Versions[2].Version)
+
+	// Remove, then restore
+	_, err = service.SecretRemove(ctx, &SecretRemoveRequest{ID: id})
+	require.NoError(t, err)
+	_, err = service.Secret(ctx, &SecretRequest{ID: id})
+	require.EqualError(t, err, "not found "+id)
+	historyResp, err = service.SecretHistory(ctx, &SecretHistoryRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, int64(6), historyResp.Versions[0].Version)
+	require.Equal(t, "password5", historyResp.Versions[0].Secret.Password)
+	_, err = service.SecretRestore(ctx, &SecretRestoreRequest{ID: id, Version: 6})
+	require.NoError(t, err)
+	secretResp, err = service.Secret(ctx, &SecretRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "password5", secretResp.Secret.Password)
+
+	// Disabled
+	service.cfg.Set(secretHistoryKey, "0")
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{ID: id, Name: "testing", Type: PasswordSecret, Password: "password6"},
+	})
+	require.NoError(t, err)
+	historyResp, err = service.SecretHistory(ctx, &SecretHistoryRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(historyResp.Versions))
+}
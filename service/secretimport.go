@@ -0,0 +1,455 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys/secret"
+	"github.com/pkg/errors"
+)
+
+// Secrets import parses (unencrypted) exports from other password managers.
+//
+// Logins are imported as password secrets, or as notes if they only have a
+// name and notes. A login with a TOTP secret is imported as a password
+// secret and an OTP secret. Cards and identities (Bitwarden JSON) are
+// imported as card and contact secrets.
+//
+// Secrets with the same content as an existing secret (or a secret earlier in
+// the import) are skipped.
+
+// SecretsImport (RPC) imports secrets from a password manager export.
+func (s *service) SecretsImport(ctx context.Context, req *SecretsImportRequest) (*SecretsImportResponse, error) {
+	secrets, err := parseSecretsImport(req.Data, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.ss.List(nil)
+	if err != nil {
+		return nil, err
+	}
+	dups := map[string]string{}
+	for _, sec := range existing {
+		dups[secretImportKey(sec)] = sec.ID
+	}
+
+	out := make([]*SecretImport, 0, len(secrets))
+	imported, duplicates := 0, 0
+	for _, sec := range secrets {
+		key := secretImportKey(sec)
+		if id, ok := dups[key]; ok {
+			out = append(out, &SecretImport{Secret: secretToRPC(sec), Duplicate: id})
+			duplicates++
+			continue
+		}
+		sec.ID = secret.RandID()
+		if !req.DryRun {
+			saved, err := s.setSecret(ctx, sec)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.vaultChanged(ctx, saved.ID, saved); err != nil {
+				return nil, err
+			}
+			sec = saved
+		}
+		dups[key] = sec.ID
+		out = append(out, &SecretImport{Secret: secretToRPC(sec)})
+		imported++
+	}
+
+	return &SecretsImportResponse{
+		Secrets:    out,
+		Imported:   int32(imported),
+		Duplicates: int32(duplicates),
+	}, nil
+}
+
+// secretImportKey is the content of a secret (ignoring ID, timestamps and
+// name case) for duplicate detection.
+func secretImportKey(sec *secret.Secret) string {
+	cp := *sec
+	cp.ID = ""
+	cp.Name = strings.ToLower(strings.TrimSpace(cp.Name))
+	cp.CreatedAt = time.Time{}
+	cp.UpdatedAt = time.Time{}
+	b, err := json.Marshal(cp)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func parseSecretsImport(b []byte, format SecretsImportFormat) ([]*secret.Secret, error) {
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	switch format {
+	case OnePasswordCSV:
+		return parseCSVImport(b, "1Password", []string{"title", "password"})
+	case BitwardenCSV:
+		return parseCSVImport(b, "Bitwarden", []string{"name", "login_password"})
+	case ChromeCSV:
+		return parseCSVImport(b, "Chrome", []string{"name", "url", "password"})
+	case BitwardenJSON:
+		return parseBitwardenJSON(b)
+	case KeePassXML:
+		return parseKeePassXML(b)
+	default:
+		return nil, errors.Errorf("unsupported import format")
+	}
+}
+
+// login is a login (or note) from an import.
+type login struct {
+	Name     string
+	Username string
+	Password string
+	URL      string
+	Notes    string
+	OTP      string
+	Note     bool
+}
+
+// secrets for a login.
+func (l *login) secrets() []*secret.Secret {
+	name := strings.TrimSpace(l.Name)
+	if name == "" {
+		if u, err := url.Parse(l.URL); err == nil && u.Host != "" {
+			name = u.Host
+		} else if l.Username != "" {
+			name = l.Username
+		} else {
+			name = "Untitled"
+		}
+	}
+	typ := secret.PasswordType
+	if l.Note || (l.Username == "" && l.Password == "" && l.URL == "" && l.OTP == "") {
+		typ = secret.NoteType
+	}
+	out := []*secret.Secret{&secret.Secret{
+		Name:     name,
+		Type:     typ,
+		Username: l.Username,
+		Password: l.Password,
+		URL:      l.URL,
+		Notes:    l.Notes,
+	}}
+	if l.OTP != "" {
+		otp := &secret.Secret{
+			Name:     name,
+			Type:     otpSecretType,
+			Username: l.Username,
+			Password: l.OTP,
+		}
+		if err := otpSecret(otp); err != nil {
+			logger.Warningf("Skipping OTP for %s: %v", name, err)
+		} else {
+			out = append(out, otp)
+		}
+	}
+	return out
+}
+
+// csvColumns maps (lowercase) CSV headers from the supported formats to login
+// fields.
+var csvColumns = map[string]string{
+	"title":          "name",
+	"name":           "name",
+	"url":            "url",
+	"website":        "url",
+	"login_uri":      "url",
+	"username":       "username",
+	"login_username": "username",
+	"password":       "password",
+	"login_password": "password",
+	"notes":          "notes",
+	"note":           "notes",
+	"otpauth":        "otp",
+	"login_totp":     "otp",
+	"type":           "type",
+	"tags":           "tags",
+}
+
+func parseCSVImport(b []byte, name string, required []string) ([]*secret.Secret, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.Errorf("invalid %s csv, no header", name)
+		}
+		return nil, errors.Wrapf(err, "invalid %s csv", name)
+	}
+	// Fields from the first matching column
+	columns := map[string]bool{}
+	fields := map[string]int{}
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		columns[h] = true
+		if field, ok := csvColumns[h]; ok {
+			if _, ok := fields[field]; !ok {
+				fields[field] = i
+			}
+		}
+	}
+	for _, h := range required {
+		if !columns[h] {
+			return nil, errors.Errorf("invalid %s csv, missing column %q", name, h)
+		}
+	}
+
+	out := []*secret.Secret{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s csv", name)
+		}
+		value := func(field string) string {
+			i, ok := fields[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		l := &login{
+			Name:     value("name"),
+			Username: value("username"),
+			Password: value("password"),
+			URL:      value("url"),
+			Notes:    value("notes"),
+			OTP:      value("otp"),
+			Note:     strings.EqualFold(value("type"), "note"),
+		}
+		if l.Name == "" && l.Username == "" && l.Password == "" && l.URL == "" && l.Notes == "" {
+			continue
+		}
+		if tags := value("tags"); tags != "" {
+			l.Notes = appendTags(l.Notes, strings.Split(tags, ","))
+		}
+		out = append(out, l.secrets()...)
+	}
+	return out, nil
+}
+
+// appendTags adds tags to notes (as #tag, see secretTags).
+func appendTags(notes string, tags []string) string {
+	hashtags := []string{}
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(tag), "-")
+		if tag != "" {
+			hashtags = append(hashtags, "#"+tag)
+		}
+	}
+	if len(hashtags) == 0 {
+		return notes
+	}
+	if notes != "" {
+		notes += "\n"
+	}
+	return notes + strings.Join(hashtags, " ")
+}
+
+// Bitwarden (unencrypted) JSON export.
+type bitwardenExport struct {
+	Encrypted bool             `json:"encrypted"`
+	Items     []*bitwardenItem `json:"items"`
+}
+
+type bitwardenItem struct {
+	Type  int    `json:"type"`
+	Name  string `json:"name"`
+	Notes string `json:"notes"`
+	Login *struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		TOTP     string `json:"totp"`
+		URIs     []struct {
+			URI string `json:"uri"`
+		} `json:"uris"`
+	} `json:"login"`
+	Card *struct {
+		CardholderName string `json:"cardholderName"`
+		Number         string `json:"number"`
+		ExpMonth       string `json:"expMonth"`
+		ExpYear        string `json:"expYear"`
+		Code           string `json:"code"`
+	} `json:"card"`
+	Identity *struct {
+		FirstName  string `json:"firstName"`
+		LastName   string `json:"lastName"`
+		Company    string `json:"company"`
+		Email      string `json:"email"`
+		Phone      string `json:"phone"`
+		Address1   string `json:"address1"`
+		Address2   string `json:"address2"`
+		Address3   string `json:"address3"`
+		City       string `json:"city"`
+		State      string `json:"state"`
+		PostalCode string `json:"postalCode"`
+		Country    string `json:"country"`
+	} `json:"identity"`
+}
+
+const (
+	bitwardenLogin    = 1
+	bitwardenNote     = 2
+	bitwardenCard     = 3
+	bitwardenIdentity = 4
+)
+
+func parseBitwardenJSON(b []byte) ([]*secret.Secret, error) {
+	var export bitwardenExport
+	if err := json.Unmarshal(b, &export); err != nil {
+		return nil, errors.Wrapf(err, "invalid Bitwarden json")
+	}
+	if export.Encrypted {
+		return nil, errors.Errorf("encrypted Bitwarden exports aren't supported")
+	}
+	out := []*secret.Secret{}
+	for _, item := range export.Items {
+		switch {
+		case item.Type == bitwardenCard && item.Card != nil:
+			card := item.Card
+			expiration := card.ExpMonth
+			if card.ExpYear != "" {
+				expiration = strings.TrimPrefix(expiration+"/"+card.ExpYear, "/")
+			}
+			out = append(out, &secret.Secret{
+				Name:  item.Name,
+				Type:  secret.CardType,
+				Notes: item.Notes,
+				Card: &secret.Card{
+					FullName:   card.CardholderName,
+					Number:     card.Number,
+					Expiration: expiration,
+					Code:       card.Code,
+				},
+			})
+		case item.Type == bitwardenIdentity && item.Identity != nil:
+			identity := item.Identity
+			contact := &secret.Contact{
+				FirstName: identity.FirstName,
+				LastName:  identity.LastName,
+				Company:   identity.Company,
+			}
+			if identity.Email != "" {
+				contact.Emails = []string{identity.Email}
+			}
+			if identity.Phone != "" {
+				contact.Phones = []string{identity.Phone}
+			}
+			address := secret.Address{
+				Address1:   identity.Address1,
+				Address2:   identity.Address2,
+				Address3:   identity.Address3,
+				City:       identity.City,
+				State:      identity.State,
+				PostalCode: identity.PostalCode,
+				Country:    identity.Country,
+			}
+			if address != (secret.Address{}) {
+				contact.Addresses = []secret.Address{address}
+			}
+			out = append(out, &secret.Secret{
+				Name:    item.Name,
+				Type:    secret.ContactType,
+				Notes:   item.Notes,
+				Contact: contact,
+			})
+		case item.Type == bitwardenLogin || item.Type == bitwardenNote:
+			l := &login{
+				Name:  item.Name,
+				Notes: item.Notes,
+				Note:  item.Type == bitwardenNote,
+			}
+			if item.Login != nil {
+				l.Username = item.Login.Username
+				l.Password = item.Login.Password
+				l.OTP = item.Login.TOTP
+				if len(item.Login.URIs) > 0 {
+					l.URL = item.Login.URIs[0].URI
+				}
+			}
+			out = append(out, l.secrets()...)
+		default:
+			logger.Warningf("Skipping unsupported Bitwarden item (type %d)", item.Type)
+		}
+	}
+	return out, nil
+}
+
+// KeePass (2.x) XML export.
+type keepassFile struct {
+	XMLName xml.Name `xml:"KeePassFile"`
+	Meta    struct {
+		RecycleBinUUID string `xml:"RecycleBinUUID"`
+	} `xml:"Meta"`
+	Root struct {
+		Groups []*keepassGroup `xml:"Group"`
+	} `xml:"Root"`
+}
+
+type keepassGroup struct {
+	UUID    string          `xml:"UUID"`
+	Name    string          `xml:"Name"`
+	Entries []*keepassEntry `xml:"Entry"`
+	Groups  []*keepassGroup `xml:"Group"`
+}
+
+type keepassEntry struct {
+	Strings []struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	} `xml:"String"`
+}
+
+func parseKeePassXML(b []byte) ([]*secret.Secret, error) {
+	var file keepassFile
+	if err := xml.Unmarshal(b, &file); err != nil {
+		return nil, errors.Wrapf(err, "invalid KeePass xml")
+	}
+	out := []*secret.Secret{}
+	var walk func(groups []*keepassGroup)
+	walk = func(groups []*keepassGroup) {
+		for _, group := range groups {
+			if file.Meta.RecycleBinUUID != "" && group.UUID == file.Meta.RecycleBinUUID {
+				continue
+			}
+			for _, entry := range group.Entries {
+				l := &login{}
+				for _, s := range entry.Strings {
+					switch s.Key {
+					case "Title":
+						l.Name = s.Value
+					case "UserName":
+						l.Username = s.Value
+					case "Password":
+						l.Password = s.Value
+					case "URL":
+						l.URL = s.Value
+					case "Notes":
+						l.Notes = s.Value
+					case "otp", "TimeOtp-Secret-Base32":
+						l.OTP = s.Value
+					}
+				}
+				out = append(out, l.secrets()...)
+			}
+			walk(group.Groups)
+		}
+	}
+	walk(file.Root.Groups)
+	return out, nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys/secret"
+	"github.com/stretchr/testify/require"
+)
+
+const testOnePasswordCSV = "\xef\xbb\xbfTitle,Url,Username,Password,OTPAuth,Favorite,Archived,Tags,Notes\n" +
+	`GitHub,https://github.com/login,alice,password1,otpauth://totp/GitHub:alice?secret=GEZDGNBVGY3TQOJQ&issuer=GitHub,false,false,"work,dev",
+Recipes,,,,,false,false,,"Chocolate cake"
+`
+
+const testBitwardenCSV = `folder,favorite,type,name,notes,fields,reprompt,login_uri,login_username,login_password,login_totp
+,,login,GitHub,,,0,https://github.com/login,alice,password1,
+,,note,Wifi,"ssid: home",,0,,,,
+`
+
+const testChromeCSV = `name,url,username,password
+github.com,https://github.com/login,alice,password1
+,https://example.com/,bob,password2
+`
+
+const testBitwardenJSON = `{
+  "encrypted": false,
+  "items": [
+    {"type": 1, "name": "GitHub", "login": {"username": "alice", "password": "password1", "totp": "GEZDGNBVGY3TQOJQ", "uris": [{"uri": "https://github.com/login"}]}},
+    {"type": 2, "name": "Recipes", "notes": "Chocolate cake", "secureNote": {"type": 0}},
+    {"type": 3, "name": "Visa", "card": {"cardholderName": "Alice", "number": "4111111111111111", "expMonth": "1", "expYear": "2030", "code": "123"}},
+    {"type": 4, "name": "Alice", "identity": {"firstName": "Alice", "lastName": "Smith", "email": "alice@example.com", "city": "Springfield"}}
+  ]
+}`
+
+const testKeePassXML = `<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<KeePassFile>
+  <Meta>
+    <RecycleBinUUID>cmVjeWNsZWJpbg==</RecycleBinUUID>
+  </Meta>
+  <Root>
+    <Group>
+      <UUID>cm9vdA==</UUID>
+      <Name>Root</Name>
+      <Entry>
+        <String><Key>Title</Key><Value>GitHub</Value></String>
+        <String><Key>UserName</Key><Value>alice</Value></String>
+        <String><Key>Password</Key><Value ProtectValueInXMLFile="True">password1</Value></String>
+        <String><Key>URL</Key><Value>https://github.com/login</Value></String>
+        <String><Key>Notes</Key><Value /></String>
+        <History>
+          <Entry>
+            <String><Key>Title</Key><Value>GitHub</Value></String>
+            <String><Key>Password</Key><Value>password0</Value></String>
+          </Entry>
+        </History>
+      </Entry>
+      <Group>
+        <UUID>bm90ZXM=</UUID>
+        <Name>Notes</Name>
+        <Entry>
+          <String><Key>Title</Key><Value>Recipes</Value></String>
+          <String><Key>Notes</Key><Value>Chocolate cake</Value></String>
+        </Entry>
+      </Group>
+      <Group>
+        <UUID>cmVjeWNsZWJpbg==</UUID>
+        <Name>Recycle Bin</Name>
+        <Entry>
+          <String><Key>Title</Key><Value>Deleted</Value></String>
+        </Entry>
+      </Group>
+    </Group>
+  </Root>
+</KeePassFile>`
+
+func TestParseSecretsImport(t *testing.T) {
+	secrets, err := parseSecretsImport([]byte(testOnePasswordCSV), OnePasswordCSV)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(secrets))
+	require.Equal(t, &secret.Secret{Name: "GitHub", Type: secret.PasswordType, Username: "alice", Password: "password1", URL: "https://github.com/login", Notes: "#work #dev"}, secrets[0])
+	require.Equal(t, otpSecretType, secrets[1].Type)
+	require.Equal(t, "otpauth://totp/GitHub:alice?secret=GEZDGNBVGY3TQOJQ&issuer=GitHub&algorithm=SHA1&digits=6&period=30", secrets[1].Password)
+	require.Equal(t, &secret.Secret{Name: "Recipes", Type: secret.NoteType, Notes: "Chocolate cake"}, secrets[2])
+
+	secrets, err = parseSecretsImport([]byte(testBitwardenCSV), BitwardenCSV)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(secrets))
+	require.Equal(t, &secret.Secret{Name: "GitHub", Type: secret.PasswordType, Username: "alice", Password: "password1", URL: "https://github.com/login"}, secrets[0])
+	require.Equal(t, &secret.Secret{Name: "Wifi", Type: secret.NoteType, Notes: "ssid: home"}, secrets[1])
+
+	secrets, err = parseSecretsImport([]byte(testChromeCSV), ChromeCSV)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(secrets))
+	require.Equal(t, &secret.Secret{Name: "github.com", Type: secret.PasswordType, Username: "alice", Password: "password1", URL: "https://github.com/login"}, secrets[0])
+	require.Equal(t, "example.com", secrets[1].Name)
+
+	secrets, err = parseSecretsImport([]byte(testBitwardenJSON), BitwardenJSON)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(secrets))
+	require.Equal(t, secret.PasswordType, secrets[0].Type)
+	require.Equal(t, otpSecretType, secrets[1].Type)
+	require.Equal(t, &secret.Secret{Name: "Recipes", Type: secret.NoteType, Notes: "Chocolate cake"}, secrets[2])
+	require.Equal(t, &secret.Secret{Name: "Visa", Type: secret.CardType, Card: &secret.Card{FullName: "Alice", Number: "4111111111111111", Expiration: "1/2030", Code: "123"}}, secrets[3])
+	require.Equal(t, &secret.Secret{Name: "Alice", Type: secret.ContactType, Contact: &secret.Contact{
+		FirstName: "Alice",
+		LastName:  "Smith",
+		Emails:    []string{"alice@example.com"},
+		Addresses: []secret.Address{secret.Address{City: "Springfield"}},
+	}}, secrets[4])
+
+	secrets, err = parseSecretsImport([]byte(testKeePassXML), KeePassXML)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(secrets))
+	require.Equal(t, &secret.Secret{Name: "GitHub", Type: secret.PasswordType, Username: "alice", Password: "password1", URL: "https://github.com/login"}, secrets[0])
+	require.Equal(t, &secret.Secret{Name: "Recipes", Type: secret.NoteType, Notes: "Chocolate cake"}, secrets[1])
+
+	_, err = parseSecretsImport([]byte(testChromeCSV), OnePasswordCSV)
+	require.EqualError(t, err, "invalid 1Password csv, missing column \"title\"")
+	_, err = parseSecretsImport([]byte{}, ChromeCSV)
+	require.EqualError(t, err, "invalid Chrome csv, no header")
+	_, err = parseSecretsImport([]byte(`{"encrypted": true, "items": []}`), BitwardenJSON)
+	require.EqualError(t, err, "encrypted Bitwarden exports aren't supported")
+	_, err = parseSecretsImport([]byte(testChromeCSV), UnknownImportFormat)
+	require.EqualError(t, err, "unsupported import format")
+}
+
+func TestSecretsImport(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+
+	existing := testSaveSecret(t, service, &Secret{Name: "github", Type: PasswordSecret, Username: "alice", Password: "password1", URL: "https://github.com/login"})
+
+	// Dry run
+	resp, err := service.SecretsImport(ctx, &SecretsImportRequest{Data: []byte(testBitwardenJSON), Format: BitwardenJSON, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), resp.Imported)
+	require.Equal(t, int32(1), resp.Duplicates)
+	require.Equal(t, existing, resp.Secrets[0].Duplicate)
+	require.Equal(t, "", resp.Secrets[1].Duplicate)
+	require.Equal(t, []string{"github"}, testSecretNames(t, service, &SecretsRequest{}))
+
+	resp, err = service.SecretsImport(ctx, &SecretsImportRequest{Data: []byte(testBitwardenJSON), Format: BitwardenJSON})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), resp.Imported)
+	require.Equal(t, int32(1), resp.Duplicates)
+	require.Equal(t, []string{"Alice", "GitHub", "Recipes", "Visa", "github"}, testSecretNames(t, service, &SecretsRequest{}))
+	require.Equal(t, []string{"Recipes"}, testSecretNames(t, service, &SecretsRequest{Query: "chocolate"}))
+
+	codeResp, err := service.OTPCode(ctx, &OTPCodeRequest{ID: resp.Secrets[1].Secret.ID})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(codeResp.Code))
+
+	// Again, all duplicates (including from other formats)
+	resp, err = service.SecretsImport(ctx, &SecretsImportRequest{Data: []byte(testBitwardenJSON), Format: BitwardenJSON})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), resp.Imported)
+	require.Equal(t, int32(5), resp.Duplicates)
+	resp, err = service.SecretsImport(ctx, &SecretsImportRequest{Data: []byte(testKeePassXML), Format: KeePassXML})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), resp.Imported)
+	require.Equal(t, int32(2), resp.Duplicates)
+}
@@ -0,0 +1,456 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keys/secret"
+	"github.com/pkg/errors"
+)
+
+// Secret search index.
+//
+// Each secret has an index document in the local (encrypted) db at
+// /secret-index/{id}, with its type, name and terms for each field. Terms are
+// only in the (encrypted) document data, not in paths, which aren't encrypted.
+// The documents are loaded into an in-memory inverted index (term to secret
+// IDs) on the first search, which is updated as secrets change, and dropped
+// when the service is closed.
+//
+// Query terms match index terms exactly, by prefix, or fuzzy (by edit
+// distance, for longer terms). Tags are #tags in notes, since secrets don't
+// have a tags field.
+
+// secretItemType is the keyring item type for secrets (see secret.Store).
+const secretItemType = "secret"
+
+// Fields (bit flags) for an index term.
+const (
+	secretFieldName uint8 = 1 << iota
+	secretFieldUsername
+	secretFieldURL
+	secretFieldNotes
+	secretFieldTag
+)
+
+var secretFields = map[string]uint8{
+	"name":     secretFieldName,
+	"username": secretFieldUsername,
+	"url":      secretFieldURL,
+	"notes":    secretFieldNotes,
+	"tag":      secretFieldTag,
+}
+
+// secretFieldWeights are the score multipliers for matches in a field.
+var secretFieldWeights = map[uint8]int{
+	secretFieldName:     4,
+	secretFieldTag:      3,
+	secretFieldUsername: 2,
+	secretFieldURL:      2,
+	secretFieldNotes:    1,
+}
+
+// secretIndexDoc is the index document for a secret.
+type secretIndexDoc struct {
+	ID    string           `json:"id"`
+	Type  secret.Type      `json:"type"`
+	Name  string           `json:"name"`
+	Terms map[string]uint8 `json:"terms"`
+}
+
+type secretIndex struct {
+	docs map[string]*secretIndexDoc
+	// terms maps a term to secret IDs and the fields the term is in.
+	terms map[string]map[string]uint8
+	// sorted terms (for prefix matching), nil if terms changed.
+	sorted []string
+}
+
+func newSecretIndex() *secretIndex {
+	return &secretIndex{
+		docs:  map[string]*secretIndexDoc{},
+		terms: map[string]map[string]uint8{},
+	}
+}
+
+func (x *secretIndex) add(doc *secretIndexDoc) {
+	x.remove(doc.ID)
+	x.docs[doc.ID] = doc
+	for term, fields := range doc.Terms {
+		ids, ok := x.terms[term]
+		if !ok {
+			ids = map[string]uint8{}
+			x.terms[term] = ids
+			x.sorted = nil
+		}
+		ids[doc.ID] = fields
+	}
+}
+
+func (x *secretIndex) remove(id string) {
+	doc, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	delete(x.docs, id)
+	for term := range doc.Terms {
+		delete(x.terms[term], id)
+		if len(x.terms[term]) == 0 {
+			delete(x.terms, term)
+			x.sorted = nil
+		}
+	}
+}
+
+func (x *secretIndex) sortedTerms() []string {
+	if x.sorted == nil {
+		x.sorted = make([]string, 0, len(x.terms))
+		for term := range x.terms {
+			x.sorted = append(x.sorted, term)
+		}
+		sort.Strings(x.sorted)
+	}
+	return x.sorted
+}
+
+// secretQueryTerm is a query term, optionally restricted to fields.
+type secretQueryTerm struct {
+	term   string
+	fields uint8
+}
+
+// parseSecretQuery parses a query into terms. A term "field:value" is
+// restricted to a field, if it's a known field (otherwise, like a URL, it's
+// all text).
+func parseSecretQuery(query string) []*secretQueryTerm {
+	terms := []*secretQueryTerm{}
+	for _, s := range strings.Fields(query) {
+		fields := uint8(0xff)
+		if i := strings.Index(s, ":"); i > 0 {
+			if f, ok := secretFields[strings.ToLower(s[:i])]; ok {
+				fields = f
+				s = s[i+1:]
+			}
+		}
+		var tokens []string
+		if fields == secretFieldTag {
+			tokens = []string{strings.ToLower(strings.TrimPrefix(s, "#"))}
+		} else {
+			tokens = tokenize(s)
+		}
+		for _, token := range tokens {
+			if token == "" {
+				continue
+			}
+			terms = append(terms, &secretQueryTerm{term: token, fields: fields})
+		}
+	}
+	return terms
+}
+
+// Scores for how a term matched.
+const (
+	secretMatchFuzzy  = 1
+	secretMatchPrefix = 2
+	secretMatchExact  = 3
+)
+
+// match returns scores for secret IDs matching a query term.
+func (x *secretIndex) match(q *secretQueryTerm) map[string]int {
+	scores := map[string]int{}
+	add := func(term string, score int) {
+		for id, fields := range x.terms[term] {
+			fields = fields & q.fields
+			if fields == 0 {
+				continue
+			}
+			best := 0
+			for field, weight := range secretFieldWeights {
+				if fields&field != 0 && score*weight > best {
+					best = score * weight
+				}
+			}
+			if best > scores[id] {
+				scores[id] = best
+			}
+		}
+	}
+
+	sorted := x.sortedTerms()
+	i := sort.SearchStrings(sorted, q.term)
+	for ; i < len(sorted) && strings.HasPrefix(sorted[i], q.term); i++ {
+		if sorted[i] == q.term {
+			add(sorted[i], secretMatchExact)
+		} else {
+			add(sorted[i], secretMatchPrefix)
+		}
+	}
+
+	if max := fuzzyDistance(q.term); max > 0 {
+		for _, term := range sorted {
+			if strings.HasPrefix(term, q.term) {
+				continue
+			}
+			if levenshtein(q.term, term, max) <= max {
+				add(term, secretMatchFuzzy)
+			}
+		}
+	}
+	return scores
+}
+
+// search returns scores for secrets matching all the query terms, of the
+// specified types (or all types if empty).
+func (x *secretIndex) search(terms []*secretQueryTerm, types []secret.Type) map[string]int {
+	scores := map[string]int{}
+	for id, doc := range x.docs {
+		if len(types) > 0 && !containsSecretType(types, doc.Type) {
+			continue
+		}
+		scores[id] = 0
+	}
+	for _, q := range terms {
+		matches := x.match(q)
+		for id, score := range scores {
+			m, ok := matches[id]
+			if !ok {
+				delete(scores, id)
+				continue
+			}
+			scores[id] = score + m
+		}
+	}
+	return scores
+}
+
+func containsSecretType(types []secret.Type, t secret.Type) bool {
+	for _, e := range types {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// fuzzyDistance is the edit distance allowed for fuzzy matching a term.
+func fuzzyDistance(term string) int {
+	n := len([]rune(term))
+	switch {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// levenshtein returns the edit distance between a and b, or max+1 if it's
+// more than max.
+func levenshtein(a string, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > max || -d > max {
+		return max + 1
+	}
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// tokenize splits text into lowercase terms (letters and digits).
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// secretTags returns #tags in text.
+func secretTags(s string) []string {
+	tags := []string{}
+	for _, f := range strings.Fields(s) {
+		if !strings.HasPrefix(f, "#") {
+			continue
+		}
+		tag := strings.TrimFunc(strings.ToLower(f[1:]), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func newSecretIndexDoc(sec *secret.Secret) *secretIndexDoc {
+	terms := map[string]uint8{}
+	add := func(field uint8, tokens []string) {
+		for _, token := range tokens {
+			terms[token] |= field
+		}
+	}
+	add(secretFieldName, tokenize(sec.Name))
+	add(secretFieldUsername, tokenize(sec.Username))
+	add(secretFieldURL, tokenize(sec.URL))
+	add(secretFieldNotes, tokenize(sec.Notes))
+	add(secretFieldTag, secretTags(sec.Notes))
+	return &secretIndexDoc{
+		ID:    sec.ID,
+		Type:  sec.Type,
+		Name:  sec.Name,
+		Terms: terms,
+	}
+}
+
+// indexSecret adds (or updates) a secret in the index.
+func (s *service) indexSecret(ctx context.Context, sec *secret.Secret) error {
+	s.secretIndexMtx.Lock()
+	defer s.secretIndexMtx.Unlock()
+	doc := newSecretIndexDoc(sec)
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Set(ctx, ds.Path("secret-index", sec.ID), b); err != nil {
+		return errors.Wrapf(err, "failed to index secret")
+	}
+	if s.secretIndex != nil {
+		s.secretIndex.add(doc)
+	}
+	return nil
+}
+
+// unindexSecret removes a secret from the index.
+func (s *service) unindexSecret(ctx context.Context, id string) error {
+	s.secretIndexMtx.Lock()
+	defer s.secretIndexMtx.Unlock()
+	if _, err := s.db.Delete(ctx, ds.Path("secret-index", id)); err != nil {
+		return errors.Wrapf(err, "failed to remove secret from index")
+	}
+	if s.secretIndex != nil {
+		s.secretIndex.remove(id)
+	}
+	return nil
+}
+
+// searchSecrets returns index documents and scores for secrets matching the
+// query.
+func (s *service) searchSecrets(ctx context.Context, query string, types []secret.Type) ([]*secretIndexDoc, map[string]int, error) {
+	terms := parseSecretQuery(query)
+	s.secretIndexMtx.Lock()
+	defer s.secretIndexMtx.Unlock()
+	if s.secretIndex == nil {
+		x, err := s.loadSecretIndex(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.secretIndex = x
+	}
+	scores := s.secretIndex.search(terms, types)
+	docs := make([]*secretIndexDoc, 0, len(scores))
+	for id := range scores {
+		docs = append(docs, s.secretIndex.docs[id])
+	}
+	return docs, scores, nil
+}
+
+func (s *service) loadSecretIndex(ctx context.Context) (*secretIndex, error) {
+	logger.Debugf("Loading secret index...")
+	iter, err := s.db.Documents(ctx, "secret-index", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	x := newSecretIndex()
+	for {
+		d, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if d == nil {
+			break
+		}
+		var doc secretIndexDoc
+		if err := json.Unmarshal(d.Data, &doc); err != nil {
+			return nil, errors.Wrapf(err, "invalid secret index")
+		}
+		x.add(&doc)
+	}
+	return x, nil
+}
+
+// reindexSecrets rebuilds the index from the keyring.
+func (s *service) reindexSecrets(ctx context.Context) error {
+	secrets, err := s.ss.List(nil)
+	if err != nil {
+		return err
+	}
+	iter, err := s.db.Documents(ctx, "secret-index", &ds.DocumentsOpts{PathOnly: true})
+	if err != nil {
+		return err
+	}
+	paths := []string{}
+	for {
+		d, err := iter.Next()
+		if err != nil {
+			iter.Release()
+			return err
+		}
+		if d == nil {
+			break
+		}
+		paths = append(paths, d.Path)
+	}
+	iter.Release()
+	for _, path := range paths {
+		if _, err := s.db.Delete(ctx, path); err != nil {
+			return err
+		}
+	}
+
+	s.secretIndexMtx.Lock()
+	s.secretIndex = nil
+	s.secretIndexMtx.Unlock()
+	for _, sec := range secrets {
+		if err := s.indexSecret(ctx, sec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateSecretIndex indexes secrets saved before the search index existed.
+func migrateSecretIndex(ctx context.Context, s *service) error {
+	return s.reindexSecrets(ctx)
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testSaveSecret(t *testing.T, service *service, sec *Secret) string {
+	resp, err := service.SecretSave(context.TODO(), &SecretSaveRequest{Secret: sec})
+	require.NoError(t, err)
+	return resp.Secret.ID
+}
+
+func testSecretNames(t *testing.T, service *service, req *SecretsRequest) []string {
+	resp, err := service.Secrets(context.TODO(), req)
+	require.NoError(t, err)
+	names := []string{}
+	for _, sec := range resp.Secrets {
+		names = append(names, sec.Name)
+	}
+	return names
+}
+
+func TestSecretSearch(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+
+	github := testSaveSecret(t, service, &Secret{Name: "GitHub", Type: PasswordSecret, Username: "alice", URL: "https://github.com/login", Notes: "2FA enabled #work"})
+	testSaveSecret(t, service, &Secret{Name: "Gist backup", Type: PasswordSecret, Username: "bob", URL: "https://gist.github.com"})
+	testSaveSecret(t, service, &Secret{Name: "Visa", Type: CardSecret, Notes: "Expires soon #personal"})
+	testSaveSecret(t, service, &Secret{Name: "Recipes", Type: NoteSecret, Notes: "Chocolate cake"})
+
+	// All, by name
+	require.Equal(t, []string{"Gist backup", "GitHub", "Recipes", "Visa"}, testSecretNames(t, service, &SecretsRequest{}))
+
+	// Exact, prefix (by score, name matches first)
+	require.Equal(t, []string{"GitHub", "Gist backup"}, testSecretNames(t, service, &SecretsRequest{Query: "github"}))
+	require.Equal(t, []string{"Gist backup", "GitHub"}, testSecretNames(t, service, &SecretsRequest{Query: "gi"}))
+	require.Equal(t, []string{"Gist backup", "GitHub"}, testSecretNames(t, service, &SecretsRequest{Query: "gi", SortField: "name"}))
+	require.Equal(t, []string{"Recipes"}, testSecretNames(t, service, &SecretsRequest{Query: "choc"}))
+	require.Equal(t, []string{"GitHub"}, testSecretNames(t, service, &SecretsRequest{Query: "https://github.com/login"}))
+
+	// Fuzzy
+	require.Equal(t, []string{"Recipes"}, testSecretNames(t, service, &SecretsRequest{Query: "chocolte"}))
+	require.Equal(t, []string{"GitHub", "Gist backup"}, testSecretNames(t, service, &SecretsRequest{Query: "githib"}))
+	require.Equal(t, []string{}, testSecretNames(t, service, &SecretsRequest{Query: "vsa"}))
+
+	// All terms must match
+	require.Equal(t, []string{"GitHub"}, testSecretNames(t, service, &SecretsRequest{Query: "github alice"}))
+	require.Equal(t, []string{}, testSecretNames(t, service, &SecretsRequest{Query: "github charlie"}))
+
+	// Fields, tags
+	require.Equal(t, []string{"GitHub"}, testSecretNames(t, service, &SecretsRequest{Query: "username:alice"}))
+	require.Equal(t, []string{}, testSecretNames(t, service, &SecretsRequest{Query: "name:alice"}))
+	require.Equal(t, []string{"GitHub"}, testSecretNames(t, service, &SecretsRequest{Query: "tag:work"}))
+	require.Equal(t, []string{"Visa"}, testSecretNames(t, service, &SecretsRequest{Query: "tag:#personal"}))
+	require.Equal(t, []string{"Gist backup", "GitHub"}, testSecretNames(t, service, &SecretsRequest{Query: "url:github"}))
+
+	// Types
+	require.Equal(t, []string{"Recipes", "Visa"}, testSecretNames(t, service, &SecretsRequest{Types: []SecretType{CardSecret, NoteSecret}}))
+	require.Equal(t, []string{}, testSecretNames(t, service, &SecretsRequest{Query: "github", Types: []SecretType{CardSecret}}))
+	_, err := service.Secrets(ctx, &SecretsRequest{Types: []SecretType{UnknownSecret}})
+	require.EqualError(t, err, "unknown secret type")
+
+	// Pagination
+	resp, err := service.Secrets(ctx, &SecretsRequest{Index: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), resp.Total)
+	require.Equal(t, 2, len(resp.Secrets))
+	require.Equal(t, "GitHub", resp.Secrets[0].Name)
+	require.Equal(t, "Recipes", resp.Secrets[1].Name)
+	resp, err = service.Secrets(ctx, &SecretsRequest{Index: 10, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), resp.Total)
+	require.Equal(t, 0, len(resp.Secrets))
+	_, err = service.Secrets(ctx, &SecretsRequest{Index: -1})
+	require.EqualError(t, err, "invalid index or limit")
+
+	// Items
+	testImportKey(t, service, alice)
+	itemsResp, err := service.Items(ctx, &ItemsRequest{Query: "github alice"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(itemsResp.Items))
+	require.Equal(t, github, itemsResp.Items[0].ID)
+	itemsResp, err = service.Items(ctx, &ItemsRequest{Query: "edx25519"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(itemsResp.Items))
+	require.Equal(t, alice.ID().String(), itemsResp.Items[0].ID)
+
+	// Update, remove
+	testSaveSecret(t, service, &Secret{ID: github, Name: "GitLab", Type: PasswordSecret})
+	require.Equal(t, []string{"Gist backup"}, testSecretNames(t, service, &SecretsRequest{Query: "github"}))
+	require.Equal(t, []string{"GitLab"}, testSecretNames(t, service, &SecretsRequest{Query: "gitlab"}))
+	_, err = service.SecretRemove(ctx, &SecretRemoveRequest{ID: github})
+	require.NoError(t, err)
+	require.Equal(t, []string{}, testSecretNames(t, service, &SecretsRequest{Query: "gitlab"}))
+
+	// Rebuild (as after a migration)
+	_, err = service.db.Delete(ctx, "/secret-index/"+github)
+	require.NoError(t, err)
+	err = migrateSecretIndex(ctx, service)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Gist backup", "Recipes", "Visa"}, testSecretNames(t, service, &SecretsRequest{}))
+
+	// Reloaded after close
+	testAuthLock(t, service)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{Password: "testpassword", Client: "test"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Visa"}, testSecretNames(t, service, &SecretsRequest{Query: "visa"}))
+}
+
+func TestLevenshtein(t *testing.T) {
+	require.Equal(t, 0, levenshtein("github", "github", 2))
+	require.Equal(t, 1, levenshtein("githib", "github", 2))
+	require.Equal(t, 1, levenshtein("gitub", "github", 2))
+	require.Equal(t, 2, levenshtein("kitten", "sittin", 2))
+	require.Equal(t, 3, levenshtein("kitten", "sitting", 2))
+	require.Equal(t, 3, levenshtein("a", "abcd", 2))
+}
+
+func TestParseSecretQuery(t *testing.T) {
+	terms := parseSecretQuery("GitHub.com tag:#Work-Stuff name:alice url:https://example.com")
+	out := []string{}
+	for _, term := range terms {
+		out = append(out, term.term)
+	}
+	require.Equal(t, []string{"github", "com", "work-stuff", "alice", "https", "example", "com"}, out)
+	require.Equal(t, secretFieldTag, terms[2].fields)
+	require.Equal(t, secretFieldName, terms[3].fields)
+	require.Equal(t, secretFieldURL, terms[4].fields)
+}
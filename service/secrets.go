@@ -33,16 +33,24 @@ func (s *service) SecretSave(ctx context.Context, req *SecretSaveRequest) (*Secr
 	if sec.Type == secret.UnknownType {
 		return nil, errors.Errorf("unknown secret type")
 	}
+	if sec.Type == otpSecretType {
+		if err := otpSecret(sec); err != nil {
+			return nil, err
+		}
+	}
 
 	name := strings.TrimSpace(sec.Name)
 	if name == "" {
 		return nil, errors.Errorf("name not specified")
 	}
 
-	out, _, err := s.ss.Set(sec)
+	out, err := s.setSecret(ctx, sec)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.vaultChanged(ctx, out.ID, out); err != nil {
+		return nil, err
+	}
 
 	return &SecretSaveResponse{
 		Secret: secretToRPC(out),
@@ -53,56 +61,86 @@ func (s *service) SecretRemove(ctx context.Context, req *SecretRemoveRequest) (*
 	if req.ID == "" {
 		return nil, errors.Errorf("id not specified")
 	}
-	ok, err := s.ss.Delete(req.ID)
+	ok, err := s.removeSecret(ctx, req.ID)
 	if err != nil {
 		return nil, err
 	}
 	if !ok {
 		return nil, keys.NewErrNotFound(req.ID)
 	}
+	if err := s.vaultChanged(ctx, req.ID, nil); err != nil {
+		return nil, err
+	}
 	return &SecretRemoveResponse{}, nil
 }
 
+// Secrets (RPC) lists or searches secrets, see secretindex.go.
 func (s *service) Secrets(ctx context.Context, req *SecretsRequest) (*SecretsResponse, error) {
 	query := strings.TrimSpace(req.Query)
 
 	sortField := req.SortField
 	if sortField == "" {
 		sortField = "name"
+		if query != "" {
+			sortField = "score"
+		}
 	}
 	sortDirection := req.SortDirection
 
-	secrets, err := s.ss.List(nil)
-	if err != nil {
-		return nil, err
+	switch sortField {
+	case "id", "name", "score":
+	default:
+		return nil, errors.Errorf("invalid sort field")
+	}
+	if req.Index < 0 || req.Limit < 0 {
+		return nil, errors.Errorf("invalid index or limit")
 	}
 
-	out := make([]*Secret, 0, len(secrets))
-	for _, s := range secrets {
-		sec := secretToRPC(s)
-		if query == "" ||
-			strings.Contains(sec.Name, query) ||
-			strings.Contains(sec.Username, query) ||
-			strings.Contains(sec.URL, query) ||
-			strings.Contains(sec.Notes, query) {
-			out = append(out, sec)
+	types := make([]secret.Type, 0, len(req.Types))
+	for _, t := range req.Types {
+		st := secretTypeFromRPC(t)
+		if st == secret.UnknownType {
+			return nil, errors.Errorf("unknown secret type")
 		}
+		types = append(types, st)
 	}
 
-	switch sortField {
-	case "id", "name":
-	default:
-		return nil, errors.Errorf("invalid sort field")
+	docs, scores, err := s.searchSecrets(ctx, query, types)
+	if err != nil {
+		return nil, err
 	}
-
-	sort.Slice(out, func(i, j int) bool {
-		return secretsSort(out, sortField, sortDirection, i, j)
+	sort.Slice(docs, func(i, j int) bool {
+		return secretsSort(docs, scores, sortField, sortDirection, i, j)
 	})
 
+	total := len(docs)
+	start := int(req.Index)
+	if start > total {
+		start = total
+	}
+	end := total
+	if req.Limit > 0 && start+int(req.Limit) < end {
+		end = start + int(req.Limit)
+	}
+
+	out := make([]*Secret, 0, end-start)
+	for _, doc := range docs[start:end] {
+		sec, err := s.ss.Get(doc.ID)
+		if err != nil {
+			return nil, err
+		}
+		if sec == nil {
+			logger.Warningf("Secret %s in index not found", doc.ID)
+			continue
+		}
+		out = append(out, secretToRPC(sec))
+	}
+
 	return &SecretsResponse{
 		Secrets:       out,
 		SortField:     sortField,
 		SortDirection: sortDirection,
+		Total:         int32(total),
 	}, nil
 }
 
@@ -144,6 +182,8 @@ func secretTypeToRPC(t secret.Type) SecretType {
 		return CardSecret
 	case secret.NoteType:
 		return NoteSecret
+	case otpSecretType:
+		return OTPSecret
 	default:
 		return UnknownSecret
 	}
@@ -159,27 +199,34 @@ func secretTypeFromRPC(s SecretType) secret.Type {
 		return secret.CardType
 	case NoteSecret:
 		return secret.NoteType
+	case OTPSecret:
+		return otpSecretType
 	default:
 		return secret.UnknownType
 	}
 }
 
-func secretsSort(secrets []*Secret, sortField string, sortDirection SortDirection, i, j int) bool {
+func secretsSort(docs []*secretIndexDoc, scores map[string]int, sortField string, sortDirection SortDirection, i, j int) bool {
 	switch sortField {
 	case "id":
 		if sortDirection == SortDesc {
-			return secrets[i].ID > secrets[j].ID
+			return docs[i].ID > docs[j].ID
 		}
-		return secrets[i].ID < secrets[j].ID
+		return docs[i].ID < docs[j].ID
 	case "name":
-		if secrets[i].Name == secrets[j].Name {
-			return secretsSort(secrets, "id", sortDirection, i, j)
+		if docs[i].Name == docs[j].Name {
+			return secretsSort(docs, scores, "id", sortDirection, i, j)
 		}
 		if sortDirection == SortDesc {
-			return secrets[i].Name > secrets[j].Name
+			return docs[i].Name > docs[j].Name
+		}
+		return docs[i].Name < docs[j].Name
+	case "score":
+		if scores[docs[i].ID] == scores[docs[j].ID] {
+			return secretsSort(docs, scores, "name", sortDirection, i, j)
 		}
-		return secrets[i].Name < secrets[j].Name
+		return scores[docs[i].ID] > scores[docs[j].ID]
 	default:
-		return secretsSort(secrets, "name", sortDirection, i, j)
+		return secretsSort(docs, scores, "name", sortDirection, i, j)
 	}
 }
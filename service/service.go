@@ -12,6 +12,7 @@ import (
 	"github.com/keys-pub/keys/user"
 	"github.com/keys-pub/keys/util"
 	"github.com/keys-pub/keysd/db"
+	"github.com/keys-pub/keysd/fido2"
 	"github.com/keys-pub/keysd/http/client"
 )
 
@@ -34,7 +35,17 @@ type service struct {
 	open    bool
 	openMtx sync.Mutex
 
-	fido2 bool
+	// fido2 authenticators, or nil if not available.
+	fido2 fido2.AuthenticatorsServer
+
+	sshAgentPath string
+
+	vaultMtx sync.Mutex
+	// secretMtx locks secret changes (with history).
+	secretMtx sync.Mutex
+	// secretIndex is the search index, or nil if not loaded.
+	secretIndex    *secretIndex
+	secretIndexMtx sync.Mutex
 
 	watchLast *ds.WatchEvent
 	watchLn   ds.WatchLn
@@ -107,6 +118,15 @@ func (s *service) Open(ctx context.Context, key *[32]byte) error {
 	if err := s.db.OpenAtPath(ctx, path, key); err != nil {
 		return err
 	}
+
+	if _, err := s.migrate(ctx, migrations); err != nil {
+		s.db.Close()
+		return err
+	}
+	if _, err := s.migrateKeyringItems(ctx, keyringMigrations); err != nil {
+		s.db.Close()
+		return err
+	}
 	s.open = true
 
 	// If database is new, we are either in a new state or from a uninstalled
@@ -138,6 +158,9 @@ func (s *service) close() {
 	s.stopUpdateCheck()
 	s.watchReqClose()
 	logger.Infof("Closing db...")
+	s.secretIndexMtx.Lock()
+	s.secretIndex = nil
+	s.secretIndexMtx.Unlock()
 	s.db.Close()
 	s.open = false
 }
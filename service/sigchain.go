@@ -5,6 +5,7 @@ import (
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys/util"
+	"github.com/keys-pub/keysd/http/api"
 	"github.com/pkg/errors"
 )
 
@@ -130,7 +131,7 @@ func (s *service) StatementCreate(ctx context.Context, req *StatementCreateReque
 	if err != nil {
 		return nil, err
 	}
-	st, err := keys.NewSigchainStatement(sc, req.Data, key, "", s.Now())
+	st, err := s.newStatement(ctx, sc, req, key)
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +148,9 @@ func (s *service) StatementCreate(ctx context.Context, req *StatementCreateReque
 	if err := s.scs.SaveSigchain(sc); err != nil {
 		return nil, err
 	}
+	if err := s.indexKeyDirectory(ctx, sc); err != nil {
+		return nil, err
+	}
 
 	stOut := statementToRPC(st)
 
@@ -155,6 +159,50 @@ func (s *service) StatementCreate(ctx context.Context, req *StatementCreateReque
 	}, nil
 }
 
+func (s *service) newStatement(ctx context.Context, sc *keys.Sigchain, req *StatementCreateRequest, key *keys.EdX25519Key) (*keys.Statement, error) {
+	switch req.Type {
+	case "":
+		return keys.NewSigchainStatement(sc, req.Data, key, "", s.Now())
+	case api.DeviceStatementType:
+		if len(req.Data) > 0 {
+			return nil, errors.Errorf("data not allowed for %s statement", req.Type)
+		}
+		device, err := s.parseSignKey(req.LinkKID, true)
+		if err != nil {
+			return nil, err
+		}
+		return api.NewDeviceStatement(sc, device, key, s.Now())
+	case api.EncryptKeyStatementType:
+		if len(req.Data) > 0 {
+			return nil, errors.Errorf("data not allowed for %s statement", req.Type)
+		}
+		kid, err := s.parseKID(req.LinkKID)
+		if err != nil {
+			return nil, err
+		}
+		if kid.PublicKeyType() != keys.X25519Public {
+			return nil, errors.Errorf("invalid encryption key type %s", kid.PublicKeyType())
+		}
+		// The encryption key signs its consent to the link, so we need it in
+		// the keyring.
+		ek, err := s.ks.X25519Key(kid)
+		if err != nil {
+			return nil, err
+		}
+		if ek == nil {
+			return nil, keys.NewErrNotFound(kid.String())
+		}
+		return api.NewEncryptKeyStatement(sc, ek, key, s.Now())
+	case fido2KeyStatementType:
+		if len(req.Data) > 0 {
+			return nil, errors.Errorf("data not allowed for %s statement", req.Type)
+		}
+		return s.newFIDO2KeyStatement(ctx, sc, req.LinkKID, key)
+	default:
+		return nil, errors.Errorf("unsupported statement type %s", req.Type)
+	}
+}
+
 // StatementRevoke (RPC) ...
 func (s *service) StatementRevoke(ctx context.Context, req *StatementRevokeRequest) (*StatementRevokeResponse, error) {
 	key, err := s.parseSignKey(req.KID, true)
@@ -181,6 +229,9 @@ func (s *service) StatementRevoke(ctx context.Context, req *StatementRevokeReque
 	if err := s.scs.SaveSigchain(sc); err != nil {
 		return nil, err
 	}
+	if err := s.indexKeyDirectory(ctx, sc); err != nil {
+		return nil, err
+	}
 
 	if _, err = s.users.Update(ctx, key.ID()); err != nil {
 		return nil, err
@@ -4,8 +4,11 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha512"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys/saltpack"
@@ -14,11 +17,51 @@ import (
 
 // Sign (RPC) ...
 func (s *service) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	if err := checkSignSig(req.Format, req.Sig); err != nil {
+		return nil, err
+	}
+	if req.Format == FIDO2SignFormat {
+		key, err := s.parseFIDO2Signer(ctx, req.Signer)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := s.fido2Sign(ctx, key, bytes.NewReader(req.Data))
+		if err != nil {
+			return nil, err
+		}
+		return &SignResponse{
+			Data: sig,
+			KID:  key.ID().String(),
+		}, nil
+	}
 	key, err := s.parseSigner(req.Signer, true)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Format == MultiSignFormat {
+		h := sha512.Sum512(req.Data)
+		sig, err := multiSignHash(key, h[:], req.Sig)
+		if err != nil {
+			return nil, err
+		}
+		return &SignResponse{
+			Data: sig,
+			KID:  key.ID().String(),
+		}, nil
+	}
+
+	if req.Format == SSHSignFormat {
+		sig, err := sshSign(key, req.Namespace, bytes.NewReader(req.Data), req.Armored)
+		if err != nil {
+			return nil, err
+		}
+		return &SignResponse{
+			Data: sig,
+			KID:  key.ID().String(),
+		}, nil
+	}
+
 	sp := saltpack.NewSaltpack(s.ks)
 	var signed []byte
 	if req.Armored {
@@ -53,19 +96,35 @@ func (s *service) SignFile(srv Keys_SignFileServer) error {
 	}
 	out := req.Out
 	if out == "" {
-		if req.Detached {
+		if req.Detached || req.Format == MultiSignFormat || req.Format == FIDO2SignFormat {
 			out = in + ".sig"
 		} else {
 			out = in + ".signed"
 		}
 	}
+	if err := checkSignSig(req.Format, req.Sig); err != nil {
+		return err
+	}
+
+	if req.Format == FIDO2SignFormat {
+		key, err := s.parseFIDO2Signer(srv.Context(), req.Signer)
+		if err != nil {
+			return err
+		}
+		if err := s.fido2SignWriteInOut(srv.Context(), in, out, key); err != nil {
+			return err
+		}
+		return srv.Send(&SignFileOutput{
+			KID: key.ID().String(),
+		})
+	}
 
 	key, err := s.parseSigner(req.Signer, true)
 	if err != nil {
 		return err
 	}
 
-	if err := s.signWriteInOut(srv.Context(), in, out, key, req.Armored, req.Detached); err != nil {
+	if err := s.signWriteInOut(srv.Context(), in, out, key, req.Armored, req.Detached, req.Format, req.Sig); err != nil {
 		return err
 	}
 
@@ -78,6 +137,69 @@ func (s *service) SignFile(srv Keys_SignFileServer) error {
 	return nil
 }
 
+// SignDir (RPC) signs a manifest for a directory.
+func (s *service) SignDir(ctx context.Context, req *SignDirRequest) (*SignDirResponse, error) {
+	if req.Dir == "" {
+		return nil, errors.Errorf("dir not specified")
+	}
+	dir := filepath.Clean(req.Dir)
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf("%s is not a directory", dir)
+	}
+	out := req.Out
+	if out == "" {
+		out = filepath.Join(dir, manifestFilename)
+	}
+	out = filepath.Clean(out)
+
+	key, err := s.parseSigner(req.Signer, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ignore, err := readIgnoreFile(dir)
+	if err != nil {
+		return nil, err
+	}
+	ignore = append(ignore, req.Ignore...)
+	if err := validateIgnorePatterns(ignore); err != nil {
+		return nil, err
+	}
+
+	logger.Infof("Signing manifest for %s to %s", dir, out)
+	files, err := scanDir(ctx, dir, ignore, out)
+	if err != nil {
+		return nil, err
+	}
+	m := &manifest{Ignore: ignore, Files: files}
+
+	sp := saltpack.NewSaltpack(s.ks)
+	signed, err := sp.SignArmored(m.encode(), key)
+	if err != nil {
+		return nil, err
+	}
+	outTmp := out + ".tmp"
+	if err := ioutil.WriteFile(outTmp, []byte(signed), 0600); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(outTmp)
+	}()
+	if err := os.Rename(outTmp, out); err != nil {
+		return nil, err
+	}
+
+	return &SignDirResponse{
+		KID:   key.ID().String(),
+		Out:   out,
+		Files: int32(len(files)),
+	}, nil
+}
+
 // SignStream (RPC) ...
 func (s *service) SignStream(srv Keys_SignStreamServer) error {
 	init := false
@@ -107,20 +229,16 @@ func (s *service) SignStream(srv Keys_SignStreamServer) error {
 			if stream != nil {
 				return errors.Errorf("stream already initialized")
 			}
-			key, err := s.parseSigner(req.Signer, true)
-			if err != nil {
-				return err
-			}
-			s, err := s.signWriter(ctx, &buf, key, req.Armored, req.Detached)
+			w, k, err := s.signStreamWriter(ctx, &buf, req)
 			if err != nil {
 				return err
 			}
-			stream = s
-			kid = key.ID()
+			stream = w
+			kid = k
 
 		} else {
 			// Make sure request only sends data after init
-			if req.Signer != "" || req.Armored || req.Detached {
+			if req.Signer != "" || req.Armored || req.Detached || req.Format != DefaultSignFormat || req.Namespace != "" || len(req.Sig) != 0 {
 				return errors.Errorf("after stream is initalized, only data should be sent")
 			}
 		}
@@ -163,6 +281,50 @@ func (s *service) SignStream(srv Keys_SignStreamServer) error {
 	return nil
 }
 
+// signStreamWriter returns the writer for a sign stream (from the initial
+// request).
+func (s *service) signStreamWriter(ctx context.Context, buf io.Writer, req *SignInput) (io.WriteCloser, keys.ID, error) {
+	if err := checkSignSig(req.Format, req.Sig); err != nil {
+		return nil, "", err
+	}
+	if req.Format == FIDO2SignFormat {
+		logger.Debugf("Signing mode: fido2")
+		key, err := s.parseFIDO2Signer(ctx, req.Signer)
+		if err != nil {
+			return nil, "", err
+		}
+		return s.newFIDO2SignWriter(ctx, buf, key), key.ID(), nil
+	}
+	key, err := s.parseSigner(req.Signer, true)
+	if err != nil {
+		return nil, "", err
+	}
+	var w io.WriteCloser
+	switch req.Format {
+	case SSHSignFormat:
+		logger.Debugf("Signing mode: ssh")
+		w, err = newSSHSignWriter(buf, key, req.Namespace, req.Armored)
+	case MultiSignFormat:
+		logger.Debugf("Signing mode: multi")
+		w, err = newMultiSignWriter(buf, key, req.Sig)
+	default:
+		w, err = s.signWriter(ctx, buf, key, req.Armored, req.Detached)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return w, key.ID(), nil
+}
+
+// checkSignSig checks that an existing signature is only specified for
+// multi-signatures.
+func checkSignSig(format SignFormat, sig []byte) error {
+	if len(sig) != 0 && format != MultiSignFormat {
+		return errors.Errorf("sig is only supported with multi format")
+	}
+	return nil
+}
+
 func (s *service) signWriter(ctx context.Context, w io.Writer, key *keys.EdX25519Key, armored bool, detached bool) (io.WriteCloser, error) {
 	sp := saltpack.NewSaltpack(s.ks)
 	if armored {
@@ -181,7 +343,7 @@ func (s *service) signWriter(ctx context.Context, w io.Writer, key *keys.EdX2551
 	return sp.NewSignStream(w, key)
 }
 
-func (s *service) signWriteInOut(ctx context.Context, in string, out string, key *keys.EdX25519Key, armored bool, detached bool) error {
+func (s *service) signWriteInOut(ctx context.Context, in string, out string, key *keys.EdX25519Key, armored bool, detached bool, format SignFormat, sig []byte) error {
 	logger.Infof("Signing %s to %s", in, out)
 
 	outTmp := out + ".tmp"
@@ -195,7 +357,15 @@ func (s *service) signWriteInOut(ctx context.Context, in string, out string, key
 	}()
 	writer := bufio.NewWriter(outFile)
 
-	stream, err := s.signWriter(ctx, writer, key, armored, detached)
+	var stream io.WriteCloser
+	switch format {
+	case DefaultSignFormat:
+		stream, err = s.signWriter(ctx, writer, key, armored, detached)
+	case MultiSignFormat:
+		stream, err = newMultiSignWriter(writer, key, sig)
+	default:
+		return errors.Errorf("unsupported sign format for file %s", format)
+	}
 	if err != nil {
 		return err
 	}
@@ -187,13 +187,13 @@ func TestSignVerifyAttachedFile(t *testing.T) {
 	aliceClient, aliceClientCloseFn := newTestRPCClient(t, aliceService, env, "")
 	defer aliceClientCloseFn()
 
-	err := signFile(aliceClient, alice.ID().String(), true, false, inPath, outPath)
+	err := signFile(aliceClient, alice.ID().String(), true, false, DefaultSignFormat, nil, inPath, outPath)
 	require.NoError(t, err)
 
 	bobClient, bobClientCloseFn := newTestRPCClient(t, bobService, env, "")
 	defer bobClientCloseFn()
 
-	_, err = verifyFile(bobClient, true, outPath, verifiedPath, alice.ID().String())
+	_, err = verifyFile(bobClient, true, outPath, verifiedPath, alice.ID().String(), "")
 	require.NoError(t, err)
 
 	bout, err := ioutil.ReadFile(verifiedPath)
@@ -245,3 +245,45 @@ func TestVerifyUnverified(t *testing.T) {
 	})
 	require.EqualError(t, err, "user bob@github has failed status connection-fail")
 }
+
+func TestSignVerifyMulti(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+	testUserSetupGithub(t, env, service, alice, "alice")
+
+	message := []byte("release v1.0.0")
+	signResp, err := service.Sign(context.TODO(), &SignRequest{Data: message, Signer: "alice@github", Format: MultiSignFormat})
+	require.NoError(t, err)
+	require.True(t, isMultiSig(signResp.Data))
+
+	signResp, err = service.Sign(context.TODO(), &SignRequest{Data: message, Signer: bob.ID().String(), Format: MultiSignFormat, Sig: signResp.Data})
+	require.NoError(t, err)
+	sig := signResp.Data
+
+	_, err = service.Sign(context.TODO(), &SignRequest{Data: message, Signer: bob.ID().String(), Sig: sig})
+	require.EqualError(t, err, "sig is only supported with multi format")
+
+	// Autodetect format
+	verifyResp, err := service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{Data: message, Sig: sig})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(verifyResp.Signers))
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+	require.Equal(t, alice.ID().String(), verifyResp.Signers[0].ID)
+	require.Equal(t, "alice@github", verifyResp.Signers[0].User.ID)
+	require.Equal(t, bob.ID().String(), verifyResp.Signers[1].ID)
+
+	policy := &SignPolicy{Signers: []string{"alice@github", bob.ID().String(), charlie.ID().String()}, Threshold: 2}
+	_, err = service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{Data: message, Sig: sig, Policy: policy})
+	require.NoError(t, err)
+
+	policy.Threshold = 3
+	_, err = service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{Data: message, Sig: sig, Policy: policy})
+	require.EqualError(t, err, "signature policy not satisfied, 2 of 3 required signers")
+
+	_, err = service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{Data: []byte("release v1.0.1"), Sig: sig})
+	require.EqualError(t, err, "invalid signature from "+alice.ID().String())
+}
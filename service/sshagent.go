@@ -0,0 +1,190 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net"
+	"os"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// sshAgent serves the SSH agent protocol for EdX25519 keys in the keyring.
+// Keys can't be added or removed through the agent, and it refuses to list or
+// sign if the service is locked.
+type sshAgent struct {
+	ks   *keys.Store
+	auth *auth
+}
+
+var _ agent.Agent = &sshAgent{}
+
+var errSSHAgentLocked = errors.New("keys service is locked")
+var errSSHAgentUnsupported = errors.New("not supported by keys ssh agent")
+
+func newSSHAgent(ks *keys.Store, auth *auth) *sshAgent {
+	return &sshAgent{ks: ks, auth: auth}
+}
+
+// sshPublicKey returns the SSH public key for an EdX25519 public key.
+func sshPublicKey(spk *keys.EdX25519PublicKey) (ssh.PublicKey, error) {
+	return ssh.NewPublicKey(ed25519.PublicKey(spk.Bytes()))
+}
+
+// sshAuthorizedKey returns an authorized_keys line for an EdX25519 public key,
+// with the kid as the comment.
+func sshAuthorizedKey(spk *keys.EdX25519PublicKey) (string, error) {
+	pk, err := sshPublicKey(spk)
+	if err != nil {
+		return "", err
+	}
+	out := bytes.TrimSpace(ssh.MarshalAuthorizedKey(pk))
+	return string(out) + " " + spk.ID().String(), nil
+}
+
+func (a *sshAgent) keys() ([]*keys.EdX25519Key, error) {
+	if a.auth.locked() {
+		return nil, errSSHAgentLocked
+	}
+	ks, err := a.ks.Keys(&keys.Opts{Types: []keys.KeyType{keys.EdX25519}})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*keys.EdX25519Key, 0, len(ks))
+	for _, k := range ks {
+		sk, ok := k.(*keys.EdX25519Key)
+		if !ok {
+			continue
+		}
+		out = append(out, sk)
+	}
+	return out, nil
+}
+
+// List returns the EdX25519 keys in the keyring.
+func (a *sshAgent) List() ([]*agent.Key, error) {
+	sks, err := a.keys()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*agent.Key, 0, len(sks))
+	for _, sk := range sks {
+		pk, err := sshPublicKey(sk.PublicKey())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &agent.Key{
+			Format:  pk.Type(),
+			Blob:    pk.Marshal(),
+			Comment: sk.ID().String(),
+		})
+	}
+	return out, nil
+}
+
+// Sign data with the EdX25519 key matching the SSH public key.
+func (a *sshAgent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	sks, err := a.keys()
+	if err != nil {
+		return nil, err
+	}
+	blob := key.Marshal()
+	for _, sk := range sks {
+		pk, err := sshPublicKey(sk.PublicKey())
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(pk.Marshal(), blob) {
+			continue
+		}
+		signer, err := ssh.NewSignerFromKey(ed25519.PrivateKey(sk.PrivateKey()[:]))
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("SSH agent sign with %s", sk.ID())
+		return signer.Sign(rand.Reader, data)
+	}
+	return nil, errors.Errorf("key not found")
+}
+
+// Signers isn't supported.
+func (a *sshAgent) Signers() ([]ssh.Signer, error) {
+	return nil, errSSHAgentUnsupported
+}
+
+// Add isn't supported, use `keys import` instead.
+func (a *sshAgent) Add(key agent.AddedKey) error {
+	return errSSHAgentUnsupported
+}
+
+// Remove isn't supported.
+func (a *sshAgent) Remove(key ssh.PublicKey) error {
+	return errSSHAgentUnsupported
+}
+
+// RemoveAll isn't supported.
+func (a *sshAgent) RemoveAll() error {
+	return errSSHAgentUnsupported
+}
+
+// Lock isn't supported, use `keys auth lock` instead.
+func (a *sshAgent) Lock(passphrase []byte) error {
+	return errSSHAgentUnsupported
+}
+
+// Unlock isn't supported.
+func (a *sshAgent) Unlock(passphrase []byte) error {
+	return errSSHAgentUnsupported
+}
+
+func sshAgentPath(cfg *Config) (string, error) {
+	return cfg.AppPath("ssh-agent.sock", false)
+}
+
+// listenSSHAgent listens on the agent socket, removing a stale socket left
+// from a previous run.
+func listenSSHAgent(path string) (net.Listener, error) {
+	logger.Infof("Listening for ssh agent on %s", path)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to remove ssh agent socket")
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen for ssh agent")
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		_ = lis.Close()
+		return nil, errors.Wrapf(err, "failed to set ssh agent socket permissions")
+	}
+	return lis, nil
+}
+
+func (a *sshAgent) serve(lis net.Listener) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			logger.Infof("SSH agent stopped: %v", err)
+			return
+		}
+		go func() {
+			defer conn.Close()
+			if err := agent.ServeAgent(a, conn); err != nil && errors.Cause(err) != io.EOF {
+				logger.Warningf("SSH agent error: %v", err)
+			}
+		}()
+	}
+}
+
+// SSHAgent (RPC) returns the SSH agent socket path.
+func (s *service) SSHAgent(ctx context.Context, req *SSHAgentRequest) (*SSHAgentResponse, error) {
+	if s.sshAgentPath == "" {
+		return nil, errors.Errorf("ssh agent is not enabled, run `keys config set %s true`", sshAgentKey)
+	}
+	return &SSHAgentResponse{Path: s.sshAgentPath}, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func TestSSHAgent(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	go newSSHAgent(service.ks, service.auth).serve(lis)
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	ag := agent.NewClient(conn)
+
+	list, err := ag.List()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, "ssh-ed25519", list[0].Format)
+	require.Equal(t, alice.ID().String(), list[0].Comment)
+
+	pk, err := sshPublicKey(alice.PublicKey())
+	require.NoError(t, err)
+	require.Equal(t, pk.Marshal(), list[0].Blob)
+
+	data := []byte("hi")
+	sig, err := ag.Sign(list[0], data)
+	require.NoError(t, err)
+	err = pk.Verify(data, sig)
+	require.NoError(t, err)
+
+	// Unknown key
+	bob, err := sshPublicKey(keys.GenerateEdX25519Key().PublicKey())
+	require.NoError(t, err)
+	_, err = ag.Sign(bob, data)
+	require.EqualError(t, err, "agent: failed to sign challenge")
+
+	err = ag.RemoveAll()
+	require.EqualError(t, err, "agent: failure")
+
+	// Locked
+	err = service.auth.lock()
+	require.NoError(t, err)
+	_, err = ag.List()
+	require.EqualError(t, err, "agent: failed to list keys")
+	_, err = ag.Sign(list[0], data)
+	require.EqualError(t, err, "agent: failed to sign challenge")
+}
+
+func TestSSHAuthorizedKey(t *testing.T) {
+	line, err := sshAuthorizedKey(alice.PublicKey())
+	require.NoError(t, err)
+
+	pk, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), comment)
+
+	spk, err := keys.ParseSSHPublicKey(string(ssh.MarshalAuthorizedKey(pk)))
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), spk.ID())
+}
+
+func TestSSHAgentRPC(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+
+	_, err := service.SSHAgent(context.TODO(), &SSHAgentRequest{})
+	require.EqualError(t, err, "ssh agent is not enabled, run `keys config set sshAgent true`")
+
+	service.sshAgentPath = "/tmp/ssh-agent.sock"
+	resp, err := service.SSHAgent(context.TODO(), &SSHAgentRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "/tmp/ssh-agent.sock", resp.Path)
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSH signatures (SSHSIG), compatible with ssh-keygen -Y sign/verify.
+// See https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+
+const (
+	sshSigMagic      = "SSHSIG"
+	sshSigVersion    = 1
+	sshSigHashSHA512 = "sha512"
+	sshSigHashSHA256 = "sha256"
+	sshSigBegin      = "-----BEGIN SSH SIGNATURE-----"
+	sshSigEnd        = "-----END SSH SIGNATURE-----"
+	sshSigLineLength = 70
+)
+
+// sshSigBlob is the signature (after the magic preamble).
+type sshSigBlob struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSigSignedData is what is signed (after the magic preamble).
+type sshSigSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+func sshSigMessage(namespace string, hashAlgorithm string, h []byte) []byte {
+	b := ssh.Marshal(sshSigSignedData{
+		Namespace:     namespace,
+		HashAlgorithm: hashAlgorithm,
+		Hash:          h,
+	})
+	return append([]byte(sshSigMagic), b...)
+}
+
+func sshSigHash(hashAlgorithm string) (hash.Hash, error) {
+	switch hashAlgorithm {
+	case sshSigHashSHA512:
+		return sha512.New(), nil
+	case sshSigHashSHA256:
+		return sha256.New(), nil
+	default:
+		return nil, errors.Errorf("unsupported ssh signature hash algorithm %s", hashAlgorithm)
+	}
+}
+
+// sshSignHash signs a (sha512) hash of a message.
+func sshSignHash(key *keys.EdX25519Key, namespace string, h []byte) ([]byte, error) {
+	if namespace == "" {
+		return nil, errors.Errorf("no namespace specified")
+	}
+	signer, err := ssh.NewSignerFromKey(ed25519.PrivateKey(key.PrivateKey()[:]))
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(rand.Reader, sshSigMessage(namespace, sshSigHashSHA512, h))
+	if err != nil {
+		return nil, err
+	}
+	b := ssh.Marshal(sshSigBlob{
+		Version:       sshSigVersion,
+		PublicKey:     signer.PublicKey().Marshal(),
+		Namespace:     namespace,
+		HashAlgorithm: sshSigHashSHA512,
+		Signature:     ssh.Marshal(sig),
+	})
+	return append([]byte(sshSigMagic), b...), nil
+}
+
+// sshSign creates a SSH signature for a message.
+func sshSign(key *keys.EdX25519Key, namespace string, r io.Reader, armored bool) ([]byte, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	sig, err := sshSignHash(key, namespace, h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	if armored {
+		return sshSigArmor(sig), nil
+	}
+	return sig, nil
+}
+
+type sshSignWriter struct {
+	w         io.Writer
+	h         hash.Hash
+	key       *keys.EdX25519Key
+	namespace string
+	armored   bool
+}
+
+// newSSHSignWriter returns a writer that writes the SSH signature to w on
+// Close.
+func newSSHSignWriter(w io.Writer, key *keys.EdX25519Key, namespace string, armored bool) (io.WriteCloser, error) {
+	if namespace == "" {
+		return nil, errors.Errorf("no namespace specified")
+	}
+	return &sshSignWriter{w: w, h: sha512.New(), key: key, namespace: namespace, armored: armored}, nil
+}
+
+func (s *sshSignWriter) Write(b []byte) (int, error) {
+	return s.h.Write(b)
+}
+
+func (s *sshSignWriter) Close() error {
+	sig, err := sshSignHash(s.key, s.namespace, s.h.Sum(nil))
+	if err != nil {
+		return err
+	}
+	if s.armored {
+		sig = sshSigArmor(sig)
+	}
+	_, err = s.w.Write(sig)
+	return err
+}
+
+// sshSigArmor armors a signature in the format ssh-keygen outputs.
+func sshSigArmor(sig []byte) []byte {
+	enc := base64.StdEncoding.EncodeToString(sig)
+	var buf bytes.Buffer
+	buf.WriteString(sshSigBegin + "\n")
+	for len(enc) > sshSigLineLength {
+		buf.WriteString(enc[:sshSigLineLength] + "\n")
+		enc = enc[sshSigLineLength:]
+	}
+	buf.WriteString(enc + "\n")
+	buf.WriteString(sshSigEnd + "\n")
+	return buf.Bytes()
+}
+
+func sshSigDearmor(b []byte) ([]byte, error) {
+	s := strings.TrimSpace(string(b))
+	if !strings.HasPrefix(s, sshSigBegin) || !strings.HasSuffix(s, sshSigEnd) {
+		return nil, errors.Errorf("invalid ssh signature armor")
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, sshSigBegin), sshSigEnd)
+	s = strings.Join(strings.Fields(s), "")
+	sig, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ssh signature armor")
+	}
+	return sig, nil
+}
+
+func parseSSHSig(b []byte, armored bool) (*sshSigBlob, error) {
+	if armored {
+		d, err := sshSigDearmor(b)
+		if err != nil {
+			return nil, err
+		}
+		b = d
+	}
+	if !bytes.HasPrefix(b, []byte(sshSigMagic)) {
+		return nil, errors.Errorf("invalid ssh signature")
+	}
+	var sig sshSigBlob
+	if err := ssh.Unmarshal(b[len(sshSigMagic):], &sig); err != nil {
+		return nil, errors.Wrapf(err, "invalid ssh signature")
+	}
+	if sig.Version != sshSigVersion {
+		return nil, errors.Errorf("unsupported ssh signature version %d", sig.Version)
+	}
+	return &sig, nil
+}
+
+// sshSigPublicKey returns the EdX25519 public key from a SSH public key.
+func sshSigPublicKey(pk ssh.PublicKey) (*keys.EdX25519PublicKey, error) {
+	cpk, ok := pk.(ssh.CryptoPublicKey)
+	if !ok || pk.Type() != ssh.KeyAlgoED25519 {
+		return nil, errors.Errorf("unsupported ssh signature key type %s", pk.Type())
+	}
+	b, ok := cpk.CryptoPublicKey().(ed25519.PublicKey)
+	if !ok || len(b) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("invalid ssh ed25519 public key")
+	}
+	return keys.NewEdX25519PublicKey(keys.Bytes32(b)), nil
+}
+
+// sshSigSigner returns the signer of a SSH signature, without verifying it.
+func sshSigSigner(b []byte, armored bool) (keys.ID, error) {
+	sig, err := parseSSHSig(b, armored)
+	if err != nil {
+		return "", err
+	}
+	pk, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	spk, err := sshSigPublicKey(pk)
+	if err != nil {
+		return "", err
+	}
+	return spk.ID(), nil
+}
+
+// sshVerify verifies a SSH signature and returns the signer.
+func sshVerify(b []byte, namespace string, r io.Reader, armored bool) (keys.ID, error) {
+	if namespace == "" {
+		return "", errors.Errorf("no namespace specified")
+	}
+	sig, err := parseSSHSig(b, armored)
+	if err != nil {
+		return "", err
+	}
+	if sig.Namespace != namespace {
+		return "", errors.Errorf("ssh signature namespace mismatch, %q != %q", sig.Namespace, namespace)
+	}
+	pk, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	spk, err := sshSigPublicKey(pk)
+	if err != nil {
+		return "", err
+	}
+	var ssig ssh.Signature
+	if err := ssh.Unmarshal(sig.Signature, &ssig); err != nil {
+		return "", errors.Wrapf(err, "invalid ssh signature")
+	}
+	h, err := sshSigHash(sig.HashAlgorithm)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	if err := pk.Verify(sshSigMessage(sig.Namespace, sig.HashAlgorithm, h.Sum(nil)), &ssig); err != nil {
+		return "", errors.Errorf("ssh signature verification failed")
+	}
+	return spk.ID(), nil
+}
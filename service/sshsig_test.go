@@ -0,0 +1,226 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// Signature from ssh-keygen -Y sign -n file, for "hello".
+const testSSHSig = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgDY6u7og9Q0T8aH91x765rDW3Z2
+YQ01lTfAsaRkMedgkAAAAEZmlsZQAAAAAAAAAGc2hhNTEyAAAAUwAAAAtzc2gtZWQyNTUx
+OQAAAECHELptkX+bo7S97JsyAGOusPfeYw0Y3180pPV6KgpXOSRZns/BI7Uzu33w2NxUK6
+MIEdJFYPK10RxvxbGSHlkG
+-----END SSH SIGNATURE-----
+`
+
+func testSSHSigKey(t *testing.T) *keys.EdX25519Key {
+	seed, err := hex.DecodeString("611acc2461b09529b1f8259689f499b2154e02237997c264a3da9aa08adadeef")
+	require.NoError(t, err)
+	return keys.NewEdX25519KeyFromSeed(keys.Bytes32(seed))
+}
+
+func TestSSHSig(t *testing.T) {
+	key := testSSHSigKey(t)
+
+	// Ed25519 is deterministic, so we should match ssh-keygen.
+	sig, err := sshSign(key, "file", bytes.NewReader([]byte("hello")), true)
+	require.NoError(t, err)
+	require.Equal(t, testSSHSig, string(sig))
+
+	kid, err := sshVerify([]byte(testSSHSig), "file", bytes.NewReader([]byte("hello")), true)
+	require.NoError(t, err)
+	require.Equal(t, key.ID(), kid)
+
+	signer, err := sshSigSigner([]byte(testSSHSig), true)
+	require.NoError(t, err)
+	require.Equal(t, key.ID(), signer)
+
+	_, err = sshVerify([]byte(testSSHSig), "file", bytes.NewReader([]byte("hello2")), true)
+	require.EqualError(t, err, "ssh signature verification failed")
+
+	_, err = sshVerify([]byte(testSSHSig), "git", bytes.NewReader([]byte("hello")), true)
+	require.EqualError(t, err, `ssh signature namespace mismatch, "file" != "git"`)
+
+	_, err = sshSign(key, "", bytes.NewReader([]byte("hello")), true)
+	require.EqualError(t, err, "no namespace specified")
+
+	// Binary
+	sig, err = sshSign(key, "file", bytes.NewReader([]byte("hello")), false)
+	require.NoError(t, err)
+	kid, err = sshVerify(sig, "file", bytes.NewReader([]byte("hello")), false)
+	require.NoError(t, err)
+	require.Equal(t, key.ID(), kid)
+
+	// Writer
+	var buf bytes.Buffer
+	w, err := newSSHSignWriter(&buf, key, "file", true)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("hel"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("lo"))
+	require.NoError(t, err)
+	err = w.Close()
+	require.NoError(t, err)
+	require.Equal(t, testSSHSig, buf.String())
+}
+
+func TestSignVerifySSH(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	data := []byte("hi")
+	signResp, err := service.Sign(context.TODO(), &SignRequest{
+		Data:      data,
+		Signer:    alice.ID().String(),
+		Armored:   true,
+		Format:    SSHSignFormat,
+		Namespace: "git",
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(signResp.Data), sshSigBegin))
+
+	verifyResp, err := service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{
+		Data:      data,
+		Sig:       signResp.Data,
+		Armored:   true,
+		Format:    SSHSignFormat,
+		Namespace: "git",
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+
+	_, err = service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{
+		Data:      []byte("hi2"),
+		Sig:       signResp.Data,
+		Armored:   true,
+		Format:    SSHSignFormat,
+		Namespace: "git",
+	})
+	require.EqualError(t, err, "ssh signature verification failed")
+}
+
+func TestSSHKeygenCommand(t *testing.T) {
+	env := newTestEnv(t)
+	appName := "KeysTest-" + randName()
+	service, closeFn := newTestService(t, env, appName)
+	client, closeClFn := newTestRPCClient(t, service, env, appName)
+	defer closeClFn()
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testUserSetupGithub(t, env, service, alice, "alice")
+
+	dir, err := ioutil.TempDir("", "KeysTest-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pub, err := sshAuthorizedKey(alice.PublicKey())
+	require.NoError(t, err)
+	keyPath := filepath.Join(dir, "key.pub")
+	err = ioutil.WriteFile(keyPath, []byte(pub), 0600)
+	require.NoError(t, err)
+
+	data := []byte("tree 1234\n")
+	dataPath := filepath.Join(dir, "commit")
+	err = ioutil.WriteFile(dataPath, data, 0600)
+	require.NoError(t, err)
+
+	// git: ssh-keygen -Y sign -n git -f <key> -U <file>
+	var out bytes.Buffer
+	err = sshKeygen(client, []string{"-Y", "sign", "-n", "git", "-f", keyPath, "-U", dataPath}, nil, &out)
+	require.NoError(t, err)
+	sigPath := dataPath + ".sig"
+	require.FileExists(t, sigPath)
+
+	// git: ssh-keygen -Y find-principals -f <allowed> -s <sig>
+	out.Reset()
+	err = sshKeygen(client, []string{"-Y", "find-principals", "-f", "/dev/null", "-s", sigPath, "-Overify-time=20200101"}, nil, &out)
+	require.NoError(t, err)
+	require.Equal(t, "alice@github\n", out.String())
+
+	// git: ssh-keygen -Y verify -n git -f <allowed> -I <principal> -s <sig>
+	fingerprint, err := sshFingerprint(alice.ID().String())
+	require.NoError(t, err)
+	out.Reset()
+	err = sshKeygen(client, []string{"-Y", "verify", "-n", "git", "-f", "/dev/null", "-I", "alice@github", "-s", sigPath}, bytes.NewReader(data), &out)
+	require.NoError(t, err)
+	require.Equal(t, `Good "git" signature for alice@github with ED25519 key `+fingerprint+"\n", out.String())
+
+	out.Reset()
+	err = sshKeygen(client, []string{"-Y", "check-novalidate", "-n", "git", "-s", sigPath}, bytes.NewReader(data), &out)
+	require.NoError(t, err)
+	require.Equal(t, `Good "git" signature with ED25519 key `+fingerprint+"\n", out.String())
+
+	err = sshKeygen(client, []string{"-Y", "verify", "-n", "git", "-f", "/dev/null", "-I", "bob@github", "-s", sigPath}, bytes.NewReader(data), &out)
+	require.EqualError(t, err, "signature is from alice@github, not bob@github")
+
+	err = sshKeygen(client, []string{"-Y", "verify", "-n", "git", "-f", "/dev/null", "-I", "alice@github", "-s", sigPath}, bytes.NewReader([]byte("tree 5678\n")), &out)
+	require.EqualError(t, err, "rpc error: code = Unknown desc = ssh signature verification failed")
+
+	// Signer without a verified user or allowed signer
+	testImportKey(t, service, bob)
+	bobPub, err := sshAuthorizedKey(bob.PublicKey())
+	require.NoError(t, err)
+	bobKeyPath := filepath.Join(dir, "bob.pub")
+	err = ioutil.WriteFile(bobKeyPath, []byte(bobPub), 0600)
+	require.NoError(t, err)
+	bobDataPath := filepath.Join(dir, "bob-commit")
+	err = ioutil.WriteFile(bobDataPath, data, 0600)
+	require.NoError(t, err)
+	err = sshKeygen(client, []string{"-Y", "sign", "-n", "git", "-f", bobKeyPath, "-U", bobDataPath}, nil, &out)
+	require.NoError(t, err)
+	bobSigPath := bobDataPath + ".sig"
+
+	out.Reset()
+	err = sshKeygen(client, []string{"-Y", "find-principals", "-f", "/dev/null", "-s", bobSigPath}, nil, &out)
+	require.EqualError(t, err, "no allowed signer or verified user for "+bob.ID().String())
+	require.Empty(t, out.String())
+
+	err = sshKeygen(client, []string{"-Y", "verify", "-n", "git", "-f", "/dev/null", "-I", bob.ID().String(), "-s", bobSigPath}, bytes.NewReader(data), &out)
+	require.EqualError(t, err, "no allowed signer or verified user for "+bob.ID().String())
+	require.Empty(t, out.String())
+
+	// Allowed signers file
+	allowedPath := filepath.Join(dir, "allowed_signers")
+	allowed := "# Allowed signers\n" +
+		"alice@example.com " + pub + "\n" +
+		"bob@example.com,bob@work.example.com namespaces=\"git\" " + bobPub + " bob\n"
+	err = ioutil.WriteFile(allowedPath, []byte(allowed), 0600)
+	require.NoError(t, err)
+
+	out.Reset()
+	err = sshKeygen(client, []string{"-Y", "find-principals", "-f", allowedPath, "-s", bobSigPath}, nil, &out)
+	require.NoError(t, err)
+	require.Equal(t, "bob@example.com\nbob@work.example.com\n", out.String())
+
+	bobFingerprint, err := sshFingerprint(bob.ID().String())
+	require.NoError(t, err)
+	out.Reset()
+	err = sshKeygen(client, []string{"-Y", "verify", "-n", "git", "-f", allowedPath, "-I", "bob@work.example.com", "-s", bobSigPath}, bytes.NewReader(data), &out)
+	require.NoError(t, err)
+	require.Equal(t, `Good "git" signature for bob@work.example.com with ED25519 key `+bobFingerprint+"\n", out.String())
+
+	out.Reset()
+	err = sshKeygen(client, []string{"-Y", "find-principals", "-f", allowedPath, "-s", sigPath}, nil, &out)
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com\nalice@github\n", out.String())
+
+	err = sshKeygen(client, []string{"-Y", "verify", "-n", "git", "-f", allowedPath, "-I", "alice@example.com", "-s", bobSigPath}, bytes.NewReader(data), &out)
+	require.EqualError(t, err, "signature is from bob@example.com,bob@work.example.com, not alice@example.com")
+
+	err = sshKeygen(client, []string{"-Y", "revoke"}, nil, &out)
+	require.EqualError(t, err, "unsupported -Y revoke")
+}
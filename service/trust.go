@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/ds"
+	"github.com/keys-pub/keys/util"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Trust policies are named sets of trusted signers (KIDs or users), stored in
+// the local db at /trust/{name}.
+//
+// If a trust policy is specified when verifying or decrypting, a valid
+// signature from a signer not in the policy fails with an untrusted error
+// (FailedPrecondition), so clients can tell it apart from an invalid
+// signature.
+
+// TrustPolicies (RPC) lists trust policies.
+func (s *service) TrustPolicies(ctx context.Context, req *TrustPoliciesRequest) (*TrustPoliciesResponse, error) {
+	policies, err := s.trustPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &TrustPoliciesResponse{
+		Policies: policies,
+	}, nil
+}
+
+// TrustPolicySet (RPC) creates or updates a trust policy.
+func (s *service) TrustPolicySet(ctx context.Context, req *TrustPolicySetRequest) (*TrustPolicySetResponse, error) {
+	if req.Policy == nil {
+		return nil, errors.Errorf("no policy specified")
+	}
+	if err := validateTrustPolicyName(req.Policy.Name); err != nil {
+		return nil, err
+	}
+	if len(req.Policy.Signers) == 0 {
+		return nil, errors.Errorf("no signers specified")
+	}
+	signers := make([]string, 0, len(req.Policy.Signers))
+	for _, signer := range req.Policy.Signers {
+		normalized, err := normalizeTrustSigner(signer)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, normalized)
+	}
+	policy := &TrustPolicy{
+		Name:    req.Policy.Name,
+		Signers: signers,
+		Expire:  req.Policy.Expire,
+	}
+	if err := s.saveTrustPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return &TrustPolicySetResponse{
+		Policy: policy,
+	}, nil
+}
+
+// TrustPolicyRemove (RPC) removes a trust policy.
+func (s *service) TrustPolicyRemove(ctx context.Context, req *TrustPolicyRemoveRequest) (*TrustPolicyRemoveResponse, error) {
+	if err := validateTrustPolicyName(req.Name); err != nil {
+		return nil, err
+	}
+	ok, err := s.db.Delete(ctx, ds.Path("trust", req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("trust policy %s not found", req.Name)
+	}
+	return &TrustPolicyRemoveResponse{}, nil
+}
+
+func validateTrustPolicyName(name string) error {
+	if name == "" {
+		return errors.Errorf("no policy name specified")
+	}
+	if strings.ContainsAny(name, "/ \t\r\n") {
+		return errors.Errorf("invalid policy name %q", name)
+	}
+	return nil
+}
+
+// normalizeTrustSigner returns the KID or user (name@service) for a signer.
+// Users can also be specified as service:name, for example github:alice.
+func normalizeTrustSigner(s string) (string, error) {
+	if i := strings.Index(s, ":"); i > 0 && !strings.Contains(s, "@") {
+		s = s[i+1:] + "@" + s[:i]
+	}
+	if strings.Contains(s, "@") {
+		parts := strings.Split(s, "@")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", errors.Errorf("invalid user %s", s)
+		}
+		return strings.ToLower(s), nil
+	}
+	kid, err := keys.ParseID(s)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid signer %s", s)
+	}
+	return kid.String(), nil
+}
+
+func (s *service) trustPolicies(ctx context.Context) ([]*TrustPolicy, error) {
+	iter, err := s.db.Documents(ctx, "trust", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	policies := []*TrustPolicy{}
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			break
+		}
+		var policy TrustPolicy
+		if err := json.Unmarshal(doc.Data, &policy); err != nil {
+			return nil, errors.Errorf("failed to get trust policy from db")
+		}
+		policies = append(policies, &policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies, nil
+}
+
+func (s *service) trustPolicy(ctx context.Context, name string) (*TrustPolicy, error) {
+	doc, err := s.db.Get(ctx, ds.Path("trust", name))
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, errors.Errorf("trust policy %s not found", name)
+	}
+	var policy TrustPolicy
+	if err := json.Unmarshal(doc.Data, &policy); err != nil {
+		return nil, errors.Errorf("failed to get trust policy from db")
+	}
+	return &policy, nil
+}
+
+func (s *service) saveTrustPolicy(ctx context.Context, policy *TrustPolicy) error {
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Set(ctx, ds.Path("trust", policy.Name), b); err != nil {
+		return errors.Wrapf(err, "failed to save trust policy")
+	}
+	return nil
+}
+
+// checkTrust checks the signers are trusted by the named policy. If name is
+// empty, there is no policy, and everything is trusted.
+func (s *service) checkTrust(ctx context.Context, name string, signers ...*Key) error {
+	if name == "" {
+		return nil
+	}
+	policy, err := s.trustPolicy(ctx, name)
+	if err != nil {
+		return err
+	}
+	if policy.Expire != 0 && s.Now().After(util.TimeFromMillis(policy.Expire)) {
+		return status.Errorf(codes.FailedPrecondition, "trust policy %s expired", name)
+	}
+	if len(signers) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "untrusted, no signer (trust policy %s)", name)
+	}
+	for _, signer := range signers {
+		if signer == nil {
+			return status.Errorf(codes.FailedPrecondition, "untrusted, no signer (trust policy %s)", name)
+		}
+		if err := checkTrustPolicy(policy, signer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkTrustPolicy(policy *TrustPolicy, signer *Key) error {
+	for _, trusted := range policy.Signers {
+		if trusted == signer.ID {
+			return nil
+		}
+		if signer.User != nil && trusted == signer.User.ID {
+			// Users that fail their status check aren't trusted, they may
+			// have removed or changed their statement.
+			if signer.User.Status != UserStatusOK {
+				return status.Errorf(codes.FailedPrecondition, "untrusted signer %s, user %s has status %s (trust policy %s)", signer.ID, signer.User.ID, signer.User.Status, policy.Name)
+			}
+			return nil
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "untrusted signer %s (trust policy %s)", signer.ID, policy.Name)
+}
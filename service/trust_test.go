@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrustPolicies(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+
+	setResp, err := service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "release", Signers: []string{alice.ID().String(), "github:Bob"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{alice.ID().String(), "bob@github"}, setResp.Policy.Signers)
+
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "docs", Signers: []string{charlie.ID().String()}},
+	})
+	require.NoError(t, err)
+
+	resp, err := service.TrustPolicies(context.TODO(), &TrustPoliciesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Policies))
+	require.Equal(t, "docs", resp.Policies[0].Name)
+	require.Equal(t, "release", resp.Policies[1].Name)
+
+	_, err = service.TrustPolicyRemove(context.TODO(), &TrustPolicyRemoveRequest{Name: "docs"})
+	require.NoError(t, err)
+	_, err = service.TrustPolicyRemove(context.TODO(), &TrustPolicyRemoveRequest{Name: "docs"})
+	require.EqualError(t, err, "trust policy docs not found")
+
+	resp, err = service.TrustPolicies(context.TODO(), &TrustPoliciesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Policies))
+
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "release"},
+	})
+	require.EqualError(t, err, "no signers specified")
+
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "release", Signers: []string{"kex1invalid"}},
+	})
+	require.Error(t, err)
+
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "a/b", Signers: []string{alice.ID().String()}},
+	})
+	require.EqualError(t, err, `invalid policy name "a/b"`)
+}
+
+func TestVerifyTrust(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+	testUserSetupGithub(t, env, service, alice, "alice")
+
+	_, err := service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "release", Signers: []string{"alice@github"}},
+	})
+	require.NoError(t, err)
+
+	message := []byte("release v1.0.0")
+	aliceSigned, err := service.Sign(context.TODO(), &SignRequest{Data: message, Signer: alice.ID().String()})
+	require.NoError(t, err)
+	bobSigned, err := service.Sign(context.TODO(), &SignRequest{Data: message, Signer: bob.ID().String()})
+	require.NoError(t, err)
+
+	verifyResp, err := service.Verify(context.TODO(), &VerifyRequest{Data: aliceSigned.Data, Trust: "release"})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+
+	// Valid, but untrusted
+	_, err = service.Verify(context.TODO(), &VerifyRequest{Data: bobSigned.Data, Trust: "release"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted signer "+bob.ID().String()+" (trust policy release)")
+
+	// Without policy
+	_, err = service.Verify(context.TODO(), &VerifyRequest{Data: bobSigned.Data})
+	require.NoError(t, err)
+
+	_, err = service.Verify(context.TODO(), &VerifyRequest{Data: aliceSigned.Data, Trust: "unknown"})
+	require.EqualError(t, err, "trust policy unknown not found")
+
+	// Detached
+	multiSig, err := service.Sign(context.TODO(), &SignRequest{Data: message, Signer: alice.ID().String(), Format: MultiSignFormat})
+	require.NoError(t, err)
+	_, err = service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{Data: message, Sig: multiSig.Data, Trust: "release"})
+	require.NoError(t, err)
+
+	// Multi-signature, all signers must be trusted
+	multiSig, err = service.Sign(context.TODO(), &SignRequest{Data: message, Signer: bob.ID().String(), Format: MultiSignFormat, Sig: multiSig.Data})
+	require.NoError(t, err)
+	_, err = service.VerifyDetached(context.TODO(), &VerifyDetachedRequest{Data: message, Sig: multiSig.Data, Trust: "release"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Expired
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "release", Signers: []string{"alice@github"}, Expire: util.TimeToMillis(env.clock.Now().Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	_, err = service.Verify(context.TODO(), &VerifyRequest{Data: aliceSigned.Data, Trust: "release"})
+	require.NoError(t, err)
+	env.clock.Add(time.Hour * 2)
+	_, err = service.Verify(context.TODO(), &VerifyRequest{Data: aliceSigned.Data, Trust: "release"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = trust policy release expired")
+}
+
+func TestVerifyTrustUserStatus(t *testing.T) {
+	env := newTestEnv(t)
+
+	aliceService, aliceCloseFn := newTestService(t, env, "")
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	bobService, bobCloseFn := newTestService(t, env, "")
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+	testUserSetupGithub(t, env, bobService, bob, "bob")
+
+	testPull(t, aliceService, bob.ID())
+
+	_, err := aliceService.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "release", Signers: []string{"bob@github"}},
+	})
+	require.NoError(t, err)
+
+	signResp, err := bobService.Sign(context.TODO(), &SignRequest{Signer: bob.ID().String(), Data: []byte("test")})
+	require.NoError(t, err)
+
+	_, err = aliceService.Verify(context.TODO(), &VerifyRequest{Data: signResp.Data, Trust: "release"})
+	require.NoError(t, err)
+
+	// Bob's statement is unavailable (connection failure)
+	env.req.SetError("https://gist.github.com/bob/1", util.ErrHTTP{StatusCode: 500})
+	_, _, err = aliceService.update(context.TODO(), bob.ID())
+	require.NoError(t, err)
+
+	_, err = aliceService.Verify(context.TODO(), &VerifyRequest{Data: signResp.Data, Trust: "release"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted signer "+bob.ID().String()+", user bob@github has status USER_CONN_FAILURE (trust policy release)")
+}
+
+func TestDecryptTrust(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+
+	_, err := service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "alice", Signers: []string{alice.ID().String()}},
+	})
+	require.NoError(t, err)
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "charlie", Signers: []string{charlie.ID().String()}},
+	})
+	require.NoError(t, err)
+
+	encrypted, err := service.Encrypt(context.TODO(), &EncryptRequest{
+		Data:       []byte("hi bob"),
+		Sender:     alice.ID().String(),
+		Recipients: []string{bob.ID().String()},
+	})
+	require.NoError(t, err)
+
+	decrypted, err := service.Decrypt(context.TODO(), &DecryptRequest{Data: encrypted.Data, Trust: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, "hi bob", string(decrypted.Data))
+
+	_, err = service.Decrypt(context.TODO(), &DecryptRequest{Data: encrypted.Data, Trust: "charlie"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted signer "+alice.ID().String()+" (trust policy charlie)")
+
+	// Anonymous sender
+	anonymous, err := service.Encrypt(context.TODO(), &EncryptRequest{
+		Data:       []byte("hi bob"),
+		Recipients: []string{bob.ID().String()},
+	})
+	require.NoError(t, err)
+	_, err = service.Decrypt(context.TODO(), &DecryptRequest{Data: anonymous.Data, Trust: "alice"})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted, no signer (trust policy alice)")
+}
+
+func TestDecryptTrustStreamAndFile(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+	client, clientCloseFn := newTestRPCClient(t, service, env, "")
+	defer clientCloseFn()
+
+	_, err := service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "alice", Signers: []string{alice.ID().String()}},
+	})
+	require.NoError(t, err)
+	_, err = service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "charlie", Signers: []string{charlie.ID().String()}},
+	})
+	require.NoError(t, err)
+
+	encrypted, err := service.Encrypt(context.TODO(), &EncryptRequest{
+		Data:       []byte("hi bob"),
+		Sender:     alice.ID().String(),
+		Recipients: []string{bob.ID().String()},
+	})
+	require.NoError(t, err)
+
+	// Stream
+	out, err := testDecryptStreamTrust(t, client, encrypted.Data, "alice")
+	require.NoError(t, err)
+	require.Equal(t, "hi bob", string(out))
+	out, err = testDecryptStreamTrust(t, client, encrypted.Data, "charlie")
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted signer "+alice.ID().String()+" (trust policy charlie)")
+	require.Empty(t, out)
+
+	// File
+	dir, err := ioutil.TempDir("", "keys-trust-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	inPath := filepath.Join(dir, "test.txt.enc")
+	outPath := filepath.Join(dir, "test.txt")
+	err = ioutil.WriteFile(inPath, encrypted.Data, 0600)
+	require.NoError(t, err)
+
+	_, err = decryptFile(client, false, EncryptV2, "", "charlie", inPath, outPath)
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted signer "+alice.ID().String()+" (trust policy charlie)")
+	exists, err := fileExists(outPath)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	dec, err := decryptFile(client, false, EncryptV2, "", "alice", inPath, outPath)
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), dec.Sender.ID)
+}
+
+func testDecryptStreamTrust(t *testing.T, client *Client, b []byte, trust string) ([]byte, error) {
+	streamClient, err := client.KeysClient().DecryptStream(context.TODO())
+	require.NoError(t, err)
+	err = streamClient.Send(&DecryptInput{Trust: trust})
+	require.NoError(t, err)
+	err = streamClient.Send(&DecryptInput{Data: b})
+	require.NoError(t, err)
+	err = streamClient.CloseSend()
+	require.NoError(t, err)
+
+	var out []byte
+	for {
+		resp, err := streamClient.Recv()
+		if err != nil {
+			return out, err
+		}
+		if len(resp.Data) == 0 {
+			return out, nil
+		}
+		out = append(out, resp.Data...)
+	}
+}
+
+func TestVerifyTrustStream(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env, "")
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+	client, clientCloseFn := newTestRPCClient(t, service, env, "")
+	defer clientCloseFn()
+
+	_, err := service.TrustPolicySet(context.TODO(), &TrustPolicySetRequest{
+		Policy: &TrustPolicy{Name: "release", Signers: []string{alice.ID().String()}},
+	})
+	require.NoError(t, err)
+
+	message := []byte("release v1.0.0")
+	aliceSigned, err := service.Sign(context.TODO(), &SignRequest{Data: message, Signer: alice.ID().String()})
+	require.NoError(t, err)
+	bobSigned, err := service.Sign(context.TODO(), &SignRequest{Data: message, Signer: bob.ID().String()})
+	require.NoError(t, err)
+
+	out, err := testVerifyStreamTrust(t, client, aliceSigned.Data, "release")
+	require.NoError(t, err)
+	require.Equal(t, message, out)
+
+	out, err = testVerifyStreamTrust(t, client, bobSigned.Data, "release")
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = untrusted signer "+bob.ID().String()+" (trust policy release)")
+	require.Empty(t, out)
+}
+
+func testVerifyStreamTrust(t *testing.T, client *Client, b []byte, trust string) ([]byte, error) {
+	streamClient, err := client.KeysClient().VerifyStream(context.TODO())
+	require.NoError(t, err)
+	err = streamClient.Send(&VerifyInput{Trust: trust})
+	require.NoError(t, err)
+	err = streamClient.Send(&VerifyInput{Data: b})
+	require.NoError(t, err)
+	err = streamClient.CloseSend()
+	require.NoError(t, err)
+
+	var out []byte
+	for {
+		resp, err := streamClient.Recv()
+		if err != nil {
+			return out, err
+		}
+		if len(resp.Data) == 0 {
+			return out, nil
+		}
+		out = append(out, resp.Data...)
+	}
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/secret"
+	"github.com/keys-pub/keysd/http/client"
+	"github.com/pkg/errors"
+)
+
+// vaultOp is a secret change, saved (encrypted) to the remote vault.
+type vaultOp struct {
+	// ID of the secret.
+	ID string `json:"id"`
+	// Secret, or nil if removed.
+	Secret *secret.Secret `json:"secret,omitempty"`
+	// Prev is the vault item (ID) this change was based on, used to detect
+	// conflicting changes.
+	Prev string `json:"prev,omitempty"`
+}
+
+// vaultState is the local sync state, stored in the db.
+type vaultState struct {
+	KID keys.ID `json:"kid"`
+	// Version to pull from.
+	Version string `json:"version,omitempty"`
+	// Seen are the vault item IDs from the last pull, since versions are
+	// inclusive and we'll see them again.
+	Seen []string `json:"seen,omitempty"`
+	// Heads are the last applied vault item ID for each secret.
+	Heads map[string]string `json:"heads,omitempty"`
+	// Pushed are the last pushed (not yet pulled) vault item ID for each secret.
+	Pushed map[string]string `json:"pushed,omitempty"`
+	// Pending are changes not yet pushed.
+	Pending []*vaultOp `json:"pending,omitempty"`
+}
+
+// VaultSync (RPC) pushes local secret changes to the remote vault and merges
+// remote changes.
+func (s *service) VaultSync(ctx context.Context, req *VaultSyncRequest) (*VaultSyncResponse, error) {
+	s.vaultMtx.Lock()
+	defer s.vaultMtx.Unlock()
+
+	state, err := s.vaultState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.KID != "" {
+		kid, err := keys.ParseID(req.KID)
+		if err != nil {
+			return nil, err
+		}
+		if state.KID != "" && state.KID != kid {
+			return nil, errors.Errorf("vault already enabled with %s", state.KID)
+		}
+		if state.KID == "" {
+			if err := s.vaultEnable(ctx, state, kid); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if state.KID == "" {
+		return nil, errors.Errorf("vault not enabled, specify a key")
+	}
+
+	if err := s.vaultPush(ctx, state); err != nil {
+		return nil, err
+	}
+	conflicts, err := s.vaultPull(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VaultSyncResponse{
+		KID:       state.KID.String(),
+		Conflicts: conflicts,
+	}, nil
+}
+
+// vaultEnable sets the vault key and queues all existing secrets.
+func (s *service) vaultEnable(ctx context.Context, state *vaultState, kid keys.ID) error {
+	key, err := s.ks.EdX25519Key(kid)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return keys.NewErrNotFound(kid.String())
+	}
+	logger.Infof("Enabling vault with %s", kid)
+	secrets, err := s.ss.List(nil)
+	if err != nil {
+		return err
+	}
+	state.KID = kid
+	for _, sec := range secrets {
+		state.Pending = append(state.Pending, &vaultOp{ID: sec.ID, Secret: sec})
+	}
+	return s.saveVaultState(ctx, state)
+}
+
+// vaultChanged queues a local secret change and tries to push it, if the
+// vault is enabled. If the push fails, it's retried on the next sync.
+func (s *service) vaultChanged(ctx context.Context, id string, sec *secret.Secret) error {
+	s.vaultMtx.Lock()
+	defer s.vaultMtx.Unlock()
+
+	state, err := s.vaultState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.KID == "" {
+		return nil
+	}
+	state.Pending = append(state.Pending, &vaultOp{ID: id, Secret: sec})
+	if err := s.saveVaultState(ctx, state); err != nil {
+		return err
+	}
+	if err := s.vaultPush(ctx, state); err != nil {
+		logger.Warningf("Failed to push vault changes: %v", err)
+	}
+	return nil
+}
+
+func (s *service) vaultPush(ctx context.Context, state *vaultState) error {
+	for len(state.Pending) > 0 {
+		op := state.Pending[0]
+		// Based on our last push, or on what we last pulled.
+		op.Prev = state.Pushed[op.ID]
+		if op.Prev == "" {
+			op.Prev = state.Heads[op.ID]
+		}
+		b, err := json.Marshal(op)
+		if err != nil {
+			return err
+		}
+		logger.Debugf("Vault push %s", op.ID)
+		resp, err := s.remote.VaultSave(ctx, state.KID, b)
+		if err != nil {
+			return err
+		}
+		if state.Pushed == nil {
+			state.Pushed = map[string]string{}
+		}
+		state.Pushed[op.ID] = resp.ID
+		state.Pending = state.Pending[1:]
+		if err := s.saveVaultState(ctx, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) vaultPull(ctx context.Context, state *vaultState) ([]*VaultConflict, error) {
+	conflicts := []*VaultConflict{}
+	for {
+		items, version, err := s.remote.Vault(ctx, state.KID, &client.VaultOpts{Version: state.Version})
+		if err != nil {
+			return nil, err
+		}
+		seen := map[string]bool{}
+		for _, id := range state.Seen {
+			seen[id] = true
+		}
+		applied := 0
+		for _, item := range items {
+			if seen[item.ID] {
+				continue
+			}
+			conflict, err := s.vaultApply(ctx, state, item)
+			if err != nil {
+				return nil, err
+			}
+			if conflict != nil {
+				conflicts = append(conflicts, conflict)
+			}
+			applied++
+		}
+		if len(items) > 0 {
+			state.Seen = make([]string, 0, len(items))
+			for _, item := range items {
+				state.Seen = append(state.Seen, item.ID)
+			}
+		}
+		state.Version = version
+		if err := s.saveVaultState(ctx, state); err != nil {
+			return nil, err
+		}
+		if applied == 0 {
+			return conflicts, nil
+		}
+	}
+}
+
+// vaultApply applies a remote change. Changes are applied in the order they
+// were saved to the vault, so all devices converge. If the change wasn't based
+// on the last change we applied for that secret, it was made concurrently and
+// we return a conflict.
+func (s *service) vaultApply(ctx context.Context, state *vaultState, item *client.VaultItem) (*VaultConflict, error) {
+	var op vaultOp
+	if err := json.Unmarshal(item.Data, &op); err != nil {
+		return nil, errors.Wrapf(err, "invalid vault item %s", item.ID)
+	}
+	if op.ID == "" {
+		return nil, errors.Errorf("invalid vault item %s: no secret id", item.ID)
+	}
+	logger.Debugf("Vault apply %s (%s)", item.ID, op.ID)
+
+	var conflict *VaultConflict
+	if op.Prev != state.Heads[op.ID] {
+		local, err := s.ss.Get(op.ID)
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("Vault conflict for %s", op.ID)
+		conflict = &VaultConflict{ID: op.ID}
+		if local != nil {
+			conflict.Local = secretToRPC(local)
+		}
+		if op.Secret != nil {
+			conflict.Secret = secretToRPC(op.Secret)
+		}
+	}
+
+	if op.Secret != nil {
+		op.Secret.ID = op.ID
+		if err := s.otpCounterSeen(ctx, op.Secret); err != nil {
+			return nil, err
+		}
+		if _, err := s.setSecret(ctx, op.Secret); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := s.removeSecret(ctx, op.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if state.Heads == nil {
+		state.Heads = map[string]string{}
+	}
+	state.Heads[op.ID] = item.ID
+	if state.Pushed[op.ID] == item.ID {
+		delete(state.Pushed, op.ID)
+	}
+	return conflict, nil
+}
+
+func (s *service) vaultState(ctx context.Context) (*vaultState, error) {
+	doc, err := s.db.Get(ctx, "vault")
+	if err != nil {
+		return nil, err
+	}
+	var state vaultState
+	if doc != nil {
+		if err := json.Unmarshal(doc.Data, &state); err != nil {
+			return nil, errors.Errorf("failed to get vault state from db")
+		}
+	}
+	return &state, nil
+}
+
+func (s *service) saveVaultState(ctx context.Context, state *vaultState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Set(ctx, "vault", b); err != nil {
+		return errors.Wrapf(err, "failed to save vault state")
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultSync(t *testing.T) {
+	// SetLogger(NewLogger(DebugLevel))
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env, "")
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	deviceService, deviceCloseFn := newTestService(t, env, "")
+	defer deviceCloseFn()
+	testAuthSetup(t, deviceService)
+	testImportKey(t, deviceService, alice)
+
+	// Sync (not enabled)
+	_, err := aliceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.EqualError(t, err, "vault not enabled, specify a key")
+
+	// Sync (key not found)
+	_, err = aliceService.VaultSync(ctx, &VaultSyncRequest{KID: bob.ID().String()})
+	require.EqualError(t, err, keys.NewErrNotFound(bob.ID().String()).Error())
+
+	saveResp, err := aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "github", Type: PasswordSecret, Password: "pass1"},
+	})
+	require.NoError(t, err)
+	id := saveResp.Secret.ID
+
+	// Enable (alice)
+	syncResp, err := aliceService.VaultSync(ctx, &VaultSyncRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), syncResp.KID)
+	require.Empty(t, syncResp.Conflicts)
+
+	// Sync (different key)
+	_, err = aliceService.VaultSync(ctx, &VaultSyncRequest{KID: bob.ID().String()})
+	require.EqualError(t, err, "vault already enabled with "+alice.ID().String())
+
+	// Enable (device)
+	syncResp, err = deviceService.VaultSync(ctx, &VaultSyncRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Empty(t, syncResp.Conflicts)
+	secretResp, err := deviceService.Secret(ctx, &SecretRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "github", secretResp.Secret.Name)
+	require.Equal(t, "pass1", secretResp.Secret.Password)
+
+	// Update (device)
+	_, err = deviceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{ID: id, Name: "github", Type: PasswordSecret, Password: "pass2"},
+	})
+	require.NoError(t, err)
+
+	syncResp, err = aliceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	require.Empty(t, syncResp.Conflicts)
+	secretResp, err = aliceService.Secret(ctx, &SecretRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "pass2", secretResp.Secret.Password)
+
+	// Concurrent updates (alice, device)
+	_, err = aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{ID: id, Name: "github", Type: PasswordSecret, Password: "alice"},
+	})
+	require.NoError(t, err)
+	_, err = deviceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{ID: id, Name: "github", Type: PasswordSecret, Password: "device"},
+	})
+	require.NoError(t, err)
+
+	// Last change wins, on both, with conflict
+	syncResp, err = aliceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(syncResp.Conflicts))
+	require.Equal(t, id, syncResp.Conflicts[0].ID)
+	require.Equal(t, "alice", syncResp.Conflicts[0].Local.Password)
+	require.Equal(t, "device", syncResp.Conflicts[0].Secret.Password)
+	secretResp, err = aliceService.Secret(ctx, &SecretRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "device", secretResp.Secret.Password)
+
+	syncResp, err = deviceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(syncResp.Conflicts))
+	secretResp, err = deviceService.Secret(ctx, &SecretRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "device", secretResp.Secret.Password)
+
+	// Sync (no changes)
+	syncResp, err = aliceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	require.Empty(t, syncResp.Conflicts)
+
+	// Remove (device)
+	_, err = deviceService.SecretRemove(ctx, &SecretRemoveRequest{ID: id})
+	require.NoError(t, err)
+	syncResp, err = aliceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	require.Empty(t, syncResp.Conflicts)
+	_, err = aliceService.Secret(ctx, &SecretRequest{ID: id})
+	require.EqualError(t, err, keys.NewErrNotFound(id).Error())
+}
+
+func TestVaultSyncHOTP(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env, "")
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	deviceService, deviceCloseFn := newTestService(t, env, "")
+	defer deviceCloseFn()
+	testAuthSetup(t, deviceService)
+	testImportKey(t, deviceService, alice)
+
+	_, err := aliceService.VaultSync(ctx, &VaultSyncRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	_, err = deviceService.VaultSync(ctx, &VaultSyncRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+
+	saveResp, err := aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "Bank", Type: OTPSecret, Password: "otpauth://hotp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&counter=0"},
+	})
+	require.NoError(t, err)
+	id := saveResp.Secret.ID
+
+	// Code (alice), counter bump is pushed
+	resp, err := aliceService.OTPCode(ctx, &OTPCodeRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.Counter)
+	require.Equal(t, "755224", resp.Code)
+
+	_, err = deviceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	resp, err = deviceService.OTPCode(ctx, &OTPCodeRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Counter)
+	require.Equal(t, "287082", resp.Code)
+
+	// Concurrent update (alice) with an older counter
+	_, err = aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{ID: id, Name: "Bank (renamed)", Type: OTPSecret, Password: "otpauth://hotp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&counter=1"},
+	})
+	require.NoError(t, err)
+	syncResp, err := aliceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(syncResp.Conflicts))
+	secretResp, err := aliceService.Secret(ctx, &SecretRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "Bank (renamed)", secretResp.Secret.Name)
+	require.Contains(t, secretResp.Secret.Password, "counter=1")
+
+	// Counter doesn't go backwards
+	resp, err = aliceService.OTPCode(ctx, &OTPCodeRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Counter)
+	require.Equal(t, "359152", resp.Code)
+
+	_, err = deviceService.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	resp, err = deviceService.OTPCode(ctx, &OTPCodeRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Counter)
+}
@@ -2,9 +2,12 @@ package service
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/keys-pub/keys"
@@ -40,38 +43,35 @@ func (s *service) Verify(ctx context.Context, req *VerifyRequest) (*VerifyRespon
 		}
 		signer = s
 	}
+	if err := s.checkTrust(ctx, req.Trust, signer); err != nil {
+		return nil, err
+	}
 
 	return &VerifyResponse{Data: verified, Signer: signer}, nil
 }
 
 // VerifyDetached (RPC) ...
 func (s *service) VerifyDetached(ctx context.Context, req *VerifyDetachedRequest) (*VerifyDetachedResponse, error) {
-	sp := saltpack.NewSaltpack(s.ks)
-	var kid keys.ID
-	if req.Armored {
-		k, err := sp.VerifyArmoredDetached(string(req.Sig), req.Data)
-		if err != nil {
-			return nil, err
-		}
-		kid = k
-	} else {
-		k, err := sp.VerifyDetached(req.Sig, req.Data)
-		if err != nil {
-			return nil, err
-		}
-		kid = k
+	kids, err := s.verifyDetachedReader(ctx, req.Sig, bytes.NewReader(req.Data), req.Armored, req.Format, req.Namespace)
+	if err != nil {
+		return nil, err
 	}
-
-	var signer *Key
-	if kid != "" {
-		s, err := s.verifyKey(ctx, kid)
-		if err != nil {
-			return nil, err
-		}
-		signer = s
+	signers, err := s.verifySigners(ctx, kids, req.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTrust(ctx, req.Trust, signers...); err != nil {
+		return nil, err
 	}
+	return newVerifyDetachedResponse(signers), nil
+}
 
-	return &VerifyDetachedResponse{Signer: signer}, nil
+func newVerifyDetachedResponse(signers []*Key) *VerifyDetachedResponse {
+	resp := &VerifyDetachedResponse{Signers: signers}
+	if len(signers) > 0 {
+		resp.Signer = signers[0]
+	}
+	return resp
 }
 
 // VerifyFile (RPC) ...
@@ -98,7 +98,7 @@ func (s *service) VerifyFile(srv Keys_VerifyFileServer) error {
 		return errors.Errorf("file already exists %s", out)
 	}
 
-	signer, err := s.verifyWriteInOut(srv.Context(), in, out, req.Armored)
+	signer, err := s.verifyWriteInOut(srv.Context(), in, out, req.Armored, req.Trust)
 	if err != nil {
 		return err
 	}
@@ -131,15 +131,64 @@ func (s *service) VerifyDetachedFile(srv Keys_VerifyDetachedFileServer) error {
 		return errors.Errorf("in not specified")
 	}
 
-	signer, err := s.verifyDetachedIn(srv.Context(), req.Sig, in, req.Armored)
+	signers, err := s.verifyDetachedIn(srv.Context(), req.Sig, in, req.Armored, req.Format, req.Namespace, req.Policy)
 	if err != nil {
 		return err
 	}
+	if err := s.checkTrust(srv.Context(), req.Trust, signers...); err != nil {
+		return err
+	}
 
-	resp := &VerifyDetachedResponse{
-		Signer: signer,
+	return srv.SendAndClose(newVerifyDetachedResponse(signers))
+}
+
+// VerifyDir (RPC) verifies a directory against a signed manifest, returning
+// the files added, removed or modified.
+func (s *service) VerifyDir(ctx context.Context, req *VerifyDirRequest) (*VerifyDirResponse, error) {
+	if req.Dir == "" {
+		return nil, errors.Errorf("dir not specified")
+	}
+	dir := filepath.Clean(req.Dir)
+	manifestPath := req.Manifest
+	if manifestPath == "" {
+		manifestPath = filepath.Join(dir, manifestFilename)
+	}
+	manifestPath = filepath.Clean(manifestPath)
+
+	b, err := ioutil.ReadFile(manifestPath) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	sp := saltpack.NewSaltpack(s.ks)
+	verified, kid, err := sp.VerifyArmored(string(b))
+	if err != nil {
+		return nil, err
+	}
+	signer, err := s.verifyKey(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTrust(ctx, req.Trust, signer); err != nil {
+		return nil, err
+	}
+
+	m, err := parseManifest(verified)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("Verifying %s with manifest %s", dir, manifestPath)
+	files, err := scanDir(ctx, dir, m.Ignore, manifestPath)
+	if err != nil {
+		return nil, err
 	}
-	return srv.SendAndClose(resp)
+	added, removed, modified := diffManifest(m, files)
+
+	return &VerifyDirResponse{
+		Signer:   signer,
+		Added:    added,
+		Removed:  removed,
+		Modified: modified,
+	}, nil
 }
 
 // VerifyStream (RPC) ...
@@ -159,6 +208,14 @@ type verifyStreamServer interface {
 }
 
 func (s *service) verifyStream(srv verifyStreamServer, armored bool) error {
+	// The first message may include the trust policy.
+	first, err := srv.Recv()
+	if err == io.EOF {
+		first = &VerifyInput{}
+	} else if err != nil {
+		return err
+	}
+
 	recvFn := func() ([]byte, error) {
 		req, recvErr := srv.Recv()
 		if recvErr != nil {
@@ -168,6 +225,9 @@ func (s *service) verifyStream(srv verifyStreamServer, armored bool) error {
 	}
 
 	reader := newStreamReader(srv.Context(), recvFn)
+	if err := reader.write(first.Data); err != nil {
+		return err
+	}
 
 	streamReader, kid, err := s.verifyReader(srv.Context(), reader, armored)
 	if err != nil {
@@ -182,6 +242,10 @@ func (s *service) verifyStream(srv verifyStreamServer, armored bool) error {
 		}
 		signer = s
 	}
+	// Check trust before sending any output.
+	if err := s.checkTrust(srv.Context(), first.Trust, signer); err != nil {
+		return err
+	}
 	sendFn := func(b []byte, signer *Key) error {
 		resp := VerifyOutput{
 			Data:   b,
@@ -213,22 +277,18 @@ func (s *service) VerifyDetachedStream(srv Keys_VerifyDetachedStreamServer) erro
 	if err := reader.write(first.Data); err != nil {
 		return err
 	}
-	kid, err := s.verifyDetachedReader(ctx, first.Sig, reader, first.Armored)
+	kids, err := s.verifyDetachedReader(ctx, first.Sig, reader, first.Armored, first.Format, first.Namespace)
 	if err != nil {
 		return err
 	}
-	var signer *Key
-	if kid != "" {
-		s, err := s.verifyKey(ctx, kid)
-		if err != nil {
-			return err
-		}
-		signer = s
+	signers, err := s.verifySigners(ctx, kids, first.Policy)
+	if err != nil {
+		return err
 	}
-	resp := &VerifyDetachedResponse{
-		Signer: signer,
+	if err := s.checkTrust(ctx, first.Trust, signers...); err != nil {
+		return err
 	}
-	return srv.SendAndClose(resp)
+	return srv.SendAndClose(newVerifyDetachedResponse(signers))
 }
 
 // VerifyStreamClient ...
@@ -284,15 +344,43 @@ func (s *service) verifyReader(ctx context.Context, reader io.Reader, armored bo
 	return sp.NewVerifyStream(reader)
 }
 
-func (s *service) verifyDetachedReader(ctx context.Context, sig []byte, reader io.Reader, armored bool) (keys.ID, error) {
-	sp := saltpack.NewSaltpack(s.ks)
-	if armored {
-		return sp.VerifyArmoredDetachedReader(string(sig), reader)
+// verifyDetachedReader returns the signers of a detached signature. Multi
+// and FIDO2 signatures are detected if the format isn't specified.
+func (s *service) verifyDetachedReader(ctx context.Context, sig []byte, reader io.Reader, armored bool, format SignFormat, namespace string) ([]keys.ID, error) {
+	if format == DefaultSignFormat {
+		if isMultiSig(sig) {
+			format = MultiSignFormat
+		} else if isFIDO2Sig(sig) {
+			format = FIDO2SignFormat
+		}
+	}
+	var kid keys.ID
+	var err error
+	switch format {
+	case MultiSignFormat:
+		return multiVerify(sig, reader)
+	case FIDO2SignFormat:
+		kid, err = fido2Verify(ctx, sig, reader)
+	case SSHSignFormat:
+		kid, err = sshVerify(sig, namespace, reader, armored)
+	default:
+		sp := saltpack.NewSaltpack(s.ks)
+		if armored {
+			kid, err = sp.VerifyArmoredDetachedReader(string(sig), reader)
+		} else {
+			kid, err = sp.VerifyDetachedReader(sig, reader)
+		}
 	}
-	return sp.VerifyDetachedReader(sig, reader)
+	if err != nil {
+		return nil, err
+	}
+	if kid == "" {
+		return []keys.ID{}, nil
+	}
+	return []keys.ID{kid}, nil
 }
 
-func (s *service) verifyWriteInOut(ctx context.Context, in string, out string, armored bool) (*Key, error) {
+func (s *service) verifyWriteInOut(ctx context.Context, in string, out string, armored bool, trust string) (*Key, error) {
 	logger.Infof("Verify %s to %s", in, out)
 
 	inFile, err := os.Open(in) // #nosec
@@ -309,6 +397,19 @@ func (s *service) verifyWriteInOut(ctx context.Context, in string, out string, a
 		return nil, err
 	}
 
+	var signer *Key
+	if kid != "" {
+		s, err := s.verifyKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		signer = s
+	}
+	// Check trust before writing any output.
+	if err := s.checkTrust(ctx, trust, signer); err != nil {
+		return nil, err
+	}
+
 	outTmp := out + ".tmp"
 	outFile, err := os.Create(outTmp)
 	if err != nil {
@@ -338,19 +439,10 @@ func (s *service) verifyWriteInOut(ctx context.Context, in string, out string, a
 		return nil, err
 	}
 
-	var signer *Key
-	if kid != "" {
-		s, err := s.verifyKey(ctx, kid)
-		if err != nil {
-			return nil, err
-		}
-		signer = s
-	}
-
 	return signer, nil
 }
 
-func (s *service) verifyDetachedIn(ctx context.Context, sig []byte, in string, armored bool) (*Key, error) {
+func (s *service) verifyDetachedIn(ctx context.Context, sig []byte, in string, armored bool, format SignFormat, namespace string, policy *SignPolicy) ([]*Key, error) {
 	logger.Infof("Verify (detached) %s", in)
 
 	inFile, err := os.Open(in) // #nosec
@@ -362,21 +454,13 @@ func (s *service) verifyDetachedIn(ctx context.Context, sig []byte, in string, a
 	}()
 	reader := bufio.NewReader(inFile)
 
-	kid, err := s.verifyDetachedReader(ctx, sig, reader, armored)
+	kids, err := s.verifyDetachedReader(ctx, sig, reader, armored, format, namespace)
 	if err != nil {
 		return nil, err
 	}
 	if err := inFile.Close(); err != nil {
 		return nil, err
 	}
-	var signer *Key
-	if kid != "" {
-		s, err := s.verifyKey(ctx, kid)
-		if err != nil {
-			return nil, err
-		}
-		signer = s
-	}
 
-	return signer, nil
+	return s.verifySigners(ctx, kids, policy)
 }